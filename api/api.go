@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/transactionLogs"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -149,6 +150,12 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	transactionLogsRoutes := ws.Group("/logs")
+	wrappedTransactionLogsRouter, err := wrapper.NewRouterWrapper("logs", transactionLogsRoutes, routesConfig)
+	if err == nil {
+		transactionLogs.Routes(wrappedTransactionLogsRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
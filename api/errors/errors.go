@@ -72,3 +72,24 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidEpoch signals an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrGetEconomicsBreakdown signals an error happening when trying to fetch the economics breakdown of an epoch
+var ErrGetEconomicsBreakdown = errors.New("getting economics breakdown failed")
+
+// ErrGetLogs signals an error happening when trying to search the transaction logs
+var ErrGetLogs = errors.New("getting logs failed")
+
+// ErrGetAntifloodStatus signals an error happening when trying to fetch the antiflood status
+var ErrGetAntifloodStatus = errors.New("getting antiflood status failed")
+
+// ErrAntifloodTuning signals an error happening when trying to change the antiflood settings
+var ErrAntifloodTuning = errors.New("changing antiflood settings failed")
+
+// ErrNodeIsNotHealthy signals that at least one of the node's liveness checks failed
+var ErrNodeIsNotHealthy = errors.New("node is not healthy")
+
+// ErrNodeIsNotReady signals that at least one of the node's readiness checks failed
+var ErrNodeIsNotReady = errors.New("node is not ready")
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/gin-gonic/gin"
 )
 
@@ -14,12 +15,14 @@ const (
 	execManualTrigger    = "executed, trigger is affecting only the current node"
 	execBroadcastTrigger = "executed, trigger is affecting current node and will get broadcast to other peers"
 	triggerPath          = "/trigger"
+	activationsPath      = "/activations"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
+	GetScheduledActivations() []*api.ScheduledActivation
 	IsInterfaceNil() bool
 }
 
@@ -32,6 +35,7 @@ type HarforkRequest struct {
 // Routes defines node related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodPost, triggerPath, Trigger)
+	router.RegisterHandler(http.MethodGet, activationsPath, GetScheduledActivations)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -112,3 +116,20 @@ func Trigger(c *gin.Context) {
 		},
 	)
 }
+
+// GetScheduledActivations returns the protocol activations scheduled by the accepted governance proposals
+func GetScheduledActivations(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"activations": facade.GetScheduledActivations()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
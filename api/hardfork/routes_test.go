@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +35,10 @@ type TriggerResponse struct {
 	Status string `json:"status"`
 }
 
+type ScheduledActivationsResponse struct {
+	Activations []*api.ScheduledActivation `json:"activations"`
+}
+
 func startNodeServer(handler hardfork.FacadeHandler) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -203,12 +208,48 @@ func TestTrigger_BroadcastShouldWork(t *testing.T) {
 	assert.Equal(t, hardfork.ExecBroadcastTrigger, triggerResponse.Status)
 }
 
+func TestGetScheduledActivations_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	activations := []*api.ScheduledActivation{
+		{
+			Name:            "HardFork",
+			Epoch:           10,
+			SoftwareVersion: "v1.2.0",
+			GitHubCommit:    "commit",
+			IsHardFork:      true,
+			Supported:       true,
+		},
+	}
+	ws := startNodeServer(&mock.HardforkFacade{
+		GetScheduledActivationsCalled: func() []*api.ScheduledActivation {
+			return activations
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/hardfork/activations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	activationsResponse := ScheduledActivationsResponse{}
+	mapResponseData := response.Data.(map[string]interface{})
+	mapResponseBytes, _ := json.Marshal(&mapResponseData)
+	_ = json.Unmarshal(mapResponseBytes, &activationsResponse)
+
+	assert.Equal(t, resp.Code, http.StatusOK)
+	assert.Equal(t, activations, activationsResponse.Activations)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
 			"hardfork": {
 				Routes: []config.RouteConfig{
 					{Name: "/trigger", Open: true},
+					{Name: "/activations", Open: true},
 				},
 			},
 		},
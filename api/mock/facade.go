@@ -51,6 +51,16 @@ type Facade struct {
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetTotalStakedValueHandler              func() (*big.Int, error)
+	GetEpochEconomicsCalled                 func(epoch uint32) (*api.EpochEconomics, error)
+	GetEpochRewardsCalled                   func(epoch uint32) (*api.EpochRewards, error)
+	GetLogsCalled                           func(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error)
+	GetConsensusRoundsCalled                func() []*api.ConsensusRound
+	GetAntifloodStatusCalled                func() (*api.AntifloodStatus, error)
+	SetAntifloodLimitsCalled                func(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicLimitCalled            func(topic string, maxMessagesPerPeer uint32) error
+	UnbanPeerCalled                         func(pid string) error
+	GetLivenessReportCalled                 func() *api.HealthReport
+	GetReadinessReportCalled                func() *api.HealthReport
 }
 
 // GetUsername -
@@ -271,6 +281,56 @@ func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	return f.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetEpochEconomics -
+func (f *Facade) GetEpochEconomics(epoch uint32) (*api.EpochEconomics, error) {
+	return f.GetEpochEconomicsCalled(epoch)
+}
+
+// GetEpochRewards -
+func (f *Facade) GetEpochRewards(epoch uint32) (*api.EpochRewards, error) {
+	return f.GetEpochRewardsCalled(epoch)
+}
+
+// GetLogs -
+func (f *Facade) GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error) {
+	return f.GetLogsCalled(address, identifier, fromNonce, toNonce)
+}
+
+// GetConsensusRounds -
+func (f *Facade) GetConsensusRounds() []*api.ConsensusRound {
+	return f.GetConsensusRoundsCalled()
+}
+
+// GetAntifloodStatus -
+func (f *Facade) GetAntifloodStatus() (*api.AntifloodStatus, error) {
+	return f.GetAntifloodStatusCalled()
+}
+
+// SetAntifloodLimits -
+func (f *Facade) SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	return f.SetAntifloodLimitsCalled(floodPreventer, maxMessagesPerPeer, maxTotalSizePerPeer)
+}
+
+// SetAntifloodTopicLimit -
+func (f *Facade) SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error {
+	return f.SetAntifloodTopicLimitCalled(topic, maxMessagesPerPeer)
+}
+
+// UnbanPeer -
+func (f *Facade) UnbanPeer(pid string) error {
+	return f.UnbanPeerCalled(pid)
+}
+
+// GetLivenessReport -
+func (f *Facade) GetLivenessReport() *api.HealthReport {
+	return f.GetLivenessReportCalled()
+}
+
+// GetReadinessReport -
+func (f *Facade) GetReadinessReport() *api.HealthReport {
+	return f.GetReadinessReportCalled()
+}
+
 // GetBlockByHash -
 func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByHashCalled(hash, withTxs)
@@ -1,9 +1,12 @@
 package mock
 
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
 // HardforkFacade -
 type HardforkFacade struct {
-	TriggerCalled       func(epoch uint32, withEarlyEndOfEpoch bool) error
-	IsSelfTriggerCalled func() bool
+	TriggerCalled                 func(epoch uint32, withEarlyEndOfEpoch bool) error
+	IsSelfTriggerCalled           func() bool
+	GetScheduledActivationsCalled func() []*api.ScheduledActivation
 }
 
 // Trigger -
@@ -24,6 +27,15 @@ func (hf *HardforkFacade) IsSelfTrigger() bool {
 	return false
 }
 
+// GetScheduledActivations -
+func (hf *HardforkFacade) GetScheduledActivations() []*api.ScheduledActivation {
+	if hf.GetScheduledActivationsCalled != nil {
+		return hf.GetScheduledActivationsCalled()
+	}
+
+	return make([]*api.ScheduledActivation, 0)
+}
+
 // IsInterfaceNil -
 func (hf *HardforkFacade) IsInterfaceNil() bool {
 	return hf == nil
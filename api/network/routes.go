@@ -1,26 +1,31 @@
 package network
 
 import (
+	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getConfigPath   = "/config"
-	getStatusPath   = "/status"
-	economicsPath   = "/economics"
-	totalStakedPath = "/total-staked"
+	getConfigPath      = "/config"
+	getStatusPath      = "/status"
+	economicsPath      = "/economics"
+	epochEconomicsPath = "/economics/epoch/:epoch"
+	totalStakedPath    = "/total-staked"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
+	GetEpochEconomics(epoch uint32) (*api.EpochEconomics, error)
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
@@ -30,6 +35,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getConfigPath, GetNetworkConfig)
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
+	router.RegisterHandler(http.MethodGet, epochEconomicsPath, GetEpochEconomics)
 	router.RegisterHandler(http.MethodGet, totalStakedPath, GetTotalStaked)
 }
 
@@ -117,6 +123,36 @@ func EconomicsMetrics(c *gin.Context) {
 	)
 }
 
+// GetEpochEconomics is the endpoint that will return the economics values computed at the start of an epoch
+func GetEpochEconomics(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	epochEconomics, err := facade.GetEpochEconomics(uint32(epoch))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEconomicsBreakdown.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"economics": epochEconomics}, "", shared.ReturnCodeSuccess)
+}
+
 // GetTotalStaked is the endpoint that will return the total staked value
 func GetTotalStaked(c *gin.Context) {
 	facade, ok := getFacade(c)
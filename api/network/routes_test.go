@@ -2,6 +2,7 @@ package network_test
 
 import (
 	"encoding/json"
+	goErrors "errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -19,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
@@ -210,6 +212,77 @@ func TestTotalStaked_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestGetEpochEconomics_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/economics/epoch/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrInvalidEpoch.Error()))
+}
+
+func TestGetEpochEconomics_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := goErrors.New("not on metachain")
+	facade := &mock.Facade{
+		GetEpochEconomicsCalled: func(epoch uint32) (*api.EpochEconomics, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/economics/epoch/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetEpochEconomics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epochEconomics := &api.EpochEconomics{
+		Epoch:             3,
+		TotalSupply:       "2000",
+		TotalToDistribute: "100",
+		Inflation:         "80",
+		BaseRewards:       "50",
+		TopUpRewards:      "10",
+		NumberOfBlocks:    40,
+	}
+	facade := &mock.Facade{
+		GetEpochEconomicsCalled: func(epoch uint32) (*api.EpochEconomics, error) {
+			if epoch != 3 {
+				return nil, goErrors.New("unexpected epoch")
+			}
+			return epochEconomics, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/economics/epoch/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Economics *api.EpochEconomics `json:"economics"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, epochEconomics, response.Data.Economics)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -259,6 +332,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/config", Open: true},
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
+					{Name: "/economics/epoch/:epoch", Open: true},
 					{Name: "/total-staked", Open: true},
 				},
 			},
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -18,11 +19,18 @@ import (
 
 const (
 	pidQueryParam       = "pid"
+	antifloodPath       = "/antiflood"
+	antifloodLimitsPath = "/antiflood/limits"
+	antifloodTopicPath  = "/antiflood/topic"
+	antifloodUnbanPath  = "/antiflood/unban"
+	consensusRoundsPath = "/consensus/rounds"
 	debugPath           = "/debug"
+	healthPath          = "/health"
 	heartbeatStatusPath = "/heartbeatstatus"
 	metricsPath         = "/metrics"
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
+	readyPath           = "/ready"
 	statisticsPath      = "/statistics"
 	statusPath          = "/status"
 )
@@ -42,6 +50,13 @@ type FacadeHandler interface {
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
+	GetConsensusRounds() []*api.ConsensusRound
+	GetAntifloodStatus() (*api.AntifloodStatus, error)
+	SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error
+	UnbanPeer(pid string) error
+	GetLivenessReport() *api.HealthReport
+	GetReadinessReport() *api.HealthReport
 	IsInterfaceNil() bool
 }
 
@@ -51,6 +66,24 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// AntifloodLimitsRequest represents the structure used to change the limits of an antiflood flood preventer
+type AntifloodLimitsRequest struct {
+	FloodPreventer      string `json:"floodPreventer"`
+	MaxMessagesPerPeer  uint32 `json:"maxMessagesPerPeer"`
+	MaxTotalSizePerPeer uint64 `json:"maxTotalSizePerPeer"`
+}
+
+// AntifloodTopicRequest represents the structure used to change the maximum number of messages per peer on a topic
+type AntifloodTopicRequest struct {
+	Topic              string `json:"topic"`
+	MaxMessagesPerPeer uint32 `json:"maxMessagesPerPeer"`
+}
+
+// AntifloodUnbanRequest represents the structure used to remove a peer from the antiflood black list
+type AntifloodUnbanRequest struct {
+	Pid string `json:"pid"`
+}
+
 type statisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
@@ -84,6 +117,13 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, consensusRoundsPath, ConsensusRounds)
+	router.RegisterHandler(http.MethodGet, antifloodPath, AntifloodStatus)
+	router.RegisterHandler(http.MethodPost, antifloodLimitsPath, SetAntifloodLimits)
+	router.RegisterHandler(http.MethodPost, antifloodTopicPath, SetAntifloodTopicLimit)
+	router.RegisterHandler(http.MethodPost, antifloodUnbanPath, UnbanPeer)
+	router.RegisterHandler(http.MethodGet, healthPath, Health)
+	router.RegisterHandler(http.MethodGet, readyPath, Ready)
 	// placeholder for custom routes
 }
 
@@ -326,3 +366,185 @@ func PrometheusMetrics(c *gin.Context) {
 		metrics,
 	)
 }
+
+// ConsensusRounds returns the timeline of the last consensus rounds: the leader, the arrival times of the consensus
+// messages, the gathered signatures and the outcome of each round
+func ConsensusRounds(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rounds": facade.GetConsensusRounds()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// AntifloodStatus returns the per-peer and per-topic counters of the input antiflood along with the blacklisted peers,
+// the reasons they were blacklisted for and the ban expiry
+func AntifloodStatus(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	status, err := facade.GetAntifloodStatus()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAntifloodStatus.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"antiflood": status},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// SetAntifloodLimits changes, without a node restart, the limits of one of the antiflood flood preventers
+func SetAntifloodLimits(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	request := AntifloodLimitsRequest{}
+	if !bindJSONRequest(c, &request) {
+		return
+	}
+
+	err := facade.SetAntifloodLimits(request.FloodPreventer, request.MaxMessagesPerPeer, request.MaxTotalSizePerPeer)
+	respondToAntifloodTuning(c, err)
+}
+
+// SetAntifloodTopicLimit changes, without a node restart, the maximum number of messages accepted from a peer on a topic
+func SetAntifloodTopicLimit(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	request := AntifloodTopicRequest{}
+	if !bindJSONRequest(c, &request) {
+		return
+	}
+
+	err := facade.SetAntifloodTopicLimit(request.Topic, request.MaxMessagesPerPeer)
+	respondToAntifloodTuning(c, err)
+}
+
+// UnbanPeer removes a peer from the antiflood black list before its ban expires
+func UnbanPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	request := AntifloodUnbanRequest{}
+	if !bindJSONRequest(c, &request) {
+		return
+	}
+
+	err := facade.UnbanPeer(request.Pid)
+	respondToAntifloodTuning(c, err)
+}
+
+func bindJSONRequest(c *gin.Context, request interface{}) bool {
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return false
+	}
+
+	return true
+}
+
+func respondToAntifloodTuning(c *gin.Context, err error) {
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrAntifloodTuning.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": "ok"},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// Health returns the results of the liveness checks. The status code is 503 if any check failed, so the endpoint can
+// be directly used as a liveness probe
+func Health(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetLivenessReport(), errors.ErrNodeIsNotHealthy)
+}
+
+// Ready returns the results of both the liveness and the readiness checks. The status code is 503 if any check
+// failed, so that load balancers route requests only to the nodes able to serve them
+func Ready(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetReadinessReport(), errors.ErrNodeIsNotReady)
+}
+
+func respondWithHealthReport(c *gin.Context, report *api.HealthReport, errUnhealthy error) {
+	if report.Status != api.HealthStatusHealthy {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"report": report},
+				Error: errUnhealthy.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"report": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
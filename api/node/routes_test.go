@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -499,6 +500,332 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestConsensusRounds_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+}
+
+func TestConsensusRounds_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedRounds := []*api.ConsensusRound{
+		{
+			Round:               5,
+			Leader:              "6c6561646572",
+			ConsensusGroupSize:  3,
+			NumSignatures:       3,
+			SignaturesThreshold: 3,
+			Outcome:             "committed",
+			Messages: []*api.ConsensusRoundMessage{
+				{PubKey: "6c6561646572", MessageType: "(BLOCK_HEADER)", ArrivalMs: 120},
+			},
+		},
+	}
+	facade := &mock.Facade{
+		GetConsensusRoundsCalled: func() []*api.ConsensusRound {
+			return expectedRounds
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type roundsResponse struct {
+		Data struct {
+			Rounds []*api.ConsensusRound `json:"rounds"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	response := roundsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, expectedRounds, response.Data.Rounds)
+}
+
+type healthReportResponse struct {
+	Data struct {
+		Report *api.HealthReport `json:"report"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestHealth_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/node/health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+}
+
+func TestHealth_HealthyShouldReturnOk(t *testing.T) {
+	t.Parallel()
+
+	report := &api.HealthReport{
+		Status: api.HealthStatusHealthy,
+		Checks: []*api.HealthCheckResult{
+			{Name: "diskSpace", Status: api.HealthStatusHealthy},
+		},
+	}
+	facade := &mock.Facade{
+		GetLivenessReportCalled: func() *api.HealthReport {
+			return report
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := healthReportResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, report, response.Data.Report)
+}
+
+func TestHealth_UnhealthyShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	report := &api.HealthReport{
+		Status: api.HealthStatusUnhealthy,
+		Checks: []*api.HealthCheckResult{
+			{Name: "diskSpace", Status: api.HealthStatusUnhealthy, Message: "not enough free disk space"},
+		},
+	}
+	facade := &mock.Facade{
+		GetLivenessReportCalled: func() *api.HealthReport {
+			return report
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := healthReportResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrNodeIsNotHealthy.Error(), response.Error)
+	assert.Equal(t, report, response.Data.Report)
+}
+
+func TestReady_NotReadyShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	report := &api.HealthReport{
+		Status: api.HealthStatusUnhealthy,
+		Checks: []*api.HealthCheckResult{
+			{Name: "diskSpace", Status: api.HealthStatusHealthy},
+			{Name: "syncStatus", Status: api.HealthStatusUnhealthy, Message: "node is syncing"},
+		},
+	}
+	facade := &mock.Facade{
+		GetReadinessReportCalled: func() *api.HealthReport {
+			return report
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := healthReportResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrNodeIsNotReady.Error(), response.Error)
+	assert.Equal(t, report, response.Data.Report)
+}
+
+func TestReady_ReadyShouldReturnOk(t *testing.T) {
+	t.Parallel()
+
+	report := &api.HealthReport{Status: api.HealthStatusHealthy, Checks: []*api.HealthCheckResult{}}
+	facade := &mock.Facade{
+		GetReadinessReportCalled: func() *api.HealthReport {
+			return report
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := healthReportResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, report, response.Data.Report)
+}
+
+func TestAntifloodStatus_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetAntifloodStatusCalled: func() (*api.AntifloodStatus, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/antiflood", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestAntifloodStatus_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &api.AntifloodStatus{
+		FloodPreventers: []*api.FloodPreventerStatus{
+			{
+				Name:                      "fast_reacting",
+				BaseMaxNumMessagesPerPeer: 75,
+				Peers:                     []*api.FloodPreventerPeerQuota{{Pid: "pid", NumReceived: 4}},
+			},
+		},
+		Topics: []*api.TopicFloodPreventerStatus{
+			{Topic: "transactions", MaxMessagesPerPeer: 30, NumMessagesPerPeer: map[string]uint32{"pid": 3}},
+		},
+		BlacklistedPeers: []*api.BlacklistedPeer{{Pid: "pid2", Reason: "flooding", ExpiresAt: 100}},
+	}
+	facade := &mock.Facade{
+		GetAntifloodStatusCalled: func() (*api.AntifloodStatus, error) {
+			return expectedStatus, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/node/antiflood", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type antifloodResponse struct {
+		Data struct {
+			Antiflood *api.AntifloodStatus `json:"antiflood"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	response := antifloodResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedStatus, response.Data.Antiflood)
+}
+
+func TestSetAntifloodLimits_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("POST", "/node/antiflood/limits", bytes.NewBuffer([]byte("invalid json")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestSetAntifloodLimits_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedRequest node.AntifloodLimitsRequest
+	facade := &mock.Facade{
+		SetAntifloodLimitsCalled: func(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+			receivedRequest = node.AntifloodLimitsRequest{
+				FloodPreventer:      floodPreventer,
+				MaxMessagesPerPeer:  maxMessagesPerPeer,
+				MaxTotalSizePerPeer: maxTotalSizePerPeer,
+			}
+			return nil
+		},
+	}
+	request := node.AntifloodLimitsRequest{
+		FloodPreventer:      "slow_reacting",
+		MaxMessagesPerPeer:  400,
+		MaxTotalSizePerPeer: 10000,
+	}
+	buff, _ := json.Marshal(&request)
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("POST", "/node/antiflood/limits", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, request, receivedRequest)
+}
+
+func TestSetAntifloodTopicLimit_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		SetAntifloodTopicLimitCalled: func(topic string, maxMessagesPerPeer uint32) error {
+			return expectedErr
+		},
+	}
+	buff, _ := json.Marshal(&node.AntifloodTopicRequest{Topic: "transactions", MaxMessagesPerPeer: 10})
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("POST", "/node/antiflood/topic", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrAntifloodTuning.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestUnbanPeer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	unbannedPid := ""
+	facade := &mock.Facade{
+		UnbanPeerCalled: func(pid string) error {
+			unbannedPid = pid
+			return nil
+		},
+	}
+	buff, _ := json.Marshal(&node.AntifloodUnbanRequest{Pid: "pid"})
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("POST", "/node/antiflood/unban", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "pid", unbannedPid)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -559,6 +886,13 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/antiflood", Open: true},
+					{Name: "/antiflood/limits", Open: true},
+					{Name: "/antiflood/topic", Open: true},
+					{Name: "/antiflood/unban", Open: true},
+					{Name: "/health", Open: true},
+					{Name: "/ready", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,112 @@
+package transactionLogs
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	searchLogsPath = ""
+
+	queryParamAddress    = "address"
+	queryParamIdentifier = "identifier"
+	queryParamFromNonce  = "fromNonce"
+	queryParamToNonce    = "toNonce"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines the transaction logs related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, searchLogsPath, SearchLogs)
+}
+
+// SearchLogs will return the events generated in a range of blocks that match the provided address and identifier
+func SearchLogs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	fromNonce, err := getQueryParamNonce(c, queryParamFromNonce)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	toNonce, err := getQueryParamNonce(c, queryParamToNonce)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	address := c.Request.URL.Query().Get(queryParamAddress)
+	identifier := c.Request.URL.Query().Get(queryParamIdentifier)
+	logEvents, err := facade.GetLogs(address, identifier, fromNonce, toNonce)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetLogs.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"logs": logEvents}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamNonce(c *gin.Context, name string) (uint64, error) {
+	nonceStr := c.Request.URL.Query().Get(name)
+	if nonceStr == "" {
+		return 0, errors.ErrInvalidBlockNonce
+	}
+
+	return strconv.ParseUint(nonceStr, 10, 64)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,167 @@
+package transactionLogs_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/transactionLogs"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type logsResponseData struct {
+	Logs []*api.LogEvent `json:"logs"`
+}
+
+type logsResponse struct {
+	Data  logsResponseData `json:"data"`
+	Error string           `json:"error"`
+	Code  string           `json:"code"`
+}
+
+func TestSearchLogs_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/logs?address=erd1&fromNonce=1&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestSearchLogs_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetLogsCalled: func(_ string, _ string, _ uint64, _ uint64) ([]*api.LogEvent, error) {
+			return make([]*api.LogEvent, 0), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/logs?address=erd1&fromNonce=invalid&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestSearchLogs_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetLogsCalled: func(_ string, _ string, _ uint64, _ uint64) ([]*api.LogEvent, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/logs?identifier=transfer&fromNonce=1&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSearchLogs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedLogs := []*api.LogEvent{
+		{BlockNonce: 5, TxHash: "abcd", Address: "erd1", Identifier: "transfer"},
+	}
+	facade := mock.Facade{
+		GetLogsCalled: func(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error) {
+			assert.Equal(t, "erd1", address)
+			assert.Equal(t, "transfer", identifier)
+			assert.Equal(t, uint64(3), fromNonce)
+			assert.Equal(t, uint64(7), toNonce)
+			return expectedLogs, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/logs?address=erd1&identifier=transfer&fromNonce=3&toNonce=7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedLogs, response.Data.Logs)
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler transactionLogs.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	logsRoutes := ws.Group("/logs")
+	if handler != nil {
+		logsRoutes.Use(middleware.WithFacade(handler))
+	}
+	logsRoute, _ := wrapper.NewRouterWrapper("logs", logsRoutes, getRoutesConfig())
+	transactionLogs.Routes(logsRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginLogsRoute := ws.Group("/logs")
+	logsRoute, _ := wrapper.NewRouterWrapper("logs", ginLogsRoute, getRoutesConfig())
+	transactionLogs.Routes(logsRoute)
+	return ws
+}
@@ -1,26 +1,34 @@
 package validator
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath = "/statistics"
+	rewardsPath    = "/rewards/:epoch"
+)
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetEpochRewards(epoch uint32) (*api.EpochRewards, error)
 	IsInterfaceNil() bool
 }
 
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	router.RegisterHandler(http.MethodGet, rewardsPath, Rewards)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -82,3 +90,33 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+// Rewards will return the rewards computed for each node and each reward address at the start of an epoch
+func Rewards(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	epochRewards, err := facade.GetEpochRewards(uint32(epoch))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEconomicsBreakdown.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"rewards": epochRewards}, "", shared.ReturnCodeSuccess)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -113,6 +114,73 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+func TestValidatorRewards_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/validator/rewards/-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+}
+
+func TestValidatorRewards_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.Facade{
+		GetEpochRewardsCalled: func(epoch uint32) (*api.EpochRewards, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/rewards/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestValidatorRewards_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	epochRewards := &api.EpochRewards{
+		Epoch: 2,
+		Nodes: []*api.NodeRewards{
+			{BlsKey: "bls", Shard: 1, RewardAddress: "owner", BaseReward: "10", TopUpReward: "5", Rewarded: true},
+		},
+		Addresses: []*api.AddressRewards{
+			{Address: "owner", BaseRewards: "10", TopUpRewards: "5", TotalRewards: "15", NumNodes: 1, Distributed: true},
+		},
+	}
+	facade := mock.Facade{
+		GetEpochRewardsCalled: func(epoch uint32) (*api.EpochRewards, error) {
+			return epochRewards, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/validator/rewards/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Rewards *api.EpochRewards `json:"rewards"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, epochRewards, response.Data.Rewards)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -157,6 +225,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/rewards/:epoch", Open: true},
 				},
 			},
 		},
@@ -6,6 +6,8 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForShufflerSim
+    generateForRatingSim
 }
 
 generateForNode() {
@@ -78,6 +80,34 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForShufflerSim() {
+    HELP="
+# Elrond Shuffler simulator CLI
+
+The **Elrond Shuffler simulator** exposes the following Command Line Interface:
+$(code)
+\$ shufflersim --help
+
+$(./shufflersim/shufflersim --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./shufflersim/CLI.md
+}
+
+generateForRatingSim() {
+    HELP="
+# Elrond Rating simulator CLI
+
+The **Elrond Rating simulator** exposes the following Command Line Interface:
+$(code)
+\$ ratingsim --help
+
+$(./ratingsim/ratingsim --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./ratingsim/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
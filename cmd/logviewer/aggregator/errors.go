@@ -0,0 +1,30 @@
+package aggregator
+
+import "errors"
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilLinesHandler signals that a nil lines handler function has been provided
+var ErrNilLinesHandler = errors.New("nil lines handler")
+
+// ErrNilLineFilter signals that a nil line filter has been provided
+var ErrNilLineFilter = errors.New("nil line filter")
+
+// ErrNilLineOutput signals that a nil line output has been provided
+var ErrNilLineOutput = errors.New("nil line output")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNoNodeAddresses signals that no node address has been provided
+var ErrNoNodeAddresses = errors.New("no node addresses")
+
+// ErrUnknownOutputFormat signals that an unknown output format has been provided
+var ErrUnknownOutputFormat = errors.New("unknown output format")
@@ -0,0 +1,14 @@
+package aggregator
+
+// LineFilter decides which of the received log lines are kept
+type LineFilter interface {
+	Matches(line *TaggedLogLine) bool
+	IsInterfaceNil() bool
+}
+
+// LineOutput is a destination of the merged log lines
+type LineOutput interface {
+	Output(line *TaggedLogLine) error
+	Close() error
+	IsInterfaceNil() bool
+}
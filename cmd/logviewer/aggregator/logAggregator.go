@@ -0,0 +1,174 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const minFlushInterval = time.Millisecond * 10
+
+// ArgsLogAggregator is the DTO used to create a new log aggregator
+type ArgsLogAggregator struct {
+	Addresses        []string
+	UseWss           bool
+	Profile          *logger.Profile
+	Marshalizer      marshal.Marshalizer
+	RetryDuration    time.Duration
+	MergeDelay       time.Duration
+	FlushInterval    time.Duration
+	MaxBufferedLines int
+	Filter           LineFilter
+	Outputs          []LineOutput
+}
+
+type logAggregator struct {
+	listeners     []*nodeListener
+	merger        *logMerger
+	filter        LineFilter
+	outputs       []LineOutput
+	flushInterval time.Duration
+	cancelFunc    context.CancelFunc
+	chStopped     chan struct{}
+}
+
+// NewLogAggregator creates a component that streams the logs of several nodes at once, keeps the lines accepted by
+// the filter and writes them, merged in timestamp order, to all the outputs
+func NewLogAggregator(args ArgsLogAggregator) (*logAggregator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	merger, err := NewLogMerger(args.MergeDelay, args.MaxBufferedLines)
+	if err != nil {
+		return nil, err
+	}
+
+	la := &logAggregator{
+		merger:        merger,
+		filter:        args.Filter,
+		outputs:       args.Outputs,
+		flushInterval: args.FlushInterval,
+		listeners:     make([]*nodeListener, 0, len(args.Addresses)),
+		chStopped:     make(chan struct{}),
+	}
+
+	for _, address := range args.Addresses {
+		listener, errCreate := NewNodeListener(ArgsNodeListener{
+			Address:       address,
+			UseWss:        args.UseWss,
+			Profile:       args.Profile,
+			Marshalizer:   args.Marshalizer,
+			RetryDuration: args.RetryDuration,
+			LinesHandler:  la.handleLine,
+		})
+		if errCreate != nil {
+			return nil, fmt.Errorf("%w for node %s", errCreate, address)
+		}
+
+		la.listeners = append(la.listeners, listener)
+	}
+
+	return la, nil
+}
+
+func checkArgs(args ArgsLogAggregator) error {
+	if len(args.Addresses) == 0 {
+		return ErrNoNodeAddresses
+	}
+	if check.IfNil(args.Filter) {
+		return ErrNilLineFilter
+	}
+	if len(args.Outputs) == 0 {
+		return ErrNilLineOutput
+	}
+	for _, output := range args.Outputs {
+		if check.IfNil(output) {
+			return ErrNilLineOutput
+		}
+	}
+	if args.FlushInterval < minFlushInterval {
+		return fmt.Errorf("%w for the flush interval, minimum %v, provided %v",
+			ErrInvalidValue, minFlushInterval, args.FlushInterval)
+	}
+
+	return nil
+}
+
+func (la *logAggregator) handleLine(line *TaggedLogLine) {
+	if !la.filter.Matches(line) {
+		return
+	}
+
+	la.merger.Add(line, time.Now())
+}
+
+// Start connects to all the nodes and starts writing the merged lines
+func (la *logAggregator) Start() {
+	var ctx context.Context
+	ctx, la.cancelFunc = context.WithCancel(context.Background())
+
+	for _, listener := range la.listeners {
+		listener.StartListening()
+	}
+
+	go la.flushLoop(ctx)
+}
+
+func (la *logAggregator) flushLoop(ctx context.Context) {
+	defer close(la.chStopped)
+
+	for {
+		select {
+		case <-ctx.Done():
+			la.writeLines(la.merger.PopAll())
+			return
+		case now := <-time.After(la.flushInterval):
+			la.writeLines(la.merger.PopReady(now))
+		}
+	}
+}
+
+func (la *logAggregator) writeLines(lines []*TaggedLogLine) {
+	for _, line := range lines {
+		for _, output := range la.outputs {
+			err := output.Output(line)
+			if err != nil {
+				log.Debug("can not output log line", "node", line.Node, "error", err.Error())
+			}
+		}
+	}
+}
+
+// Close disconnects from the nodes, writes the buffered lines and closes all the outputs
+func (la *logAggregator) Close() error {
+	for _, listener := range la.listeners {
+		err := listener.Close()
+		log.LogIfError(err, "node", listener.address)
+	}
+
+	if la.cancelFunc != nil {
+		la.cancelFunc()
+		<-la.chStopped
+	}
+
+	var lastErr error
+	for _, output := range la.outputs {
+		err := output.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (la *logAggregator) IsInterfaceNil() bool {
+	return la == nil
+}
@@ -0,0 +1,89 @@
+package aggregator
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+)
+
+// ArgsLogFilter is the DTO used to create a new log filter. An empty pattern matches everything
+type ArgsLogFilter struct {
+	MessagePattern     string
+	LoggerNamePattern  string
+	CorrelationPattern string
+}
+
+type logFilter struct {
+	message     *regexp.Regexp
+	loggerName  *regexp.Regexp
+	correlation *regexp.Regexp
+}
+
+// NewLogFilter creates a filter that keeps the log lines matching all the provided regular expressions. The
+// correlation pattern is matched against the shard/epoch/round/subround string, as the node displays it
+func NewLogFilter(args ArgsLogFilter) (*logFilter, error) {
+	lf := &logFilter{}
+
+	var err error
+	lf.message, err = compilePattern(args.MessagePattern, "message")
+	if err != nil {
+		return nil, err
+	}
+	lf.loggerName, err = compilePattern(args.LoggerNamePattern, "logger name")
+	if err != nil {
+		return nil, err
+	}
+	lf.correlation, err = compilePattern(args.CorrelationPattern, "correlation")
+	if err != nil {
+		return nil, err
+	}
+
+	return lf, nil
+}
+
+func compilePattern(pattern string, field string) (*regexp.Regexp, error) {
+	if len(pattern) == 0 {
+		return nil, nil
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the %s filter: %s", ErrInvalidValue, field, err.Error())
+	}
+
+	return regex, nil
+}
+
+// Matches returns true if the log line should be kept
+func (lf *logFilter) Matches(line *TaggedLogLine) bool {
+	if line == nil || line.Line == nil {
+		return false
+	}
+
+	if lf.message != nil && !lf.message.MatchString(line.Line.Message) {
+		return false
+	}
+	if lf.loggerName != nil && !lf.loggerName.MatchString(line.Line.LoggerName) {
+		return false
+	}
+	if lf.correlation != nil && !lf.correlation.MatchString(correlationString(line)) {
+		return false
+	}
+
+	return true
+}
+
+// correlationString returns the shard/epoch/round/subround string of the line, without the block the node carries in
+// the subround element
+func correlationString(line *TaggedLogLine) string {
+	correlation := line.Line.Correlation
+	subRound, _, _ := logging.SplitCorrelationSubround(correlation.SubRound)
+
+	return fmt.Sprintf("%s/%d/%d/%s", correlation.Shard, correlation.Epoch, correlation.Round, subRound)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lf *logFilter) IsInterfaceNil() bool {
+	return lf == nil
+}
@@ -0,0 +1,89 @@
+package aggregator
+
+import (
+	"errors"
+	"testing"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go-logger/proto"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTaggedLogLine(node string, timestamp int64, message string) *TaggedLogLine {
+	return &TaggedLogLine{
+		Node: node,
+		Line: &logger.LogLineWrapper{
+			LogLineMessage: proto.LogLineMessage{
+				LoggerName: "process/block",
+				Correlation: proto.LogCorrelationMessage{
+					Shard:    "0",
+					Epoch:    3,
+					Round:    120,
+					SubRound: "(BLOCK)",
+				},
+				Message:   message,
+				LogLevel:  int32(logger.LogInfo),
+				Args:      []string{"nonce", "7"},
+				Timestamp: timestamp,
+			},
+		},
+	}
+}
+
+func TestNewLogFilter_InvalidPatternShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lf, err := NewLogFilter(ArgsLogFilter{LoggerNamePattern: "process/("})
+
+	assert.True(t, check.IfNil(lf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestLogFilter_EmptyPatternsShouldMatchEverything(t *testing.T) {
+	t.Parallel()
+
+	lf, err := NewLogFilter(ArgsLogFilter{})
+
+	assert.False(t, check.IfNil(lf))
+	assert.Nil(t, err)
+	assert.True(t, lf.Matches(createTaggedLogLine("node", 1, "message")))
+	assert.False(t, lf.Matches(&TaggedLogLine{}))
+}
+
+func TestLogFilter_MatchesShouldCheckAllPatterns(t *testing.T) {
+	t.Parallel()
+
+	lf, _ := NewLogFilter(ArgsLogFilter{
+		MessagePattern:     "^block",
+		LoggerNamePattern:  "^process/",
+		CorrelationPattern: "^0/3/",
+	})
+
+	line := createTaggedLogLine("node", 1, "block committed")
+	assert.True(t, lf.Matches(line))
+
+	line = createTaggedLogLine("node", 1, "proposed block")
+	assert.False(t, lf.Matches(line))
+
+	line = createTaggedLogLine("node", 1, "block committed")
+	line.Line.LoggerName = "main"
+	assert.False(t, lf.Matches(line))
+
+	line = createTaggedLogLine("node", 1, "block committed")
+	line.Line.Correlation.Shard = "metachain"
+	assert.False(t, lf.Matches(line))
+}
+
+func TestLogFilter_MatchesShouldIgnoreTheBlockCarriedInTheSubround(t *testing.T) {
+	t.Parallel()
+
+	lf, _ := NewLogFilter(ArgsLogFilter{CorrelationPattern: `^0/3/120/\(BLOCK\)$`})
+
+	line := createTaggedLogLine("node", 1, "block committed")
+	line.Line.Correlation.SubRound = "(BLOCK)/56/aabbcc..eeff11"
+	assert.True(t, lf.Matches(line))
+
+	line.Line.Correlation.SubRound = "(SIGNATURE)/56/aabbcc..eeff11"
+	assert.False(t, lf.Matches(line))
+}
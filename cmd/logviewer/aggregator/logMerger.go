@@ -0,0 +1,117 @@
+package aggregator
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type linesHeap []*TaggedLogLine
+
+// Len returns the number of buffered lines
+func (lh linesHeap) Len() int {
+	return len(lh)
+}
+
+// Less orders the lines by their timestamp and, on equal timestamps, by their arrival time
+func (lh linesHeap) Less(i, j int) bool {
+	if lh[i].Line.Timestamp == lh[j].Line.Timestamp {
+		return lh[i].receivedAt.Before(lh[j].receivedAt)
+	}
+
+	return lh[i].Line.Timestamp < lh[j].Line.Timestamp
+}
+
+// Swap swaps two lines
+func (lh linesHeap) Swap(i, j int) {
+	lh[i], lh[j] = lh[j], lh[i]
+}
+
+// Push adds a line at the end of the heap
+func (lh *linesHeap) Push(x interface{}) {
+	*lh = append(*lh, x.(*TaggedLogLine))
+}
+
+// Pop removes the last line of the heap
+func (lh *linesHeap) Pop() interface{} {
+	old := *lh
+	n := len(old)
+	line := old[n-1]
+	old[n-1] = nil
+	*lh = old[:n-1]
+
+	return line
+}
+
+type logMerger struct {
+	mergeDelay       time.Duration
+	maxBufferedLines int
+
+	mutLines sync.Mutex
+	lines    linesHeap
+}
+
+// NewLogMerger creates a component that merges the log lines coming from several nodes in timestamp order. Each
+// line is held for the merge delay so the slower streams have the chance to deliver their older lines. Lines that
+// arrive later than that are released as soon as possible, so the order is only guaranteed inside the delay window
+func NewLogMerger(mergeDelay time.Duration, maxBufferedLines int) (*logMerger, error) {
+	if mergeDelay < 0 {
+		return nil, fmt.Errorf("%w for the merge delay: %v", ErrInvalidValue, mergeDelay)
+	}
+	if maxBufferedLines < 1 {
+		return nil, fmt.Errorf("%w for the maximum number of buffered lines: %d", ErrInvalidValue, maxBufferedLines)
+	}
+
+	return &logMerger{
+		mergeDelay:       mergeDelay,
+		maxBufferedLines: maxBufferedLines,
+		lines:            make(linesHeap, 0),
+	}, nil
+}
+
+// Add buffers the provided line
+func (lm *logMerger) Add(line *TaggedLogLine, receivedAt time.Time) {
+	if line == nil || line.Line == nil {
+		return
+	}
+
+	line.receivedAt = receivedAt
+
+	lm.mutLines.Lock()
+	heap.Push(&lm.lines, line)
+	lm.mutLines.Unlock()
+}
+
+// PopReady returns, in timestamp order, the lines that have waited the merge delay. When the buffer is full, the
+// oldest lines are returned regardless of their waiting time
+func (lm *logMerger) PopReady(now time.Time) []*TaggedLogLine {
+	lm.mutLines.Lock()
+	defer lm.mutLines.Unlock()
+
+	ready := make([]*TaggedLogLine, 0)
+	for lm.lines.Len() > 0 {
+		oldest := lm.lines[0]
+		bufferFull := lm.lines.Len() > lm.maxBufferedLines
+		if !bufferFull && now.Sub(oldest.receivedAt) < lm.mergeDelay {
+			break
+		}
+
+		ready = append(ready, heap.Pop(&lm.lines).(*TaggedLogLine))
+	}
+
+	return ready
+}
+
+// PopAll returns, in timestamp order, all the buffered lines
+func (lm *logMerger) PopAll() []*TaggedLogLine {
+	lm.mutLines.Lock()
+	defer lm.mutLines.Unlock()
+
+	all := make([]*TaggedLogLine, 0, lm.lines.Len())
+	for lm.lines.Len() > 0 {
+		all = append(all, heap.Pop(&lm.lines).(*TaggedLogLine))
+	}
+
+	return all
+}
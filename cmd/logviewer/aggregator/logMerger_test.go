@@ -0,0 +1,63 @@
+package aggregator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func messages(lines []*TaggedLogLine) []string {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		result = append(result, line.Line.Message)
+	}
+
+	return result
+}
+
+func TestNewLogMerger_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lm, err := NewLogMerger(-time.Second, 10)
+	assert.Nil(t, lm)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	lm, err = NewLogMerger(time.Second, 0)
+	assert.Nil(t, lm)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestLogMerger_PopReadyShouldReturnTheWaitedLinesInTimestampOrder(t *testing.T) {
+	t.Parallel()
+
+	lm, err := NewLogMerger(time.Second, 10)
+	require.Nil(t, err)
+
+	now := time.Now()
+	lm.Add(createTaggedLogLine("node1", 30, "c"), now)
+	lm.Add(createTaggedLogLine("node2", 10, "a"), now.Add(time.Millisecond))
+	lm.Add(createTaggedLogLine("node1", 20, "b"), now.Add(time.Millisecond*2))
+	lm.Add(createTaggedLogLine("node2", 40, "d"), now.Add(time.Second))
+	lm.Add(nil, now)
+
+	assert.Equal(t, 0, len(lm.PopReady(now.Add(time.Millisecond*500))))
+	assert.Equal(t, []string{"a", "b", "c"}, messages(lm.PopReady(now.Add(time.Second+time.Millisecond*2))))
+	assert.Equal(t, []string{"d"}, messages(lm.PopAll()))
+	assert.Equal(t, 0, len(lm.PopAll()))
+}
+
+func TestLogMerger_PopReadyWithFullBufferShouldReleaseTheOldestLines(t *testing.T) {
+	t.Parallel()
+
+	lm, _ := NewLogMerger(time.Hour, 2)
+
+	now := time.Now()
+	lm.Add(createTaggedLogLine("node1", 20, "b"), now)
+	lm.Add(createTaggedLogLine("node2", 10, "a"), now)
+	lm.Add(createTaggedLogLine("node1", 30, "c"), now)
+
+	assert.Equal(t, []string{"a"}, messages(lm.PopReady(now)))
+}
@@ -0,0 +1,62 @@
+package aggregator
+
+import (
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+type loggerOutput struct {
+	log         logger.Logger
+	withNodeTag bool
+}
+
+// NewLoggerOutput creates an output that re-logs the received lines through the provided logger, so they get
+// displayed by its observers (the console, usually). The node tag is prepended to the message, if required
+func NewLoggerOutput(log logger.Logger, withNodeTag bool) (*loggerOutput, error) {
+	if check.IfNil(log) {
+		return nil, ErrNilLogger
+	}
+
+	return &loggerOutput{
+		log:         log,
+		withNodeTag: withNodeTag,
+	}, nil
+}
+
+// Output logs the provided line
+func (lo *loggerOutput) Output(line *TaggedLogLine) error {
+	recoveredLogLine := &logger.LogLine{
+		LoggerName:  line.Line.LoggerName,
+		Correlation: line.Line.Correlation,
+		Message:     line.Line.Message,
+		LogLevel:    logger.LogLevel(line.Line.LogLevel),
+		Args:        make([]interface{}, len(line.Line.Args)),
+		Timestamp:   time.Unix(0, line.Line.Timestamp),
+	}
+	for i, str := range line.Line.Args {
+		recoveredLogLine.Args[i] = str
+	}
+	if lo.withNodeTag {
+		recoveredLogLine.Message = nodeTag(line.Node) + recoveredLogLine.Message
+	}
+
+	lo.log.Log(recoveredLogLine)
+
+	return nil
+}
+
+func nodeTag(node string) string {
+	return "[" + node + "] "
+}
+
+// Close does nothing as the logger is not owned by this output
+func (lo *loggerOutput) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lo *loggerOutput) IsInterfaceNil() bool {
+	return lo == nil
+}
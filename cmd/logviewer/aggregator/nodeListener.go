@@ -0,0 +1,205 @@
+package aggregator
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/gorilla/websocket"
+)
+
+var log = logger.GetOrCreate("logviewer/aggregator")
+
+const (
+	wsLogPath = "/log"
+	ws        = "ws"
+	wss       = "wss"
+)
+
+// ArgsNodeListener is the DTO used to create a new node listener
+type ArgsNodeListener struct {
+	Address       string
+	UseWss        bool
+	Profile       *logger.Profile
+	Marshalizer   marshal.Marshalizer
+	RetryDuration time.Duration
+	LinesHandler  func(line *TaggedLogLine)
+}
+
+type nodeListener struct {
+	address       string
+	useWss        bool
+	profile       *logger.Profile
+	marshalizer   marshal.Marshalizer
+	retryDuration time.Duration
+	linesHandler  func(line *TaggedLogLine)
+	cancelFunc    context.CancelFunc
+
+	mutConn sync.Mutex
+	conn    *websocket.Conn
+}
+
+// NewNodeListener creates a component that streams the log lines of a node through its /log websocket. A nil
+// profile means the node's default log profile is requested
+func NewNodeListener(args ArgsNodeListener) (*nodeListener, error) {
+	if len(args.Address) == 0 {
+		return nil, ErrNoNodeAddresses
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if args.RetryDuration <= 0 {
+		return nil, fmt.Errorf("%w for the retry duration: %v", ErrInvalidValue, args.RetryDuration)
+	}
+	if args.LinesHandler == nil {
+		return nil, ErrNilLinesHandler
+	}
+
+	return &nodeListener{
+		address:       args.Address,
+		useWss:        args.UseWss,
+		profile:       args.Profile,
+		marshalizer:   args.Marshalizer,
+		retryDuration: args.RetryDuration,
+		linesHandler:  args.LinesHandler,
+	}, nil
+}
+
+// StartListening starts the go routine that keeps the websocket open, reconnecting when needed
+func (nl *nodeListener) StartListening() {
+	var ctx context.Context
+	ctx, nl.cancelFunc = context.WithCancel(context.Background())
+
+	go nl.connectionLoop(ctx)
+}
+
+func (nl *nodeListener) connectionLoop(ctx context.Context) {
+	for {
+		err := nl.openWebSocket()
+		if err == nil {
+			nl.listen()
+		} else {
+			log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", nl.retryDuration),
+				"node", nl.address, "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Debug("node listener's go routine is stopping...", "node", nl.address)
+			return
+		case <-time.After(nl.retryDuration):
+		}
+	}
+}
+
+func (nl *nodeListener) openWebSocket() error {
+	scheme := ws
+	if nl.useWss {
+		scheme = wss
+	}
+
+	u := url.URL{
+		Scheme: scheme,
+		Host:   nl.address,
+		Path:   wsLogPath,
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if nl.profile != nil {
+		err = nl.sendProfile(conn)
+	} else {
+		err = conn.WriteMessage(websocket.TextMessage, []byte(core.DefaultLogProfileIdentifier))
+	}
+	log.LogIfError(err, "node", nl.address)
+
+	nl.mutConn.Lock()
+	nl.conn = conn
+	nl.mutConn.Unlock()
+
+	return nil
+}
+
+func (nl *nodeListener) sendProfile(conn *websocket.Conn) error {
+	profileMessage, err := nl.profile.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteMessage(websocket.TextMessage, profileMessage)
+}
+
+func (nl *nodeListener) listen() {
+	nl.mutConn.Lock()
+	conn := nl.conn
+	nl.mutConn.Unlock()
+
+	for {
+		msgType, message, err := conn.ReadMessage()
+		if msgType == websocket.CloseMessage {
+			return
+		}
+		if err == nil {
+			nl.handleMessage(message)
+			continue
+		}
+
+		_, isConnectionClosed := err.(*websocket.CloseError)
+		if !isConnectionClosed {
+			log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", nl.retryDuration),
+				"node", nl.address, "error", err.Error())
+		} else {
+			log.Error(fmt.Sprintf("logviewer websocket terminated by the server side, retrying in %v...", nl.retryDuration),
+				"node", nl.address, "error", err.Error())
+		}
+		return
+	}
+}
+
+func (nl *nodeListener) handleMessage(message []byte) {
+	logLine := &logger.LogLineWrapper{}
+	err := nl.marshalizer.Unmarshal(logLine, message)
+	if err != nil {
+		log.Debug("can not unmarshal received data", "node", nl.address, "data", hex.EncodeToString(message))
+		return
+	}
+
+	nl.linesHandler(&TaggedLogLine{
+		Node: nl.address,
+		Line: logLine,
+	})
+}
+
+// Close stops the reconnecting go routine and closes the websocket, if opened
+func (nl *nodeListener) Close() error {
+	if nl.cancelFunc != nil {
+		nl.cancelFunc()
+	}
+
+	nl.mutConn.Lock()
+	defer nl.mutConn.Unlock()
+
+	if nl.conn == nil {
+		return nil
+	}
+
+	err := nl.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	log.LogIfError(err, "node", nl.address)
+
+	return nl.conn.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nl *nodeListener) IsInterfaceNil() bool {
+	return nl == nil
+}
@@ -0,0 +1,141 @@
+package aggregator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// ArgsRotatingFileWriter is the DTO used to create a new rotating file writer
+type ArgsRotatingFileWriter struct {
+	Directory          string
+	Prefix             string
+	FileExtension      string
+	MaxFileSizeInBytes int64
+	MaxNumFiles        int
+}
+
+type rotatingFileWriter struct {
+	directory          string
+	prefix             string
+	fileExtension      string
+	maxFileSizeInBytes int64
+	maxNumFiles        int
+
+	mutFile      sync.Mutex
+	currentFile  *os.File
+	currentSize  int64
+	fileIndex    int
+	createdFiles []string
+}
+
+// NewRotatingFileWriter creates a writer that switches to a new file each time the current one reaches the maximum
+// size. Only the newest files created by this writer are kept, the older ones are removed
+func NewRotatingFileWriter(args ArgsRotatingFileWriter) (*rotatingFileWriter, error) {
+	if args.MaxFileSizeInBytes < 1 {
+		return nil, fmt.Errorf("%w for the maximum file size: %d", ErrInvalidValue, args.MaxFileSizeInBytes)
+	}
+	if args.MaxNumFiles < 1 {
+		return nil, fmt.Errorf("%w for the maximum number of files: %d", ErrInvalidValue, args.MaxNumFiles)
+	}
+
+	absPath, err := filepath.Abs(args.Directory)
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(absPath, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	rfw := &rotatingFileWriter{
+		directory:          absPath,
+		prefix:             args.Prefix,
+		fileExtension:      args.FileExtension,
+		maxFileSizeInBytes: args.MaxFileSizeInBytes,
+		maxNumFiles:        args.MaxNumFiles,
+		createdFiles:       make([]string, 0, args.MaxNumFiles+1),
+	}
+	err = rfw.rotate()
+	if err != nil {
+		return nil, err
+	}
+
+	return rfw, nil
+}
+
+// Write writes the provided bytes in the current file, rotating it first if the maximum size would be exceeded
+func (rfw *rotatingFileWriter) Write(p []byte) (int, error) {
+	rfw.mutFile.Lock()
+	defer rfw.mutFile.Unlock()
+
+	if rfw.currentFile == nil {
+		return 0, os.ErrClosed
+	}
+
+	if rfw.currentSize > 0 && rfw.currentSize+int64(len(p)) > rfw.maxFileSizeInBytes {
+		err := rfw.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rfw.currentFile.Write(p)
+	rfw.currentSize += int64(n)
+
+	return n, err
+}
+
+// rotate should be called under mutex protection
+func (rfw *rotatingFileWriter) rotate() error {
+	// the index makes the file names unique when several files are created in the same second
+	fileName := fmt.Sprintf("%s-%s-%d.%s",
+		rfw.prefix, time.Now().Format("2006-01-02-15-04-05"), rfw.fileIndex, rfw.fileExtension)
+	filePath := filepath.Join(rfw.directory, fileName)
+
+	newFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	if rfw.currentFile != nil {
+		errNotCritical := rfw.currentFile.Close()
+		log.LogIfError(errNotCritical, "step", "closing old log file")
+	}
+
+	rfw.fileIndex++
+	rfw.currentFile = newFile
+	rfw.currentSize = 0
+	rfw.createdFiles = append(rfw.createdFiles, filePath)
+	rfw.removeOldFiles()
+
+	return nil
+}
+
+func (rfw *rotatingFileWriter) removeOldFiles() {
+	for len(rfw.createdFiles) > rfw.maxNumFiles {
+		errNotCritical := os.Remove(rfw.createdFiles[0])
+		log.LogIfError(errNotCritical, "step", "removing old log file")
+
+		rfw.createdFiles = rfw.createdFiles[1:]
+	}
+}
+
+// Close closes the current file
+func (rfw *rotatingFileWriter) Close() error {
+	rfw.mutFile.Lock()
+	defer rfw.mutFile.Unlock()
+
+	if rfw.currentFile == nil {
+		return nil
+	}
+
+	err := rfw.currentFile.Close()
+	rfw.currentFile = nil
+
+	return err
+}
@@ -0,0 +1,70 @@
+package aggregator
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRotatingFileWriter(dir string) ArgsRotatingFileWriter {
+	return ArgsRotatingFileWriter{
+		Directory:          dir,
+		Prefix:             "logviewer",
+		FileExtension:      "log",
+		MaxFileSizeInBytes: 10,
+		MaxNumFiles:        2,
+	}
+}
+
+func TestNewRotatingFileWriter_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRotatingFileWriter("")
+	args.MaxFileSizeInBytes = 0
+	rfw, err := NewRotatingFileWriter(args)
+	assert.Nil(t, rfw)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createMockArgsRotatingFileWriter("")
+	args.MaxNumFiles = 0
+	rfw, err = NewRotatingFileWriter(args)
+	assert.Nil(t, rfw)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestRotatingFileWriter_WriteShouldRotateAndKeepTheNewestFiles(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "rotating_file_writer")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	rfw, err := NewRotatingFileWriter(createMockArgsRotatingFileWriter(dir))
+	require.Nil(t, err)
+
+	for _, data := range []string{"aaaa", "bbbb", "cccc", "dddd", "eeeeeeeeeeeeee"} {
+		n, errWrite := rfw.Write([]byte(data))
+		assert.Nil(t, errWrite)
+		assert.Equal(t, len(data), n)
+	}
+	err = rfw.Close()
+	assert.Nil(t, err)
+
+	files, _ := ioutil.ReadDir(dir)
+	require.Equal(t, 2, len(files))
+
+	contents := make([]string, 0, len(files))
+	for _, file := range rfw.createdFiles {
+		buff, _ := ioutil.ReadFile(file)
+		contents = append(contents, string(buff))
+	}
+	assert.Equal(t, []string{"ccccdddd", "eeeeeeeeeeeeee"}, contents)
+
+	_, err = rfw.Write([]byte("f"))
+	assert.Equal(t, os.ErrClosed, err)
+}
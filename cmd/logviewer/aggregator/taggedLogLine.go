@@ -0,0 +1,14 @@
+package aggregator
+
+import (
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// TaggedLogLine is a log line as received from a node, tagged with the address of that node
+type TaggedLogLine struct {
+	Node       string
+	Line       *logger.LogLineWrapper
+	receivedAt time.Time
+}
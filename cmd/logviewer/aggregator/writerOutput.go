@@ -0,0 +1,95 @@
+package aggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+)
+
+const (
+	// PlainFormat outputs the lines in the same format as the node's plain log files
+	PlainFormat = logging.PlainLogFormat
+	// JSONFormat outputs each line as a JSON object, one per line, same as the node's JSON log files
+	JSONFormat = logging.JSONLogFormat
+)
+
+type taggedJSONLogLine struct {
+	Node string `json:"node"`
+	*logging.JSONLogLine
+}
+
+type writerOutput struct {
+	writer      io.WriteCloser
+	format      string
+	withNodeTag bool
+	formatter   logger.Formatter
+}
+
+// NewWriterOutput creates an output that writes the lines in the requested format. The JSON format always contains
+// the node tag, the plain format contains it only if required
+func NewWriterOutput(writer io.WriteCloser, format string, withNodeTag bool) (*writerOutput, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	wo := &writerOutput{
+		writer:      writer,
+		format:      format,
+		withNodeTag: withNodeTag,
+	}
+	switch format {
+	case PlainFormat:
+		wo.formatter = &logger.PlainFormatter{}
+	case JSONFormat:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOutputFormat, format)
+	}
+
+	return wo, nil
+}
+
+// Output writes the provided line
+func (wo *writerOutput) Output(line *TaggedLogLine) error {
+	buff, err := wo.formatLine(line)
+	if err != nil {
+		return err
+	}
+
+	_, err = wo.writer.Write(buff)
+
+	return err
+}
+
+func (wo *writerOutput) formatLine(line *TaggedLogLine) ([]byte, error) {
+	if wo.format == JSONFormat {
+		buff, err := json.Marshal(&taggedJSONLogLine{
+			Node:        line.Node,
+			JSONLogLine: logging.NewJSONLogLine(line.Line),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return append(buff, '\n'), nil
+	}
+
+	buff := wo.formatter.Output(line.Line)
+	if wo.withNodeTag {
+		buff = append([]byte(nodeTag(line.Node)), buff...)
+	}
+
+	return buff, nil
+}
+
+// Close closes the underlying writer
+func (wo *writerOutput) Close() error {
+	return wo.writer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wo *writerOutput) IsInterfaceNil() bool {
+	return wo == nil
+}
@@ -0,0 +1,102 @@
+package aggregator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bufferWriteCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (bwc *bufferWriteCloser) Close() error {
+	bwc.closed = true
+	return nil
+}
+
+func TestNewWriterOutput_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wo, err := NewWriterOutput(nil, PlainFormat, false)
+	assert.Nil(t, wo)
+	assert.Equal(t, ErrNilWriter, err)
+
+	wo, err = NewWriterOutput(&bufferWriteCloser{}, "xml", false)
+	assert.Nil(t, wo)
+	assert.True(t, errors.Is(err, ErrUnknownOutputFormat))
+}
+
+func TestWriterOutput_PlainFormatShouldPrependTheNodeTag(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	wo, _ := NewWriterOutput(writer, PlainFormat, true)
+
+	err := wo.Output(createTaggedLogLine("127.0.0.1:8080", time.Now().UnixNano(), "block committed"))
+	assert.Nil(t, err)
+
+	output := writer.String()
+	assert.True(t, strings.HasPrefix(output, "[127.0.0.1:8080] INFO "))
+	assert.True(t, strings.Contains(output, "block committed"))
+	assert.True(t, strings.Contains(output, "nonce = 7"))
+
+	err = wo.Close()
+	assert.Nil(t, err)
+	assert.True(t, writer.closed)
+}
+
+func TestWriterOutput_JSONFormatShouldContainTheNode(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	wo, _ := NewWriterOutput(writer, JSONFormat, false)
+
+	_ = wo.Output(createTaggedLogLine("node1", 1, "first"))
+	_ = wo.Output(createTaggedLogLine("node2", 2, "second"))
+
+	lines := strings.Split(strings.TrimSpace(writer.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	recovered := make(map[string]interface{})
+	err := json.Unmarshal([]byte(lines[1]), &recovered)
+	require.Nil(t, err)
+	assert.Equal(t, "node2", recovered["node"])
+	assert.Equal(t, "second", recovered["message"])
+	assert.Equal(t, "process/block", recovered["logger"])
+	assert.Equal(t, "0", recovered["shard"])
+	assert.Equal(t, map[string]interface{}{"nonce": "7"}, recovered["args"])
+}
+
+func TestWriterOutput_JSONFormatShouldMatchTheNodeOutput(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	wo, _ := NewWriterOutput(writer, JSONFormat, false)
+
+	line := createTaggedLogLine("node1", 1, "processing transaction")
+	line.Line.Correlation.SubRound = "(BLOCK)/56/aabbcc..eeff11"
+	_ = wo.Output(line)
+
+	recovered := make(map[string]interface{})
+	err := json.Unmarshal(writer.Bytes(), &recovered)
+	require.Nil(t, err)
+	assert.Equal(t, "node1", recovered["node"])
+	delete(recovered, "node")
+
+	expected := make(map[string]interface{})
+	err = json.Unmarshal((&logging.JSONFormatter{}).Output(line.Line), &expected)
+	require.Nil(t, err)
+	assert.Equal(t, expected, recovered)
+	assert.Equal(t, "(BLOCK)", recovered["subRound"])
+	assert.Equal(t, float64(56), recovered["blockNonce"])
+	assert.Equal(t, "aabbcc..eeff11", recovered["blockHash"])
+}
@@ -1,28 +1,26 @@
 package main
 
 import (
-	"encoding/hex"
 	"fmt"
-	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
-	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/cmd/logviewer/aggregator"
 	"github.com/ElrondNetwork/elrond-go/marshal"
-	"github.com/gorilla/websocket"
 	"github.com/urfave/cli"
 )
 
 const (
-	defaultLogPath = "logs"
-	wsLogPath      = "/log"
-	ws             = "ws"
-	wss            = "wss"
+	defaultLogPath   = "logs"
+	megabyte         = 1024 * 1024
+	flushInterval    = time.Millisecond * 100
+	maxBufferedLines = 100000
 )
 
 type config struct {
@@ -30,9 +28,16 @@ type config struct {
 	address            string
 	logLevel           string
 	logSave            bool
+	logSaveFormat      string
+	logFileMaxSizeInMB int
+	logMaxNumFiles     int
 	useWss             bool
 	logWithCorrelation bool
 	logWithLoggerName  bool
+	filterMessage      string
+	filterLoggerName   string
+	filterCorrelation  string
+	mergeDelayInMs     int
 }
 
 var (
@@ -53,8 +58,10 @@ VERSION:
 `
 	// address defines a flag for setting the address and port on which the node will listen for connections
 	address = cli.StringFlag{
-		Name:        "address",
-		Usage:       "Address and port number on which the application will try to connect to the elrond-go node",
+		Name: "address",
+		Usage: "Address and port number on which the application will try to connect to the elrond-go node. " +
+			"Several comma-separated addresses can be provided, in which case the logs of all nodes are merged in " +
+			"timestamp order and each line is tagged with the address of its node",
 		Value:       "127.0.0.1:8080",
 		Destination: &argsConfig.address,
 	}
@@ -74,6 +81,55 @@ VERSION:
 		Usage:       "Boolean option for enabling log saving. If set, it will automatically save all the logs into a file.",
 		Destination: &argsConfig.logSave,
 	}
+	// logSaveFormat defines the format of the saved log files
+	logSaveFormat = cli.StringFlag{
+		Name: "log-save-format",
+		Usage: "The format of the saved log files. Can be " + aggregator.PlainFormat + ", the same format as the " +
+			"node's log files, or " + aggregator.JSONFormat + ", one JSON object per line, suitable for log ingestion tools",
+		Value:       aggregator.PlainFormat,
+		Destination: &argsConfig.logSaveFormat,
+	}
+	// logFileMaxSize defines the size after which a new log file is created
+	logFileMaxSize = cli.IntFlag{
+		Name:        "log-file-max-size",
+		Usage:       "The maximum size of a saved log file, in MB. A new log file is created when this size is reached.",
+		Value:       100,
+		Destination: &argsConfig.logFileMaxSizeInMB,
+	}
+	// logMaxFiles defines how many log files are kept
+	logMaxFiles = cli.IntFlag{
+		Name:        "log-max-files",
+		Usage:       "The maximum number of saved log files. The oldest log files created by the application are removed.",
+		Value:       10,
+		Destination: &argsConfig.logMaxNumFiles,
+	}
+	// filterMessage defines the regular expression the messages should match
+	filterMessage = cli.StringFlag{
+		Name:        "filter-message",
+		Usage:       "If set, only the log lines whose message matches this regular expression are displayed",
+		Destination: &argsConfig.filterMessage,
+	}
+	// filterLoggerName defines the regular expression the logger names should match
+	filterLoggerName = cli.StringFlag{
+		Name:        "filter-logger",
+		Usage:       "If set, only the log lines whose logger name matches this regular expression are displayed",
+		Destination: &argsConfig.filterLoggerName,
+	}
+	// filterCorrelation defines the regular expression the correlation elements should match
+	filterCorrelation = cli.StringFlag{
+		Name: "filter-correlation",
+		Usage: "If set, only the log lines whose correlation elements match this regular expression are displayed. " +
+			"The expression is matched against the shard/epoch/round/subround string, for example ^metachain/3/",
+		Destination: &argsConfig.filterCorrelation,
+	}
+	// mergeDelay defines how long the lines are held in order to be merged
+	mergeDelay = cli.IntFlag{
+		Name: "merge-delay",
+		Usage: "The time, in milliseconds, a log line is held before being displayed so the lines of all nodes can " +
+			"be merged in timestamp order",
+		Value:       500,
+		Destination: &argsConfig.mergeDelayInMs,
+	}
 	//useWss is used when the user require connection through wss
 	useWss = cli.BoolFlag{
 		Name:        "use-wss",
@@ -104,8 +160,6 @@ VERSION:
 
 	log           = logger.GetOrCreate("logviewer")
 	cliApp        *cli.App
-	webSocket     *websocket.Conn
-	fileForLogs   *os.File
 	marshalizer   marshal.Marshalizer
 	retryDuration = time.Second * 10
 )
@@ -135,10 +189,17 @@ func initCliFlags() {
 		address,
 		logLevel,
 		logSaveFile,
+		logSaveFormat,
+		logFileMaxSize,
+		logMaxFiles,
 		workingDirectory,
 		useWss,
 		logWithCorrelation,
 		logWithLoggerName,
+		filterMessage,
+		filterLoggerName,
+		filterCorrelation,
+		mergeDelay,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -157,6 +218,11 @@ func startLogViewer(ctx *cli.Context) error {
 		return err
 	}
 
+	addresses := parseNodeAddresses(argsConfig.address)
+	if len(addresses) == 0 {
+		return aggregator.ErrNoNodeAddresses
+	}
+
 	if !ctx.IsSet(workingDirectory.Name) {
 		argsConfig.workingDir, err = os.Getwd()
 		if err != nil {
@@ -165,17 +231,6 @@ func startLogViewer(ctx *cli.Context) error {
 		}
 	}
 
-	if argsConfig.logSave {
-		err = prepareLogFile()
-		if err != nil {
-			return err
-		}
-
-		defer func() {
-			_ = fileForLogs.Close()
-		}()
-	}
-
 	profile := &logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
 		WithCorrelation:  argsConfig.logWithCorrelation,
@@ -185,156 +240,120 @@ func startLogViewer(ctx *cli.Context) error {
 	if customLogProfile {
 		err = profile.Apply()
 		log.LogIfError(err)
+	} else {
+		profile = nil
 	}
 
-	go func() {
-		for {
-			webSocket, err = openWebSocket(argsConfig.address)
-			if err != nil {
-				log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration), "error", err.Error())
-				time.Sleep(retryDuration)
-				continue
-			}
-
-			if customLogProfile {
-				err = sendProfile(webSocket, profile)
-			} else {
-				err = sendDefaultProfileIdentifier(webSocket)
-			}
-			log.LogIfError(err)
+	filter, err := aggregator.NewLogFilter(aggregator.ArgsLogFilter{
+		MessagePattern:     argsConfig.filterMessage,
+		LoggerNamePattern:  argsConfig.filterLoggerName,
+		CorrelationPattern: argsConfig.filterCorrelation,
+	})
+	if err != nil {
+		return err
+	}
 
-			listeningOnWebSocket()
-			time.Sleep(retryDuration)
-		}
-	}()
+	outputs, err := createOutputs(len(addresses) > 1)
+	if err != nil {
+		return err
+	}
+
+	logAggregator, err := aggregator.NewLogAggregator(aggregator.ArgsLogAggregator{
+		Addresses:        addresses,
+		UseWss:           argsConfig.useWss,
+		Profile:          profile,
+		Marshalizer:      marshalizer,
+		RetryDuration:    retryDuration,
+		MergeDelay:       time.Duration(argsConfig.mergeDelayInMs) * time.Millisecond,
+		FlushInterval:    flushInterval,
+		MaxBufferedLines: maxBufferedLines,
+		Filter:           filter,
+		Outputs:          outputs,
+	})
+	if err != nil {
+		return err
+	}
 
 	//set this log's level to the lowest desired log level that matches received logs from elrond-go
 	lowestLogLevel := getLowestLogLevel(logLevels)
 	log.SetLevel(lowestLogLevel)
 
-	waitForUserToTerminateApp(webSocket)
+	logAggregator.Start()
+
+	waitForUserToTerminateApp()
+
+	err = logAggregator.Close()
+	log.LogIfError(err)
+
+	log.Info("logviewer application stopped")
 
 	return nil
 }
 
-func getLowestLogLevel(logLevels []logger.LogLevel) logger.LogLevel {
-	lowest := logLevels[0]
-	for i := 1; i < len(logLevels); i++ {
-		if lowest > logLevels[i] {
-			lowest = logLevels[i]
+func parseNodeAddresses(addresses string) []string {
+	result := make([]string, 0)
+	for _, address := range strings.Split(addresses, ",") {
+		address = strings.TrimSpace(address)
+		if len(address) > 0 {
+			result = append(result, address)
 		}
 	}
 
-	return lowest
+	return result
 }
 
-func prepareLogFile() error {
-	logDirectory := filepath.Join(argsConfig.workingDir, defaultLogPath)
-	logsFile, err := core.CreateFile(
-		core.ArgCreateFileArgument{
-			Prefix:        "logviewer",
-			Directory:     logDirectory,
-			FileExtension: "log",
-		},
-	)
+func createOutputs(withNodeTag bool) ([]aggregator.LineOutput, error) {
+	consoleOutput, err := aggregator.NewLoggerOutput(log, withNodeTag)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return logger.AddLogObserver(logsFile, &logger.PlainFormatter{})
-}
-
-func openWebSocket(address string) (*websocket.Conn, error) {
-	scheme := ws
-
-	if argsConfig.useWss {
-		scheme = wss
+	outputs := []aggregator.LineOutput{consoleOutput}
+	if !argsConfig.logSave {
+		return outputs, nil
 	}
 
-	u := url.URL{
-		Scheme: scheme,
-		Host:   address,
-		Path:   wsLogPath,
+	fileExtension := "log"
+	if argsConfig.logSaveFormat == aggregator.JSONFormat {
+		fileExtension = "json"
 	}
 
-	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	fileWriter, err := aggregator.NewRotatingFileWriter(aggregator.ArgsRotatingFileWriter{
+		Directory:          filepath.Join(argsConfig.workingDir, defaultLogPath),
+		Prefix:             "logviewer",
+		FileExtension:      fileExtension,
+		MaxFileSizeInBytes: int64(argsConfig.logFileMaxSizeInMB) * megabyte,
+		MaxNumFiles:        argsConfig.logMaxNumFiles,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return conn, nil
-}
-
-func sendProfile(conn *websocket.Conn, profile *logger.Profile) error {
-	profileMessage, err := profile.Marshal()
+	fileOutput, err := aggregator.NewWriterOutput(fileWriter, argsConfig.logSaveFormat, withNodeTag)
 	if err != nil {
-		return err
+		_ = fileWriter.Close()
+		return nil, err
 	}
 
-	return conn.WriteMessage(websocket.TextMessage, profileMessage)
-}
-
-func sendDefaultProfileIdentifier(conn *websocket.Conn) error {
-	return conn.WriteMessage(websocket.TextMessage, []byte(core.DefaultLogProfileIdentifier))
+	return append(outputs, fileOutput), nil
 }
 
-func listeningOnWebSocket() {
-	for {
-		msgType, message, err := webSocket.ReadMessage()
-		if msgType == websocket.CloseMessage {
-			return
-		}
-		if err == nil {
-			outputMessage(message)
-			continue
-		}
-
-		_, isConnectionClosed := err.(*websocket.CloseError)
-		if !isConnectionClosed {
-			log.Error("logviewer websocket error, retrying in %v...", "error", err.Error())
-		} else {
-			log.Error(fmt.Sprintf("logviewer websocket terminated by the server side, retrying in %v...", retryDuration), "error", err.Error())
+func getLowestLogLevel(logLevels []logger.LogLevel) logger.LogLevel {
+	lowest := logLevels[0]
+	for i := 1; i < len(logLevels); i++ {
+		if lowest > logLevels[i] {
+			lowest = logLevels[i]
 		}
-		return
 	}
+
+	return lowest
 }
 
-func waitForUserToTerminateApp(conn *websocket.Conn) {
+func waitForUserToTerminateApp() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
 	<-sigs
 
 	log.Info("terminating logviewer app at user's signal...")
-	if conn != nil {
-		err := conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
-		log.LogIfError(err)
-		time.Sleep(time.Second)
-	}
-
-	log.Info("logviewer application stopped")
-}
-
-func outputMessage(message []byte) {
-	logLine := &logger.LogLineWrapper{}
-
-	err := marshalizer.Unmarshal(logLine, message)
-	if err != nil {
-		log.Debug("can not unmarshal received data", "data", hex.EncodeToString(message))
-		return
-	}
-
-	recoveredLogLine := &logger.LogLine{
-		LoggerName:  logLine.LoggerName,
-		Correlation: logLine.Correlation,
-		Message:     logLine.Message,
-		LogLevel:    logger.LogLevel(logLine.LogLevel),
-		Args:        make([]interface{}, len(logLine.Args)),
-		Timestamp:   time.Unix(0, logLine.Timestamp),
-	}
-	for i, str := range logLine.Args {
-		recoveredLogLine.Args[i] = str
-	}
-
-	log.Log(recoveredLogLine)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -37,6 +38,13 @@ type P2PAntifloodHandler interface {
 // FileLoggingHandler will handle log file rotation
 type FileLoggingHandler interface {
 	ChangeFileLifeSpan(newDuration time.Duration) error
+	ChangeFileMaxSize(maxSizeInBytes uint64) error
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// HealthChecksRegistrar defines the component that runs the liveness and readiness checks on request
+type HealthChecksRegistrar interface {
+	RegisterLivenessCheck(checker health.HealthChecker) error
+	RegisterReadinessCheck(checker health.HealthChecker) error
+}
@@ -1897,6 +1897,7 @@ func newMetaBlockProcessor(
 		ValidatorAccountsDB: stateComponents.PeerAccounts,
 		ChanceComputer:      rater,
 		EpochNotifier:       epochNotifier,
+		ChainID:             core.ChainID,
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
 	if err != nil {
@@ -2158,6 +2159,7 @@ func newMetaBlockProcessor(
 			ShardCoordinator:              shardCoordinator,
 			PubkeyConverter:               stateComponents.AddressPubkeyConverter,
 			RewardsStorage:                rewardsStorage,
+			EconomicsBreakdownStorage:     data.Store.GetStorer(dataRetriever.EconomicsBreakdownUnit),
 			MiniBlockStorage:              miniBlockStorage,
 			Hasher:                        core.Hasher,
 			Marshalizer:                   core.InternalMarshalizer,
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/fallback"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+const lightClientProofsRequestTimeout = 5 * time.Second
+const lightClientNumPeersToQuery = 2
+
+type lightClientBootstrapper interface {
+	SyncLightClientComponents() (*bootstrap.LightClientComponents, error)
+}
+
+type lightClientArgs struct {
+	bootstrapper           lightClientBootstrapper
+	messenger              p2p.Messenger
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	multiSigVerifier       crypto.MultiSigVerifier
+	blockSingleSigner      crypto.SingleSigner
+	blockKeyGen            crypto.KeyGenerator
+	addressPubkeyConverter core.PubkeyConverter
+	restApiInterface       string
+}
+
+// startLightClient follows the metachain headers and serves verified account queries without syncing any state trie.
+// It blocks until the user's termination signal is received
+func startLightClient(args lightClientArgs, log logger.Logger) error {
+	log.Info("starting in light client mode")
+
+	components, err := args.bootstrapper.SyncLightClientComponents()
+	if err != nil {
+		log.Error("light client: could not sync the epoch start data", "error", err)
+		return err
+	}
+
+	headersPool := components.DataPool.Headers()
+	fallbackHeaderValidator, err := fallback.NewFallbackHeaderValidator(headersPool, args.marshalizer, disabled.NewChainStorer())
+	if err != nil {
+		return err
+	}
+
+	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(&headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:             args.marshalizer,
+		Hasher:                  args.hasher,
+		NodesCoordinator:        components.NodesCoordinator,
+		MultiSigVerifier:        args.multiSigVerifier,
+		SingleSigVerifier:       args.blockSingleSigner,
+		KeyGen:                  args.blockKeyGen,
+		FallbackHeaderValidator: fallbackHeaderValidator,
+	})
+	if err != nil {
+		return err
+	}
+
+	headersTracker, err := lightClient.NewHeadersTracker(lightClient.ArgsHeadersTracker{
+		Marshalizer:       args.marshalizer,
+		Hasher:            args.hasher,
+		HeaderSigVerifier: headerSigVerifier,
+		EpochStartHandler: components.EpochStartHandler,
+		MiniBlocksSyncer:  components.MiniBlocksSyncer,
+		RequestHandler:    components.RequestHandler,
+		StartMetaHeader:   components.EpochStartMeta,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = lightClient.NewHeadersListener(lightClient.ArgsHeadersListener{
+		HeadersPool:      headersPool,
+		HeadersProcessor: headersTracker,
+	})
+	if err != nil {
+		return err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(components.NumOfShards, core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	proofsRequester, err := lightClient.NewProofsRequester(lightClient.ArgsProofsRequester{
+		Messenger:        args.messenger,
+		Marshalizer:      args.marshalizer,
+		Hasher:           args.hasher,
+		ShardCoordinator: shardCoordinator,
+		RequestTimeout:   lightClientProofsRequestTimeout,
+		NumPeersToQuery:  lightClientNumPeersToQuery,
+	})
+	if err != nil {
+		return err
+	}
+
+	accountsProvider, err := lightClient.NewAccountsProvider(lightClient.ArgsAccountsProvider{
+		HeadersTracker:   headersTracker,
+		ProofsRequester:  proofsRequester,
+		Marshalizer:      args.marshalizer,
+		ShardCoordinator: shardCoordinator,
+	})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		errRun := startLightClientApi(args.restApiInterface, accountsProvider, args.addressPubkeyConverter)
+		log.LogIfError(errRun)
+	}()
+
+	log.Info("light client is now running", "epoch", components.EpochStartMeta.Epoch)
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+	log.Info("terminating at user's signal...")
+
+	return args.messenger.Close()
+}
+
+type lightClientAccountsProvider interface {
+	GetAccount(address []byte) (state.UserAccountHandler, error)
+}
+
+func startLightClientApi(
+	restApiInterface string,
+	accountsProvider lightClientAccountsProvider,
+	addressPubkeyConverter core.PubkeyConverter,
+) error {
+	ws := gin.Default()
+	ws.Use(cors.Default())
+
+	ws.GET("/address/:address", func(c *gin.Context) {
+		address := c.Param("address")
+		addressBytes, err := addressPubkeyConverter.Decode(address)
+		if err != nil {
+			respondLightClientError(c, http.StatusBadRequest, err, shared.ReturnCodeRequestError)
+			return
+		}
+
+		account, err := accountsProvider.GetAccount(addressBytes)
+		if err == lightClient.ErrAccountNotFound {
+			respondLightClientError(c, http.StatusNotFound, err, shared.ReturnCodeRequestError)
+			return
+		}
+		if err != nil {
+			respondLightClientError(c, http.StatusInternalServerError, err, shared.ReturnCodeInternalError)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data: gin.H{"account": gin.H{
+					"address":  address,
+					"nonce":    account.GetNonce(),
+					"balance":  account.GetBalance().String(),
+					"username": string(account.GetUserName()),
+					"codeHash": account.GetCodeHash(),
+					"rootHash": account.GetRootHash(),
+				}},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+	})
+
+	return ws.Run(restApiInterface)
+}
+
+func respondLightClientError(c *gin.Context, status int, err error, code shared.ReturnCode) {
+	c.JSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  code,
+		},
+	)
+}
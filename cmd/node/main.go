@@ -24,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/alarm"
@@ -43,6 +44,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	triesFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -61,6 +63,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
@@ -84,6 +87,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/activation"
 	exportFactory "github.com/ElrondNetwork/elrond-go/update/factory"
 	"github.com/ElrondNetwork/elrond-go/update/trigger"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -312,6 +316,14 @@ VERSION:
 		Name:  "log-save",
 		Usage: "Boolean option for enabling log saving. If set, it will automatically save all the logs into a file.",
 	}
+	// logSaveFormat defines the format of the saved log files
+	logSaveFormat = cli.StringFlag{
+		Name: "log-save-format",
+		Usage: "The format of the saved log files. Can be " + logging.PlainLogFormat + ", the same format as the " +
+			"console, or " + logging.JSONLogFormat + ", one JSON object per line, containing the correlation elements " +
+			"and the nonce and the hash of the block being processed, suitable for log ingestion tools.",
+		Value: logging.PlainLogFormat,
+	}
 	//logWithCorrelation is used to enable log correlation elements
 	logWithCorrelation = cli.BoolFlag{
 		Name:  "log-correlation",
@@ -374,6 +386,29 @@ VERSION:
 		Usage: "This flag, if set, will cause the signature checks on headers to be skipped. Can be used only if the import-db was previously set",
 	}
 
+	// importSnapshotFile defines a flag for the optional snapshot file used to bootstrap the node instead of the network
+	importSnapshotFile = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "This flag, if set, will make the node bootstrap its state from the provided snapshot `file` instead of " +
+			"syncing it from the network. The snapshot must hold the latest epoch start meta block confirmed by the " +
+			"connected peers. The file is ignored if data is already available in local disk.",
+		Value: "",
+	}
+	// exportSnapshotFile defines a flag for the optional snapshot file in which the node exports its state
+	exportSnapshotFile = cli.StringFlag{
+		Name: "export-snapshot",
+		Usage: "This flag, if set, will make the node export the last epoch start state found in local disk to the " +
+			"provided snapshot `file` and then close.",
+		Value: "",
+	}
+	// lightClientMode defines a flag that starts the node as a light client
+	lightClientMode = cli.BoolFlag{
+		Name: "light-client",
+		Usage: "Boolean option for starting the node as a light client. The node syncs only the last epoch start " +
+			"data, follows the metachain headers and answers the account queries on the REST API with values proved " +
+			"by the full nodes, without storing any state.",
+	}
+
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -437,6 +472,7 @@ func main() {
 		elasticSearchTemplates,
 		logLevel,
 		logSaveFile,
+		logSaveFormat,
 		logWithCorrelation,
 		logWithLoggerName,
 		useLogView,
@@ -447,6 +483,9 @@ func main() {
 		startInEpoch,
 		importDbDirectory,
 		importDbNoSigCheck,
+		importSnapshotFile,
+		exportSnapshotFile,
+		lightClientMode,
 		redundancyLevel,
 	}
 	app.Authors = []cli.Author{
@@ -485,7 +524,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	var err error
 	withLogFile := ctx.GlobalBool(logSaveFile.Name)
 	if withLogFile {
-		fileLogging, err = logging.NewFileLogging(workingDir, defaultLogsPath, logFilePrefix)
+		fileLogging, err = logging.NewFileLogging(logging.ArgsFileLogging{
+			WorkingDir:      workingDir,
+			DefaultLogsPath: defaultLogsPath,
+			LogFilePrefix:   logFilePrefix,
+			LogFormat:       ctx.GlobalString(logSaveFormat.Name),
+		})
 		if err != nil {
 			return fmt.Errorf("%w creating a log file", err)
 		}
@@ -587,12 +631,18 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if ctx.IsSet(port.Name) {
 		p2pConfig.Node.Port = ctx.GlobalString(port.Name)
 	}
+	p2pConfig.PeerStore.FilePath = filepath.Join(workingDir, p2pConfig.PeerStore.FilePath)
 
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.ChangeFileLifeSpan(time.Second * time.Duration(generalConfig.Logs.LogFileLifeSpanInSec))
 		if err != nil {
 			return err
 		}
+
+		err = fileLogging.ChangeFileMaxSize(generalConfig.Logs.LogFileMaxSizeInMB * core.MegabyteSize)
+		if err != nil {
+			return err
+		}
 	}
 
 	epochNotifier := forking.NewGenericEpochNotifier()
@@ -889,7 +939,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		MaxNodesEnableConfig: generalConfig.GeneralSettings.MaxNodesChangeEnableEpoch,
 	}
 
-	nodesShuffler, err := sharding.NewHashValidatorsShuffler(argsNodesShuffler)
+	argsEpochBasedShuffler := sharding.ArgsEpochBasedShuffler{
+		ShufflerArgs: argsNodesShuffler,
+		Registry:     sharding.NewShufflerRegistry(),
+		Strategies:   generalConfig.GeneralSettings.ShufflerStrategies,
+	}
+	nodesShuffler, err := sharding.NewEpochBasedShuffler(argsEpochBasedShuffler)
 	if err != nil {
 		return err
 	}
@@ -979,6 +1034,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		EconomicsData:              economicsData,
 		SingleSigner:               cryptoComponents.TxSingleSigner,
 		BlockSingleSigner:          cryptoComponents.SingleSigner,
+		MultiSigVerifier:           cryptoComponents.MultiSigner,
 		KeyGen:                     cryptoComponents.TxSignKeyGen,
 		BlockKeyGen:                cryptoComponents.BlockSignKeyGen,
 		GenesisNodesConfig:         genesisNodesConfig,
@@ -1001,6 +1057,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		HeaderIntegrityVerifier:    headerIntegrityVerifier,
 		TxSignHasher:               coreComponents.TxSignHasher,
 		EpochNotifier:              epochNotifier,
+		ImportSnapshotFile:         ctx.GlobalString(importSnapshotFile.Name),
 	}
 	bootstrapper, err := bootstrap.NewEpochStartBootstrap(epochStartBootstrapArgs)
 	if err != nil {
@@ -1008,6 +1065,24 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	if ctx.IsSet(lightClientMode.Name) {
+		return startLightClient(lightClientArgs{
+			bootstrapper:           bootstrapper,
+			messenger:              networkComponents.NetMessenger,
+			marshalizer:            coreComponents.InternalMarshalizer,
+			hasher:                 coreComponents.Hasher,
+			multiSigVerifier:       cryptoComponents.MultiSigner,
+			blockSingleSigner:      cryptoComponents.SingleSigner,
+			blockKeyGen:            cryptoComponents.BlockSignKeyGen,
+			addressPubkeyConverter: addressPubkeyConverter,
+			restApiInterface:       ctx.GlobalString(restApiInterface.Name),
+		}, log)
+	}
+
+	if ctx.IsSet(exportSnapshotFile.Name) {
+		return bootstrapper.ExportSnapshot(ctx.GlobalString(exportSnapshotFile.Name))
+	}
+
 	bootstrapParameters, err := bootstrapper.Bootstrap()
 	if err != nil {
 		log.Error("bootstrap return error", "error", err)
@@ -1470,6 +1545,20 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("creating scheduled activations handler")
+	scheduledActivationsHandler, err := createScheduledActivationsHandler(
+		epochStartNotifier,
+		epochNotifier,
+		hardForkTrigger,
+		dataComponents.Store.GetStorer(dataRetriever.MetaBlockUnit),
+		coreComponents.InternalMarshalizer,
+		bootstrapParameters.Epoch,
+		appVersion,
+	)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("starting status pooling components")
 	statusPollingInterval := time.Duration(generalConfig.GeneralSettings.StatusPollingIntervalSec) * time.Second
 	err = metrics.StartStatusPolling(
@@ -1489,6 +1578,21 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("registering health checks")
+	err = registerHealthChecks(
+		healthService,
+		generalConfig,
+		workingDir,
+		dataComponents,
+		triesComponents,
+		statusHandlersInfo.StatusMetrics,
+		networkComponents.NetMessenger,
+		syncer,
+	)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating elrond node facade")
 	restAPIServerDebugMode := ctx.GlobalBool(restApiDebug.Name)
 
@@ -1502,9 +1606,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 			RestApiInterface: ctx.GlobalString(restApiInterface.Name),
 			PprofEnabled:     ctx.GlobalBool(profileMode.Name),
 		},
-		ApiRoutesConfig: *apiRoutesConfig,
-		AccountsState:   stateComponents.AccountsAdapter,
-		PeerState:       stateComponents.PeerAccounts,
+		ApiRoutesConfig:      *apiRoutesConfig,
+		AccountsState:        stateComponents.AccountsAdapter,
+		PeerState:            stateComponents.PeerAccounts,
+		ScheduledActivations: scheduledActivationsHandler,
+		HealthReports:        healthService,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -2295,8 +2401,21 @@ func createNode(
 		return nil, err
 	}
 
+	honestyScoreHandler, ok := peerHonestyHandler.(p2p.PeerHonestyScoreHandler)
+	if ok {
+		err = network.NetMessenger.SetPeerHonestyScoreHandler(honestyScoreHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	txVersionCheckerHandler := versioning.NewTxVersionChecker(coreData.MinTransactionVersion)
 
+	roundsRecorder, err := createConsensusRoundsRecorder(config.ConsensusRoundsRecorder, data.Store)
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *node.Node
 	nd, err = node.NewNode(
 		node.WithMessenger(network.NetMessenger),
@@ -2368,16 +2487,37 @@ func createNode(
 		node.WithTxVersionChecker(txVersionCheckerHandler),
 		node.WithImportMode(isInImportDbMode),
 		node.WithNodeRedundancyHandler(nodeRedundancyHandler),
+		node.WithConsensusRoundsRecorder(roundsRecorder),
+		node.WithOptimisticSignatureVerification(config.ConsensusSignatures.OptimisticAggregateVerification),
+		node.WithSyncPipelineWindowSize(config.BlocksSync.PipelineWindowSize),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
 	}
 
+	antifloodIntrospector, ok := network.InputAntifloodHandler.(node.AntifloodIntrospector)
+	if ok {
+		err = nd.ApplyOptions(node.WithAntifloodIntrospector(antifloodIntrospector))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = nd.StartHeartbeat(config.Heartbeat, version, preferencesConfig.Preferences)
 	if err != nil {
 		return nil, err
 	}
 
+	err = nd.StartHeartbeatV2(config.HeartbeatV2, version, preferencesConfig.Preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nd.StartSlashingReporter(config.SlashingReporter)
+	if err != nil {
+		return nil, err
+	}
+
 	err = nd.ApplyOptions(node.WithDataPool(data.Datapool))
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2423,6 +2563,22 @@ func createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
 }
 
+func createConsensusRoundsRecorder(
+	recorderConfig config.ConsensusRoundsRecorderConfig,
+	store dataRetriever.StorageService,
+) (spos.RoundsRecorder, error) {
+	args := spos.ArgsRoundsRecorder{
+		MaxRoundsToKeep: recorderConfig.MaxRoundsToKeep,
+		// the recorded rounds are plain structures, not generated protobuf messages
+		Marshalizer: &marshal.JsonMarshalizer{},
+	}
+	if recorderConfig.Persist {
+		args.Storer = store.GetStorer(dataRetriever.StatusMetricsUnit)
+	}
+
+	return spos.NewRoundsRecorder(args)
+}
+
 func initStatsFileMonitor(
 	config *config.Config,
 	pathManager storage.PathManagerHandler,
@@ -2461,6 +2617,97 @@ func startStatisticsMonitor(
 	return nil
 }
 
+func createScheduledActivationsHandler(
+	epochStartNotifier epochStart.RegistrationHandler,
+	epochNotifier update.ActivationEpochsHandler,
+	hardForkTrigger update.HardforkTrigger,
+	metaBlockStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	startEpoch uint32,
+	version string,
+) (facade.ScheduledActivationsHandler, error) {
+	args := activation.ArgsScheduledActivationsHandler{
+		EpochStartNotifier: epochStartNotifier,
+		EpochNotifier:      epochNotifier,
+		HardforkTrigger:    hardForkTrigger,
+		MetaBlockStorer:    metaBlockStorer,
+		Marshalizer:        marshalizer,
+		StartEpoch:         startEpoch,
+		AppVersion:         version,
+	}
+
+	return activation.NewScheduledActivationsHandler(args)
+}
+
+// registerHealthChecks registers the checks behind the /node/health and /node/ready endpoints. The node is alive as
+// long as it can write on disk and read its state, and it is ready once it is synced, well connected, not flooded
+// with transactions and its clock is accurate
+func registerHealthChecks(
+	healthService factory.HealthChecksRegistrar,
+	generalConfig *config.Config,
+	workingDir string,
+	dataComponents *mainFactory.DataComponents,
+	triesComponents *mainFactory.TriesComponents,
+	statusMetrics health.StatusMetricsProvider,
+	messenger health.ConnectedPeersProvider,
+	syncer health.ClockOffsetProvider,
+) error {
+	healthConfig := generalConfig.Health
+
+	diskSpaceCheck, err := health.NewDiskSpaceCheck(workingDir, healthConfig.MinFreeDiskSpaceInMB*core.MegabyteSize)
+	if err != nil {
+		return err
+	}
+	trieStorageCheck, err := health.NewTrieStorageCheck(
+		dataComponents.Blkc,
+		triesComponents.TrieStorageManagers[triesFactory.UserAccountTrie],
+	)
+	if err != nil {
+		return err
+	}
+	livenessChecks := []health.HealthChecker{diskSpaceCheck, trieStorageCheck}
+
+	syncStatusCheck, err := health.NewSyncStatusCheck(statusMetrics)
+	if err != nil {
+		return err
+	}
+	connectedPeersCheck, err := health.NewConnectedPeersCheck(messenger, healthConfig.MinConnectedPeers)
+	if err != nil {
+		return err
+	}
+	txPoolCheck, err := health.NewPoolSaturationCheck(health.ArgsPoolSaturationCheck{
+		Name:                 "txPool",
+		Pool:                 dataComponents.Datapool.Transactions(),
+		Capacity:             generalConfig.TxDataPool.Capacity,
+		SizeInBytes:          generalConfig.TxDataPool.SizeInBytes,
+		MaxSaturationPercent: healthConfig.MaxPoolSaturationPercent,
+	})
+	if err != nil {
+		return err
+	}
+	maxClockOffset := time.Duration(healthConfig.MaxClockOffsetInMilliseconds) * time.Millisecond
+	clockOffsetCheck, err := health.NewClockOffsetCheck(syncer, maxClockOffset)
+	if err != nil {
+		return err
+	}
+	readinessChecks := []health.HealthChecker{syncStatusCheck, connectedPeersCheck, txPoolCheck, clockOffsetCheck}
+
+	for _, checker := range livenessChecks {
+		err = healthService.RegisterLivenessCheck(checker)
+		if err != nil {
+			return err
+		}
+	}
+	for _, checker := range readinessChecks {
+		err = healthService.RegisterReadinessCheck(checker)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func createApiResolver(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
@@ -2685,6 +2932,7 @@ func createScQueryElement(
 			ValidatorAccountsDB: validatorAccounts,
 			ChanceComputer:      rater,
 			EpochNotifier:       epochNotifier,
+			ChainID:             []byte(nodesSetup.GetChainId()),
 		}
 		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
 		if err != nil {
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/ratingsim/simulator"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+const (
+	scenarioMode = "scenario"
+	jailMode     = "jail"
+	epochsMode   = "epochs"
+	metaShard    = "meta"
+)
+
+type cfg struct {
+	ratingsConfigFile   string
+	nodesSetupFile      string
+	mode                string
+	shard               string
+	shardSizes          string
+	consensusSize       uint
+	metaSize            uint
+	metaConsensusSize   uint
+	roundDurationMillis uint64
+	startRating         uint
+	scenario            string
+	sample              uint
+	numEpochs           uint
+	roundsPerEpoch      uint64
+	availability        float64
+	csv                 bool
+}
+
+var (
+	ratingSimHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// ratingsConfigFile defines a flag for the path of the ratings configuration file
+	ratingsConfigFile = cli.StringFlag{
+		Name:        "ratings-config",
+		Usage:       "The node's ratings configuration file",
+		Value:       "./config/ratings.toml",
+		Destination: &argsConfig.ratingsConfigFile,
+	}
+	// nodesSetupFile defines a flag for the path of the nodes setup file
+	nodesSetupFile = cli.StringFlag{
+		Name: "nodes-setup",
+		Usage: "The node's nodes setup file. If provided, the consensus sizes, minimum number of nodes and the round " +
+			"duration will be loaded from it, overriding the corresponding flags",
+		Value:       "",
+		Destination: &argsConfig.nodesSetupFile,
+	}
+	// mode defines a flag for the simulation mode
+	mode = cli.StringFlag{
+		Name: "mode",
+		Usage: fmt.Sprintf("The simulation mode. Available options: %s (applies the --scenario events), "+
+			"%s (computes the number of consecutive misses until jailed), %s (simulates a validator with the "+
+			"provided --availability across epochs)", scenarioMode, jailMode, epochsMode),
+		Value:       jailMode,
+		Destination: &argsConfig.mode,
+	}
+	// shard defines a flag for the simulated shard
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the simulated validator. Use a shard ID or \"meta\"",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// shardSizes defines a flag for the simulated shard sizes
+	shardSizes = cli.StringFlag{
+		Name:        "shard-sizes",
+		Usage:       "Comma separated list of shard sizes to be simulated. The rating steps depend on the shard size",
+		Value:       "400",
+		Destination: &argsConfig.shardSizes,
+	}
+	// consensusSize defines a flag for the shard consensus group size
+	consensusSize = cli.UintFlag{
+		Name:        "consensus-size",
+		Usage:       "The consensus group size of a shard",
+		Value:       63,
+		Destination: &argsConfig.consensusSize,
+	}
+	// metaSize defines a flag for the metachain size, used when simulating a shard validator
+	metaSize = cli.UintFlag{
+		Name:        "meta-size",
+		Usage:       "The number of metachain validators, used when simulating a shard validator",
+		Value:       400,
+		Destination: &argsConfig.metaSize,
+	}
+	// metaConsensusSize defines a flag for the metachain consensus group size
+	metaConsensusSize = cli.UintFlag{
+		Name:        "meta-consensus-size",
+		Usage:       "The consensus group size of the metachain",
+		Value:       400,
+		Destination: &argsConfig.metaConsensusSize,
+	}
+	// roundDuration defines a flag for the round duration
+	roundDuration = cli.Uint64Flag{
+		Name:        "round-duration",
+		Usage:       "The round duration in milliseconds",
+		Value:       6000,
+		Destination: &argsConfig.roundDurationMillis,
+	}
+	// startRating defines a flag for the rating the simulation starts with
+	startRating = cli.UintFlag{
+		Name:        "start-rating",
+		Usage:       "The rating the simulated validator starts with. If 0, the configured start rating is used",
+		Value:       0,
+		Destination: &argsConfig.startRating,
+	}
+	// scenario defines a flag for the events applied in scenario mode
+	scenario = cli.StringFlag{
+		Name: "scenario",
+		Usage: "Comma separated list of steps, each step being a count followed by an event: ps (proposer success), " +
+			"pm (proposer miss), vs (validator success), vm (validator miss). Example: 1000vs,50ps,20pm",
+		Value:       "1000vs,100ps,20pm",
+		Destination: &argsConfig.scenario,
+	}
+	// sample defines a flag for the output sampling in scenario mode
+	sample = cli.UintFlag{
+		Name:        "sample",
+		Usage:       "In scenario mode, print only every n-th event (the last event is always printed)",
+		Value:       1,
+		Destination: &argsConfig.sample,
+	}
+	// numEpochs defines a flag for the number of simulated epochs
+	numEpochs = cli.UintFlag{
+		Name:        "epochs",
+		Usage:       "The number of epochs to be simulated in epochs mode",
+		Value:       10,
+		Destination: &argsConfig.numEpochs,
+	}
+	// roundsPerEpoch defines a flag for the number of rounds in an epoch
+	roundsPerEpoch = cli.Uint64Flag{
+		Name:        "rounds-per-epoch",
+		Usage:       "The number of rounds in an epoch",
+		Value:       14400,
+		Destination: &argsConfig.roundsPerEpoch,
+	}
+	// availability defines a flag for the fraction of duties the simulated validator fulfils
+	availability = cli.Float64Flag{
+		Name:        "availability",
+		Usage:       "In epochs mode, the fraction of the assigned proposer/validator duties the validator fulfils",
+		Value:       1,
+		Destination: &argsConfig.availability,
+	}
+	// csvOutput defines a flag for comma separated output
+	csvOutput = cli.BoolFlag{
+		Name:        "csv",
+		Usage:       "Boolean option that will output comma separated values instead of a table",
+		Destination: &argsConfig.csv,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("ratingsim")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = ratingSimHelpTemplate
+	app.Name = "Rating simulator"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary simulates the validator rating and selection chances using the node's ratings configuration"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		ratingsConfigFile,
+		nodesSetupFile,
+		mode,
+		shard,
+		shardSizes,
+		consensusSize,
+		metaSize,
+		metaConsensusSize,
+		roundDuration,
+		startRating,
+		scenario,
+		sample,
+		numEpochs,
+		roundsPerEpoch,
+		availability,
+		csvOutput,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process(os.Stdout)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the rating simulation", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process(writer io.Writer) error {
+	ratingsConfig := config.RatingsConfig{}
+	err := core.LoadTomlFile(&ratingsConfig, argsConfig.ratingsConfigFile)
+	if err != nil {
+		return err
+	}
+
+	err = applyNodesSetup()
+	if err != nil {
+		return err
+	}
+
+	shardID, err := parseShard(argsConfig.shard)
+	if err != nil {
+		return err
+	}
+
+	sizes, err := parseShardSizes(argsConfig.shardSizes)
+	if err != nil {
+		return err
+	}
+
+	out := newOutput(writer, argsConfig.csv)
+	for _, size := range sizes {
+		rater, errCreate := createRater(ratingsConfig, shardID, size)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		sim, errCreate := simulator.NewRatingSimulator(rater)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		start := uint32(argsConfig.startRating)
+		if start == 0 {
+			start = rater.GetStartRating()
+		}
+
+		out.title(fmt.Sprintf("shard %s, shard size %d, start rating %d", argsConfig.shard, size, start))
+		err = runMode(out, sim, shardID, size, start)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyNodesSetup() error {
+	if len(argsConfig.nodesSetupFile) == 0 {
+		return nil
+	}
+
+	nodesSetup := &sharding.NodesSetup{}
+	err := core.LoadJsonFile(nodesSetup, argsConfig.nodesSetupFile)
+	if err != nil {
+		return err
+	}
+
+	argsConfig.consensusSize = uint(nodesSetup.ConsensusGroupSize)
+	argsConfig.metaSize = uint(nodesSetup.MetaChainMinNodes)
+	argsConfig.metaConsensusSize = uint(nodesSetup.MetaChainConsensusGroupSize)
+	argsConfig.roundDurationMillis = nodesSetup.RoundDuration
+	if argsConfig.shard == metaShard {
+		argsConfig.shardSizes = strconv.Itoa(int(nodesSetup.MetaChainMinNodes))
+	} else {
+		argsConfig.shardSizes = strconv.Itoa(int(nodesSetup.MinNodesPerShard))
+	}
+
+	return nil
+}
+
+// createRater creates the production rater. The rating steps depend on the number of nodes in the simulated shard
+func createRater(ratingsConfig config.RatingsConfig, shardID uint32, size uint32) (*rating.BlockSigningRater, error) {
+	args := rating.RatingsDataArg{
+		Config:                   ratingsConfig,
+		ShardConsensusSize:       uint32(argsConfig.consensusSize),
+		MetaConsensusSize:        uint32(argsConfig.metaConsensusSize),
+		ShardMinNodes:            size,
+		MetaMinNodes:             uint32(argsConfig.metaSize),
+		RoundDurationMiliseconds: argsConfig.roundDurationMillis,
+	}
+	if shardID == core.MetachainShardId {
+		args.MetaMinNodes = size
+	}
+
+	ratingsData, err := rating.NewRatingsData(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return rating.NewBlockSigningRater(ratingsData)
+}
+
+func runMode(out *output, sim simulatorHandler, shardID uint32, size uint32, start uint32) error {
+	switch argsConfig.mode {
+	case scenarioMode:
+		steps, err := simulator.ParseScenario(argsConfig.scenario)
+		if err != nil {
+			return err
+		}
+
+		printScenario(out, sim.RunScenario(shardID, start, steps), uint64(argsConfig.sample))
+		return nil
+	case jailMode:
+		printJail(out, sim, shardID, start)
+		return nil
+	case epochsMode:
+		consensus := uint32(argsConfig.consensusSize)
+		if shardID == core.MetachainShardId {
+			consensus = uint32(argsConfig.metaConsensusSize)
+		}
+
+		points, err := sim.SimulateEpochs(simulator.ArgsEpochSimulation{
+			ShardID:        shardID,
+			ShardSize:      size,
+			ConsensusSize:  consensus,
+			RoundsPerEpoch: argsConfig.roundsPerEpoch,
+			NumEpochs:      uint32(argsConfig.numEpochs),
+			Availability:   argsConfig.availability,
+			StartRating:    start,
+		})
+		if err != nil {
+			return err
+		}
+
+		printEpochs(out, points)
+		return nil
+	default:
+		return fmt.Errorf("unknown mode %s", argsConfig.mode)
+	}
+}
+
+func parseShard(shard string) (uint32, error) {
+	if shard == metaShard {
+		return core.MetachainShardId, nil
+	}
+
+	shardID, err := strconv.ParseUint(shard, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid shard %s: %w", shard, err)
+	}
+
+	return uint32(shardID), nil
+}
+
+func parseShardSizes(sizes string) ([]uint32, error) {
+	result := make([]uint32, 0)
+	for _, part := range strings.Split(sizes, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+
+		size, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || size == 0 {
+			return nil, fmt.Errorf("invalid shard size %s", part)
+		}
+		result = append(result, uint32(size))
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no shard size provided")
+	}
+
+	return result, nil
+}
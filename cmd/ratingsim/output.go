@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/ratingsim/simulator"
+)
+
+type simulatorHandler interface {
+	RunScenario(shardID uint32, startRating uint32, steps []simulator.Step) []simulator.Point
+	EventsUntilJailed(shardID uint32, startRating uint32, event simulator.Event) (uint64, bool)
+	SimulateEpochs(args simulator.ArgsEpochSimulation) ([]simulator.EpochPoint, error)
+}
+
+type output struct {
+	writer io.Writer
+	csv    bool
+}
+
+func newOutput(writer io.Writer, csv bool) *output {
+	return &output{
+		writer: writer,
+		csv:    csv,
+	}
+}
+
+func (o *output) title(title string) {
+	if o.csv {
+		_, _ = fmt.Fprintf(o.writer, "# %s\n", title)
+		return
+	}
+
+	_, _ = fmt.Fprintf(o.writer, "%s\n%s\n", title, strings.Repeat("=", len(title)))
+}
+
+func (o *output) row(values ...interface{}) {
+	cells := make([]string, len(values))
+	for i, value := range values {
+		cells[i] = fmt.Sprintf("%v", value)
+	}
+
+	if o.csv {
+		_, _ = fmt.Fprintln(o.writer, strings.Join(cells, ","))
+		return
+	}
+
+	for i := range cells {
+		cells[i] = fmt.Sprintf("%12s", cells[i])
+	}
+	_, _ = fmt.Fprintln(o.writer, strings.Join(cells, " "))
+}
+
+func printScenario(out *output, points []simulator.Point, sample uint64) {
+	if sample == 0 {
+		sample = 1
+	}
+
+	out.row("index", "event", "rating", "chance", "jailed")
+	for i, point := range points {
+		isLast := i == len(points)-1
+		if point.Index%sample != 0 && !isLast {
+			continue
+		}
+
+		out.row(point.Index, point.Event, point.Rating, point.Chance, point.Jailed)
+	}
+}
+
+func printJail(out *output, sim simulatorHandler, shardID uint32, start uint32) {
+	out.row("event", "until jailed")
+	for _, event := range []simulator.Event{simulator.ProposerMiss, simulator.ValidatorMiss} {
+		numEvents, jailable := sim.EventsUntilJailed(shardID, start, event)
+		value := fmt.Sprintf("%d", numEvents)
+		if !jailable {
+			value = "never"
+		}
+
+		out.row(event, value)
+	}
+}
+
+func printEpochs(out *output, points []simulator.EpochPoint) {
+	out.row("epoch", "rating", "chance", "proposed", "validated", "prop. miss", "valid. miss", "jailed")
+	for _, point := range points {
+		out.row(
+			point.Epoch,
+			point.Rating,
+			point.Chance,
+			point.NumProposed,
+			point.NumValidated,
+			point.NumProposerMisses,
+			point.NumValidatorMiss,
+			point.Jailed,
+		)
+	}
+}
@@ -0,0 +1,21 @@
+package simulator
+
+import "errors"
+
+// ErrNilRater signals that a nil rater was provided
+var ErrNilRater = errors.New("nil rater")
+
+// ErrEmptyScenario signals that the scenario does not contain any step
+var ErrEmptyScenario = errors.New("empty scenario")
+
+// ErrInvalidScenarioStep signals that a scenario step could not be parsed
+var ErrInvalidScenarioStep = errors.New("invalid scenario step")
+
+// ErrInvalidShardSize signals that an invalid shard size was provided
+var ErrInvalidShardSize = errors.New("invalid shard size")
+
+// ErrInvalidConsensusSize signals that an invalid consensus size was provided
+var ErrInvalidConsensusSize = errors.New("invalid consensus size")
+
+// ErrInvalidAvailability signals that the provided availability is not between 0 and 1
+var ErrInvalidAvailability = errors.New("invalid availability")
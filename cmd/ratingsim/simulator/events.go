@@ -0,0 +1,81 @@
+package simulator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Event defines a rating event happening to the simulated validator
+type Event string
+
+const (
+	// ProposerSuccess is the event of proposing a block that got committed
+	ProposerSuccess Event = "ps"
+	// ProposerMiss is the event of failing to propose a block
+	ProposerMiss Event = "pm"
+	// ValidatorSuccess is the event of signing a block as a consensus member
+	ValidatorSuccess Event = "vs"
+	// ValidatorMiss is the event of being a consensus member in a round without a committed block
+	ValidatorMiss Event = "vm"
+)
+
+// Step defines an event repeated a number of times
+type Step struct {
+	Event Event
+	Count uint32
+}
+
+// ParseScenario parses a comma separated list of steps. Each step is a count followed by an event, for example
+// "100vs,10ps,5pm" means 100 validator successes, followed by 10 proposer successes and 5 consecutive proposer misses
+func ParseScenario(scenario string) ([]Step, error) {
+	steps := make([]Step, 0)
+	for _, part := range strings.Split(scenario, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+
+		step, err := parseStep(part)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+
+	if len(steps) == 0 {
+		return nil, ErrEmptyScenario
+	}
+
+	return steps, nil
+}
+
+func parseStep(part string) (Step, error) {
+	idx := strings.IndexFunc(part, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if idx < 0 {
+		return Step{}, fmt.Errorf("%w: missing event in %s", ErrInvalidScenarioStep, part)
+	}
+
+	count := uint64(1)
+	if idx > 0 {
+		var err error
+		count, err = strconv.ParseUint(part[:idx], 10, 32)
+		if err != nil {
+			return Step{}, fmt.Errorf("%w: %s", ErrInvalidScenarioStep, err.Error())
+		}
+	}
+
+	event := Event(part[idx:])
+	switch event {
+	case ProposerSuccess, ProposerMiss, ValidatorSuccess, ValidatorMiss:
+	default:
+		return Step{}, fmt.Errorf("%w: unknown event %s", ErrInvalidScenarioStep, event)
+	}
+
+	return Step{
+		Event: event,
+		Count: uint32(count),
+	}, nil
+}
@@ -0,0 +1,13 @@
+package simulator
+
+// Rater defines the rating computations used by the simulator. It is satisfied by the production
+// rating.BlockSigningRater
+type Rater interface {
+	GetStartRating() uint32
+	ComputeIncreaseProposer(shardId uint32, currentRating uint32) uint32
+	ComputeDecreaseProposer(shardId uint32, currentRating uint32, consecutiveMisses uint32) uint32
+	ComputeIncreaseValidator(shardId uint32, currentRating uint32) uint32
+	ComputeDecreaseValidator(shardId uint32, currentRating uint32) uint32
+	GetChance(rating uint32) uint32
+	IsInterfaceNil() bool
+}
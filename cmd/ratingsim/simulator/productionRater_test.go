@@ -0,0 +1,45 @@
+package simulator
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRatingSimulator_WithProductionRaterAndConfig(t *testing.T) {
+	t.Parallel()
+
+	ratingsConfig := config.RatingsConfig{}
+	err := core.LoadTomlFile(&ratingsConfig, "../../node/config/ratings.toml")
+	require.Nil(t, err)
+
+	ratingsData, err := rating.NewRatingsData(rating.RatingsDataArg{
+		Config:                   ratingsConfig,
+		ShardConsensusSize:       63,
+		MetaConsensusSize:        400,
+		ShardMinNodes:            400,
+		MetaMinNodes:             400,
+		RoundDurationMiliseconds: 6000,
+	})
+	require.Nil(t, err)
+	rater, err := rating.NewBlockSigningRater(ratingsData)
+	require.Nil(t, err)
+
+	sim, err := NewRatingSimulator(rater)
+	require.Nil(t, err)
+
+	numProposerMisses, jailable := sim.EventsUntilJailed(0, rater.GetStartRating(), ProposerMiss)
+	assert.True(t, jailable)
+	assert.True(t, numProposerMisses > 0)
+
+	numValidatorMisses, jailable := sim.EventsUntilJailed(0, rater.GetStartRating(), ValidatorMiss)
+	assert.True(t, jailable)
+	assert.True(t, numValidatorMisses > numProposerMisses)
+
+	_, jailable = sim.EventsUntilJailed(0, rater.GetStartRating(), ValidatorSuccess)
+	assert.False(t, jailable)
+}
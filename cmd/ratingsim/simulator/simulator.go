@@ -0,0 +1,227 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const (
+	// maxSimulatedEvents limits the number of events applied when searching for the jail threshold
+	maxSimulatedEvents = 10000000
+	// availabilityPrecision is used to spread the missed duties using integer arithmetic
+	availabilityPrecision = 1000000
+)
+
+// Point holds the state of the simulated validator after applying an event
+type Point struct {
+	Index  uint64
+	Event  Event
+	Rating uint32
+	Chance uint32
+	Jailed bool
+}
+
+// ArgsEpochSimulation holds the parameters of an epochs based simulation
+type ArgsEpochSimulation struct {
+	ShardID        uint32
+	ShardSize      uint32
+	ConsensusSize  uint32
+	RoundsPerEpoch uint64
+	NumEpochs      uint32
+	Availability   float64
+	StartRating    uint32
+}
+
+// EpochPoint holds the state of the simulated validator at the end of an epoch
+type EpochPoint struct {
+	Epoch             uint32
+	Rating            uint32
+	Chance            uint32
+	Jailed            bool
+	NumProposed       uint64
+	NumValidated      uint64
+	NumProposerMisses uint64
+	NumValidatorMiss  uint64
+}
+
+// ratingSimulator applies sequences of events on a validator rating using the production rater
+type ratingSimulator struct {
+	rater     Rater
+	minChance uint32
+}
+
+// NewRatingSimulator creates a new rating simulator
+func NewRatingSimulator(rater Rater) (*ratingSimulator, error) {
+	if check.IfNil(rater) {
+		return nil, ErrNilRater
+	}
+
+	return &ratingSimulator{
+		rater:     rater,
+		minChance: rater.GetChance(0),
+	}, nil
+}
+
+// IsJailable returns true if a validator with the provided rating would be jailed. This mirrors the rule used by the
+// validator statistics processor and the nodes coordinator: the chance for the rating is lower than the chance for 0
+func (rs *ratingSimulator) IsJailable(rating uint32) bool {
+	return rs.rater.GetChance(rating) < rs.minChance
+}
+
+// RunScenario applies the scenario steps, starting with the provided rating, and returns one point for each event
+func (rs *ratingSimulator) RunScenario(shardID uint32, startRating uint32, steps []Step) []Point {
+	state := &validatorState{rating: startRating}
+	points := make([]Point, 0)
+	index := uint64(0)
+
+	for _, step := range steps {
+		for i := uint32(0); i < step.Count; i++ {
+			rs.applyEvent(shardID, state, step.Event)
+			index++
+
+			points = append(points, Point{
+				Index:  index,
+				Event:  step.Event,
+				Rating: state.rating,
+				Chance: rs.rater.GetChance(state.rating),
+				Jailed: rs.IsJailable(state.rating),
+			})
+		}
+	}
+
+	return points
+}
+
+// EventsUntilJailed returns how many consecutive events of the provided type are needed for a validator having the
+// start rating to become jailable. The returned flag is false if the validator can not be jailed by this event
+func (rs *ratingSimulator) EventsUntilJailed(shardID uint32, startRating uint32, event Event) (uint64, bool) {
+	state := &validatorState{rating: startRating}
+	if rs.IsJailable(state.rating) {
+		return 0, true
+	}
+
+	for i := uint64(1); i <= maxSimulatedEvents; i++ {
+		previousRating := state.rating
+		rs.applyEvent(shardID, state, event)
+		if rs.IsJailable(state.rating) {
+			return i, true
+		}
+
+		ratingDidNotDecrease := state.rating >= previousRating
+		if ratingDidNotDecrease {
+			return i, false
+		}
+	}
+
+	return maxSimulatedEvents, false
+}
+
+// SimulateEpochs simulates a validator in a shard of the provided size. In each round the validator is proposer with
+// a probability of 1/shardSize and consensus member with a probability of (consensusSize-1)/shardSize. The events
+// are spread evenly across rounds (no randomness is involved) so the results are reproducible. The validator
+// performs the assigned duty with the provided availability
+func (rs *ratingSimulator) SimulateEpochs(args ArgsEpochSimulation) ([]EpochPoint, error) {
+	err := checkEpochSimulationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &validatorState{rating: args.StartRating}
+	shardSize := uint64(args.ShardSize)
+	availability := uint64(math.Round(args.Availability * availabilityPrecision))
+	proposerAccumulator := uint64(0)
+	validatorAccumulator := uint64(0)
+	availabilityAccumulator := uint64(0)
+
+	points := make([]EpochPoint, 0, args.NumEpochs)
+	for epoch := uint32(1); epoch <= args.NumEpochs; epoch++ {
+		point := EpochPoint{Epoch: epoch}
+
+		for round := uint64(0); round < args.RoundsPerEpoch && !state.jailed; round++ {
+			proposerAccumulator++
+			validatorAccumulator += uint64(args.ConsensusSize - 1)
+
+			if proposerAccumulator >= shardSize {
+				proposerAccumulator -= shardSize
+				if isAvailable(&availabilityAccumulator, availability) {
+					rs.applyEvent(args.ShardID, state, ProposerSuccess)
+					point.NumProposed++
+				} else {
+					rs.applyEvent(args.ShardID, state, ProposerMiss)
+					point.NumProposerMisses++
+				}
+			} else if validatorAccumulator >= shardSize {
+				validatorAccumulator -= shardSize
+				if isAvailable(&availabilityAccumulator, availability) {
+					rs.applyEvent(args.ShardID, state, ValidatorSuccess)
+					point.NumValidated++
+				} else {
+					rs.applyEvent(args.ShardID, state, ValidatorMiss)
+					point.NumValidatorMiss++
+				}
+			}
+
+			state.jailed = rs.IsJailable(state.rating)
+		}
+
+		point.Rating = state.rating
+		point.Chance = rs.rater.GetChance(state.rating)
+		point.Jailed = state.jailed
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+func checkEpochSimulationArgs(args ArgsEpochSimulation) error {
+	if args.ShardSize == 0 {
+		return ErrInvalidShardSize
+	}
+	if args.ConsensusSize == 0 || args.ConsensusSize > args.ShardSize {
+		return fmt.Errorf("%w: consensus size %d, shard size %d", ErrInvalidConsensusSize, args.ConsensusSize, args.ShardSize)
+	}
+	if args.Availability < 0 || args.Availability > 1 {
+		return fmt.Errorf("%w: %v", ErrInvalidAvailability, args.Availability)
+	}
+
+	return nil
+}
+
+func isAvailable(accumulator *uint64, availability uint64) bool {
+	*accumulator += availability
+	if *accumulator >= availabilityPrecision {
+		*accumulator -= availabilityPrecision
+		return true
+	}
+
+	return false
+}
+
+type validatorState struct {
+	rating                    uint32
+	consecutiveProposerMisses uint32
+	jailed                    bool
+}
+
+// applyEvent mirrors the way the validator statistics processor updates the temp rating
+func (rs *ratingSimulator) applyEvent(shardID uint32, state *validatorState, event Event) {
+	switch event {
+	case ProposerSuccess:
+		state.consecutiveProposerMisses = 0
+		state.rating = rs.rater.ComputeIncreaseProposer(shardID, state.rating)
+	case ProposerMiss:
+		state.rating = rs.rater.ComputeDecreaseProposer(shardID, state.rating, state.consecutiveProposerMisses)
+		state.consecutiveProposerMisses++
+	case ValidatorSuccess:
+		state.rating = rs.rater.ComputeIncreaseValidator(shardID, state.rating)
+	case ValidatorMiss:
+		state.rating = rs.rater.ComputeDecreaseValidator(shardID, state.rating)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *ratingSimulator) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,218 @@
+package simulator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// raterStub uses linear steps: +10 for successes, -100 for validator misses and -100 * (consecutiveMisses + 1) for
+// proposer misses. Ratings are kept between 1 and 1000. Ratings up to 100 are jailable
+type raterStub struct{}
+
+func (rs *raterStub) GetStartRating() uint32 {
+	return 500
+}
+
+func (rs *raterStub) ComputeIncreaseProposer(_ uint32, currentRating uint32) uint32 {
+	return bound(int64(currentRating) + 10)
+}
+
+func (rs *raterStub) ComputeDecreaseProposer(_ uint32, currentRating uint32, consecutiveMisses uint32) uint32 {
+	return bound(int64(currentRating) - 100*int64(consecutiveMisses+1))
+}
+
+func (rs *raterStub) ComputeIncreaseValidator(_ uint32, currentRating uint32) uint32 {
+	return bound(int64(currentRating) + 10)
+}
+
+func (rs *raterStub) ComputeDecreaseValidator(_ uint32, currentRating uint32) uint32 {
+	return bound(int64(currentRating) - 100)
+}
+
+func (rs *raterStub) GetChance(rating uint32) uint32 {
+	switch {
+	case rating == 0:
+		return 5
+	case rating <= 100:
+		return 0
+	default:
+		return 10
+	}
+}
+
+func (rs *raterStub) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+func bound(rating int64) uint32 {
+	if rating < 1 {
+		return 1
+	}
+	if rating > 1000 {
+		return 1000
+	}
+
+	return uint32(rating)
+}
+
+func TestNewRatingSimulator_NilRaterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sim, err := NewRatingSimulator(nil)
+
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrNilRater, err)
+}
+
+func TestRatingSimulator_IsJailable(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewRatingSimulator(&raterStub{})
+
+	assert.False(t, check.IfNil(sim))
+	assert.True(t, sim.IsJailable(1))
+	assert.True(t, sim.IsJailable(100))
+	assert.False(t, sim.IsJailable(101))
+}
+
+func TestRatingSimulator_RunScenarioShouldApplyEventsInOrder(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewRatingSimulator(&raterStub{})
+	steps := []Step{
+		{Event: ValidatorSuccess, Count: 2},
+		{Event: ProposerMiss, Count: 2},
+		{Event: ProposerSuccess, Count: 1},
+		{Event: ProposerMiss, Count: 1},
+		{Event: ValidatorMiss, Count: 2},
+	}
+
+	points := sim.RunScenario(0, 500, steps)
+	require.Equal(t, 8, len(points))
+
+	expectedRatings := []uint32{510, 520, 420, 220, 230, 130, 30, 1}
+	for i, point := range points {
+		assert.Equal(t, uint64(i+1), point.Index)
+		assert.Equal(t, expectedRatings[i], point.Rating)
+	}
+	assert.False(t, points[5].Jailed)
+	assert.True(t, points[6].Jailed)
+	assert.Equal(t, uint32(0), points[6].Chance)
+}
+
+func TestRatingSimulator_EventsUntilJailed(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewRatingSimulator(&raterStub{})
+
+	numEvents, jailable := sim.EventsUntilJailed(0, 500, ValidatorMiss)
+	assert.True(t, jailable)
+	assert.Equal(t, uint64(4), numEvents)
+
+	numEvents, jailable = sim.EventsUntilJailed(0, 500, ProposerMiss)
+	assert.True(t, jailable)
+	assert.Equal(t, uint64(3), numEvents)
+
+	numEvents, jailable = sim.EventsUntilJailed(0, 50, ProposerMiss)
+	assert.True(t, jailable)
+	assert.Equal(t, uint64(0), numEvents)
+
+	_, jailable = sim.EventsUntilJailed(0, 500, ValidatorSuccess)
+	assert.False(t, jailable)
+}
+
+func TestRatingSimulator_SimulateEpochsInvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewRatingSimulator(&raterStub{})
+	args := ArgsEpochSimulation{
+		ShardSize:      10,
+		ConsensusSize:  5,
+		RoundsPerEpoch: 100,
+		NumEpochs:      2,
+		Availability:   1,
+		StartRating:    500,
+	}
+
+	argsCopy := args
+	argsCopy.ShardSize = 0
+	_, err := sim.SimulateEpochs(argsCopy)
+	assert.Equal(t, ErrInvalidShardSize, err)
+
+	argsCopy = args
+	argsCopy.ConsensusSize = 11
+	_, err = sim.SimulateEpochs(argsCopy)
+	assert.True(t, errors.Is(err, ErrInvalidConsensusSize))
+
+	argsCopy = args
+	argsCopy.Availability = 1.5
+	_, err = sim.SimulateEpochs(argsCopy)
+	assert.True(t, errors.Is(err, ErrInvalidAvailability))
+}
+
+func TestRatingSimulator_SimulateEpochsFullAvailability(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewRatingSimulator(&raterStub{})
+	points, err := sim.SimulateEpochs(ArgsEpochSimulation{
+		ShardSize:      10,
+		ConsensusSize:  5,
+		RoundsPerEpoch: 100,
+		NumEpochs:      2,
+		Availability:   1,
+		StartRating:    500,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(points))
+
+	assert.Equal(t, uint64(10), points[0].NumProposed)
+	assert.Equal(t, uint64(0), points[0].NumProposerMisses)
+	assert.Equal(t, uint64(0), points[0].NumValidatorMiss)
+	assert.True(t, points[0].NumValidated > 0)
+	assert.Equal(t, uint32(1000), points[1].Rating)
+	assert.False(t, points[1].Jailed)
+}
+
+func TestRatingSimulator_SimulateEpochsNoAvailabilityShouldJail(t *testing.T) {
+	t.Parallel()
+
+	sim, _ := NewRatingSimulator(&raterStub{})
+	points, err := sim.SimulateEpochs(ArgsEpochSimulation{
+		ShardSize:      10,
+		ConsensusSize:  5,
+		RoundsPerEpoch: 100,
+		NumEpochs:      3,
+		Availability:   0,
+		StartRating:    500,
+	})
+	require.Nil(t, err)
+
+	assert.True(t, points[0].Jailed)
+	assert.Equal(t, uint64(0), points[0].NumProposed+points[0].NumValidated)
+	assert.Equal(t, uint64(0), points[2].NumProposerMisses+points[2].NumValidatorMiss)
+}
+
+func TestParseScenario(t *testing.T) {
+	t.Parallel()
+
+	steps, err := ParseScenario(" 100vs, ps,3pm ,")
+	require.Nil(t, err)
+	assert.Equal(t, []Step{
+		{Event: ValidatorSuccess, Count: 100},
+		{Event: ProposerSuccess, Count: 1},
+		{Event: ProposerMiss, Count: 3},
+	}, steps)
+
+	_, err = ParseScenario("")
+	assert.Equal(t, ErrEmptyScenario, err)
+
+	_, err = ParseScenario("10xx")
+	assert.True(t, errors.Is(err, ErrInvalidScenarioStep))
+
+	_, err = ParseScenario("10")
+	assert.True(t, errors.Is(err, ErrInvalidScenarioStep))
+}
@@ -5,6 +5,9 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/crawler"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -13,18 +16,66 @@ import (
 
 var log = logger.GetOrCreate("seednode/api")
 
-// Start will boot up the api and appropriate routes, handlers and validators
-func Start(restApiInterface string, marshalizer marshal.Marshalizer) error {
+const errCrawlerDisabled = "the network crawler is disabled"
+
+// TopologyHandler defines the behavior of a component able to provide the network topology
+type TopologyHandler interface {
+	GetTopology() *crawler.Topology
+	IsInterfaceNil() bool
+}
+
+// Start will boot up the api and appropriate routes, handlers and validators. The topology handler can be nil if the
+// network crawler is disabled
+func Start(restApiInterface string, marshalizer marshal.Marshalizer, topologyHandler TopologyHandler) error {
 	ws := gin.Default()
 	ws.Use(cors.Default())
 
-	registerRoutes(ws, marshalizer)
+	registerRoutes(ws, marshalizer, topologyHandler)
 
 	return ws.Run(restApiInterface)
 }
 
-func registerRoutes(ws *gin.Engine, marshalizer marshal.Marshalizer) {
+func registerRoutes(ws *gin.Engine, marshalizer marshal.Marshalizer, topologyHandler TopologyHandler) {
 	registerLoggerWsRoute(ws, marshalizer)
+	registerTopologyRoutes(ws, topologyHandler)
+}
+
+func registerTopologyRoutes(ws *gin.Engine, topologyHandler TopologyHandler) {
+	ws.GET("/network/topology", func(c *gin.Context) {
+		if check.IfNil(topologyHandler) {
+			respondCrawlerDisabled(c)
+			return
+		}
+
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"topology": topologyHandler.GetTopology()},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+	})
+
+	ws.GET("/network/topology/graphviz", func(c *gin.Context) {
+		if check.IfNil(topologyHandler) {
+			respondCrawlerDisabled(c)
+			return
+		}
+
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(topologyHandler.GetTopology().GraphViz()))
+	})
+}
+
+func respondCrawlerDisabled(c *gin.Context) {
+	c.JSON(
+		http.StatusServiceUnavailable,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: errCrawlerDisabled,
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
 }
 
 func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
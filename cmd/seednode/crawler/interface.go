@@ -0,0 +1,15 @@
+package crawler
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// CrawlerMessenger defines the messenger behavior needed by the network crawler
+type CrawlerMessenger interface {
+	ID() core.PeerID
+	Peers() []core.PeerID
+	ConnectedPeers() []core.PeerID
+	PeerAddresses(pid core.PeerID) []string
+	QueryDhtNeighbours(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,382 @@
+package crawler
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var log = logger.GetOrCreate("seednode/crawler")
+
+const minCrawlInterval = time.Second
+
+// ArgsNetworkCrawler is the DTO used to create a new network crawler
+type ArgsNetworkCrawler struct {
+	Messenger            CrawlerMessenger
+	Marshalizer          marshal.Marshalizer
+	CrawlInterval        time.Duration
+	MaxPeersPerRound     int
+	NumDhtQueriesPerPeer int
+	PeerExpiry           time.Duration
+}
+
+type peerState struct {
+	addresses       []string
+	shard           string
+	publicKey       string
+	version         string
+	displayName     string
+	identity        string
+	reachable       bool
+	connectedToSeed bool
+	firstSeen       time.Time
+	lastSeen        time.Time
+	lastCrawled     time.Time
+	lastHeartbeat   time.Time
+	neighbours      map[core.PeerID]struct{}
+}
+
+type networkCrawler struct {
+	messenger            CrawlerMessenger
+	marshalizer          marshal.Marshalizer
+	crawlInterval        time.Duration
+	maxPeersPerRound     int
+	numDhtQueriesPerPeer int
+	peerExpiry           time.Duration
+	cancelFunc           context.CancelFunc
+
+	mutPeers sync.RWMutex
+	peers    map[core.PeerID]*peerState
+}
+
+// NewNetworkCrawler creates a component that periodically walks the network through the kad-dht routing tables of the
+// known peers and learns the shard and the software version of each peer from the heartbeat messages. The gathered
+// information can be exported as a network topology
+func NewNetworkCrawler(args ArgsNetworkCrawler) (*networkCrawler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkCrawler{
+		messenger:            args.Messenger,
+		marshalizer:          args.Marshalizer,
+		crawlInterval:        args.CrawlInterval,
+		maxPeersPerRound:     args.MaxPeersPerRound,
+		numDhtQueriesPerPeer: args.NumDhtQueriesPerPeer,
+		peerExpiry:           args.PeerExpiry,
+		peers:                make(map[core.PeerID]*peerState),
+	}, nil
+}
+
+func checkArgs(args ArgsNetworkCrawler) error {
+	if check.IfNil(args.Messenger) {
+		return ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if args.CrawlInterval < minCrawlInterval {
+		return fmt.Errorf("%w for the crawl interval, minimum %v, provided %v",
+			ErrInvalidValue, minCrawlInterval, args.CrawlInterval)
+	}
+	if args.MaxPeersPerRound < 1 {
+		return fmt.Errorf("%w for MaxPeersPerRound: %d", ErrInvalidValue, args.MaxPeersPerRound)
+	}
+	if args.NumDhtQueriesPerPeer < 1 {
+		return fmt.Errorf("%w for NumDhtQueriesPerPeer: %d", ErrInvalidValue, args.NumDhtQueriesPerPeer)
+	}
+	if args.PeerExpiry < args.CrawlInterval {
+		return fmt.Errorf("%w for the peer expiry, should be at least the crawl interval", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// StartCrawling starts the crawling go routine
+func (nc *networkCrawler) StartCrawling() {
+	var ctx context.Context
+	ctx, nc.cancelFunc = context.WithCancel(context.Background())
+
+	go nc.crawlLoop(ctx)
+}
+
+func (nc *networkCrawler) crawlLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("network crawler's go routine is stopping...")
+			return
+		case <-time.After(nc.crawlInterval):
+		}
+
+		nc.CrawlOnce()
+	}
+}
+
+// CrawlOnce executes a crawling round: refreshes the seed node's direct connections, queries the least recently
+// crawled peers about their neighbours and removes the peers that were not seen for a long time
+func (nc *networkCrawler) CrawlOnce() {
+	now := time.Now()
+	nc.updateConnectedPeers(now)
+	nc.addPeerstorePeers(now)
+
+	for _, pid := range nc.selectPeersToCrawl() {
+		nc.crawlPeer(pid)
+	}
+
+	nc.removeExpiredPeers()
+}
+
+func (nc *networkCrawler) updateConnectedPeers(now time.Time) {
+	connectedPeers := nc.messenger.ConnectedPeers()
+
+	nc.mutPeers.Lock()
+	defer nc.mutPeers.Unlock()
+
+	for _, state := range nc.peers {
+		state.connectedToSeed = false
+	}
+	for _, pid := range connectedPeers {
+		state := nc.getOrCreatePeerState(pid, now)
+		state.connectedToSeed = true
+		state.reachable = true
+		state.lastSeen = now
+	}
+}
+
+func (nc *networkCrawler) addPeerstorePeers(now time.Time) {
+	self := nc.messenger.ID()
+	peers := nc.messenger.Peers()
+
+	nc.mutPeers.Lock()
+	defer nc.mutPeers.Unlock()
+
+	for _, pid := range peers {
+		if pid == self {
+			continue
+		}
+
+		_, found := nc.peers[pid]
+		if !found {
+			nc.getOrCreatePeerState(pid, now)
+		}
+	}
+}
+
+// getOrCreatePeerState should be called under mutex protection
+func (nc *networkCrawler) getOrCreatePeerState(pid core.PeerID, now time.Time) *peerState {
+	state, found := nc.peers[pid]
+	if found {
+		return state
+	}
+
+	state = &peerState{
+		shard:      UnknownShard,
+		firstSeen:  now,
+		lastSeen:   now,
+		neighbours: make(map[core.PeerID]struct{}),
+	}
+	nc.peers[pid] = state
+
+	return state
+}
+
+func (nc *networkCrawler) selectPeersToCrawl() []core.PeerID {
+	nc.mutPeers.RLock()
+	candidates := make([]core.PeerID, 0, len(nc.peers))
+	lastCrawled := make(map[core.PeerID]time.Time, len(nc.peers))
+	for pid, state := range nc.peers {
+		candidates = append(candidates, pid)
+		lastCrawled[pid] = state.lastCrawled
+	}
+	nc.mutPeers.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		ti, tj := lastCrawled[candidates[i]], lastCrawled[candidates[j]]
+		if ti.Equal(tj) {
+			return candidates[i] < candidates[j]
+		}
+
+		return ti.Before(tj)
+	})
+
+	if len(candidates) > nc.maxPeersPerRound {
+		candidates = candidates[:nc.maxPeersPerRound]
+	}
+
+	return candidates
+}
+
+func (nc *networkCrawler) crawlPeer(pid core.PeerID) {
+	neighbours, err := nc.messenger.QueryDhtNeighbours(pid, nc.numDhtQueriesPerPeer)
+	addresses := nc.messenger.PeerAddresses(pid)
+	now := time.Now()
+
+	nc.mutPeers.Lock()
+	defer nc.mutPeers.Unlock()
+
+	state := nc.getOrCreatePeerState(pid, now)
+	state.lastCrawled = now
+	if len(addresses) > 0 {
+		state.addresses = addresses
+	}
+	if err != nil {
+		log.Trace("network crawler: peer not reachable", "pid", pid.Pretty(), "error", err)
+		state.reachable = state.connectedToSeed
+		return
+	}
+
+	state.reachable = true
+	state.lastSeen = now
+	state.neighbours = make(map[core.PeerID]struct{}, len(neighbours))
+
+	self := nc.messenger.ID()
+	for neighbour, neighbourAddresses := range neighbours {
+		if neighbour == self {
+			continue
+		}
+
+		state.neighbours[neighbour] = struct{}{}
+		neighbourState := nc.getOrCreatePeerState(neighbour, now)
+		neighbourState.lastSeen = now
+		if len(neighbourAddresses) > 0 {
+			neighbourState.addresses = neighbourAddresses
+		}
+	}
+}
+
+func (nc *networkCrawler) removeExpiredPeers() {
+	nc.mutPeers.Lock()
+	defer nc.mutPeers.Unlock()
+
+	for pid, state := range nc.peers {
+		if state.connectedToSeed || time.Since(state.lastSeen) <= nc.peerExpiry {
+			continue
+		}
+
+		delete(nc.peers, pid)
+		log.Trace("network crawler: removed expired peer", "pid", pid.Pretty())
+	}
+}
+
+// ProcessReceivedMessage records the shard and the software version advertised by the originator of a heartbeat
+// message. The p2p layer checks the message signature, so the originator's peer ID can be trusted
+func (nc *networkCrawler) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+
+	hb := &data.Heartbeat{}
+	err := nc.marshalizer.Unmarshal(hb, message.Data())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	nc.mutPeers.Lock()
+	defer nc.mutPeers.Unlock()
+
+	state := nc.getOrCreatePeerState(message.Peer(), now)
+	state.shard = core.GetShardIDString(hb.ShardID)
+	state.publicKey = hex.EncodeToString(hb.Pubkey)
+	state.version = hb.VersionNumber
+	state.displayName = hb.NodeDisplayName
+	state.identity = hb.Identity
+	state.lastSeen = now
+	state.lastHeartbeat = now
+
+	return nil
+}
+
+// GetTopology returns the network topology as gathered so far. The links point to neighbours that are still tracked
+func (nc *networkCrawler) GetTopology() *Topology {
+	nc.mutPeers.RLock()
+	defer nc.mutPeers.RUnlock()
+
+	peers := make([]*PeerNode, 0, len(nc.peers))
+	links := make([]*PeerLink, 0)
+	for pid, state := range nc.peers {
+		peers = append(peers, &PeerNode{
+			Pid:                pid.Pretty(),
+			Addresses:          state.addresses,
+			Shard:              state.shard,
+			PublicKey:          state.publicKey,
+			Version:            state.version,
+			DisplayName:        state.displayName,
+			Identity:           state.identity,
+			Reachable:          state.reachable,
+			ConnectedToSeed:    state.connectedToSeed,
+			NumKnownNeighbours: len(state.neighbours),
+			FirstSeen:          state.firstSeen.Unix(),
+			LastSeen:           state.lastSeen.Unix(),
+			LastCrawled:        unixOrZero(state.lastCrawled),
+			LastHeartbeat:      unixOrZero(state.lastHeartbeat),
+		})
+
+		for neighbour := range state.neighbours {
+			neighbourState, found := nc.peers[neighbour]
+			if !found {
+				continue
+			}
+
+			links = append(links, &PeerLink{
+				From: pid.Pretty(),
+				To:   neighbour.Pretty(),
+				Type: linkType(state.shard, neighbourState.shard),
+			})
+		}
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Pid < peers[j].Pid
+	})
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].From == links[j].From {
+			return links[i].To < links[j].To
+		}
+
+		return links[i].From < links[j].From
+	})
+
+	return &Topology{
+		SeedPid:   nc.messenger.ID().Pretty(),
+		Timestamp: time.Now().Unix(),
+		Peers:     peers,
+		Links:     links,
+		Shards:    computeShardSummaries(peers, links),
+	}
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+// Close stops the crawling go routine
+func (nc *networkCrawler) Close() error {
+	if nc.cancelFunc != nil {
+		nc.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nc *networkCrawler) IsInterfaceNil() bool {
+	return nc == nil
+}
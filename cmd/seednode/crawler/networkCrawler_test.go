@@ -0,0 +1,252 @@
+package crawler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/mock"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsNetworkCrawler() ArgsNetworkCrawler {
+	return ArgsNetworkCrawler{
+		Messenger:            &mock.CrawlerMessengerStub{},
+		Marshalizer:          &marshal.GogoProtoMarshalizer{},
+		CrawlInterval:        time.Second,
+		MaxPeersPerRound:     10,
+		NumDhtQueriesPerPeer: 2,
+		PeerExpiry:           time.Minute,
+	}
+}
+
+func createHeartbeatMessage(t *testing.T, pid core.PeerID, shardID uint32, version string) *mock.P2PMessageMock {
+	hb := &data.Heartbeat{
+		Pubkey:          []byte("pk"),
+		ShardID:         shardID,
+		VersionNumber:   version,
+		NodeDisplayName: "node " + string(pid),
+	}
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(hb)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func findPeer(topology *Topology, pid core.PeerID) *PeerNode {
+	for _, p := range topology.Peers {
+		if p.Pid == pid.Pretty() {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func TestNewNetworkCrawler_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNetworkCrawler()
+	args.Messenger = nil
+	nc, err := NewNetworkCrawler(args)
+	assert.True(t, check.IfNil(nc))
+	assert.Equal(t, ErrNilMessenger, err)
+
+	args = createMockArgsNetworkCrawler()
+	args.Marshalizer = nil
+	nc, err = NewNetworkCrawler(args)
+	assert.True(t, check.IfNil(nc))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsNetworkCrawler()
+	args.CrawlInterval = time.Millisecond
+	nc, err = NewNetworkCrawler(args)
+	assert.True(t, check.IfNil(nc))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createMockArgsNetworkCrawler()
+	args.MaxPeersPerRound = 0
+	nc, err = NewNetworkCrawler(args)
+	assert.True(t, check.IfNil(nc))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createMockArgsNetworkCrawler()
+	args.NumDhtQueriesPerPeer = 0
+	nc, err = NewNetworkCrawler(args)
+	assert.True(t, check.IfNil(nc))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	args = createMockArgsNetworkCrawler()
+	args.PeerExpiry = args.CrawlInterval - 1
+	nc, err = NewNetworkCrawler(args)
+	assert.True(t, check.IfNil(nc))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNewNetworkCrawler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nc, err := NewNetworkCrawler(createMockArgsNetworkCrawler())
+
+	assert.False(t, check.IfNil(nc))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(nc.GetTopology().Peers))
+}
+
+func TestNetworkCrawler_ProcessReceivedMessageShouldRecordShardAndVersion(t *testing.T) {
+	t.Parallel()
+
+	nc, _ := NewNetworkCrawler(createMockArgsNetworkCrawler())
+
+	err := nc.ProcessReceivedMessage(nil, "")
+	assert.Equal(t, ErrNilMessage, err)
+
+	err = nc.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("not a heartbeat")}, "")
+	assert.NotNil(t, err)
+
+	err = nc.ProcessReceivedMessage(createHeartbeatMessage(t, "pid", core.MetachainShardId, "v1.1.0"), "relayer")
+	assert.Nil(t, err)
+
+	p := findPeer(nc.GetTopology(), "pid")
+	require.NotNil(t, p)
+	assert.Equal(t, "metachain", p.Shard)
+	assert.Equal(t, "v1.1.0", p.Version)
+	assert.Equal(t, "node pid", p.DisplayName)
+	assert.Equal(t, "706b", p.PublicKey)
+	assert.True(t, p.LastHeartbeat > 0)
+	assert.Nil(t, findPeer(nc.GetTopology(), "relayer"))
+}
+
+func TestNetworkCrawler_CrawlOnceShouldBuildLinks(t *testing.T) {
+	t.Parallel()
+
+	neighbours := map[core.PeerID]map[core.PeerID][]string{
+		"pid0a": {"pid0b": {"/ip4/10.0.0.2/tcp/1"}, "pid1a": nil},
+		"pid0b": {"pid0a": nil},
+	}
+	args := createMockArgsNetworkCrawler()
+	args.Messenger = &mock.CrawlerMessengerStub{
+		PeersCalled: func() []core.PeerID {
+			return []core.PeerID{"seed", "pid0a", "unreachable"}
+		},
+		ConnectedPeersCalled: func() []core.PeerID {
+			return []core.PeerID{"pid0a"}
+		},
+		QueryDhtNeighboursCalled: func(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error) {
+			assert.Equal(t, 2, numQueries)
+			n, found := neighbours[pid]
+			if !found {
+				return nil, errors.New("unreachable")
+			}
+
+			return n, nil
+		},
+	}
+	nc, _ := NewNetworkCrawler(args)
+	_ = nc.ProcessReceivedMessage(createHeartbeatMessage(t, "pid0a", 0, "v1"), "")
+	_ = nc.ProcessReceivedMessage(createHeartbeatMessage(t, "pid0b", 0, "v1"), "")
+	_ = nc.ProcessReceivedMessage(createHeartbeatMessage(t, "pid1a", 1, "v1"), "")
+
+	nc.CrawlOnce()
+	topology := nc.GetTopology()
+
+	assert.Equal(t, 4, len(topology.Peers))
+	assert.True(t, findPeer(topology, "pid0a").ConnectedToSeed)
+	assert.True(t, findPeer(topology, "pid0b").Reachable)
+	assert.Equal(t, []string{"/ip4/10.0.0.2/tcp/1"}, findPeer(topology, "pid0b").Addresses)
+	assert.False(t, findPeer(topology, "unreachable").Reachable)
+	assert.Equal(t, UnknownShard, findPeer(topology, "unreachable").Shard)
+
+	linkTypes := make(map[string]string)
+	for _, link := range topology.Links {
+		linkTypes[link.From+"->"+link.To] = link.Type
+	}
+	assert.Equal(t, 3, len(topology.Links))
+	assert.Equal(t, IntraShardLink, linkTypes[core.PeerID("pid0a").Pretty()+"->"+core.PeerID("pid0b").Pretty()])
+	assert.Equal(t, CrossShardLink, linkTypes[core.PeerID("pid0a").Pretty()+"->"+core.PeerID("pid1a").Pretty()])
+
+	require.Equal(t, 3, len(topology.Shards))
+	assert.Equal(t, "0", topology.Shards[0].Shard)
+	assert.Equal(t, 2, topology.Shards[0].NumPeers)
+	assert.Equal(t, 2, topology.Shards[0].NumIntraShardLinks)
+	assert.Equal(t, 1, topology.Shards[0].NumCrossShardLinks)
+}
+
+func TestNetworkCrawler_CrawlOnceShouldRespectMaxPeersPerRound(t *testing.T) {
+	t.Parallel()
+
+	queried := make(map[core.PeerID]int)
+	args := createMockArgsNetworkCrawler()
+	args.MaxPeersPerRound = 2
+	args.Messenger = &mock.CrawlerMessengerStub{
+		PeersCalled: func() []core.PeerID {
+			return []core.PeerID{"pid1", "pid2", "pid3"}
+		},
+		QueryDhtNeighboursCalled: func(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error) {
+			queried[pid]++
+			return make(map[core.PeerID][]string), nil
+		},
+	}
+	nc, _ := NewNetworkCrawler(args)
+
+	nc.CrawlOnce()
+	assert.Equal(t, 2, len(queried))
+
+	nc.CrawlOnce()
+	assert.Equal(t, 1, queried["pid3"])
+}
+
+func TestNetworkCrawler_CrawlOnceShouldRemoveExpiredPeers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNetworkCrawler()
+	args.PeerExpiry = args.CrawlInterval
+	args.Messenger = &mock.CrawlerMessengerStub{
+		QueryDhtNeighboursCalled: func(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error) {
+			return nil, errors.New("unreachable")
+		},
+	}
+	nc, _ := NewNetworkCrawler(args)
+	_ = nc.ProcessReceivedMessage(createHeartbeatMessage(t, "pid", 0, "v1"), "")
+
+	nc.mutPeers.Lock()
+	nc.peers["pid"].lastSeen = time.Now().Add(-2 * args.PeerExpiry)
+	nc.mutPeers.Unlock()
+	nc.CrawlOnce()
+
+	assert.Equal(t, 0, len(nc.GetTopology().Peers))
+}
+
+func TestTopology_GraphVizShouldGroupPeersByShard(t *testing.T) {
+	t.Parallel()
+
+	peers := []*PeerNode{
+		{Pid: "a", Shard: "0", Reachable: true, ConnectedToSeed: true, Version: "v1"},
+		{Pid: "b", Shard: "1", DisplayName: "bob"},
+	}
+	links := []*PeerLink{{From: "a", To: "b", Type: CrossShardLink}}
+	topology := &Topology{
+		SeedPid: "seed",
+		Peers:   peers,
+		Links:   links,
+		Shards:  computeShardSummaries(peers, links),
+	}
+
+	dot := topology.GraphViz()
+
+	assert.True(t, strings.HasPrefix(dot, "digraph network {"))
+	assert.True(t, strings.Contains(dot, `subgraph "cluster_0"`))
+	assert.True(t, strings.Contains(dot, `subgraph "cluster_1"`))
+	assert.True(t, strings.Contains(dot, `"b" [label="bob\nb", style=dashed];`))
+	assert.True(t, strings.Contains(dot, `"seed" -> "a" [color=gray, arrowhead=none];`))
+	assert.True(t, strings.Contains(dot, `"a" -> "b" [color=red];`))
+}
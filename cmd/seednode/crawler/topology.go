@@ -0,0 +1,183 @@
+package crawler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnknownShard is the shard reported for the peers that did not send a heartbeat message yet
+const UnknownShard = "unknown"
+
+const (
+	// IntraShardLink marks a link between two peers advertising the same shard
+	IntraShardLink = "intra-shard"
+	// CrossShardLink marks a link between two peers advertising different shards
+	CrossShardLink = "cross-shard"
+	// UnknownLink marks a link where at least one of the ends has an unknown shard
+	UnknownLink = "unknown"
+)
+
+// PeerNode holds what the crawler found out about a peer
+type PeerNode struct {
+	Pid                string   `json:"pid"`
+	Addresses          []string `json:"addresses"`
+	Shard              string   `json:"shard"`
+	PublicKey          string   `json:"publicKey,omitempty"`
+	Version            string   `json:"version,omitempty"`
+	DisplayName        string   `json:"displayName,omitempty"`
+	Identity           string   `json:"identity,omitempty"`
+	Reachable          bool     `json:"reachable"`
+	ConnectedToSeed    bool     `json:"connectedToSeed"`
+	NumKnownNeighbours int      `json:"numKnownNeighbours"`
+	FirstSeen          int64    `json:"firstSeen"`
+	LastSeen           int64    `json:"lastSeen"`
+	LastCrawled        int64    `json:"lastCrawled,omitempty"`
+	LastHeartbeat      int64    `json:"lastHeartbeat,omitempty"`
+}
+
+// PeerLink is a directed edge from a crawled peer to one of the peers found in its kad-dht routing table
+type PeerLink struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// ShardSummary counts the peers and the links of a shard
+type ShardSummary struct {
+	Shard              string `json:"shard"`
+	NumPeers           int    `json:"numPeers"`
+	NumIntraShardLinks int    `json:"numIntraShardLinks"`
+	NumCrossShardLinks int    `json:"numCrossShardLinks"`
+}
+
+// Topology is the snapshot of the network, as seen by the seed node crawler
+type Topology struct {
+	SeedPid   string          `json:"seedPid"`
+	Timestamp int64           `json:"timestamp"`
+	Peers     []*PeerNode     `json:"peers"`
+	Links     []*PeerLink     `json:"links"`
+	Shards    []*ShardSummary `json:"shards"`
+}
+
+// GraphViz renders the topology in the GraphViz dot language. The peers are grouped in a cluster for each shard and
+// the links are colored by their intra-shard/cross-shard type
+func (t *Topology) GraphViz() string {
+	sb := &strings.Builder{}
+	sb.WriteString("digraph network {\n")
+	sb.WriteString("\tnode [shape=box, fontsize=10];\n")
+	_, _ = fmt.Fprintf(sb, "\t%q [shape=doubleoctagon, label=\"seed\\n%s\"];\n", t.SeedPid, shortPid(t.SeedPid))
+
+	peersByShard := make(map[string][]*PeerNode)
+	for _, p := range t.Peers {
+		peersByShard[p.Shard] = append(peersByShard[p.Shard], p)
+	}
+
+	for _, summary := range t.Shards {
+		_, _ = fmt.Fprintf(sb, "\tsubgraph %q {\n", "cluster_"+summary.Shard)
+		_, _ = fmt.Fprintf(sb, "\t\tlabel=%q;\n", "shard "+summary.Shard)
+		for _, p := range peersByShard[summary.Shard] {
+			style := "solid"
+			if !p.Reachable {
+				style = "dashed"
+			}
+			_, _ = fmt.Fprintf(sb, "\t\t%q [label=%q, style=%s];\n", p.Pid, peerLabel(p), style)
+		}
+		sb.WriteString("\t}\n")
+	}
+
+	for _, p := range t.Peers {
+		if p.ConnectedToSeed {
+			_, _ = fmt.Fprintf(sb, "\t%q -> %q [color=gray, arrowhead=none];\n", t.SeedPid, p.Pid)
+		}
+	}
+
+	for _, link := range t.Links {
+		_, _ = fmt.Fprintf(sb, "\t%q -> %q [color=%s];\n", link.From, link.To, linkColor(link.Type))
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func peerLabel(p *PeerNode) string {
+	label := shortPid(p.Pid)
+	if len(p.DisplayName) > 0 {
+		label = p.DisplayName + "\n" + label
+	}
+	if len(p.Version) > 0 {
+		label += "\n" + p.Version
+	}
+
+	return label
+}
+
+func shortPid(pid string) string {
+	const maxLen = 12
+	if len(pid) <= maxLen {
+		return pid
+	}
+
+	return "..." + pid[len(pid)-maxLen:]
+}
+
+func linkColor(linkType string) string {
+	switch linkType {
+	case IntraShardLink:
+		return "blue"
+	case CrossShardLink:
+		return "red"
+	default:
+		return "gray"
+	}
+}
+
+func linkType(fromShard string, toShard string) string {
+	if fromShard == UnknownShard || toShard == UnknownShard {
+		return UnknownLink
+	}
+	if fromShard == toShard {
+		return IntraShardLink
+	}
+
+	return CrossShardLink
+}
+
+func computeShardSummaries(peers []*PeerNode, links []*PeerLink) []*ShardSummary {
+	summaries := make(map[string]*ShardSummary)
+	shardOfPeer := make(map[string]string, len(peers))
+	for _, p := range peers {
+		shardOfPeer[p.Pid] = p.Shard
+		summary, found := summaries[p.Shard]
+		if !found {
+			summary = &ShardSummary{Shard: p.Shard}
+			summaries[p.Shard] = summary
+		}
+		summary.NumPeers++
+	}
+
+	for _, link := range links {
+		summary := summaries[shardOfPeer[link.From]]
+		if summary == nil {
+			continue
+		}
+
+		switch link.Type {
+		case IntraShardLink:
+			summary.NumIntraShardLinks++
+		case CrossShardLink:
+			summary.NumCrossShardLinks++
+		}
+	}
+
+	result := make([]*ShardSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Shard < result[j].Shard
+	})
+
+	return result
+}
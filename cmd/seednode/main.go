@@ -12,6 +12,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/cmd/seednode/api"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/crawler"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -93,6 +94,11 @@ VERSION:
 
 var log = logger.GetOrCreate("main")
 
+type networkCrawlerHandler interface {
+	api.TopologyHandler
+	Close() error
+}
+
 func main() {
 	app := cli.NewApp()
 	cli.AppHelpTemplate = seedNodeHelpTemplate
@@ -149,7 +155,12 @@ func startNode(ctx *cli.Context) error {
 	var fileLogging factory.FileLoggingHandler
 	if withLogFile {
 		workingDir := getWorkingDir(log)
-		fileLogging, err = logging.NewFileLogging(workingDir, defaultLogsPath, logFilePrefix)
+		fileLogging, err = logging.NewFileLogging(logging.ArgsFileLogging{
+			WorkingDir:      workingDir,
+			DefaultLogsPath: defaultLogsPath,
+			LogFilePrefix:   logFilePrefix,
+			LogFormat:       logging.PlainLogFormat,
+		})
 		if err != nil {
 			return fmt.Errorf("%w creating a log file", err)
 		}
@@ -158,9 +169,12 @@ func startNode(ctx *cli.Context) error {
 		if err != nil {
 			return err
 		}
-	}
 
-	startRestServices(ctx, internalMarshalizer)
+		err = fileLogging.ChangeFileMaxSize(generalConfig.Logs.LogFileMaxSizeInMB * core.MegabyteSize)
+		if err != nil {
+			return err
+		}
+	}
 
 	log.Info("starting seednode...")
 
@@ -191,6 +205,13 @@ func startNode(ctx *cli.Context) error {
 		return err
 	}
 
+	networkCrawler, err := createNetworkCrawler(generalConfig.Crawler, messenger, internalMarshalizer)
+	if err != nil {
+		return err
+	}
+
+	startRestServices(ctx, internalMarshalizer, networkCrawler)
+
 	err = messenger.Bootstrap()
 	if err != nil {
 		return err
@@ -200,6 +221,10 @@ func startNode(ctx *cli.Context) error {
 	mainLoop(messenger, sigs)
 
 	log.Debug("closing seednode")
+	if !check.IfNil(networkCrawler) {
+		err = networkCrawler.Close()
+		log.LogIfError(err)
+	}
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.Close()
 		log.LogIfError(err)
@@ -242,6 +267,51 @@ func createNode(p2pConfig config.P2PConfig, marshalizer marshal.Marshalizer) (p2
 	return libp2p.NewNetworkMessenger(arg)
 }
 
+// createNetworkCrawler returns a nil handler if the crawler is disabled
+func createNetworkCrawler(
+	crawlerConfig config.CrawlerConfig,
+	messenger p2p.Messenger,
+	marshalizer marshal.Marshalizer,
+) (networkCrawlerHandler, error) {
+	if !crawlerConfig.Enabled {
+		log.Info("network crawler is disabled")
+		return nil, nil
+	}
+
+	crawlerMessenger, ok := messenger.(crawler.CrawlerMessenger)
+	if !ok {
+		return nil, fmt.Errorf("the messenger can not be used by the network crawler")
+	}
+
+	argsCrawler := crawler.ArgsNetworkCrawler{
+		Messenger:            crawlerMessenger,
+		Marshalizer:          marshalizer,
+		CrawlInterval:        time.Second * time.Duration(crawlerConfig.CrawlIntervalInSec),
+		MaxPeersPerRound:     int(crawlerConfig.MaxPeersPerRound),
+		NumDhtQueriesPerPeer: int(crawlerConfig.NumDhtQueriesPerPeer),
+		PeerExpiry:           time.Second * time.Duration(crawlerConfig.PeerExpiryInSec),
+	}
+	networkCrawler, err := crawler.NewNetworkCrawler(argsCrawler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = messenger.CreateTopic(core.HeartbeatTopic, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = messenger.RegisterMessageProcessor(core.HeartbeatTopic, networkCrawler)
+	if err != nil {
+		return nil, err
+	}
+
+	networkCrawler.StartCrawling()
+	log.Info("network crawler started", "crawl interval", argsCrawler.CrawlInterval)
+
+	return networkCrawler, nil
+}
+
 func displayMessengerInfo(messenger p2p.Messenger) {
 	headerSeedAddresses := []string{"Seednode addresses:"}
 	addresses := make([]*display.LineData, 0)
@@ -304,17 +374,17 @@ func checkExpectedPeerCount(p2pConfig config.P2PConfig) error {
 	return nil
 }
 
-func startRestServices(ctx *cli.Context, marshalizer marshal.Marshalizer) {
+func startRestServices(ctx *cli.Context, marshalizer marshal.Marshalizer, topologyHandler api.TopologyHandler) {
 	restApiInterface := ctx.GlobalString(restApiInterfaceFlag.Name)
 	if restApiInterface != facade.DefaultRestPortOff {
-		go startGinServer(restApiInterface, marshalizer)
+		go startGinServer(restApiInterface, marshalizer, topologyHandler)
 	} else {
 		log.Info("rest api is disabled")
 	}
 }
 
-func startGinServer(restApiInterface string, marshalizer marshal.Marshalizer) {
-	err := api.Start(restApiInterface, marshalizer)
+func startGinServer(restApiInterface string, marshalizer marshal.Marshalizer, topologyHandler api.TopologyHandler) {
+	err := api.Start(restApiInterface, marshalizer, topologyHandler)
 	if err != nil {
 		log.LogIfError(err)
 	}
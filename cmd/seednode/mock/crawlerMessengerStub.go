@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// CrawlerMessengerStub -
+type CrawlerMessengerStub struct {
+	IDCalled                 func() core.PeerID
+	PeersCalled              func() []core.PeerID
+	ConnectedPeersCalled     func() []core.PeerID
+	PeerAddressesCalled      func(pid core.PeerID) []string
+	QueryDhtNeighboursCalled func(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error)
+}
+
+// ID -
+func (stub *CrawlerMessengerStub) ID() core.PeerID {
+	if stub.IDCalled != nil {
+		return stub.IDCalled()
+	}
+
+	return "seed"
+}
+
+// Peers -
+func (stub *CrawlerMessengerStub) Peers() []core.PeerID {
+	if stub.PeersCalled != nil {
+		return stub.PeersCalled()
+	}
+
+	return make([]core.PeerID, 0)
+}
+
+// ConnectedPeers -
+func (stub *CrawlerMessengerStub) ConnectedPeers() []core.PeerID {
+	if stub.ConnectedPeersCalled != nil {
+		return stub.ConnectedPeersCalled()
+	}
+
+	return make([]core.PeerID, 0)
+}
+
+// PeerAddresses -
+func (stub *CrawlerMessengerStub) PeerAddresses(pid core.PeerID) []string {
+	if stub.PeerAddressesCalled != nil {
+		return stub.PeerAddressesCalled(pid)
+	}
+
+	return make([]string, 0)
+}
+
+// QueryDhtNeighbours -
+func (stub *CrawlerMessengerStub) QueryDhtNeighbours(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error) {
+	if stub.QueryDhtNeighboursCalled != nil {
+		return stub.QueryDhtNeighboursCalled(pid, numQueries)
+	}
+
+	return make(map[core.PeerID][]string), nil
+}
+
+// IsInterfaceNil -
+func (stub *CrawlerMessengerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
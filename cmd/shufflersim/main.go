@@ -0,0 +1,261 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/shufflersim/simulator"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	configFile       string
+	shufflerType     string
+	numEpochs        uint
+	numShards        uint
+	eligiblePerShard uint
+	eligibleMeta     uint
+	waitingPerShard  uint
+	waitingMeta      uint
+	newNodesPerEpoch uint
+	leavingPerEpoch  uint
+	hysteresis       float64
+	adaptivity       bool
+	seed             string
+}
+
+var (
+	shufflerSimHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// configFile defines a flag for the node's main configuration file, used to load the shuffler strategies
+	configFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The node's main configuration file. If provided, the ShufflerStrategies and MaxNodesChangeEnableEpoch " +
+			"settings will be loaded from it",
+		Value:       "",
+		Destination: &argsConfig.configFile,
+	}
+	// shufflerType defines a flag for the shuffler strategy used when no configuration file is provided
+	shufflerType = cli.StringFlag{
+		Name:        "shuffler-type",
+		Usage:       "The shuffler strategy used from genesis when no configuration file is provided",
+		Value:       sharding.HashShufflerType,
+		Destination: &argsConfig.shufflerType,
+	}
+	// numEpochs defines a flag for the number of simulated epochs
+	numEpochs = cli.UintFlag{
+		Name:        "epochs",
+		Usage:       "The number of epochs to be simulated",
+		Value:       10,
+		Destination: &argsConfig.numEpochs,
+	}
+	// numShards defines a flag for the number of shards
+	numShards = cli.UintFlag{
+		Name:        "shards",
+		Usage:       "The number of shards, metachain excluded",
+		Value:       3,
+		Destination: &argsConfig.numShards,
+	}
+	// eligiblePerShard defines a flag for the number of eligible validators in each shard
+	eligiblePerShard = cli.UintFlag{
+		Name:        "eligible-per-shard",
+		Usage:       "The number of eligible validators in each shard",
+		Value:       400,
+		Destination: &argsConfig.eligiblePerShard,
+	}
+	// eligibleMeta defines a flag for the number of eligible validators in metachain
+	eligibleMeta = cli.UintFlag{
+		Name:        "eligible-meta",
+		Usage:       "The number of eligible validators in metachain",
+		Value:       400,
+		Destination: &argsConfig.eligibleMeta,
+	}
+	// waitingPerShard defines a flag for the initial number of waiting validators in each shard
+	waitingPerShard = cli.UintFlag{
+		Name:        "waiting-per-shard",
+		Usage:       "The initial number of waiting validators in each shard",
+		Value:       80,
+		Destination: &argsConfig.waitingPerShard,
+	}
+	// waitingMeta defines a flag for the initial number of waiting validators in metachain
+	waitingMeta = cli.UintFlag{
+		Name:        "waiting-meta",
+		Usage:       "The initial number of waiting validators in metachain",
+		Value:       80,
+		Destination: &argsConfig.waitingMeta,
+	}
+	// newNodesPerEpoch defines a flag for the number of validators joining in each epoch
+	newNodesPerEpoch = cli.UintFlag{
+		Name:        "new-per-epoch",
+		Usage:       "The number of new validators joining in each epoch",
+		Value:       0,
+		Destination: &argsConfig.newNodesPerEpoch,
+	}
+	// leavingPerEpoch defines a flag for the number of validators unstaking in each epoch
+	leavingPerEpoch = cli.UintFlag{
+		Name:        "leaving-per-epoch",
+		Usage:       "The number of validators unstaking in each epoch",
+		Value:       0,
+		Destination: &argsConfig.leavingPerEpoch,
+	}
+	// hysteresis defines a flag for the shuffler hysteresis
+	hysteresis = cli.Float64Flag{
+		Name:        "hysteresis",
+		Usage:       "The hysteresis used by the shuffler",
+		Value:       0.2,
+		Destination: &argsConfig.hysteresis,
+	}
+	// adaptivity defines a flag for the shuffler adaptivity
+	adaptivity = cli.BoolFlag{
+		Name:        "adaptivity",
+		Usage:       "Boolean option that enables the shuffler adaptivity",
+		Destination: &argsConfig.adaptivity,
+	}
+	// seed defines a flag for the seed from which all the simulation randomness is derived
+	seed = cli.StringFlag{
+		Name:        "seed",
+		Usage:       "The seed from which the randomness of each epoch is derived. Same seed produces the same output",
+		Value:       "elrond",
+		Destination: &argsConfig.seed,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("shufflersim")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = shufflerSimHelpTemplate
+	app.Name = "Shuffler simulator"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary replays the validators shuffling for a number of epochs and prints the per-shard composition"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		configFile,
+		shufflerType,
+		numEpochs,
+		numShards,
+		eligiblePerShard,
+		eligibleMeta,
+		waitingPerShard,
+		waitingMeta,
+		newNodesPerEpoch,
+		leavingPerEpoch,
+		hysteresis,
+		adaptivity,
+		seed,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process(os.Stdout)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the shuffler simulation", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process(writer io.Writer) error {
+	strategies := []config.ShufflerStrategyConfig{{EpochEnable: 0, Type: argsConfig.shufflerType}}
+	var maxNodesConfig []config.MaxNodesChangeConfig
+	if len(argsConfig.configFile) > 0 {
+		generalConfig := &config.Config{}
+		err := core.LoadTomlFile(generalConfig, argsConfig.configFile)
+		if err != nil {
+			return err
+		}
+
+		strategies = generalConfig.GeneralSettings.ShufflerStrategies
+		maxNodesConfig = generalConfig.GeneralSettings.MaxNodesChangeEnableEpoch
+	}
+
+	shuffler, err := sharding.NewEpochBasedShuffler(sharding.ArgsEpochBasedShuffler{
+		ShufflerArgs: &sharding.NodesShufflerArgs{
+			NodesShard:           uint32(argsConfig.eligiblePerShard),
+			NodesMeta:            uint32(argsConfig.eligibleMeta),
+			Hysteresis:           float32(argsConfig.hysteresis),
+			Adaptivity:           argsConfig.adaptivity,
+			ShuffleBetweenShards: true,
+			MaxNodesEnableConfig: maxNodesConfig,
+		},
+		Registry:   sharding.NewShufflerRegistry(),
+		Strategies: strategies,
+	})
+	if err != nil {
+		return err
+	}
+
+	sim, err := simulator.NewShufflingSimulator(simulator.ArgsShufflingSimulator{
+		Shuffler:         shuffler,
+		NumShards:        uint32(argsConfig.numShards),
+		EligiblePerShard: uint32(argsConfig.eligiblePerShard),
+		EligibleMeta:     uint32(argsConfig.eligibleMeta),
+		WaitingPerShard:  uint32(argsConfig.waitingPerShard),
+		WaitingMeta:      uint32(argsConfig.waitingMeta),
+		NewNodesPerEpoch: uint32(argsConfig.newNodesPerEpoch),
+		LeavingPerEpoch:  uint32(argsConfig.leavingPerEpoch),
+		Seed:             argsConfig.seed,
+	})
+	if err != nil {
+		return err
+	}
+
+	results, err := sim.Run(uint32(argsConfig.numEpochs))
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		printEpoch(writer, result, shuffler.ShufflerType(result.Epoch))
+	}
+
+	return nil
+}
+
+func printEpoch(writer io.Writer, result *simulator.EpochComposition, shufflerType string) {
+	_, _ = fmt.Fprintf(writer, "epoch %d (shuffler: %s, new: %d, leaving: %d)\n",
+		result.Epoch, shufflerType, result.NumNew, result.NumLeaving)
+	_, _ = fmt.Fprintf(writer, "  %-6s %10s %10s %10s\n", "shard", "eligible", "waiting", "moved in")
+	for _, shard := range result.Shards {
+		_, _ = fmt.Fprintf(writer, "  %-6s %10d %10d %10d\n",
+			shardName(shard.ShardID), shard.NumEligible, shard.NumWaiting, shard.NumMovedIn)
+	}
+	_, _ = fmt.Fprintln(writer, strings.Repeat("-", 42))
+}
+
+func shardName(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
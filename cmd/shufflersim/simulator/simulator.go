@@ -0,0 +1,245 @@
+package simulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ErrNilShuffler signals that a nil shuffler was provided
+var ErrNilShuffler = errors.New("nil shuffler")
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
+
+// ErrInvalidNumberOfNodes signals that an invalid number of eligible nodes per shard was provided
+var ErrInvalidNumberOfNodes = errors.New("invalid number of nodes")
+
+// ArgsShufflingSimulator holds the arguments needed to create a shuffling simulator
+type ArgsShufflingSimulator struct {
+	Shuffler         sharding.NodesShuffler
+	NumShards        uint32
+	EligiblePerShard uint32
+	EligibleMeta     uint32
+	WaitingPerShard  uint32
+	WaitingMeta      uint32
+	NewNodesPerEpoch uint32
+	LeavingPerEpoch  uint32
+	Seed             string
+}
+
+// ShardComposition holds the validators composition of a shard after an epoch change
+type ShardComposition struct {
+	ShardID     uint32
+	NumEligible int
+	NumWaiting  int
+	NumMovedIn  int
+}
+
+// EpochComposition holds the composition of all shards after an epoch change
+type EpochComposition struct {
+	Epoch      uint32
+	NumNew     int
+	NumLeaving int
+	Shards     []ShardComposition
+}
+
+// shufflingSimulator replays the validators shuffling on a fabricated validators set. The randomness of each epoch
+// and the selection of the leaving validators are derived from the seed so the results are reproducible
+type shufflingSimulator struct {
+	args          ArgsShufflingSimulator
+	hasher        *sha256.Sha256
+	eligible      map[uint32][]sharding.Validator
+	waiting       map[uint32][]sharding.Validator
+	nextNodeIndex int
+}
+
+// NewShufflingSimulator creates a new shuffling simulator
+func NewShufflingSimulator(args ArgsShufflingSimulator) (*shufflingSimulator, error) {
+	if check.IfNil(args.Shuffler) {
+		return nil, ErrNilShuffler
+	}
+	if args.NumShards == 0 {
+		return nil, ErrInvalidNumberOfShards
+	}
+	if args.EligiblePerShard == 0 || args.EligibleMeta == 0 {
+		return nil, ErrInvalidNumberOfNodes
+	}
+
+	ss := &shufflingSimulator{
+		args:     args,
+		hasher:   &sha256.Sha256{},
+		eligible: make(map[uint32][]sharding.Validator),
+		waiting:  make(map[uint32][]sharding.Validator),
+	}
+
+	for shardID := uint32(0); shardID < args.NumShards; shardID++ {
+		ss.eligible[shardID] = ss.createValidators(args.EligiblePerShard)
+		ss.waiting[shardID] = ss.createValidators(args.WaitingPerShard)
+	}
+	ss.eligible[core.MetachainShardId] = ss.createValidators(args.EligibleMeta)
+	ss.waiting[core.MetachainShardId] = ss.createValidators(args.WaitingMeta)
+
+	return ss, nil
+}
+
+func (ss *shufflingSimulator) createValidators(numValidators uint32) []sharding.Validator {
+	validators := make([]sharding.Validator, 0, numValidators)
+	for i := uint32(0); i < numValidators; i++ {
+		pubKey := []byte(fmt.Sprintf("node-%06d", ss.nextNodeIndex))
+		ss.nextNodeIndex++
+
+		v, _ := sharding.NewValidator(pubKey, 1, 0)
+		validators = append(validators, v)
+	}
+
+	return validators
+}
+
+// Run replays the shuffling for the provided number of epochs, starting with epoch 1, returning the shards
+// composition after each epoch change
+func (ss *shufflingSimulator) Run(numEpochs uint32) ([]*EpochComposition, error) {
+	results := make([]*EpochComposition, 0, numEpochs)
+	for epoch := uint32(1); epoch <= numEpochs; epoch++ {
+		result, err := ss.simulateEpoch(epoch)
+		if err != nil {
+			return nil, fmt.Errorf("%w in epoch %d", err, epoch)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (ss *shufflingSimulator) simulateEpoch(epoch uint32) (*EpochComposition, error) {
+	randomness := ss.computeRandomness(epoch)
+	newNodes := ss.createValidators(ss.args.NewNodesPerEpoch)
+	leaving := ss.selectLeaving(randomness)
+	previousShards := ss.validatorsShards()
+
+	res, err := ss.args.Shuffler.UpdateNodeLists(sharding.ArgsUpdateNodes{
+		Eligible:       ss.eligible,
+		Waiting:        ss.waiting,
+		NewNodes:       newNodes,
+		UnStakeLeaving: leaving,
+		Rand:           randomness,
+		NbShards:       ss.args.NumShards,
+		Epoch:          epoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ss.eligible = res.Eligible
+	ss.waiting = res.Waiting
+
+	return &EpochComposition{
+		Epoch:      epoch,
+		NumNew:     len(newNodes),
+		NumLeaving: len(res.Leaving),
+		Shards:     ss.computeComposition(previousShards),
+	}, nil
+}
+
+func (ss *shufflingSimulator) computeRandomness(epoch uint32) []byte {
+	epochBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(epochBytes, epoch)
+
+	return ss.hasher.Compute(ss.args.Seed + string(epochBytes))
+}
+
+// selectLeaving deterministically selects the validators that unstake in the current epoch
+func (ss *shufflingSimulator) selectLeaving(randomness []byte) []sharding.Validator {
+	all := make([]sharding.Validator, 0)
+	for _, shardID := range sortedShardIDs(ss.eligible) {
+		all = append(all, ss.eligible[shardID]...)
+	}
+	for _, shardID := range sortedShardIDs(ss.waiting) {
+		all = append(all, ss.waiting[shardID]...)
+	}
+
+	hashes := make(map[string]string, len(all))
+	for _, v := range all {
+		hashes[string(v.PubKey())] = string(ss.hasher.Compute(string(v.PubKey()) + string(randomness)))
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return hashes[string(all[i].PubKey())] < hashes[string(all[j].PubKey())]
+	})
+
+	numLeaving := int(ss.args.LeavingPerEpoch)
+	if numLeaving > len(all) {
+		numLeaving = len(all)
+	}
+
+	return all[:numLeaving]
+}
+
+func (ss *shufflingSimulator) validatorsShards() map[string]uint32 {
+	shards := make(map[string]uint32)
+	for shardID, validators := range ss.eligible {
+		for _, v := range validators {
+			shards[string(v.PubKey())] = shardID
+		}
+	}
+	for shardID, validators := range ss.waiting {
+		for _, v := range validators {
+			shards[string(v.PubKey())] = shardID
+		}
+	}
+
+	return shards
+}
+
+func (ss *shufflingSimulator) computeComposition(previousShards map[string]uint32) []ShardComposition {
+	allShards := make(map[uint32][]sharding.Validator)
+	for shardID := range ss.eligible {
+		allShards[shardID] = nil
+	}
+	for shardID := range ss.waiting {
+		allShards[shardID] = nil
+	}
+
+	composition := make([]ShardComposition, 0, len(allShards))
+	for _, shardID := range sortedShardIDs(allShards) {
+		numMovedIn := 0
+		for _, v := range append(append([]sharding.Validator{}, ss.eligible[shardID]...), ss.waiting[shardID]...) {
+			previousShardID, existed := previousShards[string(v.PubKey())]
+			if existed && previousShardID != shardID {
+				numMovedIn++
+			}
+		}
+
+		composition = append(composition, ShardComposition{
+			ShardID:     shardID,
+			NumEligible: len(ss.eligible[shardID]),
+			NumWaiting:  len(ss.waiting[shardID]),
+			NumMovedIn:  numMovedIn,
+		})
+	}
+
+	return composition
+}
+
+func sortedShardIDs(validators map[uint32][]sharding.Validator) []uint32 {
+	shardIDs := make([]uint32, 0, len(validators))
+	for shardID := range validators {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *shufflingSimulator) IsInterfaceNil() bool {
+	return ss == nil
+}
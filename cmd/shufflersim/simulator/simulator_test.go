@@ -0,0 +1,108 @@
+package simulator
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsShufflingSimulator(shufflerType string) ArgsShufflingSimulator {
+	shuffler, _ := sharding.NewShufflerRegistry().Create(shufflerType, &sharding.NodesShufflerArgs{
+		NodesShard:           20,
+		NodesMeta:            20,
+		Hysteresis:           0.2,
+		ShuffleBetweenShards: true,
+	})
+
+	return ArgsShufflingSimulator{
+		Shuffler:         shuffler,
+		NumShards:        2,
+		EligiblePerShard: 20,
+		EligibleMeta:     20,
+		WaitingPerShard:  4,
+		WaitingMeta:      4,
+		NewNodesPerEpoch: 3,
+		LeavingPerEpoch:  2,
+		Seed:             "seed",
+	}
+}
+
+func TestNewShufflingSimulator_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsShufflingSimulator(sharding.HashShufflerType)
+	args.Shuffler = nil
+	sim, err := NewShufflingSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrNilShuffler, err)
+
+	args = createMockArgsShufflingSimulator(sharding.HashShufflerType)
+	args.NumShards = 0
+	sim, err = NewShufflingSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrInvalidNumberOfShards, err)
+
+	args = createMockArgsShufflingSimulator(sharding.HashShufflerType)
+	args.EligibleMeta = 0
+	sim, err = NewShufflingSimulator(args)
+	assert.True(t, check.IfNil(sim))
+	assert.Equal(t, ErrInvalidNumberOfNodes, err)
+}
+
+func TestShufflingSimulator_RunShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	sim1, _ := NewShufflingSimulator(createMockArgsShufflingSimulator(sharding.HashShufflerType))
+	results1, err := sim1.Run(5)
+	require.Nil(t, err)
+
+	sim2, _ := NewShufflingSimulator(createMockArgsShufflingSimulator(sharding.HashShufflerType))
+	results2, err := sim2.Run(5)
+	require.Nil(t, err)
+
+	require.Equal(t, 5, len(results1))
+	assert.Equal(t, results1, results2)
+}
+
+func TestShufflingSimulator_RunShouldKeepTheNumberOfValidators(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsShufflingSimulator(sharding.BalancedWaitingListShufflerType)
+	sim, _ := NewShufflingSimulator(args)
+	results, err := sim.Run(3)
+	require.Nil(t, err)
+
+	expectedTotal := 3 * (20 + 4)
+	for _, result := range results {
+		expectedTotal += result.NumNew - result.NumLeaving
+
+		total := 0
+		for _, shard := range result.Shards {
+			total += shard.NumEligible + shard.NumWaiting
+		}
+		assert.Equal(t, expectedTotal, total)
+	}
+}
+
+func TestShufflingSimulator_RunWithNoShufflerShouldNotMoveValidators(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsShufflingSimulator(sharding.NoShufflerType)
+	args.LeavingPerEpoch = 0
+	sim, _ := NewShufflingSimulator(args)
+	results, err := sim.Run(3)
+	require.Nil(t, err)
+
+	for _, result := range results {
+		require.Equal(t, 3, len(result.Shards))
+		assert.Equal(t, core.MetachainShardId, result.Shards[2].ShardID)
+		for _, shard := range result.Shards {
+			assert.Equal(t, 20, shard.NumEligible)
+			assert.Equal(t, 0, shard.NumMovedIn)
+		}
+	}
+}
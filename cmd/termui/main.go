@@ -5,12 +5,14 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/termui/provider"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/presenter"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/view/termuic"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/view/termuic/termuiRenders"
 	"github.com/urfave/cli"
 )
 
@@ -41,8 +43,10 @@ VERSION:
 `
 	// address defines a flag for setting the address and port on which the node will listen for connections
 	address = cli.StringFlag{
-		Name:        "address",
-		Usage:       "Address and port number on which the application will try to connect to the elrond-go node",
+		Name: "address",
+		Usage: "Address and port number on which the application will try to connect to the elrond-go node. " +
+			"Several comma-separated addresses can be provided, in which case a summary of all nodes will be " +
+			"displayed and the logs will be fetched from the first node",
 		Value:       "127.0.0.1:8080",
 		Destination: &argsConfig.address,
 	}
@@ -104,22 +108,41 @@ func main() {
 }
 
 func startTermuiViewer(ctx *cli.Context) error {
-	nodeAddress := argsConfig.address
+	nodeAddresses := parseNodeAddresses(argsConfig.address)
 	fetchIntervalFlagValue := argsConfig.interval
 
-	presenterStatusHandler := presenter.NewPresenterStatusHandler()
-	statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchIntervalFlagValue)
+	nodes := make([]termuiRenders.NodeInfo, 0, len(nodeAddresses))
+	statusMetricsProviders := make([]*provider.StatusMetricsProvider, 0, len(nodeAddresses))
+	var logsPresenter provider.PresenterHandler
+	for _, nodeAddress := range nodeAddresses {
+		presenterStatusHandler := presenter.NewPresenterStatusHandler()
+		statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchIntervalFlagValue)
+		if err != nil {
+			return fmt.Errorf("%w for node %s", err, nodeAddress)
+		}
+
+		nodes = append(nodes, termuiRenders.NodeInfo{
+			Address:   nodeAddress,
+			Presenter: presenterStatusHandler,
+		})
+		statusMetricsProviders = append(statusMetricsProviders, statusMetricsProvider)
+		if logsPresenter == nil {
+			logsPresenter = presenterStatusHandler
+		}
+	}
+	if len(nodes) == 0 {
+		return provider.ErrInvalidAddressLength
+	}
+
+	termuiConsole, err := termuic.NewMultiNodeTermuiConsole(nodes, fetchIntervalFlagValue)
 	if err != nil {
 		return err
 	}
 
-	termuiConsole, err := termuic.NewTermuiConsole(presenterStatusHandler, fetchIntervalFlagValue)
-	if err != nil {
-		return err
+	for _, statusMetricsProvider := range statusMetricsProviders {
+		statusMetricsProvider.StartUpdatingData()
 	}
 
-	statusMetricsProvider.StartUpdatingData()
-
 	loggerProfile := &logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
 		WithCorrelation:  argsConfig.logWithCorrelation,
@@ -130,7 +153,7 @@ func startTermuiViewer(ctx *cli.Context) error {
 		log.LogIfError(err)
 	}
 
-	err = provider.InitLogHandler(presenterStatusHandler, nodeAddress, loggerProfile, argsConfig.useWss, customLogProfile)
+	err = provider.InitLogHandler(logsPresenter, nodes[0].Address, loggerProfile, argsConfig.useWss, customLogProfile)
 	if err != nil {
 		return err
 	}
@@ -147,6 +170,20 @@ func startTermuiViewer(ctx *cli.Context) error {
 	return nil
 }
 
+func parseNodeAddresses(addresses string) []string {
+	nodeAddresses := make([]string, 0)
+	for _, nodeAddress := range strings.Split(addresses, ",") {
+		nodeAddress = strings.TrimSpace(nodeAddress)
+		if len(nodeAddress) == 0 {
+			continue
+		}
+
+		nodeAddresses = append(nodeAddresses, nodeAddress)
+	}
+
+	return nodeAddresses
+}
+
 func initCliFlags() {
 	cliApp = cli.NewApp()
 	cli.AppHelpTemplate = nodeHelpTemplate
@@ -11,7 +11,7 @@ type CacheConfig struct {
 	Shards               uint32
 }
 
-//HeadersPoolConfig will map the headers cache configuration
+// HeadersPoolConfig will map the headers cache configuration
 type HeadersPoolConfig struct {
 	MaxHeadersPerShard            int
 	NumElementsToRemoveOnEviction int
@@ -106,6 +106,7 @@ type Config struct {
 	ShardHdrNonceHashStorage        StorageConfig
 	MetaHdrNonceHashStorage         StorageConfig
 	StatusMetricsStorage            StorageConfig
+	EconomicsBreakdownStorage       StorageConfig
 	ReceiptsStorage                 StorageConfig
 	SmartContractsStorage           StorageConfig
 	SmartContractsStorageForSCQuery StorageConfig
@@ -146,14 +147,20 @@ type Config struct {
 	PublicKeyPIDSignature CacheConfig
 	PeerHonesty           CacheConfig
 
-	Antiflood           AntifloodConfig
-	ResourceStats       ResourceStatsConfig
-	Heartbeat           HeartbeatConfig
-	ValidatorStatistics ValidatorStatisticsConfig
-	GeneralSettings     GeneralSettingsConfig
-	Consensus           TypeConfig
-	StoragePruning      StoragePruningConfig
-	TxLogsStorage       StorageConfig
+	Antiflood               AntifloodConfig
+	ResourceStats           ResourceStatsConfig
+	Heartbeat               HeartbeatConfig
+	HeartbeatV2             HeartbeatV2Config
+	ValidatorStatistics     ValidatorStatisticsConfig
+	GeneralSettings         GeneralSettingsConfig
+	Consensus               TypeConfig
+	ConsensusRoundsRecorder ConsensusRoundsRecorderConfig
+	ConsensusSignatures     ConsensusSignaturesConfig
+	SlashingReporter        SlashingReporterConfig
+	BlocksSync              BlocksSyncConfig
+	StoragePruning          StoragePruningConfig
+	TxLogsStorage           StorageConfig
+	LogsBloomStorage        StorageConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -170,11 +177,22 @@ type Config struct {
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
+	Crawler               CrawlerConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
+	LogFileMaxSizeInMB   uint64
+}
+
+// CrawlerConfig will hold the settings of the seed node's network crawler
+type CrawlerConfig struct {
+	Enabled              bool
+	CrawlIntervalInSec   uint32
+	MaxPeersPerRound     uint32
+	NumDhtQueriesPerPeer uint32
+	PeerExpiryInSec      uint32
 }
 
 // StoragePruningConfig will hold settings related to storage pruning
@@ -201,6 +219,47 @@ type HeartbeatConfig struct {
 	HeartbeatStorage                    StorageConfig
 }
 
+// HeartbeatV2Config will hold the settings of the peer authentication and heartbeat v2 subsystem
+type HeartbeatV2Config struct {
+	Enabled                                          bool
+	PeerAuthenticationTimeBetweenSendsInSec          int64
+	PeerAuthenticationTimeBetweenSendsWhenErrorInSec int64
+	PeerAuthenticationThresholdBetweenSends          float64
+	HeartbeatTimeBetweenSendsInSec                   int64
+	HeartbeatTimeBetweenSendsWhenErrorInSec          int64
+	HeartbeatThresholdBetweenSends                   float64
+	MaxDurationPeerUnresponsiveInSec                 int64
+	HideInactiveValidatorIntervalInSec               int64
+	PeerAuthenticationPool                           CacheConfig
+	HeartbeatPool                                    CacheConfig
+}
+
+// ConsensusRoundsRecorderConfig will hold the settings of the consensus rounds timeline recorder
+type ConsensusRoundsRecorderConfig struct {
+	MaxRoundsToKeep int
+	Persist         bool
+}
+
+// ConsensusSignaturesConfig will hold the settings used when aggregating the consensus group signatures
+type ConsensusSignaturesConfig struct {
+	OptimisticAggregateVerification bool
+}
+
+// SlashingReporterConfig will hold the settings of the component that submits the slash transactions
+type SlashingReporterConfig struct {
+	Enabled                bool
+	WalletKeyPemFile       string
+	IntervalInSeconds      uint32
+	ResendTimeoutInSeconds uint32
+	GasPrice               uint64
+	GasLimit               uint64
+}
+
+// BlocksSyncConfig will hold the settings used by the bootstrapper while syncing blocks
+type BlocksSyncConfig struct {
+	PipelineWindowSize uint64
+}
+
 // ValidatorStatisticsConfig will hold validator statistics specific settings
 type ValidatorStatisticsConfig struct {
 	CacheRefreshIntervalInSec uint32
@@ -213,6 +272,12 @@ type MaxNodesChangeConfig struct {
 	NodesToShufflePerShard uint32
 }
 
+// ShufflerStrategyConfig defines the nodes shuffler strategy that becomes active starting with a certain epoch
+type ShufflerStrategyConfig struct {
+	EpochEnable uint32
+	Type        string
+}
+
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
 	StatusPollingIntervalSec               int
@@ -231,6 +296,7 @@ type GeneralSettingsConfig struct {
 	GasPriceModifierEnableEpoch            uint32
 	RepairCallbackEnableEpoch              uint32
 	MaxNodesChangeEnableEpoch              []MaxNodesChangeConfig
+	ShufflerStrategies                     []ShufflerStrategyConfig
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
 	BlockGasAndFeesReCheckEnableEpoch      uint32
@@ -403,6 +469,10 @@ type HealthServiceConfig struct {
 	MemoryUsageToCreateProfiles               int
 	NumMemoryUsageRecordsToKeep               int
 	FolderPath                                string
+	MinConnectedPeers                         uint32
+	MinFreeDiskSpaceInMB                      uint64
+	MaxPoolSaturationPercent                  uint32
+	MaxClockOffsetInMilliseconds              uint64
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
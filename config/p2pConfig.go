@@ -5,6 +5,9 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	PeerStore           PeerStoreConfig
+	PeerScoring         PeerScoringConfig
+	Compression         CompressionConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -33,4 +36,58 @@ type ShardingConfig struct {
 	MaxIntraShardObservers  uint32
 	MaxCrossShardObservers  uint32
 	Type                    string
+	PreferredPeers          []string
+	BlockedPeers            []string
+}
+
+// PeerStoreConfig will hold the persistent peer address book config settings
+type PeerStoreConfig struct {
+	Enabled                    bool
+	FilePath                   string
+	MaxPeers                   int
+	MaxPeersToConnectAtStartup int
+	SaveIntervalInSec          uint32
+}
+
+// PeerScoringConfig will hold the gossipsub peer scoring config settings
+type PeerScoringConfig struct {
+	Enabled                     bool
+	DecayIntervalInSec          uint32
+	DecayToZero                 float64
+	RetainScoreInSec            uint32
+	TopicScoreCap               float64
+	AppSpecificWeight           float64
+	DeniedPeerScore             float64
+	IPColocationFactorWeight    float64
+	IPColocationFactorThreshold int
+	BehaviourPenaltyWeight      float64
+	BehaviourPenaltyDecayInSec  uint32
+	GossipThreshold             float64
+	PublishThreshold            float64
+	GraylistThreshold           float64
+	AcceptPXThreshold           float64
+	OpportunisticGraftThreshold float64
+	Topics                      []TopicScoringConfig
+}
+
+// TopicScoringConfig will hold the gossipsub scoring settings applied on the topics starting with the provided prefix
+type TopicScoringConfig struct {
+	TopicPrefix                        string
+	TopicWeight                        float64
+	TimeInMeshWeight                   float64
+	TimeInMeshQuantumInMs              uint32
+	TimeInMeshCap                      float64
+	FirstMessageDeliveriesWeight       float64
+	FirstMessageDeliveriesDecayInSec   uint32
+	FirstMessageDeliveriesCap          float64
+	InvalidMessageDeliveriesWeight     float64
+	InvalidMessageDeliveriesDecayInSec uint32
+}
+
+// CompressionConfig will hold the p2p payload compression config settings
+type CompressionConfig struct {
+	Enabled                 bool
+	Algorithm               string
+	MinPayloadSizeInBytes   uint32
+	CompressBroadcastedData bool
 }
@@ -25,6 +25,8 @@ type StakingSystemSCConfig struct {
 	StakeEnableEpoch                     uint32
 	DoubleKeyProtectionEnableEpoch       uint32
 	ActivateBLSPubKeyMessageVerification bool
+	SlashingEnableEpoch                  uint32
+	SlashingPercentage                   float64
 }
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
@@ -42,19 +44,23 @@ type GovernanceSystemSCConfig struct {
 	MinPassThreshold int32
 	MinVetoThreshold int32
 	EnabledEpoch     uint32
+
+	ScheduledActivationsEnableEpoch uint32
 }
 
 // DelegationManagerSystemSCConfig defines a set of constants to initialize the delegation manager system smart contract
 type DelegationManagerSystemSCConfig struct {
-	MinCreationDeposit  string
-	EnabledEpoch        uint32
-	MinStakeAmount      string
-	ConfigChangeAddress string
+	MinCreationDeposit               string
+	EnabledEpoch                     uint32
+	MinStakeAmount                   string
+	ConfigChangeAddress              string
+	ValidatorToDelegationEnableEpoch uint32
 }
 
 // DelegationSystemSCConfig defines a set of constants to initialize the delegation system smart contract
 type DelegationSystemSCConfig struct {
-	EnabledEpoch  uint32
-	MinServiceFee uint64
-	MaxServiceFee uint64
+	EnabledEpoch             uint32
+	MinServiceFee            uint64
+	MaxServiceFee            uint64
+	LiquidStakingEnableEpoch uint32
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/display"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -167,7 +168,7 @@ func (chr *chronology) startRound() {
 
 	msg := fmt.Sprintf("SUBROUND %s BEGINS", sr.Name())
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
-	logger.SetCorrelationSubround(sr.Name())
+	logging.SetCorrelationSubround(sr.Name())
 
 	if !sr.DoWork(chr.rounder) {
 		chr.subroundId = srBeforeStartRound
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// AccountsStub -
+type AccountsStub struct {
+	GetExistingAccountCalled func(address []byte) (state.AccountHandler, error)
+}
+
+// GetExistingAccount -
+func (as *AccountsStub) GetExistingAccount(address []byte) (state.AccountHandler, error) {
+	if as.GetExistingAccountCalled != nil {
+		return as.GetExistingAccountCalled(address)
+	}
+
+	return nil, errors.New("account not found")
+}
+
+// IsInterfaceNil -
+func (as *AccountsStub) IsInterfaceNil() bool {
+	return as == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	AddProposedHeaderCalled func(proposer []byte, headerHash []byte, marshalizedHeader []byte, header data.HeaderHandler)
+	AddSignatureShareCalled func(round int64, pubKey []byte, headerHash []byte, signatureShare []byte)
+}
+
+// AddProposedHeader -
+func (eds *EquivocationDetectorStub) AddProposedHeader(proposer []byte, headerHash []byte, marshalizedHeader []byte, header data.HeaderHandler) {
+	if eds.AddProposedHeaderCalled != nil {
+		eds.AddProposedHeaderCalled(proposer, headerHash, marshalizedHeader, header)
+	}
+}
+
+// AddSignatureShare -
+func (eds *EquivocationDetectorStub) AddSignatureShare(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if eds.AddSignatureShareCalled != nil {
+		eds.AddSignatureShareCalled(round, pubKey, headerHash, signatureShare)
+	}
+}
+
+// IsInterfaceNil -
+func (eds *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return eds == nil
+}
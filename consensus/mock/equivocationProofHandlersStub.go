@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+)
+
+// EquivocationProofVerifierStub -
+type EquivocationProofVerifierStub struct {
+	VerifyCalled func(proof *slashing.EquivocationProof) error
+}
+
+// Verify -
+func (epvs *EquivocationProofVerifierStub) Verify(proof *slashing.EquivocationProof) error {
+	if epvs.VerifyCalled != nil {
+		return epvs.VerifyCalled(proof)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (epvs *EquivocationProofVerifierStub) IsInterfaceNil() bool {
+	return epvs == nil
+}
+
+// EquivocationProofSenderStub -
+type EquivocationProofSenderStub struct {
+	SendCalled func(proof *slashing.EquivocationProof) error
+}
+
+// Send -
+func (epss *EquivocationProofSenderStub) Send(proof *slashing.EquivocationProof) error {
+	if epss.SendCalled != nil {
+		return epss.SendCalled(proof)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (epss *EquivocationProofSenderStub) IsInterfaceNil() bool {
+	return epss == nil
+}
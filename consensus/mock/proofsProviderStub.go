@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/consensus/slashing"
+
+// ProofsProviderStub -
+type ProofsProviderStub struct {
+	ProofsCalled func() []*slashing.EquivocationProof
+}
+
+// Proofs -
+func (pps *ProofsProviderStub) Proofs() []*slashing.EquivocationProof {
+	if pps.ProofsCalled != nil {
+		return pps.ProofsCalled()
+	}
+
+	return make([]*slashing.EquivocationProof, 0)
+}
+
+// IsInterfaceNil -
+func (pps *ProofsProviderStub) IsInterfaceNil() bool {
+	return pps == nil
+}
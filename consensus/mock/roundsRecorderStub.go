@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// RoundsRecorderStub -
+type RoundsRecorderStub struct {
+	StartRoundCalled      func(round int64, startTime time.Time)
+	AddMessageCalled      func(round int64, pubKey []byte, messageType string, isSignature bool, arrivalTime time.Time)
+	SetRoundOutcomeCalled func(round int64, leader []byte, consensusGroupSize int, signaturesThreshold int, outcome string)
+	GetRoundsCalled       func() []*api.ConsensusRound
+}
+
+// StartRound -
+func (rrs *RoundsRecorderStub) StartRound(round int64, startTime time.Time) {
+	if rrs.StartRoundCalled != nil {
+		rrs.StartRoundCalled(round, startTime)
+	}
+}
+
+// AddMessage -
+func (rrs *RoundsRecorderStub) AddMessage(round int64, pubKey []byte, messageType string, isSignature bool, arrivalTime time.Time) {
+	if rrs.AddMessageCalled != nil {
+		rrs.AddMessageCalled(round, pubKey, messageType, isSignature, arrivalTime)
+	}
+}
+
+// SetRoundOutcome -
+func (rrs *RoundsRecorderStub) SetRoundOutcome(round int64, leader []byte, consensusGroupSize int, signaturesThreshold int, outcome string) {
+	if rrs.SetRoundOutcomeCalled != nil {
+		rrs.SetRoundOutcomeCalled(round, leader, consensusGroupSize, signaturesThreshold, outcome)
+	}
+}
+
+// GetRounds -
+func (rrs *RoundsRecorderStub) GetRounds() []*api.ConsensusRound {
+	if rrs.GetRoundsCalled != nil {
+		return rrs.GetRoundsCalled()
+	}
+
+	return make([]*api.ConsensusRound, 0)
+}
+
+// IsInterfaceNil -
+func (rrs *RoundsRecorderStub) IsInterfaceNil() bool {
+	return rrs == nil
+}
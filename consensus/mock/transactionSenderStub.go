@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+// TransactionSenderStub -
+type TransactionSenderStub struct {
+	SendBulkTransactionsCalled func(txs []*transaction.Transaction) (uint64, error)
+}
+
+// SendBulkTransactions -
+func (tss *TransactionSenderStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
+	if tss.SendBulkTransactionsCalled != nil {
+		return tss.SendBulkTransactionsCalled(txs)
+	}
+
+	return uint64(len(txs)), nil
+}
+
+// IsInterfaceNil -
+func (tss *TransactionSenderStub) IsInterfaceNil() bool {
+	return tss == nil
+}
@@ -0,0 +1,16 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. equivocationProof.proto
+package slashing
+
+import (
+	"encoding/binary"
+)
+
+// ID returns the identifier of the proof. Any two proofs against the same key in the same round will have the same
+// identifier, so the offence can be punished only once
+func (m *EquivocationProof) ID() []byte {
+	id := make([]byte, len(m.PubKey)+8)
+	copy(id, m.PubKey)
+	binary.BigEndian.PutUint64(id[len(m.PubKey):], m.Round)
+
+	return id
+}
@@ -0,0 +1,990 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: equivocationProof.proto
+
+package slashing
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProofType defines the kind of misbehaviour an equivocation proof attests
+type ProofType int32
+
+const (
+	DoubleSigning  ProofType = 0
+	DoubleProposal ProofType = 1
+)
+
+var ProofType_name = map[int32]string{
+	0: "DoubleSigning",
+	1: "DoubleProposal",
+}
+
+var ProofType_value = map[string]int32{
+	"DoubleSigning":  0,
+	"DoubleProposal": 1,
+}
+
+func (ProofType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_933e67ef2dfdd504, []int{0}
+}
+
+// SignedHeader holds a marshalized header together with the signature share given by a validator on its hash
+type SignedHeader struct {
+	HeaderHash []byte `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	Header     []byte `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	Signature  []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (m *SignedHeader) Reset()      { *m = SignedHeader{} }
+func (*SignedHeader) ProtoMessage() {}
+func (*SignedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_933e67ef2dfdd504, []int{0}
+}
+func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SignedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedHeader.Merge(m, src)
+}
+func (m *SignedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedHeader proto.InternalMessageInfo
+
+func (m *SignedHeader) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// EquivocationProof holds two conflicting headers from the same round, both signed by the same validator key
+type EquivocationProof struct {
+	Type    ProofType    `protobuf:"varint,1,opt,name=Type,proto3,enum=proto.ProofType" json:"Type,omitempty"`
+	PubKey  []byte       `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardID uint32       `protobuf:"varint,3,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round   uint64       `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	First   SignedHeader `protobuf:"bytes,5,opt,name=First,proto3" json:"First"`
+	Second  SignedHeader `protobuf:"bytes,6,opt,name=Second,proto3" json:"Second"`
+}
+
+func (m *EquivocationProof) Reset()      { *m = EquivocationProof{} }
+func (*EquivocationProof) ProtoMessage() {}
+func (*EquivocationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_933e67ef2dfdd504, []int{1}
+}
+func (m *EquivocationProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EquivocationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationProof.Merge(m, src)
+}
+func (m *EquivocationProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationProof proto.InternalMessageInfo
+
+func (m *EquivocationProof) GetType() ProofType {
+	if m != nil {
+		return m.Type
+	}
+	return DoubleSigning
+}
+
+func (m *EquivocationProof) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *EquivocationProof) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *EquivocationProof) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *EquivocationProof) GetFirst() SignedHeader {
+	if m != nil {
+		return m.First
+	}
+	return SignedHeader{}
+}
+
+func (m *EquivocationProof) GetSecond() SignedHeader {
+	if m != nil {
+		return m.Second
+	}
+	return SignedHeader{}
+}
+
+func init() {
+	proto.RegisterEnum("proto.ProofType", ProofType_name, ProofType_value)
+	proto.RegisterType((*SignedHeader)(nil), "proto.SignedHeader")
+	proto.RegisterType((*EquivocationProof)(nil), "proto.EquivocationProof")
+}
+
+func init() { proto.RegisterFile("equivocationProof.proto", fileDescriptor_933e67ef2dfdd504) }
+
+var fileDescriptor_933e67ef2dfdd504 = []byte{
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x8f, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x67, 0xb4, 0xad, 0x32, 0x02, 0x81, 0xd1, 0x68, 0x63, 0xcc, 0x95, 0x10, 0x17, 0xc4,
+	0x44, 0x88, 0xf8, 0x06, 0x04, 0x0d, 0xc6, 0x0d, 0x29, 0xae, 0xdc, 0xb5, 0x74, 0x68, 0x9b, 0x60,
+	0x07, 0xfb, 0x63, 0xc2, 0xce, 0x47, 0xf0, 0x31, 0x7c, 0x14, 0x96, 0x2c, 0x59, 0x19, 0x19, 0x62,
+	0xe2, 0x92, 0x47, 0x30, 0x9d, 0x01, 0x25, 0x6e, 0x5c, 0xf5, 0x7e, 0xe7, 0xdc, 0xdb, 0x73, 0x86,
+	0x1c, 0xb1, 0xa7, 0x34, 0x78, 0xe6, 0x7d, 0x3b, 0x09, 0x78, 0xd8, 0x8d, 0x38, 0x1f, 0xd4, 0x47,
+	0x11, 0x4f, 0x38, 0xd5, 0xe5, 0xe7, 0xf8, 0xc2, 0x0b, 0x12, 0x3f, 0x75, 0xea, 0x7d, 0xfe, 0xd8,
+	0xf0, 0xb8, 0xc7, 0x1b, 0x52, 0x76, 0xd2, 0x81, 0x24, 0x09, 0x72, 0x52, 0x57, 0x55, 0x97, 0xe4,
+	0x7b, 0x81, 0x17, 0x32, 0xb7, 0xc3, 0x6c, 0x97, 0x45, 0x14, 0x08, 0x51, 0x53, 0xc7, 0x8e, 0x7d,
+	0x13, 0x57, 0x70, 0x2d, 0x6f, 0x6d, 0x28, 0xf4, 0x90, 0x18, 0x8a, 0xcc, 0x2d, 0xe9, 0xad, 0x88,
+	0x9e, 0x90, 0x5c, 0xf6, 0x1f, 0x3b, 0x49, 0x23, 0x66, 0x6e, 0x4b, 0xeb, 0x57, 0xa8, 0x7e, 0x62,
+	0x52, 0xbe, 0xfe, 0xdb, 0x9b, 0x9e, 0x11, 0xed, 0x7e, 0x3c, 0x62, 0x32, 0xa5, 0xd8, 0x2c, 0xa9,
+	0x46, 0x75, 0xe9, 0x65, 0xba, 0x25, 0xdd, 0x2c, 0xb1, 0x9b, 0x3a, 0x77, 0x6c, 0xbc, 0x4e, 0x54,
+	0x44, 0x4d, 0xb2, 0xd3, 0xf3, 0xed, 0xc8, 0xbd, 0x6d, 0xcb, 0xbc, 0x82, 0xb5, 0x46, 0x7a, 0x40,
+	0x74, 0x8b, 0xa7, 0xa1, 0x6b, 0x6a, 0x15, 0x5c, 0xd3, 0x2c, 0x05, 0xb4, 0x41, 0xf4, 0x9b, 0x20,
+	0x8a, 0x13, 0x53, 0xaf, 0xe0, 0xda, 0x5e, 0x73, 0x7f, 0x15, 0xb7, 0xf9, 0xfa, 0x96, 0x36, 0x79,
+	0x3f, 0x45, 0x96, 0xda, 0xa3, 0x97, 0xc4, 0xe8, 0xb1, 0x3e, 0x0f, 0x5d, 0xd3, 0xf8, 0xef, 0x62,
+	0xb5, 0x78, 0xde, 0x24, 0xb9, 0x9f, 0xfa, 0xb4, 0x4c, 0x0a, 0x6d, 0x9e, 0x3a, 0x43, 0x96, 0x1d,
+	0x04, 0xa1, 0x57, 0x42, 0x94, 0x92, 0xa2, 0x92, 0xba, 0x11, 0x1f, 0xf1, 0xd8, 0x1e, 0x96, 0x70,
+	0xab, 0x35, 0x9d, 0x03, 0x9a, 0xcd, 0x01, 0x2d, 0xe7, 0x80, 0x5f, 0x04, 0xe0, 0x37, 0x01, 0x78,
+	0x22, 0x00, 0x4f, 0x05, 0xe0, 0x99, 0x00, 0xfc, 0x21, 0x00, 0x7f, 0x09, 0x40, 0x4b, 0x01, 0xf8,
+	0x75, 0x01, 0x68, 0xba, 0x00, 0x34, 0x5b, 0x00, 0x7a, 0xd8, 0x8d, 0x87, 0x76, 0xec, 0x07, 0xa1,
+	0xe7, 0x18, 0xb2, 0xd9, 0xd5, 0xf7, 0x00, 0x67, 0xac, 0x6a, 0x0b, 0x1d, 0x02, 0x00, 0x00,
+}
+
+func (x ProofType) String() string {
+	s, ok := ProofType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *SignedHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignedHeader)
+	if !ok {
+		that2, ok := that.(SignedHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if !bytes.Equal(this.Header, that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *EquivocationProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EquivocationProof)
+	if !ok {
+		that2, ok := that.(EquivocationProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !this.First.Equal(&that1.First) {
+		return false
+	}
+	if !this.Second.Equal(&that1.Second) {
+		return false
+	}
+	return true
+}
+func (this *SignedHeader) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&slashing.SignedHeader{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EquivocationProof) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&slashing.EquivocationProof{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "First: "+strings.Replace(this.First.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Second: "+strings.Replace(this.Second.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEquivocationProof(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SignedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EquivocationProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Round != 0 {
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEquivocationProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEquivocationProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	return n
+}
+
+func (m *EquivocationProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovEquivocationProof(uint64(m.Type))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovEquivocationProof(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEquivocationProof(uint64(m.Round))
+	}
+	l = m.First.Size()
+	n += 1 + l + sovEquivocationProof(uint64(l))
+	l = m.Second.Size()
+	n += 1 + l + sovEquivocationProof(uint64(l))
+	return n
+}
+
+func sovEquivocationProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEquivocationProof(x uint64) (n int) {
+	return sovEquivocationProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SignedHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignedHeader{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EquivocationProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EquivocationProof{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`First:` + strings.Replace(strings.Replace(this.First.String(), "SignedHeader", "SignedHeader", 1), `&`, ``, 1) + `,`,
+		`Second:` + strings.Replace(strings.Replace(this.Second.String(), "SignedHeader", "SignedHeader", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEquivocationProof(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SignedHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEquivocationProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = append(m.Header[:0], dAtA[iNdEx:postIndex]...)
+			if m.Header == nil {
+				m.Header = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEquivocationProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEquivocationProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ProofType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEquivocationProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEquivocationProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEquivocationProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEquivocationProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEquivocationProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEquivocationProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEquivocationProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEquivocationProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEquivocationProof = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,96 @@
+package slashing
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilSignatureVerifier signals that a nil signature verifier has been provided
+var ErrNilSignatureVerifier = errors.New("nil signature verifier")
+
+// ErrNilProofVerifier signals that a nil proof verifier has been provided
+var ErrNilProofVerifier = errors.New("nil proof verifier")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilCacher signals that a nil cacher has been provided
+var ErrNilCacher = errors.New("nil cacher")
+
+// ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
+var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
+
+// ErrNilMessage signals that a nil message has been provided
+var ErrNilMessage = errors.New("nil message")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrNilProof signals that a nil equivocation proof has been provided
+var ErrNilProof = errors.New("nil equivocation proof")
+
+// ErrEmptyPubKey signals that the proof does not contain a public key
+var ErrEmptyPubKey = errors.New("empty public key")
+
+// ErrInvalidProofType signals that the proof type is not known
+var ErrInvalidProofType = errors.New("invalid proof type")
+
+// ErrHeadersNotConflicting signals that the two headers of the proof are the same
+var ErrHeadersNotConflicting = errors.New("headers are not conflicting")
+
+// ErrHeaderHashMismatch signals that the provided header hash does not match the hash of the provided header
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrChainIDMismatch signals that a header is not from the chain the proof is verified on
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrRoundMismatch signals that a header is not from the round the proof was issued for
+var ErrRoundMismatch = errors.New("round mismatch")
+
+// ErrShardMismatch signals that a header is not from the shard the proof was issued for
+var ErrShardMismatch = errors.New("shard mismatch")
+
+// ErrInvalidSignature signals that a signature from the proof is invalid
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrInvalidRandSeed signals that the rand seed of a proposed header was not produced by the accused key
+var ErrInvalidRandSeed = errors.New("invalid rand seed")
+
+// ErrNilProofsProvider signals that a nil proofs provider has been provided
+var ErrNilProofsProvider = errors.New("nil proofs provider")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilTransactionSender signals that a nil transaction sender has been provided
+var ErrNilTransactionSender = errors.New("nil transaction sender")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrEmptyReceiverAddress signals that an empty receiver address has been provided
+var ErrEmptyReceiverAddress = errors.New("empty receiver address")
+
+// ErrInvalidGasLimit signals that an invalid gas limit has been provided
+var ErrInvalidGasLimit = errors.New("invalid gas limit")
+
+// ErrInvalidSubmitInterval signals that an invalid submit interval has been provided
+var ErrInvalidSubmitInterval = errors.New("invalid submit interval")
+
+// ErrInvalidResendTimeout signals that the resend timeout is lower than the submit interval
+var ErrInvalidResendTimeout = errors.New("invalid resend timeout")
+
+// ErrReporterNotInSelfShard signals that the reporter wallet does not belong to the node's own shard
+var ErrReporterNotInSelfShard = errors.New("reporter wallet is not in the node's own shard")
@@ -0,0 +1,55 @@
+package slashing
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// SignatureVerifier defines the behaviour of a component able to verify a signature given the signer's public key bytes
+type SignatureVerifier interface {
+	Verify(message []byte, signedMessage []byte, pubKey []byte) error
+	IsInterfaceNil() bool
+}
+
+// ProofVerifier defines the behaviour of a component able to verify equivocation proofs
+type ProofVerifier interface {
+	Verify(proof *EquivocationProof) error
+	IsInterfaceNil() bool
+}
+
+// P2PMessenger defines the subset of the p2p messenger used to propagate the equivocation proofs
+type P2PMessenger interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
+
+// P2PAntifloodHandler defines the behavior of a component able to signal that the system is too busy (or flooded) processing
+// p2p messages
+type P2PAntifloodHandler interface {
+	CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error
+	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
+	IsInterfaceNil() bool
+}
+
+// ProofsProvider defines the behaviour of a component able to provide the valid equivocation proofs received so far
+type ProofsProvider interface {
+	Proofs() []*EquivocationProof
+	IsInterfaceNil() bool
+}
+
+// AccountsAdapter defines the subset of the accounts adapter used to read the reporter's nonce
+type AccountsAdapter interface {
+	GetExistingAccount(address []byte) (state.AccountHandler, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionSender defines the behaviour of a component able to send transactions
+type TransactionSender interface {
+	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,129 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("consensus/slashing")
+
+// ArgsProofProcessor is the argument structure used to create a new proof processor
+type ArgsProofProcessor struct {
+	Marshalizer      marshal.Marshalizer
+	ProofVerifier    ProofVerifier
+	ProofsCacher     storage.Cacher
+	AntifloodHandler P2PAntifloodHandler
+	Topic            string
+}
+
+// proofProcessor receives the equivocation proofs on the metachain and keeps the valid ones
+type proofProcessor struct {
+	marshalizer      marshal.Marshalizer
+	proofVerifier    ProofVerifier
+	proofsCacher     storage.Cacher
+	antifloodHandler P2PAntifloodHandler
+	topic            string
+}
+
+// NewProofProcessor creates a p2p message processor for the equivocation proofs topic
+func NewProofProcessor(args ArgsProofProcessor) (*proofProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, ErrNilProofVerifier
+	}
+	if check.IfNil(args.ProofsCacher) {
+		return nil, ErrNilCacher
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, ErrNilAntifloodHandler
+	}
+
+	return &proofProcessor{
+		marshalizer:      args.Marshalizer,
+		proofVerifier:    args.ProofVerifier,
+		proofsCacher:     args.ProofsCacher,
+		antifloodHandler: args.AntifloodHandler,
+		topic:            args.Topic,
+	}, nil
+}
+
+// ProcessReceivedMessage verifies the received equivocation proof and stores it if valid. An invalid proof will cause
+// the originator and the connected peer to be blacklisted
+func (pp *proofProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+
+	err := pp.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = pp.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, pp.topic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	proof := &EquivocationProof{}
+	err = pp.marshalizer.Unmarshal(proof, message.Data())
+	if err != nil {
+		return err
+	}
+
+	id := proof.ID()
+	if pp.proofsCacher.Has(id) {
+		return nil
+	}
+
+	err = pp.proofVerifier.Verify(proof)
+	if err != nil {
+		reason := fmt.Sprintf("blacklisted due to invalid equivocation proof: %s", err.Error())
+		pp.antifloodHandler.BlacklistPeer(message.Peer(), reason, core.InvalidMessageBlacklistDuration)
+		pp.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+
+		return err
+	}
+
+	pp.proofsCacher.Put(id, proof, proof.Size())
+	log.Warn("received valid equivocation proof",
+		"type", proof.Type.String(),
+		"pk", proof.PubKey,
+		"shard", proof.ShardID,
+		"round", proof.Round,
+	)
+
+	return nil
+}
+
+// Proofs returns all the valid equivocation proofs received so far and not yet evicted from the cache
+func (pp *proofProcessor) Proofs() []*EquivocationProof {
+	keys := pp.proofsCacher.Keys()
+	proofs := make([]*EquivocationProof, 0, len(keys))
+	for _, key := range keys {
+		value, ok := pp.proofsCacher.Peek(key)
+		if !ok {
+			continue
+		}
+
+		proof, ok := value.(*EquivocationProof)
+		if !ok {
+			continue
+		}
+
+		proofs = append(proofs, proof)
+	}
+
+	return proofs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *proofProcessor) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,135 @@
+package slashing_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsProofProcessor() slashing.ArgsProofProcessor {
+	return slashing.ArgsProofProcessor{
+		Marshalizer:      &mock.MarshalizerMock{},
+		ProofVerifier:    &mock.EquivocationProofVerifierStub{},
+		ProofsCacher:     testscommon.NewCacherMock(),
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		Topic:            "topic",
+	}
+}
+
+func createProofMessage(t *testing.T, proof *slashing.EquivocationProof) *mock.P2PMessageMock {
+	buff, err := (&mock.MarshalizerMock{}).Marshal(proof)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: "originator",
+	}
+}
+
+func TestNewProofProcessor_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsProofProcessor()
+	args.Marshalizer = nil
+	pp, err := slashing.NewProofProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, slashing.ErrNilMarshalizer, err)
+
+	args = createMockArgsProofProcessor()
+	args.ProofVerifier = nil
+	pp, err = slashing.NewProofProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, slashing.ErrNilProofVerifier, err)
+
+	args = createMockArgsProofProcessor()
+	args.ProofsCacher = nil
+	pp, err = slashing.NewProofProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, slashing.ErrNilCacher, err)
+
+	args = createMockArgsProofProcessor()
+	args.AntifloodHandler = nil
+	pp, err = slashing.NewProofProcessor(args)
+	assert.Nil(t, pp)
+	assert.Equal(t, slashing.ErrNilAntifloodHandler, err)
+}
+
+func TestProofProcessor_ProcessReceivedMessageAntifloodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsProofProcessor()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+			return expectedErr
+		},
+	}
+	pp, _ := slashing.NewProofProcessor(args)
+
+	err := pp.ProcessReceivedMessage(createProofMessage(t, &slashing.EquivocationProof{PubKey: []byte("pk")}), "connected")
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 0, args.ProofsCacher.Len())
+}
+
+func TestProofProcessor_ProcessReceivedMessageInvalidProofShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsProofProcessor()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *slashing.EquivocationProof) error {
+			return expectedErr
+		},
+	}
+	blacklisted := make([]core.PeerID, 0)
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			blacklisted = append(blacklisted, peer)
+		},
+	}
+	pp, _ := slashing.NewProofProcessor(args)
+
+	err := pp.ProcessReceivedMessage(createProofMessage(t, &slashing.EquivocationProof{PubKey: []byte("pk")}), "connected")
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []core.PeerID{"originator", "connected"}, blacklisted)
+	assert.Equal(t, 0, args.ProofsCacher.Len())
+}
+
+func TestProofProcessor_ProcessReceivedMessageShouldStoreProofOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsProofProcessor()
+	numVerified := 0
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *slashing.EquivocationProof) error {
+			numVerified++
+			return nil
+		},
+	}
+	pp, _ := slashing.NewProofProcessor(args)
+
+	proof := &slashing.EquivocationProof{
+		Type:   slashing.DoubleSigning,
+		PubKey: []byte("pk"),
+		Round:  3,
+		First:  slashing.SignedHeader{HeaderHash: []byte("h1")},
+		Second: slashing.SignedHeader{HeaderHash: []byte("h2")},
+	}
+	var msg p2p.MessageP2P = createProofMessage(t, proof)
+	assert.Nil(t, pp.ProcessReceivedMessage(msg, "connected"))
+	assert.Nil(t, pp.ProcessReceivedMessage(msg, "connected"))
+
+	assert.Equal(t, 1, numVerified)
+	proofs := pp.Proofs()
+	require.Equal(t, 1, len(proofs))
+	assert.Equal(t, proof, proofs[0])
+	assert.False(t, pp.IsInterfaceNil())
+}
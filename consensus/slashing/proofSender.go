@@ -0,0 +1,78 @@
+package slashing
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsProofSender is the argument structure used to create a new proof sender
+type ArgsProofSender struct {
+	Messenger        P2PMessenger
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+}
+
+type proofSender struct {
+	messenger   P2PMessenger
+	marshalizer marshal.Marshalizer
+	topics      []string
+}
+
+// NewProofSender creates a component that propagates equivocation proofs between the shards and the metachain
+func NewProofSender(args ArgsProofSender) (*proofSender, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &proofSender{
+		messenger:   args.Messenger,
+		marshalizer: args.Marshalizer,
+		topics:      ProofTopics(args.ShardCoordinator),
+	}, nil
+}
+
+// ProofTopics returns the topics on which the equivocation proofs are exchanged. A shard node uses its shard - metachain
+// topic while a metachain node uses the topics of all the shards, so the proofs it issues reach the shard reporters
+func ProofTopics(shardCoordinator sharding.Coordinator) []string {
+	if shardCoordinator.SelfId() != core.MetachainShardId {
+		return []string{core.EquivocationProofTopic + shardCoordinator.CommunicationIdentifier(core.MetachainShardId)}
+	}
+
+	topics := make([]string, 0, shardCoordinator.NumberOfShards())
+	for shardID := uint32(0); shardID < shardCoordinator.NumberOfShards(); shardID++ {
+		topics = append(topics, core.EquivocationProofTopic+shardCoordinator.CommunicationIdentifier(shardID))
+	}
+
+	return topics
+}
+
+// Send broadcasts the provided proof
+func (ps *proofSender) Send(proof *EquivocationProof) error {
+	if proof == nil {
+		return ErrNilProof
+	}
+
+	buff, err := ps.marshalizer.Marshal(proof)
+	if err != nil {
+		return err
+	}
+
+	for _, topic := range ps.topics {
+		ps.messenger.Broadcast(topic, buff)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ps *proofSender) IsInterfaceNil() bool {
+	return ps == nil
+}
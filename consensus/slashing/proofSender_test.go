@@ -0,0 +1,92 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsProofSender() slashing.ArgsProofSender {
+	return slashing.ArgsProofSender{
+		Messenger:        &mock.MessengerStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		ShardCoordinator: mock.ShardCoordinatorMock{},
+	}
+}
+
+func TestNewProofSender_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsProofSender()
+	args.Messenger = nil
+	ps, err := slashing.NewProofSender(args)
+	assert.Nil(t, ps)
+	assert.Equal(t, slashing.ErrNilMessenger, err)
+
+	args = createMockArgsProofSender()
+	args.Marshalizer = nil
+	ps, err = slashing.NewProofSender(args)
+	assert.Nil(t, ps)
+	assert.Equal(t, slashing.ErrNilMarshalizer, err)
+
+	args = createMockArgsProofSender()
+	args.ShardCoordinator = nil
+	ps, err = slashing.NewProofSender(args)
+	assert.Nil(t, ps)
+	assert.Equal(t, slashing.ErrNilShardCoordinator, err)
+}
+
+func TestProofSender_SendShouldBroadcastOnMetachainTopic(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsProofSender()
+	var sentTopic string
+	var sentBuff []byte
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			sentTopic = topic
+			sentBuff = buff
+		},
+	}
+	ps, _ := slashing.NewProofSender(args)
+
+	assert.Equal(t, slashing.ErrNilProof, ps.Send(nil))
+
+	proof := &slashing.EquivocationProof{PubKey: []byte("pk"), Round: 2}
+	assert.Nil(t, ps.Send(proof))
+
+	expectedTopic := core.EquivocationProofTopic + args.ShardCoordinator.CommunicationIdentifier(core.MetachainShardId)
+	assert.Equal(t, expectedTopic, sentTopic)
+	recovered := &slashing.EquivocationProof{}
+	_ = args.Marshalizer.Unmarshal(recovered, sentBuff)
+	assert.Equal(t, proof, recovered)
+	assert.False(t, ps.IsInterfaceNil())
+}
+
+func TestProofSender_SendFromMetachainShouldBroadcastOnAllShardTopics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsProofSender()
+	args.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(2, core.MetachainShardId)
+	sentTopics := make([]string, 0)
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			sentTopics = append(sentTopics, topic)
+		},
+	}
+	ps, _ := slashing.NewProofSender(args)
+
+	proof := &slashing.EquivocationProof{PubKey: []byte("pk"), Round: 2}
+	assert.Nil(t, ps.Send(proof))
+
+	expectedTopics := []string{
+		core.EquivocationProofTopic + "_0_META",
+		core.EquivocationProofTopic + "_1_META",
+	}
+	assert.Equal(t, expectedTopics, sentTopics)
+	assert.Equal(t, expectedTopics, slashing.ProofTopics(args.ShardCoordinator))
+}
@@ -0,0 +1,150 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// ArgsProofVerifier is the argument structure used to create a new proof verifier
+type ArgsProofVerifier struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	SignatureVerifier SignatureVerifier
+	ChainID           []byte
+}
+
+type proofVerifier struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	signatureVerifier SignatureVerifier
+	chainID           []byte
+}
+
+// NewProofVerifier creates a component able to check equivocation proofs. It only needs the accused public key, contained
+// in the proof, so any node can use it, regardless of its shard or of the epoch the offence happened in
+func NewProofVerifier(args ArgsProofVerifier) (*proofVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.SignatureVerifier) {
+		return nil, ErrNilSignatureVerifier
+	}
+	if len(args.ChainID) == 0 {
+		return nil, ErrInvalidChainID
+	}
+
+	return &proofVerifier{
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		signatureVerifier: args.SignatureVerifier,
+		chainID:           args.ChainID,
+	}, nil
+}
+
+// Verify checks that the proof contains two different headers from the same chain, round and shard, both signed by
+// the accused key. A double proposal proof must also contain headers whose rand seed was produced by the accused key
+func (pv *proofVerifier) Verify(proof *EquivocationProof) error {
+	if proof == nil {
+		return ErrNilProof
+	}
+	if len(proof.PubKey) == 0 {
+		return ErrEmptyPubKey
+	}
+	if proof.Type != DoubleSigning && proof.Type != DoubleProposal {
+		return fmt.Errorf("%w: %d", ErrInvalidProofType, proof.Type)
+	}
+	if bytes.Equal(proof.First.HeaderHash, proof.Second.HeaderHash) {
+		return ErrHeadersNotConflicting
+	}
+
+	signedHeaders := []SignedHeader{proof.First, proof.Second}
+	for _, signedHeader := range signedHeaders {
+		header, err := pv.checkSignedHeader(proof, signedHeader)
+		if err != nil {
+			return err
+		}
+		if proof.Type != DoubleProposal {
+			continue
+		}
+
+		err = pv.signatureVerifier.Verify(header.GetPrevRandSeed(), header.GetRandSeed(), proof.PubKey)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidRandSeed, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// VerifyMarshalized unmarshalls the provided buffer and verifies the resulting proof
+func (pv *proofVerifier) VerifyMarshalized(buff []byte) (*EquivocationProof, error) {
+	proof := &EquivocationProof{}
+	err := pv.marshalizer.Unmarshal(proof, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pv.Verify(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+func (pv *proofVerifier) checkSignedHeader(proof *EquivocationProof, signedHeader SignedHeader) (data.HeaderHandler, error) {
+	computedHash := pv.hasher.Compute(string(signedHeader.Header))
+	if !bytes.Equal(computedHash, signedHeader.HeaderHash) {
+		return nil, ErrHeaderHashMismatch
+	}
+
+	header, err := pv.decodeHeader(proof.ShardID, signedHeader.Header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header.GetChainID(), pv.chainID) {
+		return nil, fmt.Errorf("%w: header chain ID %s", ErrChainIDMismatch, header.GetChainID())
+	}
+	if header.GetRound() != proof.Round {
+		return nil, fmt.Errorf("%w: header round %d, proof round %d", ErrRoundMismatch, header.GetRound(), proof.Round)
+	}
+	if header.GetShardID() != proof.ShardID {
+		return nil, fmt.Errorf("%w: header shard %d, proof shard %d", ErrShardMismatch, header.GetShardID(), proof.ShardID)
+	}
+
+	err = pv.signatureVerifier.Verify(signedHeader.HeaderHash, signedHeader.Signature, proof.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return header, nil
+}
+
+func (pv *proofVerifier) decodeHeader(shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	}
+
+	err := pv.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pv *proofVerifier) IsInterfaceNil() bool {
+	return pv == nil
+}
@@ -0,0 +1,260 @@
+package slashing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	vmProcess "github.com/ElrondNetwork/elrond-go/vm/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSigner struct {
+	privKey      crypto.PrivateKey
+	pubKey       []byte
+	singleSigner crypto.SingleSigner
+	marshalizer  marshal.Marshalizer
+	hasher       *blake2b.Blake2b
+}
+
+func newTestSigner(t *testing.T) (*testSigner, slashing.SignatureVerifier) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privKey, pubKey := keyGen.GeneratePair()
+	pubKeyBytes, err := pubKey.ToByteArray()
+	require.Nil(t, err)
+
+	singleSigner := &singlesig.BlsSingleSigner{}
+	sigVerifier, err := vmProcess.NewMessageSigVerifier(keyGen, singleSigner)
+	require.Nil(t, err)
+
+	return &testSigner{
+		privKey:      privKey,
+		pubKey:       pubKeyBytes,
+		singleSigner: singleSigner,
+		marshalizer:  &marshal.GogoProtoMarshalizer{},
+		hasher:       &blake2b.Blake2b{},
+	}, sigVerifier
+}
+
+func (ts *testSigner) signedHeader(t *testing.T, header data.HeaderHandler) slashing.SignedHeader {
+	prevRandSeed := []byte("prev rand seed")
+	randSeed, err := ts.singleSigner.Sign(ts.privKey, prevRandSeed)
+	require.Nil(t, err)
+	header.SetPrevRandSeed(prevRandSeed)
+	header.SetRandSeed(randSeed)
+
+	buff, err := ts.marshalizer.Marshal(header)
+	require.Nil(t, err)
+	hash := ts.hasher.Compute(string(buff))
+	sig, err := ts.singleSigner.Sign(ts.privKey, hash)
+	require.Nil(t, err)
+
+	return slashing.SignedHeader{
+		HeaderHash: hash,
+		Header:     buff,
+		Signature:  sig,
+	}
+}
+
+var testChainID = []byte("chain ID")
+
+func createProof(t *testing.T, signer *testSigner, shardID uint32) *slashing.EquivocationProof {
+	return createProofOnChain(t, signer, shardID, testChainID)
+}
+
+func createProofOnChain(t *testing.T, signer *testSigner, shardID uint32, chainID []byte) *slashing.EquivocationProof {
+	var first, second data.HeaderHandler = &block.Header{Round: 10, ShardID: shardID, Nonce: 4, ChainID: chainID}, &block.Header{Round: 10, ShardID: shardID, Nonce: 5, ChainID: chainID}
+	if shardID == core.MetachainShardId {
+		first, second = &block.MetaBlock{Round: 10, Nonce: 4, ChainID: chainID}, &block.MetaBlock{Round: 10, Nonce: 5, ChainID: chainID}
+	}
+
+	return &slashing.EquivocationProof{
+		Type:    slashing.DoubleProposal,
+		PubKey:  signer.pubKey,
+		ShardID: shardID,
+		Round:   10,
+		First:   signer.signedHeader(t, first),
+		Second:  signer.signedHeader(t, second),
+	}
+}
+
+func createVerifier(t *testing.T, sigVerifier slashing.SignatureVerifier) slashing.ProofVerifier {
+	pv, err := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+		SignatureVerifier: sigVerifier,
+		ChainID:           testChainID,
+	})
+	require.Nil(t, err)
+
+	return pv
+}
+
+func TestNewProofVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, sigVerifier := newTestSigner(t)
+	pv, err := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Hasher:            &blake2b.Blake2b{},
+		SignatureVerifier: sigVerifier,
+		ChainID:           testChainID,
+	})
+	assert.Nil(t, pv)
+	assert.Equal(t, slashing.ErrNilMarshalizer, err)
+
+	pv, err = slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		SignatureVerifier: sigVerifier,
+		ChainID:           testChainID,
+	})
+	assert.Nil(t, pv)
+	assert.Equal(t, slashing.ErrNilHasher, err)
+
+	pv, err = slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		Hasher:      &blake2b.Blake2b{},
+		ChainID:     testChainID,
+	})
+	assert.Nil(t, pv)
+	assert.Equal(t, slashing.ErrNilSignatureVerifier, err)
+
+	pv, err = slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+		SignatureVerifier: sigVerifier,
+	})
+	assert.Nil(t, pv)
+	assert.Equal(t, slashing.ErrInvalidChainID, err)
+}
+
+func TestProofVerifier_VerifyValidProofsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	signer, sigVerifier := newTestSigner(t)
+	pv := createVerifier(t, sigVerifier)
+
+	assert.Nil(t, pv.Verify(createProof(t, signer, 1)))
+	assert.Nil(t, pv.Verify(createProof(t, signer, core.MetachainShardId)))
+
+	proof := createProof(t, signer, 0)
+	proof.Type = slashing.DoubleSigning
+	assert.Nil(t, pv.Verify(proof))
+	assert.False(t, pv.IsInterfaceNil())
+}
+
+func TestProofVerifier_VerifyInvalidProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer, sigVerifier := newTestSigner(t)
+	otherSigner, _ := newTestSigner(t)
+	pv := createVerifier(t, sigVerifier)
+
+	assert.Equal(t, slashing.ErrNilProof, pv.Verify(nil))
+
+	proof := createProof(t, signer, 0)
+	proof.PubKey = nil
+	assert.Equal(t, slashing.ErrEmptyPubKey, pv.Verify(proof))
+
+	proof = createProof(t, signer, 0)
+	proof.Type = 5
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrInvalidProofType))
+
+	proof = createProof(t, signer, 0)
+	proof.Second = proof.First
+	assert.Equal(t, slashing.ErrHeadersNotConflicting, pv.Verify(proof))
+
+	proof = createProof(t, signer, 0)
+	proof.Second.HeaderHash = []byte("other hash")
+	assert.Equal(t, slashing.ErrHeaderHashMismatch, pv.Verify(proof))
+
+	proof = createProof(t, signer, 0)
+	proof.Round = 11
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrRoundMismatch))
+
+	proof = createProof(t, signer, 0)
+	proof.ShardID = 2
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrShardMismatch))
+
+	proof = createProof(t, signer, 0)
+	proof.Second.Signature = proof.First.Signature
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrInvalidSignature))
+
+	proof = createProof(t, signer, 0)
+	proof.PubKey = otherSigner.pubKey
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrInvalidSignature))
+}
+
+func TestProofVerifier_VerifyDoubleProposalWithForeignRandSeedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer, sigVerifier := newTestSigner(t)
+	leader, _ := newTestSigner(t)
+	pv := createVerifier(t, sigVerifier)
+
+	// the headers are proposed by another key, the accused key only signed both of them
+	first := leader.signedHeader(t, &block.Header{Round: 10, Nonce: 4, ChainID: testChainID})
+	second := leader.signedHeader(t, &block.Header{Round: 10, Nonce: 5, ChainID: testChainID})
+	first.Signature, _ = signer.singleSigner.Sign(signer.privKey, first.HeaderHash)
+	second.Signature, _ = signer.singleSigner.Sign(signer.privKey, second.HeaderHash)
+	proof := &slashing.EquivocationProof{
+		Type:   slashing.DoubleProposal,
+		PubKey: signer.pubKey,
+		Round:  10,
+		First:  first,
+		Second: second,
+	}
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrInvalidRandSeed))
+
+	proof.Type = slashing.DoubleSigning
+	assert.Nil(t, pv.Verify(proof))
+}
+
+func TestProofVerifier_VerifyHeadersFromAnotherChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer, sigVerifier := newTestSigner(t)
+	pv := createVerifier(t, sigVerifier)
+
+	// the same key signed two conflicting headers on another network, as a testnet reusing the key
+	proof := createProofOnChain(t, signer, 0, []byte("other chain ID"))
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrChainIDMismatch))
+
+	proof = createProofOnChain(t, signer, core.MetachainShardId, []byte("other chain ID"))
+	proof.Type = slashing.DoubleSigning
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrChainIDMismatch))
+
+	proof = createProofOnChain(t, signer, 0, nil)
+	assert.True(t, errors.Is(pv.Verify(proof), slashing.ErrChainIDMismatch))
+}
+
+func TestProofVerifier_VerifyMarshalized(t *testing.T) {
+	t.Parallel()
+
+	signer, sigVerifier := newTestSigner(t)
+	pv, _ := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+		SignatureVerifier: sigVerifier,
+		ChainID:           testChainID,
+	})
+
+	proof := createProof(t, signer, 0)
+	buff, _ := signer.marshalizer.Marshal(proof)
+	recovered, err := pv.VerifyMarshalized(buff)
+	assert.Nil(t, err)
+	assert.Equal(t, proof, recovered)
+
+	recovered, err = pv.VerifyMarshalized([]byte("not a proof"))
+	assert.NotNil(t, err)
+	assert.Nil(t, recovered)
+}
@@ -0,0 +1,305 @@
+package slashing
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const slashFunctionName = "slash"
+const minSubmitInterval = time.Second
+
+// ArgsSlashTxSubmitter is the argument structure used to create a new slash transaction submitter
+type ArgsSlashTxSubmitter struct {
+	ProofsProvider    ProofsProvider
+	Accounts          AccountsAdapter
+	TxSender          TransactionSender
+	Marshalizer       marshal.Marshalizer
+	TxSignMarshalizer marshal.Marshalizer
+	TxSigner          crypto.SingleSigner
+	PrivateKey        crypto.PrivateKey
+	AddressConverter  core.PubkeyConverter
+	ShardCoordinator  sharding.Coordinator
+	ReceiverAddress   []byte
+	ChainID           []byte
+	MinTxVersion      uint32
+	GasPrice          uint64
+	GasLimit          uint64
+	SubmitInterval    time.Duration
+	ResendTimeout     time.Duration
+}
+
+type submittedProof struct {
+	nonce     uint64
+	timestamp time.Time
+}
+
+type slashTxSubmitter struct {
+	proofsProvider    ProofsProvider
+	accounts          AccountsAdapter
+	txSender          TransactionSender
+	marshalizer       marshal.Marshalizer
+	txSignMarshalizer marshal.Marshalizer
+	txSigner          crypto.SingleSigner
+	privateKey        crypto.PrivateKey
+	addressConverter  core.PubkeyConverter
+	senderAddress     []byte
+	receiverAddress   []byte
+	chainID           []byte
+	minTxVersion      uint32
+	gasPrice          uint64
+	gasLimit          uint64
+	submitInterval    time.Duration
+	resendTimeout     time.Duration
+
+	mutSubmitted sync.Mutex
+	submitted    map[string]*submittedProof
+	executed     map[string]struct{}
+	cancelFunc   func()
+}
+
+// NewSlashTxSubmitter creates a component that sends a slash transaction to the validator system SC for every valid
+// equivocation proof received. The reporter wallet must belong to the node's own shard as its nonce can only be
+// read from this shard's state
+func NewSlashTxSubmitter(args ArgsSlashTxSubmitter) (*slashTxSubmitter, error) {
+	err := checkArgsSlashTxSubmitter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	senderAddress, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	if args.ShardCoordinator.ComputeId(senderAddress) != args.ShardCoordinator.SelfId() {
+		return nil, ErrReporterNotInSelfShard
+	}
+
+	return &slashTxSubmitter{
+		proofsProvider:    args.ProofsProvider,
+		accounts:          args.Accounts,
+		txSender:          args.TxSender,
+		marshalizer:       args.Marshalizer,
+		txSignMarshalizer: args.TxSignMarshalizer,
+		txSigner:          args.TxSigner,
+		privateKey:        args.PrivateKey,
+		addressConverter:  args.AddressConverter,
+		senderAddress:     senderAddress,
+		receiverAddress:   args.ReceiverAddress,
+		chainID:           args.ChainID,
+		minTxVersion:      args.MinTxVersion,
+		gasPrice:          args.GasPrice,
+		gasLimit:          args.GasLimit,
+		submitInterval:    args.SubmitInterval,
+		resendTimeout:     args.ResendTimeout,
+		submitted:         make(map[string]*submittedProof),
+		executed:          make(map[string]struct{}),
+	}, nil
+}
+
+func checkArgsSlashTxSubmitter(args ArgsSlashTxSubmitter) error {
+	if check.IfNil(args.ProofsProvider) {
+		return ErrNilProofsProvider
+	}
+	if check.IfNil(args.Accounts) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.TxSender) {
+		return ErrNilTransactionSender
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxSignMarshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.PrivateKey) {
+		return ErrNilPrivateKey
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if len(args.ReceiverAddress) == 0 {
+		return ErrEmptyReceiverAddress
+	}
+	if args.GasLimit == 0 {
+		return ErrInvalidGasLimit
+	}
+	if args.SubmitInterval < minSubmitInterval {
+		return ErrInvalidSubmitInterval
+	}
+	if args.ResendTimeout < args.SubmitInterval {
+		return ErrInvalidResendTimeout
+	}
+
+	return nil
+}
+
+// StartSubmitting starts the go routine which periodically submits the slash transactions
+func (sts *slashTxSubmitter) StartSubmitting() {
+	var ctx context.Context
+	ctx, sts.cancelFunc = context.WithCancel(context.Background())
+	go sts.submitProofs(ctx)
+}
+
+func (sts *slashTxSubmitter) submitProofs(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("slashTxSubmitter's go routine is stopping...")
+			return
+		case <-time.After(sts.submitInterval):
+		}
+
+		err := sts.SubmitPendingProofs()
+		if err != nil {
+			log.Debug("slashTxSubmitter.SubmitPendingProofs", "error", err.Error())
+		}
+	}
+}
+
+// SubmitPendingProofs sends a slash transaction for each proof which was not yet submitted. While previously sent
+// transactions are still pending, nothing new is sent unless the resend timeout expired
+func (sts *slashTxSubmitter) SubmitPendingProofs() error {
+	sts.mutSubmitted.Lock()
+	defer sts.mutSubmitted.Unlock()
+
+	account, err := sts.accounts.GetExistingAccount(sts.senderAddress)
+	if err != nil {
+		return err
+	}
+	currentNonce := account.GetNonce()
+
+	proofs := sts.proofsProvider.Proofs()
+	sts.updateSubmittedProofs(proofs, currentNonce)
+	if len(sts.submitted) > 0 {
+		return nil
+	}
+
+	txs := make([]*transaction.Transaction, 0)
+	ids := make([]string, 0)
+	nonce := currentNonce
+	for _, proof := range proofs {
+		id := string(proof.ID())
+		if _, isExecuted := sts.executed[id]; isExecuted {
+			continue
+		}
+
+		tx, errCreate := sts.createSlashTx(proof, nonce)
+		if errCreate != nil {
+			log.Debug("slashTxSubmitter.createSlashTx", "pk", proof.PubKey, "round", proof.Round, "error", errCreate.Error())
+			continue
+		}
+
+		txs = append(txs, tx)
+		ids = append(ids, id)
+		nonce++
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+
+	_, err = sts.txSender.SendBulkTransactions(txs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i, id := range ids {
+		sts.submitted[id] = &submittedProof{
+			nonce:     txs[i].Nonce,
+			timestamp: now,
+		}
+	}
+	log.Info("submitted slash transactions", "num", len(txs), "first nonce", currentNonce)
+
+	return nil
+}
+
+// updateSubmittedProofs moves the proofs whose transactions were executed out of the submitted map and drops all the
+// submitted proofs if one of them waited for longer than the resend timeout, so they will be sent again
+func (sts *slashTxSubmitter) updateSubmittedProofs(proofs []*EquivocationProof, currentNonce uint64) {
+	isTimedOut := false
+	for id, sp := range sts.submitted {
+		if sp.nonce < currentNonce {
+			sts.executed[id] = struct{}{}
+			delete(sts.submitted, id)
+			continue
+		}
+
+		isTimedOut = isTimedOut || time.Since(sp.timestamp) > sts.resendTimeout
+	}
+	if isTimedOut {
+		sts.submitted = make(map[string]*submittedProof)
+	}
+
+	knownProofs := make(map[string]struct{}, len(proofs))
+	for _, proof := range proofs {
+		knownProofs[string(proof.ID())] = struct{}{}
+	}
+	for id := range sts.executed {
+		if _, isKnown := knownProofs[id]; !isKnown {
+			delete(sts.executed, id)
+		}
+	}
+}
+
+func (sts *slashTxSubmitter) createSlashTx(proof *EquivocationProof, nonce uint64) (*transaction.Transaction, error) {
+	proofBuff, err := sts.marshalizer.Marshal(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  sts.receiverAddress,
+		SndAddr:  sts.senderAddress,
+		GasPrice: sts.gasPrice,
+		GasLimit: sts.gasLimit,
+		Data:     []byte(slashFunctionName + "@" + hex.EncodeToString(proofBuff)),
+		ChainID:  sts.chainID,
+		Version:  sts.minTxVersion,
+	}
+
+	buff, err := tx.GetDataForSigning(sts.addressConverter, sts.txSignMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Signature, err = sts.txSigner.Sign(sts.privateKey, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// Close stops the submitting go routine
+func (sts *slashTxSubmitter) Close() error {
+	if sts.cancelFunc != nil {
+		sts.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sts *slashTxSubmitter) IsInterfaceNil() bool {
+	return sts == nil
+}
@@ -0,0 +1,282 @@
+package slashing_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSlashTxSubmitter() slashing.ArgsSlashTxSubmitter {
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	sk, _ := keyGen.GeneratePair()
+	addressConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32)
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+
+	return slashing.ArgsSlashTxSubmitter{
+		ProofsProvider:    &mock.ProofsProviderStub{},
+		Accounts:          &mock.AccountsStub{},
+		TxSender:          &mock.TransactionSenderStub{},
+		Marshalizer:       &mock.MarshalizerMock{},
+		TxSignMarshalizer: &mock.MarshalizerMock{},
+		TxSigner:          &singlesig.Ed25519Signer{},
+		PrivateKey:        sk,
+		AddressConverter:  addressConverter,
+		ShardCoordinator:  shardCoordinator,
+		ReceiverAddress:   []byte("validator sc address"),
+		ChainID:           []byte("chain ID"),
+		MinTxVersion:      1,
+		GasPrice:          1000,
+		GasLimit:          60000000,
+		SubmitInterval:    time.Second,
+		ResendTimeout:     time.Minute,
+	}
+}
+
+func createAccountsStubWithNonce(nonce *uint64) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.Nonce = *nonce
+			return account, nil
+		},
+	}
+}
+
+func TestNewSlashTxSubmitter_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashTxSubmitter()
+	args.ProofsProvider = nil
+	sts, err := slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrNilProofsProvider, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.Accounts = nil
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.TxSender = nil
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrNilTransactionSender, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.PrivateKey = nil
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrNilPrivateKey, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.ReceiverAddress = nil
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrEmptyReceiverAddress, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.GasLimit = 0
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrInvalidGasLimit, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.SubmitInterval = time.Millisecond
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrInvalidSubmitInterval, err)
+
+	args = createMockArgsSlashTxSubmitter()
+	args.ResendTimeout = time.Millisecond
+	sts, err = slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrInvalidResendTimeout, err)
+}
+
+func TestNewSlashTxSubmitter_ReporterInAnotherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashTxSubmitter()
+	args.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(2, 0)
+	for {
+		pk, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+		if args.ShardCoordinator.ComputeId(pk) != 0 {
+			break
+		}
+		args.PrivateKey, _ = signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	}
+
+	sts, err := slashing.NewSlashTxSubmitter(args)
+	assert.Nil(t, sts)
+	assert.Equal(t, slashing.ErrReporterNotInSelfShard, err)
+}
+
+func TestSlashTxSubmitter_SubmitPendingProofsShouldSendSignedSlashTransactions(t *testing.T) {
+	t.Parallel()
+
+	proofs := []*slashing.EquivocationProof{
+		{PubKey: []byte("pk1"), Round: 1},
+		{PubKey: []byte("pk2"), Round: 2},
+	}
+	nonce := uint64(7)
+	var sentTxs []*transaction.Transaction
+	args := createMockArgsSlashTxSubmitter()
+	args.ProofsProvider = &mock.ProofsProviderStub{
+		ProofsCalled: func() []*slashing.EquivocationProof {
+			return proofs
+		},
+	}
+	args.Accounts = createAccountsStubWithNonce(&nonce)
+	args.TxSender = &mock.TransactionSenderStub{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs = txs
+			return uint64(len(txs)), nil
+		},
+	}
+	sts, _ := slashing.NewSlashTxSubmitter(args)
+
+	err := sts.SubmitPendingProofs()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(sentTxs))
+
+	senderAddress, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+	for i, tx := range sentTxs {
+		assert.Equal(t, nonce+uint64(i), tx.Nonce)
+		assert.Equal(t, senderAddress, tx.SndAddr)
+		assert.Equal(t, args.ReceiverAddress, tx.RcvAddr)
+		assert.Equal(t, args.GasLimit, tx.GasLimit)
+
+		proofBuff, _ := args.Marshalizer.Marshal(proofs[i])
+		assert.Equal(t, "slash@"+hex.EncodeToString(proofBuff), string(tx.Data))
+
+		signature := tx.Signature
+		tx.Signature = nil
+		buff, _ := tx.GetDataForSigning(args.AddressConverter, args.TxSignMarshalizer)
+		assert.Nil(t, args.TxSigner.Verify(args.PrivateKey.GeneratePublic(), buff, signature))
+	}
+}
+
+func TestSlashTxSubmitter_SubmitPendingProofsShouldWaitForPendingTransactions(t *testing.T) {
+	t.Parallel()
+
+	proofs := []*slashing.EquivocationProof{{PubKey: []byte("pk1"), Round: 1}}
+	nonce := uint64(0)
+	numSent := 0
+	args := createMockArgsSlashTxSubmitter()
+	args.ProofsProvider = &mock.ProofsProviderStub{
+		ProofsCalled: func() []*slashing.EquivocationProof {
+			return proofs
+		},
+	}
+	args.Accounts = createAccountsStubWithNonce(&nonce)
+	args.TxSender = &mock.TransactionSenderStub{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			numSent += len(txs)
+			return uint64(len(txs)), nil
+		},
+	}
+	sts, _ := slashing.NewSlashTxSubmitter(args)
+
+	_ = sts.SubmitPendingProofs()
+	assert.Equal(t, 1, numSent)
+
+	proofs = append(proofs, &slashing.EquivocationProof{PubKey: []byte("pk2"), Round: 2})
+	_ = sts.SubmitPendingProofs()
+	assert.Equal(t, 1, numSent)
+
+	nonce = 1
+	_ = sts.SubmitPendingProofs()
+	assert.Equal(t, 2, numSent)
+
+	nonce = 2
+	_ = sts.SubmitPendingProofs()
+	assert.Equal(t, 2, numSent)
+}
+
+func TestSlashTxSubmitter_SubmitPendingProofsShouldResendAfterTimeout(t *testing.T) {
+	t.Parallel()
+
+	proofs := []*slashing.EquivocationProof{{PubKey: []byte("pk1"), Round: 1}}
+	nonce := uint64(0)
+	numSent := 0
+	args := createMockArgsSlashTxSubmitter()
+	args.ResendTimeout = args.SubmitInterval
+	args.ProofsProvider = &mock.ProofsProviderStub{
+		ProofsCalled: func() []*slashing.EquivocationProof {
+			return proofs
+		},
+	}
+	args.Accounts = createAccountsStubWithNonce(&nonce)
+	args.TxSender = &mock.TransactionSenderStub{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			numSent += len(txs)
+			return uint64(len(txs)), nil
+		},
+	}
+	sts, _ := slashing.NewSlashTxSubmitter(args)
+
+	_ = sts.SubmitPendingProofs()
+	_ = sts.SubmitPendingProofs()
+	assert.Equal(t, 1, numSent)
+
+	time.Sleep(args.ResendTimeout + 100*time.Millisecond)
+	_ = sts.SubmitPendingProofs()
+	assert.Equal(t, 2, numSent)
+}
+
+func TestSlashTxSubmitter_SubmitPendingProofsSenderErrorShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	proofs := []*slashing.EquivocationProof{{PubKey: []byte("pk1"), Round: 1}}
+	nonce := uint64(0)
+	sendErr := expectedErr
+	numSent := 0
+	args := createMockArgsSlashTxSubmitter()
+	args.ProofsProvider = &mock.ProofsProviderStub{
+		ProofsCalled: func() []*slashing.EquivocationProof {
+			return proofs
+		},
+	}
+	args.Accounts = createAccountsStubWithNonce(&nonce)
+	args.TxSender = &mock.TransactionSenderStub{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			numSent++
+			return 0, sendErr
+		},
+	}
+	sts, _ := slashing.NewSlashTxSubmitter(args)
+
+	err := sts.SubmitPendingProofs()
+	assert.Equal(t, expectedErr, err)
+
+	sendErr = nil
+	err = sts.SubmitPendingProofs()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numSent)
+}
+
+func TestSlashTxSubmitter_StartSubmittingAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashTxSubmitter()
+	sts, _ := slashing.NewSlashTxSubmitter(args)
+	assert.False(t, sts.IsInterfaceNil())
+
+	sts.StartSubmitting()
+	assert.Nil(t, sts.Close())
+}
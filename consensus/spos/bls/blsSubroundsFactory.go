@@ -20,6 +20,8 @@ type factory struct {
 	indexer          spos.ConsensusDataIndexer
 	chainID          []byte
 	currentPid       core.PeerID
+
+	optimisticSignatureVerification bool
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -90,6 +92,12 @@ func (fct *factory) SetIndexer(indexer spos.ConsensusDataIndexer) {
 	fct.indexer = indexer
 }
 
+// SetOptimisticSignatureVerification sets whether the leader verifies the aggregated signature first and falls back
+// to verifying each signature share only when the aggregated signature is invalid
+func (fct *factory) SetOptimisticSignatureVerification(enabled bool) {
+	fct.optimisticSignatureVerification = enabled
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -278,6 +286,8 @@ func (fct *factory) generateEndRoundSubround() error {
 		return err
 	}
 
+	subroundEndRoundObject.SetOptimisticSignatureVerification(fct.optimisticSignatureVerification)
+
 	fct.worker.AddReceivedMessageCall(MtBlockHeaderFinalInfo, subroundEndRoundObject.receivedBlockHeaderFinalInfo)
 	fct.worker.AddReceivedHeaderHandler(subroundEndRoundObject.receivedHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
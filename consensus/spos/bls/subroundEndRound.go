@@ -21,6 +21,8 @@ type subroundEndRound struct {
 	displayStatistics             func()
 	appStatusHandler              core.AppStatusHandler
 	mutProcessingEndRound         sync.Mutex
+
+	optimisticSignatureVerification bool
 }
 
 // SetAppStatusHandler method set appStatusHandler
@@ -33,6 +35,11 @@ func (sr *subroundEndRound) SetAppStatusHandler(ash core.AppStatusHandler) error
 	return nil
 }
 
+// SetOptimisticSignatureVerification sets whether the aggregated signature is verified before the signature shares
+func (sr *subroundEndRound) SetOptimisticSignatureVerification(enabled bool) {
+	sr.optimisticSignatureVerification = enabled
+}
+
 // NewSubroundEndRound creates a subroundEndRound object
 func NewSubroundEndRound(
 	baseSubround *spos.Subround,
@@ -53,6 +60,7 @@ func NewSubroundEndRound(
 		displayStatistics,
 		statusHandler.NewNilStatusHandler(),
 		sync.Mutex{},
+		false,
 	}
 	srEndRound.Job = srEndRound.doEndRoundJob
 	srEndRound.Check = srEndRound.doEndRoundConsensusCheck
@@ -178,16 +186,11 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 
 func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	bitmap := sr.GenerateBitmap(SrSignature)
-	err := sr.checkSignaturesValidity(bitmap)
-	if err != nil {
-		log.Debug("doEndRoundJob.checkSignaturesValidity", "error", err.Error())
-		return false
-	}
 
 	// Aggregate sig and add it to the block
-	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	bitmap, sig, err := sr.aggregateSignatures(bitmap)
 	if err != nil {
-		log.Debug("doEndRoundJob.AggregateSigs", "error", err.Error())
+		log.Debug("doEndRoundJob.aggregateSignatures", "error", err.Error())
 		return false
 	}
 
@@ -481,6 +484,162 @@ func (sr *subroundEndRound) doEndRoundConsensusCheck() bool {
 	return false
 }
 
+// aggregateSignatures aggregates the signature shares selected in the bitmap and returns the bitmap of the aggregated
+// shares. When the optimistic verification is enabled, the signature shares are aggregated first and only the
+// aggregated signature is verified, the costly verification of each signature share being done only if the aggregated
+// signature proves to be invalid. In that case, the invalid shares are left out and the valid ones are aggregated again
+func (sr *subroundEndRound) aggregateSignatures(bitmap []byte) ([]byte, []byte, error) {
+	if !sr.optimisticSignatureVerification {
+		err := sr.checkSignaturesValidity(bitmap)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return bitmap, sig, nil
+	}
+
+	sig, err := sr.aggregateAndVerifySignatures(bitmap)
+	if err == nil {
+		return bitmap, sig, nil
+	}
+
+	log.Debug("aggregated signature verification failed, verifying each signature share",
+		"error", err.Error())
+
+	validBitmap, err := sr.removeInvalidSignatureShares(bitmap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err = sr.MultiSigner().AggregateSigs(validBitmap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validBitmap, sig, nil
+}
+
+func (sr *subroundEndRound) aggregateAndVerifySignatures(bitmap []byte) ([]byte, error) {
+	err := sr.checkSignaturesReceived(bitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().SetAggregatedSig(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().Verify(sr.GetData(), bitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func (sr *subroundEndRound) checkSignaturesReceived(bitmap []byte) error {
+	consensusGroup := sr.ConsensusGroup()
+	size := len(consensusGroup)
+	if size > len(bitmap)*8 {
+		size = len(bitmap) * 8
+	}
+
+	for i := 0; i < size; i++ {
+		indexRequired := (bitmap[i/8] & (1 << uint16(i%8))) > 0
+		if !indexRequired {
+			continue
+		}
+
+		isSigJobDone, err := sr.JobDone(consensusGroup[i], SrSignature)
+		if err != nil {
+			return err
+		}
+
+		if !isSigJobDone {
+			return spos.ErrNilSignature
+		}
+	}
+
+	return nil
+}
+
+// removeInvalidSignatureShares verifies each signature share selected in the bitmap and returns a copy of the bitmap
+// without the invalid ones. If any share was left out, the remaining ones must still reach the consensus threshold
+func (sr *subroundEndRound) removeInvalidSignatureShares(bitmap []byte) ([]byte, error) {
+	validBitmap := make([]byte, len(bitmap))
+	copy(validBitmap, bitmap)
+
+	consensusGroup := sr.ConsensusGroup()
+	size := len(consensusGroup)
+	if size > len(bitmap)*8 {
+		size = len(bitmap) * 8
+	}
+
+	numValidShares := 0
+	numInvalidShares := 0
+	for i := 0; i < size; i++ {
+		indexRequired := (bitmap[i/8] & (1 << uint16(i%8))) > 0
+		if !indexRequired {
+			continue
+		}
+
+		pubKey := consensusGroup[i]
+		isSigJobDone, err := sr.JobDone(pubKey, SrSignature)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isSigJobDone {
+			return nil, spos.ErrNilSignature
+		}
+
+		signature, err := sr.MultiSigner().SignatureShare(uint16(i))
+		if err != nil {
+			return nil, err
+		}
+
+		err = sr.MultiSigner().VerifySignatureShare(uint16(i), signature, sr.GetData(), bitmap)
+		if err != nil {
+			log.Debug("invalid signature share left out of the aggregated signature",
+				"node", []byte(pubKey),
+				"index", i,
+				"error", err.Error())
+
+			validBitmap[i/8] &^= 1 << uint16(i%8)
+			numInvalidShares++
+			continue
+		}
+
+		numValidShares++
+	}
+
+	if numInvalidShares == 0 {
+		return validBitmap, nil
+	}
+
+	threshold := sr.Threshold(SrSignature)
+	if sr.FallbackHeaderValidator().ShouldApplyFallbackValidation(sr.Header) {
+		threshold = sr.FallbackThreshold(SrSignature)
+	}
+	if numValidShares < threshold {
+		return nil, fmt.Errorf("%w: %d valid signature shares, %d required",
+			spos.ErrNotEnoughValidSignatureShares, numValidShares, threshold)
+	}
+
+	return validBitmap, nil
+}
+
 func (sr *subroundEndRound) checkSignaturesValidity(bitmap []byte) error {
 	nbBitsBitmap := len(bitmap) * 8
 	consensusGroup := sr.ConsensusGroup()
@@ -499,6 +499,149 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobOptimisticVerificationShouldNotVerifySignatureShares(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSignerMock := mock.InitMultiSignerMock()
+	aggregatedSigVerified := false
+	multiSignerMock.VerifyMock = func(msg []byte, bitmap []byte) error {
+		aggregatedSigVerified = true
+		return nil
+	}
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		assert.Fail(t, "signature shares should not have been verified")
+		return nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetOptimisticSignatureVerification(true)
+	sr.SetSelfPubKey("A")
+	_ = sr.SetJobDone(sr.ConsensusGroup()[0], bls.SrSignature, true)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.True(t, aggregatedSigVerified)
+}
+
+func TestSubroundEndRound_DoEndRoundJobOptimisticVerificationFailedShouldVerifySignatureShares(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.VerifyMock = func(msg []byte, bitmap []byte) error {
+		return crypto.ErrAggSigNotValid
+	}
+	numSharesVerified := 0
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		numSharesVerified++
+		return nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetOptimisticSignatureVerification(true)
+	sr.SetSelfPubKey("A")
+	_ = sr.SetJobDone(sr.ConsensusGroup()[0], bls.SrSignature, true)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.Equal(t, 1, numSharesVerified)
+}
+
+func TestSubroundEndRound_DoEndRoundJobOptimisticVerificationWithInvalidShareShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.VerifyMock = func(msg []byte, bitmap []byte) error {
+		return crypto.ErrAggSigNotValid
+	}
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		return crypto.ErrSigNotValid
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetOptimisticSignatureVerification(true)
+	sr.SetSelfPubKey("A")
+	_ = sr.SetJobDone(sr.ConsensusGroup()[0], bls.SrSignature, true)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+}
+
+func TestSubroundEndRound_DoEndRoundJobOptimisticVerificationShouldLeaveOutTheInvalidShare(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.VerifyMock = func(msg []byte, bitmap []byte) error {
+		return crypto.ErrAggSigNotValid
+	}
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		if index == 2 {
+			return crypto.ErrSigNotValid
+		}
+		return nil
+	}
+	aggregatedBitmaps := make([][]byte, 0)
+	multiSignerMock.AggregateSigsMock = func(bitmap []byte) ([]byte, error) {
+		aggregatedBitmaps = append(aggregatedBitmaps, bitmap)
+		return []byte("aggregated signature"), nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetOptimisticSignatureVerification(true)
+	sr.SetSelfPubKey("A")
+	for _, pubKey := range sr.ConsensusGroup()[:8] {
+		_ = sr.SetJobDone(pubKey, bls.SrSignature, true)
+	}
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	require.Equal(t, 2, len(aggregatedBitmaps))
+	assert.Equal(t, []byte{0xff, 0x00}, aggregatedBitmaps[0])
+	assert.Equal(t, []byte{0xfb, 0x00}, aggregatedBitmaps[1])
+	assert.Equal(t, []byte{0xfb, 0x00}, sr.Header.GetPubKeysBitmap())
+	assert.Equal(t, []byte("aggregated signature"), sr.Header.GetSignature())
+}
+
+func TestSubroundEndRound_DoEndRoundJobOptimisticVerificationWithTooManyInvalidSharesShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.VerifyMock = func(msg []byte, bitmap []byte) error {
+		return crypto.ErrAggSigNotValid
+	}
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		if index == 2 || index == 5 {
+			return crypto.ErrSigNotValid
+		}
+		return nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetOptimisticSignatureVerification(true)
+	sr.SetSelfPubKey("A")
+	for _, pubKey := range sr.ConsensusGroup()[:8] {
+		_ = sr.SetJobDone(pubKey, bls.SrSignature, true)
+	}
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.Nil(t, sr.Header.GetSignature())
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
@@ -24,3 +24,7 @@ const ValidatorPeerHonestyDecreaseFactor = -2
 // MaxNumOfMessageTypeAccepted represents the maximum number of the same message type accepted in one round to be
 // received from the same public key
 const MaxNumOfMessageTypeAccepted = 1
+
+// MaxEquivocationRecordsPerKeyInRound represents the maximum number of distinct header hashes tracked for the same key
+// in one round while looking for equivocation
+const MaxEquivocationRecordsPerKeyInRound = 10
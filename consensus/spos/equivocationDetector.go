@@ -0,0 +1,221 @@
+package spos
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ArgsEquivocationDetector is the argument structure used to create a new equivocation detector
+type ArgsEquivocationDetector struct {
+	ProofVerifier   slashing.ProofVerifier
+	ProofSender     EquivocationProofSender
+	MaxRoundsToKeep uint64
+}
+
+type proposedHeader struct {
+	proposer          []byte
+	marshalizedHeader []byte
+	shardID           uint32
+}
+
+type roundEquivocationRecords struct {
+	headers  map[string]*proposedHeader
+	shares   map[string]map[string][]byte
+	reported map[string]struct{}
+}
+
+type equivocationDetector struct {
+	proofVerifier   slashing.ProofVerifier
+	proofSender     EquivocationProofSender
+	maxRoundsToKeep uint64
+
+	mutRecords   sync.Mutex
+	rounds       map[uint64]*roundEquivocationRecords
+	highestRound uint64
+}
+
+// NewEquivocationDetector creates a component that keeps the proposed headers and signature shares from the last
+// rounds and issues an equivocation proof whenever the same key signs two different headers in the same round
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.ProofVerifier) {
+		return nil, ErrNilEquivocationProofVerifier
+	}
+	if check.IfNil(args.ProofSender) {
+		return nil, ErrNilEquivocationProofSender
+	}
+	if args.MaxRoundsToKeep == 0 {
+		return nil, ErrInvalidNumOfRoundsToKeep
+	}
+
+	return &equivocationDetector{
+		proofVerifier:   args.ProofVerifier,
+		proofSender:     args.ProofSender,
+		maxRoundsToKeep: args.MaxRoundsToKeep,
+		rounds:          make(map[uint64]*roundEquivocationRecords),
+	}, nil
+}
+
+// AddProposedHeader records a header proposed in consensus. The proposer's signature share on the header is received
+// separately, so the header only completes the evidence against the keys that already signed it
+func (ed *equivocationDetector) AddProposedHeader(proposer []byte, headerHash []byte, marshalizedHeader []byte, header data.HeaderHandler) {
+	if check.IfNil(header) || len(headerHash) == 0 {
+		return
+	}
+
+	ed.mutRecords.Lock()
+	defer ed.mutRecords.Unlock()
+
+	round := header.GetRound()
+	records := ed.getOrCreateRoundRecords(round)
+	if records == nil {
+		return
+	}
+	if _, exists := records.headers[string(headerHash)]; exists {
+		return
+	}
+
+	records.headers[string(headerHash)] = &proposedHeader{
+		proposer:          proposer,
+		marshalizedHeader: marshalizedHeader,
+		shardID:           header.GetShardID(),
+	}
+
+	for pubKey, sharesOfKey := range records.shares {
+		if _, signed := sharesOfKey[string(headerHash)]; signed {
+			ed.checkEquivocation(round, records, pubKey)
+		}
+	}
+}
+
+// AddSignatureShare records the signature share given by a key on a header hash
+func (ed *equivocationDetector) AddSignatureShare(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if round < 0 || len(pubKey) == 0 || len(headerHash) == 0 || len(signatureShare) == 0 {
+		return
+	}
+
+	ed.mutRecords.Lock()
+	defer ed.mutRecords.Unlock()
+
+	records := ed.getOrCreateRoundRecords(uint64(round))
+	if records == nil {
+		return
+	}
+
+	sharesOfKey, ok := records.shares[string(pubKey)]
+	if !ok {
+		sharesOfKey = make(map[string][]byte)
+		records.shares[string(pubKey)] = sharesOfKey
+	}
+	if _, exists := sharesOfKey[string(headerHash)]; exists {
+		return
+	}
+	if len(sharesOfKey) >= MaxEquivocationRecordsPerKeyInRound {
+		return
+	}
+
+	sharesOfKey[string(headerHash)] = signatureShare
+	ed.checkEquivocation(uint64(round), records, string(pubKey))
+}
+
+func (ed *equivocationDetector) getOrCreateRoundRecords(round uint64) *roundEquivocationRecords {
+	if round+ed.maxRoundsToKeep < ed.highestRound {
+		return nil
+	}
+
+	if round > ed.highestRound {
+		ed.highestRound = round
+		ed.removeOldRounds()
+	}
+
+	records, ok := ed.rounds[round]
+	if !ok {
+		records = &roundEquivocationRecords{
+			headers:  make(map[string]*proposedHeader),
+			shares:   make(map[string]map[string][]byte),
+			reported: make(map[string]struct{}),
+		}
+		ed.rounds[round] = records
+	}
+
+	return records
+}
+
+func (ed *equivocationDetector) removeOldRounds() {
+	for round := range ed.rounds {
+		if round+ed.maxRoundsToKeep < ed.highestRound {
+			delete(ed.rounds, round)
+		}
+	}
+}
+
+func (ed *equivocationDetector) checkEquivocation(round uint64, records *roundEquivocationRecords, pubKey string) {
+	if _, alreadyReported := records.reported[pubKey]; alreadyReported {
+		return
+	}
+
+	sharesOfKey := records.shares[pubKey]
+	hashes := make([]string, 0, len(sharesOfKey))
+	for hash := range sharesOfKey {
+		if _, isHeaderKnown := records.headers[hash]; isHeaderKnown {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) < 2 {
+		return
+	}
+	sort.Strings(hashes)
+
+	first := records.headers[hashes[0]]
+	second := records.headers[hashes[1]]
+	proofType := slashing.DoubleSigning
+	if string(first.proposer) == pubKey && string(second.proposer) == pubKey {
+		proofType = slashing.DoubleProposal
+	}
+
+	proof := &slashing.EquivocationProof{
+		Type:    proofType,
+		PubKey:  []byte(pubKey),
+		ShardID: first.shardID,
+		Round:   round,
+		First: slashing.SignedHeader{
+			HeaderHash: []byte(hashes[0]),
+			Header:     first.marshalizedHeader,
+			Signature:  sharesOfKey[hashes[0]],
+		},
+		Second: slashing.SignedHeader{
+			HeaderHash: []byte(hashes[1]),
+			Header:     second.marshalizedHeader,
+			Signature:  sharesOfKey[hashes[1]],
+		},
+	}
+
+	err := ed.proofVerifier.Verify(proof)
+	if err != nil {
+		log.Debug("equivocationDetector: could not build a valid equivocation proof",
+			"pk", []byte(pubKey),
+			"round", round,
+			"error", err.Error())
+		return
+	}
+
+	records.reported[pubKey] = struct{}{}
+	log.Warn("equivocation detected",
+		"type", proofType.String(),
+		"pk", []byte(pubKey),
+		"shard", proof.ShardID,
+		"round", round)
+
+	err = ed.proofSender.Send(proof)
+	if err != nil {
+		log.Debug("equivocationDetector: could not send equivocation proof", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,178 @@
+package spos_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEquivocationDetector() spos.ArgsEquivocationDetector {
+	return spos.ArgsEquivocationDetector{
+		ProofVerifier:   &mock.EquivocationProofVerifierStub{},
+		ProofSender:     &mock.EquivocationProofSenderStub{},
+		MaxRoundsToKeep: 2,
+	}
+}
+
+func TestNewEquivocationDetector_NilProofVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.ProofVerifier = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilEquivocationProofVerifier, err)
+}
+
+func TestNewEquivocationDetector_NilProofSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.ProofSender = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilEquivocationProofSender, err)
+}
+
+func TestNewEquivocationDetector_InvalidRoundsToKeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.MaxRoundsToKeep = 0
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrInvalidNumOfRoundsToKeep, err)
+}
+
+func TestEquivocationDetector_SameHeaderSignedTwiceShouldNotSendProof(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	numSent := 0
+	args.ProofSender = &mock.EquivocationProofSenderStub{
+		SendCalled: func(proof *slashing.EquivocationProof) error {
+			numSent++
+			return nil
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddProposedHeader([]byte("leader"), []byte("hash1"), []byte("hdr1"), &block.Header{Round: 5})
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash1"), []byte("sig1"))
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash1"), []byte("sig1"))
+
+	assert.Equal(t, 0, numSent)
+	assert.False(t, ed.IsInterfaceNil())
+}
+
+func TestEquivocationDetector_DoubleSigningShouldSendProofOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	sentProofs := make([]*slashing.EquivocationProof, 0)
+	args.ProofSender = &mock.EquivocationProofSenderStub{
+		SendCalled: func(proof *slashing.EquivocationProof) error {
+			sentProofs = append(sentProofs, proof)
+			return nil
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddProposedHeader([]byte("leader"), []byte("hash1"), []byte("hdr1"), &block.Header{Round: 5, ShardID: 1})
+	ed.AddProposedHeader([]byte("other leader"), []byte("hash2"), []byte("hdr2"), &block.Header{Round: 5, ShardID: 1})
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash1"), []byte("sig1"))
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash2"), []byte("sig2"))
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash3"), []byte("sig3"))
+	ed.AddProposedHeader([]byte("leader"), []byte("hash3"), []byte("hdr3"), &block.Header{Round: 5, ShardID: 1})
+
+	require.Equal(t, 1, len(sentProofs))
+	proof := sentProofs[0]
+	assert.Equal(t, slashing.DoubleSigning, proof.Type)
+	assert.Equal(t, []byte("validator"), proof.PubKey)
+	assert.Equal(t, uint32(1), proof.ShardID)
+	assert.Equal(t, uint64(5), proof.Round)
+	assert.Equal(t, slashing.SignedHeader{HeaderHash: []byte("hash1"), Header: []byte("hdr1"), Signature: []byte("sig1")}, proof.First)
+	assert.Equal(t, slashing.SignedHeader{HeaderHash: []byte("hash2"), Header: []byte("hdr2"), Signature: []byte("sig2")}, proof.Second)
+}
+
+func TestEquivocationDetector_DoubleProposalWithHeadersReceivedLastShouldSendProof(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	var sentProof *slashing.EquivocationProof
+	args.ProofSender = &mock.EquivocationProofSenderStub{
+		SendCalled: func(proof *slashing.EquivocationProof) error {
+			sentProof = proof
+			return nil
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddSignatureShare(7, []byte("leader"), []byte("hash1"), []byte("sig1"))
+	ed.AddSignatureShare(7, []byte("leader"), []byte("hash2"), []byte("sig2"))
+	assert.Nil(t, sentProof)
+
+	ed.AddProposedHeader([]byte("leader"), []byte("hash1"), []byte("hdr1"), &block.Header{Round: 7})
+	ed.AddProposedHeader([]byte("leader"), []byte("hash2"), []byte("hdr2"), &block.Header{Round: 7})
+
+	require.NotNil(t, sentProof)
+	assert.Equal(t, slashing.DoubleProposal, sentProof.Type)
+	assert.Equal(t, []byte("leader"), sentProof.PubKey)
+}
+
+func TestEquivocationDetector_InvalidProofShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *slashing.EquivocationProof) error {
+			return errors.New("invalid signature")
+		},
+	}
+	numSent := 0
+	args.ProofSender = &mock.EquivocationProofSenderStub{
+		SendCalled: func(proof *slashing.EquivocationProof) error {
+			numSent++
+			return nil
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddProposedHeader([]byte("leader"), []byte("hash1"), []byte("hdr1"), &block.Header{Round: 5})
+	ed.AddProposedHeader([]byte("leader"), []byte("hash2"), []byte("hdr2"), &block.Header{Round: 5})
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash1"), []byte("sig1"))
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash2"), []byte("sig2"))
+
+	assert.Equal(t, 0, numSent)
+}
+
+func TestEquivocationDetector_OldRoundsShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	numSent := 0
+	args.ProofSender = &mock.EquivocationProofSenderStub{
+		SendCalled: func(proof *slashing.EquivocationProof) error {
+			numSent++
+			return nil
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddProposedHeader([]byte("leader"), []byte("hash1"), []byte("hdr1"), &block.Header{Round: 5})
+	ed.AddProposedHeader([]byte("leader"), []byte("hash2"), []byte("hdr2"), &block.Header{Round: 5})
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash1"), []byte("sig1"))
+	ed.AddSignatureShare(10, []byte("validator"), []byte("hash10"), []byte("sig10"))
+	ed.AddSignatureShare(5, []byte("validator"), []byte("hash2"), []byte("sig2"))
+
+	assert.Equal(t, 0, numSent)
+}
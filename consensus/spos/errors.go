@@ -19,6 +19,9 @@ var ErrNilPrivateKey = errors.New("private key is nil")
 // ErrNilSignature is raised when a valid signature was expected but nil was used
 var ErrNilSignature = errors.New("signature is nil")
 
+// ErrNotEnoughValidSignatureShares is raised when the valid signature shares do not reach the consensus threshold
+var ErrNotEnoughValidSignatureShares = errors.New("not enough valid signature shares")
+
 // ErrNilSingleSigner is raised when a valid singleSigner is expected but nil used
 var ErrNilSingleSigner = errors.New("singleSigner is nil")
 
@@ -216,3 +219,18 @@ var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
 
 // ErrNilNodeRedundancyHandler signals that provided node redundancy handler is nil
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilEquivocationProofVerifier signals that a nil equivocation proof verifier has been provided
+var ErrNilEquivocationProofVerifier = errors.New("nil equivocation proof verifier")
+
+// ErrNilEquivocationProofSender signals that a nil equivocation proof sender has been provided
+var ErrNilEquivocationProofSender = errors.New("nil equivocation proof sender")
+
+// ErrInvalidNumOfRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumOfRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrNilRoundsRecorder signals that a nil rounds recorder has been provided
+var ErrNilRoundsRecorder = errors.New("nil rounds recorder")
@@ -1,11 +1,15 @@
 package spos
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elastic-indexer-go/workItems"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -156,3 +160,26 @@ type ConsensusDataIndexer interface {
 	SaveRoundsInfo(roundsInfos []workItems.RoundInfo)
 	IsInterfaceNil() bool
 }
+
+// EquivocationDetector defines the behaviour of a component able to detect conflicting proposals and signatures given
+// by the same key in the same round
+type EquivocationDetector interface {
+	AddProposedHeader(proposer []byte, headerHash []byte, marshalizedHeader []byte, header data.HeaderHandler)
+	AddSignatureShare(round int64, pubKey []byte, headerHash []byte, signatureShare []byte)
+	IsInterfaceNil() bool
+}
+
+// RoundsRecorder defines the behaviour of a component able to record the timeline of the consensus rounds
+type RoundsRecorder interface {
+	StartRound(round int64, startTime time.Time)
+	AddMessage(round int64, pubKey []byte, messageType string, isSignature bool, arrivalTime time.Time)
+	SetRoundOutcome(round int64, leader []byte, consensusGroupSize int, signaturesThreshold int, outcome string)
+	GetRounds() []*api.ConsensusRound
+	IsInterfaceNil() bool
+}
+
+// EquivocationProofSender defines the behaviour of a component able to propagate equivocation proofs
+type EquivocationProofSender interface {
+	Send(proof *slashing.EquivocationProof) error
+	IsInterfaceNil() bool
+}
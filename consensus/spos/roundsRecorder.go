@@ -0,0 +1,195 @@
+package spos
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// RoundOutcomeCommitted is the outcome of a round in which the node committed the proposed block
+const RoundOutcomeCommitted = "committed"
+
+const persistedRoundKeyPrefix = "consensusRound_"
+
+// ArgsRoundsRecorder is the argument structure used to create a new rounds recorder
+type ArgsRoundsRecorder struct {
+	MaxRoundsToKeep int
+	Marshalizer     marshal.Marshalizer
+	// Storer is optional, the recorded rounds are kept only in memory when it is nil
+	Storer storage.Storer
+}
+
+type roundsRecorder struct {
+	maxRoundsToKeep int
+	marshalizer     marshal.Marshalizer
+	storer          storage.Storer
+
+	mutRounds sync.RWMutex
+	rounds    []*api.ConsensusRound
+}
+
+// NewRoundsRecorder creates a component that records, for the last rounds, the leader, the arrival times of the
+// consensus messages, the number of signatures and the outcome of each round. The recorded rounds are kept in a
+// bounded ring which is also persisted, if a storer is provided, so it survives a node restart
+func NewRoundsRecorder(args ArgsRoundsRecorder) (*roundsRecorder, error) {
+	if args.MaxRoundsToKeep <= 0 {
+		return nil, ErrInvalidNumOfRoundsToKeep
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	rr := &roundsRecorder{
+		maxRoundsToKeep: args.MaxRoundsToKeep,
+		marshalizer:     args.Marshalizer,
+		storer:          args.Storer,
+		rounds:          make([]*api.ConsensusRound, 0, args.MaxRoundsToKeep),
+	}
+	rr.loadPersistedRounds()
+
+	return rr, nil
+}
+
+func (rr *roundsRecorder) loadPersistedRounds() {
+	if check.IfNil(rr.storer) {
+		return
+	}
+
+	for i := 0; i < rr.maxRoundsToKeep; i++ {
+		buff, err := rr.storer.Get(persistedRoundKey(int64(i)))
+		if err != nil {
+			continue
+		}
+
+		round := &api.ConsensusRound{}
+		err = rr.marshalizer.Unmarshal(round, buff)
+		if err != nil {
+			log.Debug("roundsRecorder.loadPersistedRounds", "error", err.Error())
+			continue
+		}
+
+		rr.rounds = append(rr.rounds, round)
+	}
+
+	sort.Slice(rr.rounds, func(i, j int) bool {
+		return rr.rounds[i].Round < rr.rounds[j].Round
+	})
+}
+
+// StartRound records the start of a new round
+func (rr *roundsRecorder) StartRound(round int64, startTime time.Time) {
+	rr.mutRounds.Lock()
+	defer rr.mutRounds.Unlock()
+
+	record := rr.getOrCreateRound(round)
+	record.StartTimestamp = startTime.UnixNano() / int64(time.Millisecond)
+}
+
+// AddMessage records the arrival of a consensus message. The messages holding signature shares are also counted
+// towards the number of signatures gathered in the round
+func (rr *roundsRecorder) AddMessage(round int64, pubKey []byte, messageType string, isSignature bool, arrivalTime time.Time) {
+	rr.mutRounds.Lock()
+	defer rr.mutRounds.Unlock()
+
+	record := rr.getOrCreateRound(round)
+	arrivalMs := arrivalTime.UnixNano() / int64(time.Millisecond)
+	if record.StartTimestamp > 0 {
+		arrivalMs -= record.StartTimestamp
+	}
+
+	record.Messages = append(record.Messages, &api.ConsensusRoundMessage{
+		PubKey:      hex.EncodeToString(pubKey),
+		MessageType: messageType,
+		ArrivalMs:   arrivalMs,
+	})
+	if isSignature {
+		record.NumSignatures++
+	}
+}
+
+// SetRoundOutcome records the final outcome of a round along with its leader and the signatures threshold
+func (rr *roundsRecorder) SetRoundOutcome(round int64, leader []byte, consensusGroupSize int, signaturesThreshold int, outcome string) {
+	rr.mutRounds.Lock()
+	record := rr.getOrCreateRound(round)
+	record.Leader = hex.EncodeToString(leader)
+	record.ConsensusGroupSize = consensusGroupSize
+	record.SignaturesThreshold = signaturesThreshold
+	record.Outcome = outcome
+	buff, err := rr.marshalizer.Marshal(record)
+	rr.mutRounds.Unlock()
+
+	if err != nil {
+		log.Debug("roundsRecorder.SetRoundOutcome", "round", round, "error", err.Error())
+		return
+	}
+
+	rr.persistRound(round, buff)
+}
+
+func (rr *roundsRecorder) persistRound(round int64, buff []byte) {
+	if check.IfNil(rr.storer) {
+		return
+	}
+
+	err := rr.storer.Put(persistedRoundKey(round%int64(rr.maxRoundsToKeep)), buff)
+	if err != nil {
+		log.Debug("roundsRecorder.persistRound", "round", round, "error", err.Error())
+	}
+}
+
+func (rr *roundsRecorder) getOrCreateRound(round int64) *api.ConsensusRound {
+	for i := len(rr.rounds) - 1; i >= 0; i-- {
+		if rr.rounds[i].Round == round {
+			return rr.rounds[i]
+		}
+	}
+
+	record := &api.ConsensusRound{
+		Round:    round,
+		Messages: make([]*api.ConsensusRoundMessage, 0),
+	}
+	rr.rounds = append(rr.rounds, record)
+	sort.Slice(rr.rounds, func(i, j int) bool {
+		return rr.rounds[i].Round < rr.rounds[j].Round
+	})
+	if len(rr.rounds) > rr.maxRoundsToKeep {
+		rr.rounds = rr.rounds[len(rr.rounds)-rr.maxRoundsToKeep:]
+	}
+
+	return record
+}
+
+// GetRounds returns a copy of the recorded rounds, ordered ascending by round index
+func (rr *roundsRecorder) GetRounds() []*api.ConsensusRound {
+	rr.mutRounds.RLock()
+	defer rr.mutRounds.RUnlock()
+
+	rounds := make([]*api.ConsensusRound, 0, len(rr.rounds))
+	for _, record := range rr.rounds {
+		recordCopy := *record
+		recordCopy.Messages = make([]*api.ConsensusRoundMessage, 0, len(record.Messages))
+		for _, message := range record.Messages {
+			messageCopy := *message
+			recordCopy.Messages = append(recordCopy.Messages, &messageCopy)
+		}
+		rounds = append(rounds, &recordCopy)
+	}
+
+	return rounds
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rr *roundsRecorder) IsInterfaceNil() bool {
+	return rr == nil
+}
+
+func persistedRoundKey(index int64) []byte {
+	return []byte(fmt.Sprintf("%s%d", persistedRoundKeyPrefix, index))
+}
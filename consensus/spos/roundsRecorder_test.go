@@ -0,0 +1,126 @@
+package spos_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRoundsRecorder() spos.ArgsRoundsRecorder {
+	return spos.ArgsRoundsRecorder{
+		MaxRoundsToKeep: 3,
+		Marshalizer:     &mock.MarshalizerMock{},
+	}
+}
+
+func TestNewRoundsRecorder_InvalidMaxRoundsToKeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundsRecorder()
+	args.MaxRoundsToKeep = 0
+	rr, err := spos.NewRoundsRecorder(args)
+
+	assert.Nil(t, rr)
+	assert.Equal(t, spos.ErrInvalidNumOfRoundsToKeep, err)
+}
+
+func TestNewRoundsRecorder_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundsRecorder()
+	args.Marshalizer = nil
+	rr, err := spos.NewRoundsRecorder(args)
+
+	assert.Nil(t, rr)
+	assert.Equal(t, spos.ErrNilMarshalizer, err)
+}
+
+func TestNewRoundsRecorder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rr, err := spos.NewRoundsRecorder(createMockArgsRoundsRecorder())
+
+	assert.Nil(t, err)
+	assert.False(t, rr.IsInterfaceNil())
+	assert.Equal(t, 0, len(rr.GetRounds()))
+}
+
+func TestRoundsRecorder_AddMessageShouldCountSignaturesAndArrivalTime(t *testing.T) {
+	t.Parallel()
+
+	rr, _ := spos.NewRoundsRecorder(createMockArgsRoundsRecorder())
+	startTime := time.Unix(100, 0)
+	rr.StartRound(1, startTime)
+	rr.AddMessage(1, []byte("pk1"), "(BLOCK_HEADER)", false, startTime.Add(150*time.Millisecond))
+	rr.AddMessage(1, []byte("pk2"), "(SIGNATURE)", true, startTime.Add(700*time.Millisecond))
+	rr.AddMessage(1, []byte("pk3"), "(SIGNATURE)", true, startTime.Add(900*time.Millisecond))
+	rr.SetRoundOutcome(1, []byte("pk1"), 3, 3, spos.RoundOutcomeCommitted)
+
+	rounds := rr.GetRounds()
+	require.Equal(t, 1, len(rounds))
+	assert.Equal(t, int64(1), rounds[0].Round)
+	assert.Equal(t, int64(100000), rounds[0].StartTimestamp)
+	assert.Equal(t, hex.EncodeToString([]byte("pk1")), rounds[0].Leader)
+	assert.Equal(t, 2, rounds[0].NumSignatures)
+	assert.Equal(t, 3, rounds[0].SignaturesThreshold)
+	assert.Equal(t, spos.RoundOutcomeCommitted, rounds[0].Outcome)
+	require.Equal(t, 3, len(rounds[0].Messages))
+	assert.Equal(t, int64(150), rounds[0].Messages[0].ArrivalMs)
+	assert.Equal(t, hex.EncodeToString([]byte("pk3")), rounds[0].Messages[2].PubKey)
+	assert.Equal(t, int64(900), rounds[0].Messages[2].ArrivalMs)
+}
+
+func TestRoundsRecorder_ShouldKeepOnlyTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	rr, _ := spos.NewRoundsRecorder(createMockArgsRoundsRecorder())
+	for round := int64(1); round <= 5; round++ {
+		rr.StartRound(round, time.Unix(round, 0))
+	}
+
+	rounds := rr.GetRounds()
+	require.Equal(t, 3, len(rounds))
+	assert.Equal(t, int64(3), rounds[0].Round)
+	assert.Equal(t, int64(5), rounds[2].Round)
+}
+
+func TestRoundsRecorder_GetRoundsShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	rr, _ := spos.NewRoundsRecorder(createMockArgsRoundsRecorder())
+	rr.AddMessage(1, []byte("pk1"), "(SIGNATURE)", true, time.Unix(1, 0))
+
+	rounds := rr.GetRounds()
+	rounds[0].NumSignatures = 10
+	rounds[0].Messages[0].PubKey = "modified"
+
+	rounds = rr.GetRounds()
+	assert.Equal(t, 1, rounds[0].NumSignatures)
+	assert.Equal(t, hex.EncodeToString([]byte("pk1")), rounds[0].Messages[0].PubKey)
+}
+
+func TestRoundsRecorder_PersistedRoundsShouldBeReloaded(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundsRecorder()
+	args.Storer = genericMocks.NewStorerMock("ConsensusRounds", 0)
+	rr, _ := spos.NewRoundsRecorder(args)
+	for round := int64(1); round <= 4; round++ {
+		rr.StartRound(round, time.Unix(round, 0))
+		rr.SetRoundOutcome(round, []byte("leader"), 3, 3, spos.RoundOutcomeCommitted)
+	}
+	rr.StartRound(5, time.Unix(5, 0))
+
+	reloaded, _ := spos.NewRoundsRecorder(args)
+	rounds := reloaded.GetRounds()
+	require.Equal(t, 3, len(rounds))
+	assert.Equal(t, int64(2), rounds[0].Round)
+	assert.Equal(t, int64(4), rounds[2].Round)
+	assert.Equal(t, spos.RoundOutcomeCommitted, rounds[2].Outcome)
+}
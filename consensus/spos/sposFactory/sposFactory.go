@@ -23,6 +23,7 @@ func GetSubroundsFactory(
 	indexer process.Indexer,
 	chainID []byte,
 	currentPid core.PeerID,
+	optimisticSignatureVerification bool,
 ) (spos.SubroundsFactory, error) {
 	switch consensusType {
 	case blsConsensusType:
@@ -43,6 +44,7 @@ func GetSubroundsFactory(
 		}
 
 		subRoundFactoryBls.SetIndexer(indexer)
+		subRoundFactoryBls.SetOptimisticSignatureVerification(optimisticSignatureVerification)
 
 		return subRoundFactoryBls, nil
 	default:
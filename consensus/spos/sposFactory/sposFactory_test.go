@@ -49,6 +49,7 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		false,
 	)
 
 	assert.Nil(t, sf)
@@ -72,6 +73,7 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		false,
 	)
 
 	assert.Nil(t, sf)
@@ -96,6 +98,7 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		false,
 	)
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sf))
@@ -114,6 +117,7 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		currentPid,
+		false,
 	)
 
 	assert.Nil(t, sf)
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +30,9 @@ var _ closing.Closer = (*Worker)(nil)
 // sleepTime defines the time in milliseconds between each iteration made in checkChannels method
 const sleepTime = 5 * time.Millisecond
 
+// maxRoundsInTimelineMetric defines the number of the most recent rounds summarized in the rounds timeline metric
+const maxRoundsInTimelineMetric = 10
+
 // Worker defines the data needed by spos to communicate between nodes which are in the validators group
 type Worker struct {
 	consensusService        ConsensusService
@@ -71,6 +75,8 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	equivocationDetector      EquivocationDetector
+	roundsRecorder            RoundsRecorder
 }
 
 // WorkerArgs holds the consensus worker arguments
@@ -97,6 +103,8 @@ type WorkerArgs struct {
 	SignatureSize            int
 	PublicKeySize            int
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	EquivocationDetector     EquivocationDetector
+	RoundsRecorder           RoundsRecorder
 }
 
 // NewWorker creates a new Worker object
@@ -142,6 +150,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		equivocationDetector:     args.EquivocationDetector,
+		roundsRecorder:           args.RoundsRecorder,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -233,6 +243,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
+	if check.IfNil(args.RoundsRecorder) {
+		return ErrNilRoundsRecorder
+	}
 
 	return nil
 }
@@ -364,10 +380,20 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
 	if err != nil {
+		if errors.Is(err, ErrMessageTypeLimitReached) {
+			wrk.checkRepeatedMessageForEquivocation(cnsMsg, message.Peer())
+		}
 		return err
 	}
 
 	wrk.updateNetworkShardingVals(message, cnsMsg)
+	wrk.roundsRecorder.AddMessage(
+		cnsMsg.RoundIndex,
+		cnsMsg.PubKey,
+		wrk.consensusService.GetStringValue(msgType),
+		wrk.consensusService.IsMessageWithSignature(msgType),
+		wrk.syncTimer.CurrentTime(),
+	)
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
@@ -451,6 +477,7 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 	}
 
 	wrk.processReceivedHeaderMetric(cnsMsg)
+	wrk.equivocationDetector.AddProposedHeader(cnsMsg.PubKey, headerHash, cnsMsg.Header, header)
 
 	errNotCritical := wrk.forkDetector.AddHeader(header, headerHash, process.BHProposed, nil, nil)
 	if errNotCritical != nil {
@@ -469,6 +496,30 @@ func (wrk *Worker) doJobOnMessageWithSignature(cnsMsg *consensus.Message) {
 
 	hash := string(cnsMsg.BlockHeaderHash)
 	wrk.mapDisplayHashConsensusMessage[hash] = append(wrk.mapDisplayHashConsensusMessage[hash], cnsMsg)
+
+	wrk.equivocationDetector.AddSignatureShare(cnsMsg.RoundIndex, cnsMsg.PubKey, cnsMsg.BlockHeaderHash, cnsMsg.SignatureShare)
+}
+
+// checkRepeatedMessageForEquivocation feeds the equivocation detector with an authentic message which is otherwise
+// dropped because its sender already sent a message of the same type in the current round. A second, different
+// proposal or signature from the same key is exactly the evidence needed for an equivocation proof
+func (wrk *Worker) checkRepeatedMessageForEquivocation(cnsMsg *consensus.Message, originator core.PeerID) {
+	err := wrk.consensusMessageValidator.checkMessageOriginator(cnsMsg, originator)
+	if err != nil {
+		return
+	}
+
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	isMessageWithHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType) ||
+		wrk.consensusService.IsMessageWithBlockBodyAndHeader(msgType)
+	if isMessageWithHeader {
+		header := wrk.blockProcessor.DecodeBlockHeader(cnsMsg.Header)
+		wrk.equivocationDetector.AddProposedHeader(cnsMsg.PubKey, cnsMsg.BlockHeaderHash, cnsMsg.Header, header)
+	}
+
+	if wrk.consensusService.IsMessageWithSignature(msgType) {
+		wrk.equivocationDetector.AddSignatureShare(cnsMsg.RoundIndex, cnsMsg.PubKey, cnsMsg.BlockHeaderHash, cnsMsg.SignatureShare)
+	}
 }
 
 func (wrk *Worker) addBlockToPool(bodyBytes []byte) {
@@ -591,6 +642,7 @@ func (wrk *Worker) Extend(subroundId int) {
 		"subround", wrk.consensusService.GetSubroundName(subroundId))
 
 	wrk.DisplayStatistics()
+	wrk.recordRoundOutcome(fmt.Sprintf("extended in subround %s", wrk.consensusService.GetSubroundName(subroundId)))
 
 	if wrk.consensusService.IsSubroundStartRound(subroundId) {
 		return
@@ -605,8 +657,13 @@ func (wrk *Worker) Extend(subroundId int) {
 	wrk.blockProcessor.RevertAccountState(wrk.consensusState.Header)
 }
 
-// DisplayStatistics logs the consensus messages split on proposed headers
+// DisplayStatistics logs the consensus messages split on proposed headers. When called at the end of a round which
+// was not extended, it also records that the proposed block was committed
 func (wrk *Worker) DisplayStatistics() {
+	if !wrk.consensusState.ExtendedCalled {
+		wrk.recordRoundOutcome(RoundOutcomeCommitted)
+	}
+
 	wrk.mutDisplayHashConsensusMessage.Lock()
 	for hash, consensusMessages := range wrk.mapDisplayHashConsensusMessage {
 		log.Debug("proposed header with signatures",
@@ -626,6 +683,41 @@ func (wrk *Worker) DisplayStatistics() {
 	wrk.mutDisplayHashConsensusMessage.Unlock()
 }
 
+func (wrk *Worker) recordRoundOutcome(outcome string) {
+	leader, _ := wrk.consensusState.GetLeader()
+	consensusGroupSize := len(wrk.consensusState.ConsensusGroup())
+	wrk.roundsRecorder.SetRoundOutcome(
+		wrk.consensusState.RoundIndex,
+		[]byte(leader),
+		consensusGroupSize,
+		core.GetPBFTThreshold(consensusGroupSize),
+		outcome,
+	)
+
+	wrk.appStatusHandler.SetStringValue(core.MetricConsensusRoundsTimeline, wrk.lastRoundsSummary())
+}
+
+func (wrk *Worker) lastRoundsSummary() string {
+	rounds := wrk.roundsRecorder.GetRounds()
+	lines := make([]string, 0, maxRoundsInTimelineMetric)
+	for i := len(rounds) - 1; i >= 0 && len(lines) < maxRoundsInTimelineMetric; i-- {
+		round := rounds[i]
+		if len(round.Outcome) == 0 {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("round %d: %s, signatures %d/%d, leader %s",
+			round.Round,
+			round.Outcome,
+			round.NumSignatures,
+			round.SignaturesThreshold,
+			core.GetTrimmedPk(round.Leader),
+		))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // GetConsensusStateChangedChannel gets the channel for the consensusStateChanged
 func (wrk *Worker) GetConsensusStateChangedChannel() chan bool {
 	return wrk.consensusStateChangedChannel
@@ -660,6 +752,7 @@ func (wrk *Worker) Close() error {
 // ResetConsensusMessages resets at the start of each round all the previous consensus messages received
 func (wrk *Worker) ResetConsensusMessages() {
 	wrk.consensusMessageValidator.resetConsensusMessages()
+	wrk.roundsRecorder.StartRound(wrk.rounder.Index(), wrk.rounder.TimeStamp())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,8 +1,10 @@
 package spos_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -20,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const roundTimeDuration = 100 * time.Millisecond
@@ -96,6 +99,8 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		EquivocationDetector:     &mock.EquivocationDetectorStub{},
+		RoundsRecorder:           &mock.RoundsRecorderStub{},
 	}
 
 	return workerArgs
@@ -358,6 +363,28 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerNilEquivocationDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func TestWorker_NewWorkerNilRoundsRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.RoundsRecorder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundsRecorder, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -816,6 +843,81 @@ func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
 }
 
+func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldStillFeedEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	signedHashes := make([][]byte, 0)
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddSignatureShareCalled: func(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+			signedHashes = append(signedHashes, headerHash)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	firstHash := bytes.Repeat([]byte("a"), HashSize)
+	secondHash := bytes.Repeat([]byte("b"), HashSize)
+	for _, hash := range [][]byte{firstHash, secondHash} {
+		cnsMsg := consensus.NewConsensusMessage(
+			hash,
+			signature,
+			nil,
+			nil,
+			[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+			signature,
+			int(bls.MtSignature),
+			0,
+			chainID,
+			nil,
+			nil,
+			nil,
+			currentPid,
+		)
+		buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+		_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	}
+
+	assert.Equal(t, [][]byte{firstHash, secondHash}, signedHashes)
+}
+
+func TestWorker_ProcessReceivedMessageTypeLimitReachedWithBadOriginatorShouldNotFeedEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	numCalls := 0
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddSignatureShareCalled: func(round int64, pubKey []byte, headerHash []byte, signatureShare []byte) {
+			numCalls++
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		blockHeaderHash,
+		signature,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+
+	cnsMsg.BlockHeaderHash = bytes.Repeat([]byte("b"), HashSize)
+	buff, _ = wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: "other pid"}, fromConnectedPeerId)
+
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+	assert.Equal(t, 1, numCalls)
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -1477,6 +1579,42 @@ func TestWorker_ExtendShouldWork(t *testing.T) {
 	assert.Equal(t, int32(1), atomic.LoadInt32(&executed))
 }
 
+func TestWorker_ExtendShouldRecordRoundOutcome(t *testing.T) {
+	t.Parallel()
+
+	recordedOutcome := ""
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.RoundsRecorder = &mock.RoundsRecorderStub{
+		SetRoundOutcomeCalled: func(round int64, leader []byte, consensusGroupSize int, signaturesThreshold int, outcome string) {
+			recordedOutcome = outcome
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+	wrk.Extend(0)
+
+	assert.True(t, strings.Contains(recordedOutcome, "extended"))
+}
+
+func TestWorker_DisplayStatisticsShouldRecordCommittedRound(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	roundsRecorder, _ := spos.NewRoundsRecorder(spos.ArgsRoundsRecorder{
+		MaxRoundsToKeep: 10,
+		Marshalizer:     &mock.MarshalizerMock{},
+	})
+	workerArgs.RoundsRecorder = roundsRecorder
+	wrk, _ := spos.NewWorker(workerArgs)
+	wrk.ConsensusState().RoundIndex = 7
+	wrk.DisplayStatistics()
+
+	rounds := roundsRecorder.GetRounds()
+	require.Equal(t, 1, len(rounds))
+	assert.Equal(t, int64(7), rounds[0].Round)
+	assert.Equal(t, spos.RoundOutcomeCommitted, rounds[0].Outcome)
+	assert.Equal(t, len(wrk.ConsensusState().ConsensusGroup()), rounds[0].ConsensusGroupSize)
+}
+
 func TestWorker_ExecuteStoredMessagesShouldWork(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -72,6 +72,16 @@ const ConsensusTopic = "consensus"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// PeerAuthenticationTopic is the topic used for the signed messages that bind a validator key to a peer ID
+const PeerAuthenticationTopic = "peerAuthentication"
+
+// HeartbeatV2Topic is the topic used for the lightweight liveness messages. It is suffixed with the shard identifier
+const HeartbeatV2Topic = "heartbeatV2"
+
+// EquivocationProofTopic is the topic used to propagate equivocation proofs towards the metachain. It is suffixed with
+// the metachain communication identifier
+const EquivocationProofTopic = "equivocationProof"
+
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
 
@@ -105,6 +115,13 @@ const MetricSynchronizedRound = "erd_synchronized_round"
 // MetricIsSyncing is the metric for monitoring if a node is syncing
 const MetricIsSyncing = "erd_is_syncing"
 
+// MetricSyncBlocksPerMinute is the metric for monitoring the sync throughput, as number of blocks synced per minute
+const MetricSyncBlocksPerMinute = "erd_sync_blocks_per_minute"
+
+// MetricSyncPipelineVerifiedHeaders is the metric for monitoring the number of headers, following the block being
+// synced, whose chain was verified ahead of execution
+const MetricSyncPipelineVerifiedHeaders = "erd_sync_pipeline_verified_headers"
+
 // MetricPublicKeyBlockSign is the metric for monitoring public key of a node used in block signing
 const MetricPublicKeyBlockSign = "erd_public_key_block_sign"
 
@@ -201,6 +218,9 @@ const MetricNumMiniBlocks = "erd_num_mini_blocks"
 // MetricConsensusRoundState is the metric for consensus round state for a block
 const MetricConsensusRoundState = "erd_consensus_round_state"
 
+// MetricConsensusRoundsTimeline is the metric that holds a summary of the last consensus rounds, one round per line
+const MetricConsensusRoundsTimeline = "erd_consensus_rounds_timeline"
+
 // MetricCrossCheckBlockHeight is the metric that store cross block height
 const MetricCrossCheckBlockHeight = "erd_cross_check_block_height"
 
@@ -401,6 +421,8 @@ const (
 	IndexerOrder
 	// NetStatisticsOrder defines the order in which netStatistic component is notified of a start of epoch event
 	NetStatisticsOrder
+	// ScheduledActivationsOrder defines the order in which the scheduled activations handler is notified of a start of epoch event
+	ScheduledActivationsOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -572,3 +594,16 @@ const MaxLeafSize = uint64(1<<18) + uint64(1<<19) //786KB
 
 // MaxUserNameLength represents the maximum number of bytes a UserName can have
 const MaxUserNameLength = 32
+
+const (
+	// BuiltInFunctionsFeature is the name of the built in functions feature, whose activation can be scheduled on chain
+	BuiltInFunctionsFeature = "BuiltInFunctions"
+	// RelayedTransactionsFeature is the name of the relayed transactions feature, whose activation can be scheduled on chain
+	RelayedTransactionsFeature = "RelayedTransactions"
+	// PenalizedTooMuchGasFeature is the name of the penalized too much gas feature, whose activation can be scheduled on chain
+	PenalizedTooMuchGasFeature = "PenalizedTooMuchGas"
+	// MetaProtectionFeature is the name of the meta protection feature, whose activation can be scheduled on chain
+	MetaProtectionFeature = "MetaProtection"
+	// GasPriceModifierFeature is the name of the gas price modifier feature, whose activation can be scheduled on chain
+	GasPriceModifierFeature = "GasPriceModifier"
+)
@@ -77,6 +77,9 @@ var ErrNilSignalChan = errors.New("nil signal channel")
 // ErrInvalidLogFileMinLifeSpan signals that an invalid log file life span was provided
 var ErrInvalidLogFileMinLifeSpan = errors.New("minimum log file life span is invalid")
 
+// ErrInvalidLogFormat signals that an unknown log format was provided
+var ErrInvalidLogFormat = errors.New("invalid log format")
+
 // ErrFileLoggingProcessIsClosed signals that the file logging process is closed
 var ErrFileLoggingProcessIsClosed = errors.New("file logging process is closed")
 
@@ -103,3 +106,6 @@ var ErrSubtractionOverflow = errors.New("uint64 subtraction overflowed")
 
 // ErrNilTransactionFeeCalculator signals that a nil transaction fee calculator has been provided
 var ErrNilTransactionFeeCalculator = errors.New("nil transaction fee calculator")
+
+// ErrEmptyPeerID signals that an empty peer ID has been provided
+var ErrEmptyPeerID = errors.New("empty peer ID")
@@ -15,12 +15,16 @@ type genericEpochNotifier struct {
 	currentEpoch uint32
 	mutHandler   sync.RWMutex
 	handlers     []core.EpochSubscriberHandler
+
+	mutActivationEpochs sync.RWMutex
+	activationEpochs    map[string]uint32
 }
 
 // NewGenericEpochNotifier creates a new instance of a genericEpochNotifier component
 func NewGenericEpochNotifier() *genericEpochNotifier {
 	return &genericEpochNotifier{
-		handlers: make([]core.EpochSubscriberHandler, 0),
+		handlers:         make([]core.EpochSubscriberHandler, 0),
+		activationEpochs: make(map[string]uint32),
 	}
 }
 
@@ -67,6 +71,34 @@ func (gen *genericEpochNotifier) CurrentEpoch() uint32 {
 	return atomic.LoadUint32(&gen.currentEpoch)
 }
 
+// SetActivationEpochs replaces the activation epochs scheduled on chain for the protocol features. The new values are
+// taken into account by the registered handlers on the next epoch change
+func (gen *genericEpochNotifier) SetActivationEpochs(activationEpochs map[string]uint32) {
+	activationEpochsCopy := make(map[string]uint32, len(activationEpochs))
+	for featureName, epoch := range activationEpochs {
+		activationEpochsCopy[featureName] = epoch
+	}
+
+	gen.mutActivationEpochs.Lock()
+	gen.activationEpochs = activationEpochsCopy
+	gen.mutActivationEpochs.Unlock()
+}
+
+// ActivationEpoch returns the epoch from which the provided feature is active. An activation scheduled on chain can
+// only bring the feature's activation forward, so the smallest value between the scheduled and the provided default
+// epoch is returned
+func (gen *genericEpochNotifier) ActivationEpoch(featureName string, defaultEpoch uint32) uint32 {
+	gen.mutActivationEpochs.RLock()
+	scheduledEpoch, isScheduled := gen.activationEpochs[featureName]
+	gen.mutActivationEpochs.RUnlock()
+
+	if isScheduled && scheduledEpoch < defaultEpoch {
+		return scheduledEpoch
+	}
+
+	return defaultEpoch
+}
+
 // UnRegisterAll removes all registered handlers queue
 func (gen *genericEpochNotifier) UnRegisterAll() {
 	gen.mutHandler.Lock()
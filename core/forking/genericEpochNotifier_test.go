@@ -120,3 +120,36 @@ func TestGenericEpochNotifier_CheckEpochInSyncShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
 	assert.True(t, end.Sub(start) >= handlerWait)
 }
+
+func TestGenericEpochNotifier_ActivationEpochNotScheduledShouldReturnDefault(t *testing.T) {
+	t.Parallel()
+
+	gep := NewGenericEpochNotifier()
+	gep.SetActivationEpochs(map[string]uint32{"feature": 5})
+
+	assert.Equal(t, uint32(10), gep.ActivationEpoch("another feature", 10))
+}
+
+func TestGenericEpochNotifier_ActivationEpochShouldOnlyBringActivationForward(t *testing.T) {
+	t.Parallel()
+
+	gep := NewGenericEpochNotifier()
+	gep.SetActivationEpochs(map[string]uint32{"feature": 5})
+
+	assert.Equal(t, uint32(5), gep.ActivationEpoch("feature", 10))
+	assert.Equal(t, uint32(3), gep.ActivationEpoch("feature", 3))
+}
+
+func TestGenericEpochNotifier_SetActivationEpochsShouldReplaceThePreviousValues(t *testing.T) {
+	t.Parallel()
+
+	activationEpochs := map[string]uint32{"feature": 5}
+	gep := NewGenericEpochNotifier()
+	gep.SetActivationEpochs(activationEpochs)
+	activationEpochs["feature"] = 4
+	assert.Equal(t, uint32(5), gep.ActivationEpoch("feature", 10))
+
+	gep.SetActivationEpochs(map[string]uint32{"another feature": 6})
+	assert.Equal(t, uint32(10), gep.ActivationEpoch("feature", 10))
+	assert.Equal(t, uint32(6), gep.ActivationEpoch("another feature", 10))
+}
@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const blockElementsSeparator = "/"
+
+var globalBlockCorrelation blockCorrelation
+
+// blockCorrelation completes the logger's correlation elements (shard, epoch, round and subround) with the block
+// being processed. The elements are global, same as the logger's ones, as the node processes one block at a time.
+// The logger only holds the shard, epoch, round and subround elements, so the block is carried in the subround
+// element in order to reach all the log outputs (console, files and the log viewer). The structured outputs split it
+// back with SplitCorrelationSubround, so the lines get the same fields no matter where they are formatted
+type blockCorrelation struct {
+	mut      sync.RWMutex
+	isSet    bool
+	nonce    uint64
+	hash     []byte
+	subRound string
+}
+
+// SetCorrelationSubround sets the current subround as a log correlation element, keeping the block being processed
+func SetCorrelationSubround(subRound string) {
+	globalBlockCorrelation.mut.Lock()
+	globalBlockCorrelation.subRound = subRound
+	globalBlockCorrelation.updateLoggerCorrelation()
+	globalBlockCorrelation.mut.Unlock()
+}
+
+// SetCorrelationBlock sets the nonce and the hash of the block being processed as log correlation elements. The
+// hash can be empty, for example while the block is being created
+func SetCorrelationBlock(nonce uint64, hash []byte) {
+	globalBlockCorrelation.mut.Lock()
+	globalBlockCorrelation.isSet = true
+	globalBlockCorrelation.nonce = nonce
+	globalBlockCorrelation.hash = hash
+	globalBlockCorrelation.updateLoggerCorrelation()
+	globalBlockCorrelation.mut.Unlock()
+}
+
+// ClearCorrelationBlock removes the block log correlation elements, as no block is processed anymore
+func ClearCorrelationBlock() {
+	globalBlockCorrelation.mut.Lock()
+	globalBlockCorrelation.isSet = false
+	globalBlockCorrelation.nonce = 0
+	globalBlockCorrelation.hash = nil
+	globalBlockCorrelation.updateLoggerCorrelation()
+	globalBlockCorrelation.mut.Unlock()
+}
+
+// GetCorrelationBlock returns the nonce and the hex encoded hash of the block being processed. The last returned
+// value is false if no block is processed
+func GetCorrelationBlock() (uint64, string, bool) {
+	globalBlockCorrelation.mut.RLock()
+	defer globalBlockCorrelation.mut.RUnlock()
+
+	return globalBlockCorrelation.nonce, hex.EncodeToString(globalBlockCorrelation.hash), globalBlockCorrelation.isSet
+}
+
+// SplitCorrelationSubround splits the logger's subround element in the subround, the nonce and the short hex encoded
+// hash of the block being processed. The nonce and the hash are empty if the element does not carry a block
+func SplitCorrelationSubround(element string) (string, uint64, string) {
+	elements := strings.SplitN(element, blockElementsSeparator, 3)
+	if len(elements) < 2 {
+		return element, 0, ""
+	}
+
+	nonce, err := strconv.ParseUint(elements[1], 10, 64)
+	if err != nil {
+		return element, 0, ""
+	}
+	if len(elements) == 2 {
+		return elements[0], nonce, ""
+	}
+
+	return elements[0], nonce, elements[2]
+}
+
+// updateLoggerCorrelation sets the logger's subround element as subround/nonce/short hash while a block is processed.
+// It should be called under mutex protection
+func (bc *blockCorrelation) updateLoggerCorrelation() {
+	if !bc.isSet {
+		logger.SetCorrelationSubround(bc.subRound)
+		return
+	}
+
+	logger.SetCorrelationSubround(bc.subRound + bc.blockElements())
+}
+
+func (bc *blockCorrelation) blockElements() string {
+	elements := blockElementsSeparator + strconv.FormatUint(bc.nonce, 10)
+	if len(bc.hash) > 0 {
+		elements += blockElementsSeparator + logger.ToHexShort(bc.hash)
+	}
+
+	return elements
+}
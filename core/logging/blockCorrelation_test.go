@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"testing"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCorrelationBlock_ShouldCarryTheBlockInTheLoggerCorrelation(t *testing.T) {
+	// not a parallel test as the correlation elements are global
+
+	SetCorrelationSubround("(BLOCK)")
+	SetCorrelationBlock(56, []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff, 0x11})
+	assert.Equal(t, "(BLOCK)/56/aabbcc..eeff11", getLoggerSubround())
+
+	SetCorrelationSubround("(SIGNATURE)")
+	assert.Equal(t, "(SIGNATURE)/56/aabbcc..eeff11", getLoggerSubround())
+
+	SetCorrelationBlock(57, nil)
+	assert.Equal(t, "(SIGNATURE)/57", getLoggerSubround())
+
+	ClearCorrelationBlock()
+	assert.Equal(t, "(SIGNATURE)", getLoggerSubround())
+
+	SetCorrelationSubround("")
+}
+
+func TestSplitCorrelationSubround(t *testing.T) {
+	t.Parallel()
+
+	subRound, nonce, hash := SplitCorrelationSubround("(BLOCK)/56/aabbcc..eeff11")
+	assert.Equal(t, "(BLOCK)", subRound)
+	assert.Equal(t, uint64(56), nonce)
+	assert.Equal(t, "aabbcc..eeff11", hash)
+
+	subRound, nonce, hash = SplitCorrelationSubround("(BLOCK)/57")
+	assert.Equal(t, "(BLOCK)", subRound)
+	assert.Equal(t, uint64(57), nonce)
+	assert.Equal(t, "", hash)
+
+	subRound, nonce, hash = SplitCorrelationSubround("/58")
+	assert.Equal(t, "", subRound)
+	assert.Equal(t, uint64(58), nonce)
+	assert.Equal(t, "", hash)
+
+	subRound, nonce, hash = SplitCorrelationSubround("(BLOCK)")
+	assert.Equal(t, "(BLOCK)", subRound)
+	assert.Equal(t, uint64(0), nonce)
+	assert.Equal(t, "", hash)
+
+	subRound, nonce, hash = SplitCorrelationSubround("custom/subround")
+	assert.Equal(t, "custom/subround", subRound)
+	assert.Equal(t, uint64(0), nonce)
+	assert.Equal(t, "", hash)
+}
+
+func getLoggerSubround() string {
+	correlation := logger.GetCorrelation()
+	return correlation.GetSubRound()
+}
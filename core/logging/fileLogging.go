@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -19,28 +20,81 @@ const defaultFileLifeSpan = time.Hour * 24
 
 var log = logger.GetOrCreate("core/logging")
 
+const (
+	// PlainLogFormat writes the log lines in the same format as the console, without the ANSI colors
+	PlainLogFormat = "plain"
+	// JSONLogFormat writes each log line as a JSON object on a single line
+	JSONLogFormat = "json"
+)
+
+// ArgsFileLogging is the DTO used to create a new file logging handler
+type ArgsFileLogging struct {
+	WorkingDir      string
+	DefaultLogsPath string
+	LogFilePrefix   string
+	LogFormat       string
+}
+
 // fileLogging is able to rotate the log files
 type fileLogging struct {
 	chLifeSpanChanged chan time.Duration
+	chMaxSizeReached  chan struct{}
 	mutFile           sync.Mutex
 	currentFile       *os.File
+	currentWriter     *sizeCountingWriter
+	maxFileSize       uint64
 	workingDir        string
 	defaultLogsPath   string
 	logFilePrefix     string
+	fileExtension     string
+	createFormatter   func() logger.Formatter
 	cancelFunc        func()
 	mutIsClosed       sync.Mutex
 	isClosed          bool
 }
 
-// NewFileLogging creates a file log watcher used to break the log file into multiple smaller files
-func NewFileLogging(workingDir string, defaultLogsPath string, logFilePrefix string) (*fileLogging, error) {
+// sizeCountingWriter counts the bytes written in a log file so the file can be rotated when it grows too large
+type sizeCountingWriter struct {
+	file         *os.File
+	numWritten   uint64
+	onBytesAdded func(numWritten uint64)
+}
+
+// Write writes the provided bytes in the file
+func (scw *sizeCountingWriter) Write(p []byte) (int, error) {
+	n, err := scw.file.Write(p)
+	scw.onBytesAdded(atomic.AddUint64(&scw.numWritten, uint64(n)))
+
+	return n, err
+}
+
+// NewFileLogging creates a file log watcher used to break the log file into multiple smaller files. A new file is
+// created when the current one reaches its life span or, if set, its maximum size
+func NewFileLogging(args ArgsFileLogging) (*fileLogging, error) {
 	fl := &fileLogging{
-		workingDir:        workingDir,
-		defaultLogsPath:   defaultLogsPath,
-		logFilePrefix:     logFilePrefix,
+		workingDir:        args.WorkingDir,
+		defaultLogsPath:   args.DefaultLogsPath,
+		logFilePrefix:     args.LogFilePrefix,
 		chLifeSpanChanged: make(chan time.Duration),
+		chMaxSizeReached:  make(chan struct{}, 1),
 		isClosed:          false,
 	}
+
+	switch args.LogFormat {
+	case PlainLogFormat:
+		fl.fileExtension = "log"
+		fl.createFormatter = func() logger.Formatter {
+			return &logger.PlainFormatter{}
+		}
+	case JSONLogFormat:
+		fl.fileExtension = "json"
+		fl.createFormatter = func() logger.Formatter {
+			return &JSONFormatter{}
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", core.ErrInvalidLogFormat, args.LogFormat)
+	}
+
 	fl.recreateLogFile()
 
 	//we need this function as to call file.Close() when the code panics and the defer func associated
@@ -63,7 +117,7 @@ func (fl *fileLogging) createFile() (*os.File, error) {
 		core.ArgCreateFileArgument{
 			Prefix:        fl.logFilePrefix,
 			Directory:     logDirectory,
-			FileExtension: "log",
+			FileExtension: fl.fileExtension,
 		},
 	)
 }
@@ -79,7 +133,12 @@ func (fl *fileLogging) recreateLogFile() {
 	defer fl.mutFile.Unlock()
 
 	oldFile := fl.currentFile
-	err = logger.AddLogObserver(newFile, &logger.PlainFormatter{})
+	oldWriter := fl.currentWriter
+	newWriter := &sizeCountingWriter{
+		file:         newFile,
+		onBytesAdded: fl.checkMaxSize,
+	}
+	err = logger.AddLogObserver(newWriter, fl.createFormatter())
 	if err != nil {
 		log.Error("error adding log observer", "error", err)
 		return
@@ -89,16 +148,43 @@ func (fl *fileLogging) recreateLogFile() {
 	log.LogIfError(errNotCritical, "step", "redirecting std error")
 
 	fl.currentFile = newFile
+	fl.currentWriter = newWriter
 
 	if oldFile == nil {
 		return
 	}
 
+	errNotCritical = logger.RemoveLogObserver(oldWriter)
+	log.LogIfError(errNotCritical, "step", "removing old log observer")
+
 	errNotCritical = oldFile.Close()
 	log.LogIfError(errNotCritical, "step", "closing old log file")
+}
 
-	errNotCritical = logger.RemoveLogObserver(oldFile)
-	log.LogIfError(errNotCritical, "step", "removing old log observer")
+// checkMaxSize is called while the logger holds its observers lock, so the file recreation is only signaled here
+func (fl *fileLogging) checkMaxSize(numWritten uint64) {
+	maxFileSize := atomic.LoadUint64(&fl.maxFileSize)
+	if maxFileSize == 0 || numWritten < maxFileSize {
+		return
+	}
+
+	select {
+	case fl.chMaxSizeReached <- struct{}{}:
+	default:
+	}
+}
+
+// isMaxSizeReached filters out the signals sent by the previous file, right before it was replaced
+func (fl *fileLogging) isMaxSizeReached() bool {
+	fl.mutFile.Lock()
+	defer fl.mutFile.Unlock()
+
+	maxFileSize := atomic.LoadUint64(&fl.maxFileSize)
+	if maxFileSize == 0 || fl.currentWriter == nil {
+		return false
+	}
+
+	return atomic.LoadUint64(&fl.currentWriter.numWritten) >= maxFileSize
 }
 
 func (fl *fileLogging) autoRecreateFile(ctx context.Context) {
@@ -110,6 +196,11 @@ func (fl *fileLogging) autoRecreateFile(ctx context.Context) {
 			return
 		case <-time.After(fileLifeSpan):
 			fl.recreateLogFile()
+		case <-fl.chMaxSizeReached:
+			if fl.isMaxSizeReached() {
+				log.Debug("log file reached its maximum size, creating a new one")
+				fl.recreateLogFile()
+			}
 		case fileLifeSpan = <-fl.chLifeSpanChanged:
 			log.Debug("changed log file span", "new value", fileLifeSpan)
 		}
@@ -133,6 +224,21 @@ func (fl *fileLogging) ChangeFileLifeSpan(newDuration time.Duration) error {
 	return nil
 }
 
+// ChangeFileMaxSize changes the size, in bytes, after which a new log file is created. 0 disables the size limit
+func (fl *fileLogging) ChangeFileMaxSize(maxSizeInBytes uint64) error {
+	fl.mutIsClosed.Lock()
+	defer fl.mutIsClosed.Unlock()
+
+	if fl.isClosed {
+		return core.ErrFileLoggingProcessIsClosed
+	}
+
+	atomic.StoreUint64(&fl.maxFileSize, maxSizeInBytes)
+	log.Debug("changed log file maximum size", "new value", maxSizeInBytes)
+
+	return nil
+}
+
 // Close closes the file logging handler
 func (fl *fileLogging) Close() error {
 	fl.mutIsClosed.Lock()
@@ -15,6 +15,15 @@ import (
 
 const logsDirectory = "logs"
 
+func createMockArgsFileLogging(dir string) ArgsFileLogging {
+	return ArgsFileLogging{
+		WorkingDir:      dir,
+		DefaultLogsPath: logsDirectory,
+		LogFilePrefix:   "elrond-go",
+		LogFormat:       PlainLogFormat,
+	}
+}
+
 func TestNewFileLogging_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -24,7 +33,7 @@ func TestNewFileLogging_ShouldWork(t *testing.T) {
 		log.LogIfError(err)
 	}()
 
-	fl, err := NewFileLogging(dir, logsDirectory, "elrond-go")
+	fl, err := NewFileLogging(createMockArgsFileLogging(dir))
 
 	assert.False(t, check.IfNil(fl))
 	assert.Nil(t, err)
@@ -39,7 +48,7 @@ func TestNewFileLogging_CloseShouldStopCreatingLogFiles(t *testing.T) {
 		log.LogIfError(err)
 	}()
 
-	fl, _ := NewFileLogging(dir, logsDirectory, "elrond-go")
+	fl, _ := NewFileLogging(createMockArgsFileLogging(dir))
 	_ = fl.ChangeFileLifeSpan(time.Second)
 	time.Sleep(time.Second*3 + time.Millisecond*200)
 
@@ -64,7 +73,7 @@ func TestNewFileLogging_CloseCallTwiceShouldWork(t *testing.T) {
 		log.LogIfError(err)
 	}()
 
-	fl, _ := NewFileLogging(dir, logsDirectory, "elrond-go")
+	fl, _ := NewFileLogging(createMockArgsFileLogging(dir))
 
 	err := fl.Close()
 	assert.Nil(t, err)
@@ -82,7 +91,7 @@ func TestFileLogging_ChangeFileLifeSpanInvalidValueShouldErr(t *testing.T) {
 		log.LogIfError(err)
 	}()
 
-	fl, _ := NewFileLogging(dir, logsDirectory, "elrond-go")
+	fl, _ := NewFileLogging(createMockArgsFileLogging(dir))
 	err := fl.ChangeFileLifeSpan(time.Millisecond)
 
 	assert.True(t, errors.Is(err, core.ErrInvalidLogFileMinLifeSpan))
@@ -97,7 +106,7 @@ func TestFileLogging_ChangeFileLifeSpanAfterCloseShouldErr(t *testing.T) {
 		log.LogIfError(err)
 	}()
 
-	fl, _ := NewFileLogging(dir, logsDirectory, "elrond-go")
+	fl, _ := NewFileLogging(createMockArgsFileLogging(dir))
 	err := fl.ChangeFileLifeSpan(time.Second)
 	assert.Nil(t, err)
 
@@ -106,3 +115,45 @@ func TestFileLogging_ChangeFileLifeSpanAfterCloseShouldErr(t *testing.T) {
 	err = fl.ChangeFileLifeSpan(time.Second)
 	assert.True(t, errors.Is(err, core.ErrFileLoggingProcessIsClosed))
 }
+
+func TestNewFileLogging_InvalidLogFormatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFileLogging("")
+	args.LogFormat = "xml"
+	fl, err := NewFileLogging(args)
+
+	assert.True(t, check.IfNil(fl))
+	assert.True(t, errors.Is(err, core.ErrInvalidLogFormat))
+}
+
+func TestFileLogging_ChangeFileMaxSizeShouldRotateTheJSONLogFiles(t *testing.T) {
+	// not a parallel test as the log observers are global and the lines logged by the other tests would rotate the file
+
+	dir, _ := ioutil.TempDir("", "file_logging")
+	defer func() {
+		err := os.RemoveAll(dir)
+		log.LogIfError(err)
+	}()
+
+	args := createMockArgsFileLogging(dir)
+	args.LogFormat = JSONLogFormat
+	fl, _ := NewFileLogging(args)
+	err := fl.ChangeFileMaxSize(1)
+	assert.Nil(t, err)
+
+	// the file names have a resolution of one second, a new file is created only on a different name
+	time.Sleep(time.Second + time.Millisecond*100)
+	log.Warn("this line exceeds the maximum log file size")
+	time.Sleep(time.Millisecond * 200)
+
+	_ = fl.Close()
+	err = fl.ChangeFileMaxSize(1)
+	assert.True(t, errors.Is(err, core.ErrFileLoggingProcessIsClosed))
+
+	files, _ := ioutil.ReadDir(filepath.Join(dir, logsDirectory))
+	assert.Equal(t, 2, len(files))
+	for _, file := range files {
+		assert.Equal(t, ".json", filepath.Ext(file.Name()))
+	}
+}
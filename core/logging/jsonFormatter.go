@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+// JSONLogLine is the structured form of a log line, suitable for log ingestion tools
+type JSONLogLine struct {
+	Timestamp  string            `json:"timestamp"`
+	Level      string            `json:"level"`
+	Logger     string            `json:"logger,omitempty"`
+	Shard      string            `json:"shard,omitempty"`
+	Epoch      uint32            `json:"epoch,omitempty"`
+	Round      int64             `json:"round,omitempty"`
+	SubRound   string            `json:"subRound,omitempty"`
+	BlockNonce uint64            `json:"blockNonce,omitempty"`
+	BlockHash  string            `json:"blockHash,omitempty"`
+	Message    string            `json:"message"`
+	Args       map[string]string `json:"args,omitempty"`
+}
+
+// NewJSONLogLine converts a log line in its structured form. The arguments are expected in the
+// "name1", "val1", "name2", "val2" ... format, the last one being ignored on an odd count. The block carried by the
+// subround correlation element is split in its own fields
+func NewJSONLogLine(line logger.LogLineHandler) *JSONLogLine {
+	correlation := line.GetCorrelation()
+	jsonLine := &JSONLogLine{
+		Timestamp: time.Unix(0, line.GetTimestamp()).UTC().Format(time.RFC3339Nano),
+		Level:     strings.TrimSpace(logger.LogLevel(line.GetLogLevel()).String()),
+		Logger:    line.GetLoggerName(),
+		Shard:     correlation.GetShard(),
+		Epoch:     correlation.GetEpoch(),
+		Round:     correlation.GetRound(),
+		Message:   line.GetMessage(),
+	}
+	jsonLine.SubRound, jsonLine.BlockNonce, jsonLine.BlockHash = SplitCorrelationSubround(correlation.GetSubRound())
+
+	args := line.GetArgs()
+	if len(args) < 2 {
+		return jsonLine
+	}
+
+	jsonLine.Args = make(map[string]string, len(args)/2)
+	for index := 1; index < len(args); index += 2 {
+		jsonLine.Args[args[index-1]] = args[index]
+	}
+
+	return jsonLine
+}
+
+// JSONFormatter outputs each log line as a JSON object on a single line
+type JSONFormatter struct {
+}
+
+// Output converts the provided log line in a newline terminated JSON object
+func (jf *JSONFormatter) Output(line logger.LogLineHandler) []byte {
+	if line == nil || line.IsInterfaceNil() {
+		return nil
+	}
+
+	buff, err := json.Marshal(NewJSONLogLine(line))
+	if err != nil {
+		return nil
+	}
+
+	return append(buff, '\n')
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jf *JSONFormatter) IsInterfaceNil() bool {
+	return jf == nil
+}
@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go-logger/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFormatter_OutputNilLineShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	jf := &JSONFormatter{}
+
+	assert.Nil(t, jf.Output(nil))
+	assert.False(t, jf.IsInterfaceNil())
+}
+
+func TestJSONFormatter_OutputShouldWork(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2020, 10, 1, 12, 30, 0, 500, time.UTC)
+	line := &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			LoggerName: "process/block",
+			Correlation: proto.LogCorrelationMessage{
+				Shard:    "metachain",
+				Epoch:    3,
+				Round:    1234,
+				SubRound: "(BLOCK)",
+			},
+			Message:   "block committed",
+			LogLevel:  int32(logger.LogInfo),
+			Args:      []string{"nonce", "56", "hash", "aabb", "odd"},
+			Timestamp: timestamp.UnixNano(),
+		},
+	}
+
+	output := (&JSONFormatter{}).Output(line)
+	require.NotNil(t, output)
+	assert.Equal(t, byte('\n'), output[len(output)-1])
+
+	recovered := &JSONLogLine{}
+	err := json.Unmarshal(output, recovered)
+	require.Nil(t, err)
+
+	expected := &JSONLogLine{
+		Timestamp: "2020-10-01T12:30:00.0000005Z",
+		Level:     "INFO",
+		Logger:    "process/block",
+		Shard:     "metachain",
+		Epoch:     3,
+		Round:     1234,
+		SubRound:  "(BLOCK)",
+		Message:   "block committed",
+		Args:      map[string]string{"nonce": "56", "hash": "aabb"},
+	}
+	assert.Equal(t, expected, recovered)
+}
+
+func TestJSONFormatter_OutputShouldSplitTheBlockFromTheSubround(t *testing.T) {
+	t.Parallel()
+
+	line := &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			Correlation: proto.LogCorrelationMessage{
+				SubRound: "(BLOCK)/56/aabbcc..eeff11",
+			},
+			Message:  "processing transaction",
+			LogLevel: int32(logger.LogDebug),
+		},
+	}
+
+	output := (&JSONFormatter{}).Output(line)
+	recovered := &JSONLogLine{}
+	err := json.Unmarshal(output, recovered)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(56), recovered.BlockNonce)
+	assert.Equal(t, "aabbcc..eeff11", recovered.BlockHash)
+	assert.Equal(t, "(BLOCK)", recovered.SubRound)
+
+	line.Correlation.SubRound = "(BLOCK)"
+	output = (&JSONFormatter{}).Output(line)
+	recovered = &JSONLogLine{}
+	_ = json.Unmarshal(output, recovered)
+	assert.Equal(t, uint64(0), recovered.BlockNonce)
+	assert.Equal(t, "", recovered.BlockHash)
+	assert.Equal(t, "(BLOCK)", recovered.SubRound)
+}
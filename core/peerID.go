@@ -5,6 +5,20 @@ import "github.com/mr-tron/base58/base58"
 // PeerID is a p2p peer identity.
 type PeerID string
 
+// NewPeerID decodes a b58-encoded peer ID, as returned by the Pretty function
+func NewPeerID(pretty string) (PeerID, error) {
+	if len(pretty) == 0 {
+		return "", ErrEmptyPeerID
+	}
+
+	buff, err := base58.Decode(pretty)
+	if err != nil {
+		return "", err
+	}
+
+	return PeerID(buff), nil
+}
+
 // Bytes returns the peer ID as byte slice
 func (pid PeerID) Bytes() []byte {
 	return []byte(pid)
@@ -0,0 +1,30 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerID_InvalidOrEmptyStringShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pid, err := core.NewPeerID("0OIl")
+	assert.Equal(t, core.PeerID(""), pid)
+	assert.NotNil(t, err)
+
+	pid, err = core.NewPeerID("")
+	assert.Equal(t, core.PeerID(""), pid)
+	assert.Equal(t, core.ErrEmptyPeerID, err)
+}
+
+func TestNewPeerID_ShouldDecodeThePrettyString(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("a peer ID")
+	decoded, err := core.NewPeerID(pid.Pretty())
+
+	assert.Nil(t, err)
+	assert.Equal(t, pid, decoded)
+}
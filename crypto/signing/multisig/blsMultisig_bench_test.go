@@ -0,0 +1,73 @@
+package multisig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var benchmarkConsensusGroupSizes = []uint16{21, 63, 400}
+
+func createBitmapForSigners(numSigners uint16) []byte {
+	bitmap := make([]byte, numSigners/8+1)
+	for i := uint16(0); i < numSigners; i++ {
+		bitmap[i/8] |= 1 << (i % 8)
+	}
+
+	return bitmap
+}
+
+func createMultiSignerWithAllSigShares(b *testing.B, grSize uint16, message []byte) (crypto.MultiSigner, [][]byte, []byte) {
+	sigShares, multiSigner := createSigSharesBLS(grSize, grSize, message, 0)
+	for i := 0; i < len(sigShares); i++ {
+		err := multiSigner.StoreSignatureShare(uint16(i), sigShares[i])
+		require.Nil(b, err)
+	}
+
+	return multiSigner, sigShares, createBitmapForSigners(grSize)
+}
+
+// BenchmarkBLSMultiSigner_VerifySharesThenAggregate measures the path in which each signature share is verified
+// before being aggregated
+func BenchmarkBLSMultiSigner_VerifySharesThenAggregate(b *testing.B) {
+	message := []byte("message to be signed")
+	for _, grSize := range benchmarkConsensusGroupSizes {
+		multiSigner, sigShares, bitmap := createMultiSignerWithAllSigShares(b, grSize, message)
+
+		b.Run(fmt.Sprintf("consensus group size %d", grSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for index, sigShare := range sigShares {
+					err := multiSigner.VerifySignatureShare(uint16(index), sigShare, message, bitmap)
+					require.Nil(b, err)
+				}
+
+				_, err := multiSigner.AggregateSigs(bitmap)
+				require.Nil(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkBLSMultiSigner_AggregateThenVerify measures the optimistic path in which the signature shares are
+// aggregated first and only the aggregated signature is verified
+func BenchmarkBLSMultiSigner_AggregateThenVerify(b *testing.B) {
+	message := []byte("message to be signed")
+	for _, grSize := range benchmarkConsensusGroupSizes {
+		multiSigner, _, bitmap := createMultiSignerWithAllSigShares(b, grSize, message)
+
+		b.Run(fmt.Sprintf("consensus group size %d", grSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				aggSig, err := multiSigner.AggregateSigs(bitmap)
+				require.Nil(b, err)
+
+				err = multiSigner.SetAggregatedSig(aggSig)
+				require.Nil(b, err)
+
+				err = multiSigner.Verify(message, bitmap)
+				require.Nil(b, err)
+			}
+		})
+	}
+}
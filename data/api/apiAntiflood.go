@@ -0,0 +1,42 @@
+package api
+
+// FloodPreventerPeerQuota holds the counters of a peer, as gathered by a flood preventer in the current interval
+type FloodPreventerPeerQuota struct {
+	Pid           string `json:"pid"`
+	NumReceived   uint32 `json:"numReceived"`
+	SizeReceived  uint64 `json:"sizeReceived"`
+	NumProcessed  uint32 `json:"numProcessed"`
+	SizeProcessed uint64 `json:"sizeProcessed"`
+}
+
+// FloodPreventerStatus holds the limits and the per-peer counters of a quota flood preventer
+type FloodPreventerStatus struct {
+	Name                          string                     `json:"name"`
+	BaseMaxNumMessagesPerPeer     uint32                     `json:"baseMaxNumMessagesPerPeer"`
+	ComputedMaxNumMessagesPerPeer uint32                     `json:"computedMaxNumMessagesPerPeer"`
+	MaxTotalSizePerPeer           uint64                     `json:"maxTotalSizePerPeer"`
+	PercentReserved               float32                    `json:"percentReserved"`
+	Peers                         []*FloodPreventerPeerQuota `json:"peers"`
+}
+
+// TopicFloodPreventerStatus holds the limit and the number of messages received from each peer on a topic
+type TopicFloodPreventerStatus struct {
+	Topic              string            `json:"topic"`
+	MaxMessagesPerPeer uint32            `json:"maxMessagesPerPeer"`
+	NumMessagesPerPeer map[string]uint32 `json:"numMessagesPerPeer"`
+}
+
+// BlacklistedPeer holds a blacklisted peer along with the reason it was blacklisted for and the moment, as unix
+// timestamp, when the ban expires
+type BlacklistedPeer struct {
+	Pid       string `json:"pid"`
+	Reason    string `json:"reason"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// AntifloodStatus holds the current state of the input antiflood component
+type AntifloodStatus struct {
+	FloodPreventers  []*FloodPreventerStatus      `json:"floodPreventers"`
+	Topics           []*TopicFloodPreventerStatus `json:"topics"`
+	BlacklistedPeers []*BlacklistedPeer           `json:"blacklistedPeers"`
+}
@@ -0,0 +1,21 @@
+package api
+
+// ConsensusRoundMessage holds the arrival of a consensus message in a round
+type ConsensusRoundMessage struct {
+	PubKey      string `json:"pubKey"`
+	MessageType string `json:"messageType"`
+	ArrivalMs   int64  `json:"arrivalMs"`
+}
+
+// ConsensusRound holds what happened in a consensus round, as seen by the current node. The messages arrival
+// is expressed in milliseconds elapsed since the round start
+type ConsensusRound struct {
+	Round               int64                    `json:"round"`
+	StartTimestamp      int64                    `json:"startTimestamp"`
+	Leader              string                   `json:"leader"`
+	ConsensusGroupSize  int                      `json:"consensusGroupSize"`
+	NumSignatures       int                      `json:"numSignatures"`
+	SignaturesThreshold int                      `json:"signaturesThreshold"`
+	Outcome             string                   `json:"outcome"`
+	Messages            []*ConsensusRoundMessage `json:"messages"`
+}
@@ -0,0 +1,52 @@
+package api
+
+// EpochEconomics represents the economics values computed at the start of an epoch, as returned by api routes
+type EpochEconomics struct {
+	Epoch                         uint32 `json:"epoch"`
+	RewardsVersion                uint32 `json:"rewardsVersion"`
+	TotalSupply                   string `json:"totalSupply"`
+	TotalToDistribute             string `json:"totalToDistribute"`
+	Inflation                     string `json:"inflation"`
+	RewardsPerBlock               string `json:"rewardsPerBlock"`
+	NodePrice                     string `json:"nodePrice"`
+	AccumulatedFees               string `json:"accumulatedFees"`
+	DeveloperFees                 string `json:"developerFees"`
+	LeaderFees                    string `json:"leaderFees"`
+	BaseRewards                   string `json:"baseRewards"`
+	TopUpRewards                  string `json:"topUpRewards"`
+	ProtocolSustainabilityRewards string `json:"protocolSustainabilityRewards"`
+	NumberOfBlocks                uint64 `json:"numberOfBlocks"`
+}
+
+// NodeRewards represents the rewards computed for a node at the start of an epoch
+type NodeRewards struct {
+	BlsKey                     string `json:"blsKey"`
+	Shard                      uint32 `json:"shard"`
+	RewardAddress              string `json:"rewardAddress"`
+	BaseReward                 string `json:"baseReward"`
+	TopUpReward                string `json:"topUpReward"`
+	LeaderFees                 string `json:"leaderFees"`
+	TopUpStake                 string `json:"topUpStake"`
+	NumSelectedInSuccessBlocks uint32 `json:"numSelectedInSuccessBlocks"`
+	Rewarded                   bool   `json:"rewarded"`
+}
+
+// AddressRewards represents the rewards computed for a reward address (validator owner or delegation contract) at
+// the start of an epoch
+type AddressRewards struct {
+	Address              string `json:"address"`
+	BaseRewards          string `json:"baseRewards"`
+	TopUpRewards         string `json:"topUpRewards"`
+	LeaderFees           string `json:"leaderFees"`
+	TotalRewards         string `json:"totalRewards"`
+	NumNodes             uint32 `json:"numNodes"`
+	IsDelegationContract bool   `json:"isDelegationContract"`
+	Distributed          bool   `json:"distributed"`
+}
+
+// EpochRewards represents the per node and per reward address rewards computed at the start of an epoch
+type EpochRewards struct {
+	Epoch     uint32            `json:"epoch"`
+	Nodes     []*NodeRewards    `json:"nodes"`
+	Addresses []*AddressRewards `json:"addresses"`
+}
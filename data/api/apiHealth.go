@@ -0,0 +1,21 @@
+package api
+
+const (
+	// HealthStatusHealthy is the status of a check, or of a whole report, that found no issue
+	HealthStatusHealthy = "healthy"
+	// HealthStatusUnhealthy is the status of a failed check and of any report containing at least one failed check
+	HealthStatusUnhealthy = "unhealthy"
+)
+
+// HealthCheckResult holds the outcome of a single health check. The message describes the issue, if any
+type HealthCheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// HealthReport aggregates the results of all the health checks run for a liveness or a readiness probe
+type HealthReport struct {
+	Status string               `json:"status"`
+	Checks []*HealthCheckResult `json:"checks"`
+}
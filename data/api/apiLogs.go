@@ -0,0 +1,13 @@
+package api
+
+// LogEvent represents an event generated by a transaction, as returned by the logs search
+type LogEvent struct {
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	TxHash     string   `json:"txHash"`
+	LogAddress string   `json:"logAddress"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
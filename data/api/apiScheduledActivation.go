@@ -0,0 +1,11 @@
+package api
+
+// ScheduledActivation represents a protocol activation scheduled by an accepted governance proposal
+type ScheduledActivation struct {
+	Name            string `json:"name"`
+	Epoch           uint32 `json:"epoch"`
+	SoftwareVersion string `json:"softwareVersion"`
+	GitHubCommit    string `json:"gitHubCommit"`
+	IsHardFork      bool   `json:"isHardFork"`
+	Supported       bool   `json:"supported"`
+}
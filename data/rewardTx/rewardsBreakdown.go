@@ -0,0 +1,11 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. rewardsBreakdown.proto
+package rewardTx
+
+import (
+	"strconv"
+)
+
+// EpochRewardsBreakdownKey returns the storage key under which the rewards breakdown of the provided epoch is saved
+func EpochRewardsBreakdownKey(epoch uint32) []byte {
+	return []byte(strconv.FormatUint(uint64(epoch), 10))
+}
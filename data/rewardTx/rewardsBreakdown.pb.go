@@ -0,0 +1,2718 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardsBreakdown.proto
+
+package rewardTx
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NodeRewardsBreakdown holds the rewards computed for one eligible node at the end of an epoch
+type NodeRewardsBreakdown struct {
+	PubKey                     []byte        `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardID                    uint32        `protobuf:"varint,2,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	RewardAddress              []byte        `protobuf:"bytes,3,opt,name=RewardAddress,proto3" json:"RewardAddress,omitempty"`
+	BaseReward                 *math_big.Int `protobuf:"bytes,4,opt,name=BaseReward,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseReward,omitempty"`
+	TopUpReward                *math_big.Int `protobuf:"bytes,5,opt,name=TopUpReward,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TopUpReward,omitempty"`
+	LeaderFees                 *math_big.Int `protobuf:"bytes,6,opt,name=LeaderFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"LeaderFees,omitempty"`
+	TopUpStake                 *math_big.Int `protobuf:"bytes,7,opt,name=TopUpStake,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TopUpStake,omitempty"`
+	NumSelectedInSuccessBlocks uint32        `protobuf:"varint,8,opt,name=NumSelectedInSuccessBlocks,proto3" json:"NumSelectedInSuccessBlocks,omitempty"`
+	Rewarded                   bool          `protobuf:"varint,9,opt,name=Rewarded,proto3" json:"Rewarded,omitempty"`
+}
+
+func (m *NodeRewardsBreakdown) Reset()      { *m = NodeRewardsBreakdown{} }
+func (*NodeRewardsBreakdown) ProtoMessage() {}
+func (*NodeRewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17855dbf0640421b, []int{0}
+}
+func (m *NodeRewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeRewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeRewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRewardsBreakdown.Merge(m, src)
+}
+func (m *NodeRewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeRewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeRewardsBreakdown proto.InternalMessageInfo
+
+func (m *NodeRewardsBreakdown) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *NodeRewardsBreakdown) GetRewardAddress() []byte {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetBaseReward() *math_big.Int {
+	if m != nil {
+		return m.BaseReward
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetTopUpReward() *math_big.Int {
+	if m != nil {
+		return m.TopUpReward
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetLeaderFees() *math_big.Int {
+	if m != nil {
+		return m.LeaderFees
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetTopUpStake() *math_big.Int {
+	if m != nil {
+		return m.TopUpStake
+	}
+	return nil
+}
+
+func (m *NodeRewardsBreakdown) GetNumSelectedInSuccessBlocks() uint32 {
+	if m != nil {
+		return m.NumSelectedInSuccessBlocks
+	}
+	return 0
+}
+
+func (m *NodeRewardsBreakdown) GetRewarded() bool {
+	if m != nil {
+		return m.Rewarded
+	}
+	return false
+}
+
+// AddressRewardsBreakdown holds the rewards aggregated for one reward address (a validator owner or a delegation
+// contract) at the end of an epoch
+type AddressRewardsBreakdown struct {
+	Address              []byte        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	BaseRewards          *math_big.Int `protobuf:"bytes,2,opt,name=BaseRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseRewards,omitempty"`
+	TopUpRewards         *math_big.Int `protobuf:"bytes,3,opt,name=TopUpRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TopUpRewards,omitempty"`
+	LeaderFees           *math_big.Int `protobuf:"bytes,4,opt,name=LeaderFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"LeaderFees,omitempty"`
+	TotalRewards         *math_big.Int `protobuf:"bytes,5,opt,name=TotalRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalRewards,omitempty"`
+	NumNodes             uint32        `protobuf:"varint,6,opt,name=NumNodes,proto3" json:"NumNodes,omitempty"`
+	IsDelegationContract bool          `protobuf:"varint,7,opt,name=IsDelegationContract,proto3" json:"IsDelegationContract,omitempty"`
+	Distributed          bool          `protobuf:"varint,8,opt,name=Distributed,proto3" json:"Distributed,omitempty"`
+}
+
+func (m *AddressRewardsBreakdown) Reset()      { *m = AddressRewardsBreakdown{} }
+func (*AddressRewardsBreakdown) ProtoMessage() {}
+func (*AddressRewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17855dbf0640421b, []int{1}
+}
+func (m *AddressRewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressRewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRewardsBreakdown.Merge(m, src)
+}
+func (m *AddressRewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRewardsBreakdown proto.InternalMessageInfo
+
+func (m *AddressRewardsBreakdown) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AddressRewardsBreakdown) GetBaseRewards() *math_big.Int {
+	if m != nil {
+		return m.BaseRewards
+	}
+	return nil
+}
+
+func (m *AddressRewardsBreakdown) GetTopUpRewards() *math_big.Int {
+	if m != nil {
+		return m.TopUpRewards
+	}
+	return nil
+}
+
+func (m *AddressRewardsBreakdown) GetLeaderFees() *math_big.Int {
+	if m != nil {
+		return m.LeaderFees
+	}
+	return nil
+}
+
+func (m *AddressRewardsBreakdown) GetTotalRewards() *math_big.Int {
+	if m != nil {
+		return m.TotalRewards
+	}
+	return nil
+}
+
+func (m *AddressRewardsBreakdown) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *AddressRewardsBreakdown) GetIsDelegationContract() bool {
+	if m != nil {
+		return m.IsDelegationContract
+	}
+	return false
+}
+
+func (m *AddressRewardsBreakdown) GetDistributed() bool {
+	if m != nil {
+		return m.Distributed
+	}
+	return false
+}
+
+// EpochRewardsBreakdown holds the economics values and the rewards computed at the start of an epoch
+type EpochRewardsBreakdown struct {
+	Epoch                         uint32                     `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	RewardsVersion                uint32                     `protobuf:"varint,2,opt,name=RewardsVersion,proto3" json:"RewardsVersion,omitempty"`
+	TotalSupply                   *math_big.Int              `protobuf:"bytes,3,opt,name=TotalSupply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalSupply,omitempty"`
+	TotalToDistribute             *math_big.Int              `protobuf:"bytes,4,opt,name=TotalToDistribute,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalToDistribute,omitempty"`
+	TotalNewlyMinted              *math_big.Int              `protobuf:"bytes,5,opt,name=TotalNewlyMinted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalNewlyMinted,omitempty"`
+	RewardsPerBlock               *math_big.Int              `protobuf:"bytes,6,opt,name=RewardsPerBlock,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"RewardsPerBlock,omitempty"`
+	NodePrice                     *math_big.Int              `protobuf:"bytes,7,opt,name=NodePrice,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"NodePrice,omitempty"`
+	AccumulatedFees               *math_big.Int              `protobuf:"bytes,8,opt,name=AccumulatedFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"AccumulatedFees,omitempty"`
+	DeveloperFees                 *math_big.Int              `protobuf:"bytes,9,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
+	LeaderFees                    *math_big.Int              `protobuf:"bytes,10,opt,name=LeaderFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"LeaderFees,omitempty"`
+	BaseRewards                   *math_big.Int              `protobuf:"bytes,11,opt,name=BaseRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseRewards,omitempty"`
+	TopUpRewards                  *math_big.Int              `protobuf:"bytes,12,opt,name=TopUpRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TopUpRewards,omitempty"`
+	ProtocolSustainabilityRewards *math_big.Int              `protobuf:"bytes,13,opt,name=ProtocolSustainabilityRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ProtocolSustainabilityRewards,omitempty"`
+	NumberOfBlocks                uint64                     `protobuf:"varint,14,opt,name=NumberOfBlocks,proto3" json:"NumberOfBlocks,omitempty"`
+	Nodes                         []*NodeRewardsBreakdown    `protobuf:"bytes,15,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Addresses                     []*AddressRewardsBreakdown `protobuf:"bytes,16,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *EpochRewardsBreakdown) Reset()      { *m = EpochRewardsBreakdown{} }
+func (*EpochRewardsBreakdown) ProtoMessage() {}
+func (*EpochRewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17855dbf0640421b, []int{2}
+}
+func (m *EpochRewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EpochRewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRewardsBreakdown.Merge(m, src)
+}
+func (m *EpochRewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRewardsBreakdown proto.InternalMessageInfo
+
+func (m *EpochRewardsBreakdown) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRewardsBreakdown) GetRewardsVersion() uint32 {
+	if m != nil {
+		return m.RewardsVersion
+	}
+	return 0
+}
+
+func (m *EpochRewardsBreakdown) GetTotalSupply() *math_big.Int {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetTotalToDistribute() *math_big.Int {
+	if m != nil {
+		return m.TotalToDistribute
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetTotalNewlyMinted() *math_big.Int {
+	if m != nil {
+		return m.TotalNewlyMinted
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetRewardsPerBlock() *math_big.Int {
+	if m != nil {
+		return m.RewardsPerBlock
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetNodePrice() *math_big.Int {
+	if m != nil {
+		return m.NodePrice
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetAccumulatedFees() *math_big.Int {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetDeveloperFees() *math_big.Int {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetLeaderFees() *math_big.Int {
+	if m != nil {
+		return m.LeaderFees
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetBaseRewards() *math_big.Int {
+	if m != nil {
+		return m.BaseRewards
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetTopUpRewards() *math_big.Int {
+	if m != nil {
+		return m.TopUpRewards
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetProtocolSustainabilityRewards() *math_big.Int {
+	if m != nil {
+		return m.ProtocolSustainabilityRewards
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetNumberOfBlocks() uint64 {
+	if m != nil {
+		return m.NumberOfBlocks
+	}
+	return 0
+}
+
+func (m *EpochRewardsBreakdown) GetNodes() []*NodeRewardsBreakdown {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *EpochRewardsBreakdown) GetAddresses() []*AddressRewardsBreakdown {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*NodeRewardsBreakdown)(nil), "protoRewardTx.NodeRewardsBreakdown")
+	proto.RegisterType((*AddressRewardsBreakdown)(nil), "protoRewardTx.AddressRewardsBreakdown")
+	proto.RegisterType((*EpochRewardsBreakdown)(nil), "protoRewardTx.EpochRewardsBreakdown")
+}
+
+func init() { proto.RegisterFile("rewardsBreakdown.proto", fileDescriptor_17855dbf0640421b) }
+
+var fileDescriptor_17855dbf0640421b = []byte{
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x21, 0x81, 0x64, 0x42, 0x80, 0x37, 0xe2, 0xf1, 0x2c, 0xa4, 0xe7, 0x17, 0xf1, 0x2a,
+	0x94, 0x0d, 0x89, 0x44, 0x57, 0x55, 0xa5, 0x4a, 0x84, 0x50, 0x29, 0x6a, 0x9b, 0x46, 0x0e, 0xed,
+	0xa2, 0xbb, 0xb1, 0x7d, 0x71, 0xac, 0x38, 0x9e, 0x74, 0x66, 0xdc, 0x34, 0xbb, 0xee, 0xbb, 0xe9,
+	0x67, 0x54, 0xfd, 0x92, 0x4a, 0xed, 0x82, 0x25, 0xbb, 0x16, 0xb3, 0x61, 0xc9, 0x27, 0x54, 0x1e,
+	0x3b, 0xc4, 0x71, 0x28, 0x2b, 0xd3, 0x55, 0x72, 0x67, 0x7c, 0xcf, 0xd1, 0x99, 0x7b, 0x7c, 0xc6,
+	0x68, 0x9b, 0xc1, 0x98, 0x30, 0x8b, 0x37, 0x19, 0x90, 0x81, 0x45, 0xc7, 0x5e, 0x7d, 0xc4, 0xa8,
+	0xa0, 0xb8, 0x22, 0x7f, 0x74, 0xb9, 0x79, 0xf2, 0x7e, 0x67, 0xdf, 0x76, 0x44, 0xdf, 0x37, 0xea,
+	0x26, 0x1d, 0x36, 0x6c, 0x6a, 0xd3, 0x86, 0xdc, 0x36, 0xfc, 0x53, 0x59, 0xc9, 0x42, 0xfe, 0x8b,
+	0xba, 0x77, 0xaf, 0xf2, 0x68, 0xab, 0x43, 0x2d, 0xd0, 0x53, 0xe0, 0x78, 0x1b, 0xad, 0x74, 0x7d,
+	0xe3, 0x19, 0x4c, 0x54, 0xa5, 0xaa, 0xd4, 0xd6, 0xf4, 0xb8, 0xc2, 0x2a, 0x5a, 0xed, 0xf5, 0x09,
+	0xb3, 0xda, 0x2d, 0x75, 0xa9, 0xaa, 0xd4, 0x2a, 0xfa, 0xb4, 0xc4, 0x0f, 0x50, 0x25, 0x42, 0x39,
+	0xb4, 0x2c, 0x06, 0x9c, 0xab, 0xcb, 0xb2, 0x71, 0x7e, 0x11, 0x03, 0x42, 0x4d, 0xc2, 0x63, 0x3e,
+	0x35, 0x1f, 0x3e, 0xd2, 0x3c, 0xfe, 0xf2, 0xe3, 0xbf, 0xc3, 0x21, 0x11, 0xfd, 0x86, 0xe1, 0xd8,
+	0xf5, 0xb6, 0x27, 0x1e, 0x27, 0x44, 0x1c, 0xbb, 0x8c, 0x7a, 0x56, 0x07, 0xc4, 0x98, 0xb2, 0x41,
+	0x03, 0x64, 0xb5, 0x6f, 0xd3, 0x86, 0x45, 0x04, 0xa9, 0x37, 0x1d, 0xbb, 0xed, 0x89, 0x23, 0xc2,
+	0x05, 0x30, 0x3d, 0x01, 0x8c, 0x6d, 0x54, 0x3e, 0xa1, 0xa3, 0x57, 0xa3, 0x98, 0xa7, 0x90, 0x25,
+	0x4f, 0x12, 0x39, 0xd4, 0xf3, 0x1c, 0x88, 0x05, 0xec, 0x29, 0x00, 0x57, 0x57, 0x32, 0xd5, 0x33,
+	0x03, 0x0e, 0x69, 0x24, 0x6b, 0x4f, 0x90, 0x01, 0xa8, 0xab, 0x99, 0xd2, 0xcc, 0x80, 0xf1, 0x13,
+	0xb4, 0xd3, 0xf1, 0x87, 0x3d, 0x70, 0xc1, 0x14, 0x60, 0xb5, 0xbd, 0x9e, 0x6f, 0x9a, 0xc0, 0x79,
+	0xd3, 0xa5, 0xe6, 0x80, 0xab, 0x45, 0x39, 0xf0, 0x3b, 0x9e, 0xc0, 0x3b, 0xa8, 0x18, 0x9d, 0x0b,
+	0x58, 0x6a, 0xa9, 0xaa, 0xd4, 0x8a, 0xfa, 0x4d, 0xbd, 0xfb, 0x2d, 0x8f, 0xfe, 0x89, 0x5d, 0xb0,
+	0xe0, 0x36, 0x15, 0xad, 0x4e, 0x5d, 0x13, 0xd9, 0x6d, 0x5a, 0x86, 0x83, 0x9c, 0x8d, 0x95, 0xab,
+	0x4b, 0x59, 0x2a, 0x4f, 0x22, 0x63, 0x07, 0xad, 0x25, 0xe6, 0x1a, 0xbb, 0x37, 0x2b, 0xa6, 0x39,
+	0xe8, 0x94, 0x67, 0xf2, 0xf7, 0xe5, 0x19, 0xa9, 0x48, 0x10, 0x77, 0xaa, 0xa8, 0x90, 0xb1, 0xa2,
+	0x19, 0x74, 0x38, 0xf7, 0x8e, 0x3f, 0x0c, 0x83, 0x24, 0x7a, 0x07, 0x2a, 0xfa, 0x4d, 0x8d, 0x0f,
+	0xd0, 0x56, 0x9b, 0xb7, 0xc0, 0x05, 0x9b, 0x08, 0x87, 0x7a, 0x47, 0xd4, 0x13, 0x8c, 0x98, 0x42,
+	0x9a, 0xb8, 0xa8, 0xdf, 0xba, 0x87, 0xab, 0xa8, 0xdc, 0x72, 0xb8, 0x60, 0x8e, 0xe1, 0x0b, 0xb0,
+	0xa4, 0xf1, 0x8a, 0x7a, 0x72, 0x69, 0xf7, 0x7b, 0x19, 0xfd, 0x7d, 0x3c, 0xa2, 0x66, 0x7f, 0xc1,
+	0x4b, 0x5b, 0xa8, 0x20, 0x37, 0xa4, 0x93, 0x2a, 0x7a, 0x54, 0xe0, 0x3d, 0xb4, 0x1e, 0x3f, 0xf9,
+	0x1a, 0x18, 0x77, 0xa8, 0x17, 0xc7, 0x57, 0x6a, 0x35, 0x0a, 0x0e, 0x41, 0xdc, 0x9e, 0x3f, 0x1a,
+	0xb9, 0x93, 0x6c, 0x5d, 0x90, 0x44, 0xc6, 0x1c, 0xfd, 0x25, 0xcb, 0x13, 0x3a, 0x93, 0x95, 0xad,
+	0x17, 0x16, 0xf1, 0xf1, 0x5b, 0xb4, 0x29, 0x17, 0x3b, 0x30, 0x76, 0x27, 0x2f, 0x1c, 0x2f, 0x3c,
+	0xdc, 0x4c, 0x6d, 0xb1, 0x00, 0x8f, 0x29, 0xda, 0x88, 0x8f, 0xb8, 0x0b, 0x4c, 0xc6, 0x44, 0xb6,
+	0x29, 0x99, 0x46, 0xc7, 0x26, 0x2a, 0x85, 0xc6, 0xeb, 0x32, 0xc7, 0xcc, 0x38, 0x29, 0x67, 0xb8,
+	0xa1, 0xaa, 0x43, 0xd3, 0xf4, 0x87, 0xbe, 0x4b, 0x04, 0x58, 0xf2, 0x3d, 0x2e, 0x66, 0xaa, 0x2a,
+	0x85, 0x8e, 0x07, 0xa8, 0xd2, 0x82, 0x77, 0xe0, 0xd2, 0x51, 0x1c, 0x1b, 0xa5, 0x2c, 0xe9, 0xe6,
+	0xb1, 0x53, 0x01, 0x85, 0xee, 0x2b, 0xa0, 0x52, 0xd9, 0x5e, 0xfe, 0x63, 0xd9, 0xbe, 0x76, 0x7f,
+	0xd9, 0xfe, 0x51, 0x41, 0xff, 0x76, 0x19, 0x15, 0xd4, 0xa4, 0x6e, 0xcf, 0xe7, 0x82, 0x38, 0x1e,
+	0x31, 0x1c, 0xd7, 0x11, 0x93, 0x29, 0x79, 0x25, 0x4b, 0xf2, 0xbb, 0xb9, 0xc2, 0xd4, 0xeb, 0xf8,
+	0x43, 0x03, 0xd8, 0xcb, 0xd3, 0xf8, 0x0e, 0x5f, 0xaf, 0x2a, 0xb5, 0xbc, 0x9e, 0x5a, 0xc5, 0x8f,
+	0x50, 0x21, 0x0a, 0xef, 0x8d, 0xea, 0x72, 0xad, 0x7c, 0xf0, 0x7f, 0x7d, 0xee, 0xa3, 0xb2, 0x7e,
+	0xdb, 0x17, 0xa2, 0x1e, 0x75, 0xe0, 0x16, 0x2a, 0xc5, 0x77, 0x35, 0x70, 0x75, 0x53, 0xb6, 0xef,
+	0xa5, 0xda, 0x7f, 0x73, 0xeb, 0xeb, 0xb3, 0xc6, 0x66, 0xf3, 0xec, 0x42, 0xcb, 0x9d, 0x5f, 0x68,
+	0xb9, 0xeb, 0x0b, 0x4d, 0xf9, 0x10, 0x68, 0xca, 0xe7, 0x40, 0x53, 0xbe, 0x06, 0x9a, 0x72, 0x16,
+	0x68, 0xca, 0x79, 0xa0, 0x29, 0x3f, 0x03, 0x4d, 0xb9, 0x0a, 0xb4, 0xdc, 0x75, 0xa0, 0x29, 0x9f,
+	0x2e, 0xb5, 0xdc, 0xd9, 0xa5, 0x96, 0x3b, 0xbf, 0xd4, 0x72, 0x6f, 0x8a, 0x2c, 0xa6, 0x31, 0x56,
+	0x24, 0xeb, 0xc3, 0x5f, 0x03, 0x00, 0x99, 0x12, 0xb9, 0x97, 0x2a, 0x0b, 0x00, 0x00,
+}
+
+func (this *NodeRewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodeRewardsBreakdown)
+	if !ok {
+		that2, ok := that.(NodeRewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if !bytes.Equal(this.RewardAddress, that1.RewardAddress) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.BaseReward, that1.BaseReward) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpReward, that1.TopUpReward) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.LeaderFees, that1.LeaderFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpStake, that1.TopUpStake) {
+			return false
+		}
+	}
+	if this.NumSelectedInSuccessBlocks != that1.NumSelectedInSuccessBlocks {
+		return false
+	}
+	if this.Rewarded != that1.Rewarded {
+		return false
+	}
+	return true
+}
+func (this *AddressRewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressRewardsBreakdown)
+	if !ok {
+		that2, ok := that.(AddressRewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.BaseRewards, that1.BaseRewards) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpRewards, that1.TopUpRewards) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.LeaderFees, that1.LeaderFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalRewards, that1.TotalRewards) {
+			return false
+		}
+	}
+	if this.NumNodes != that1.NumNodes {
+		return false
+	}
+	if this.IsDelegationContract != that1.IsDelegationContract {
+		return false
+	}
+	if this.Distributed != that1.Distributed {
+		return false
+	}
+	return true
+}
+func (this *EpochRewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochRewardsBreakdown)
+	if !ok {
+		that2, ok := that.(EpochRewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.RewardsVersion != that1.RewardsVersion {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalSupply, that1.TotalSupply) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalToDistribute, that1.TotalToDistribute) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalNewlyMinted, that1.TotalNewlyMinted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.RewardsPerBlock, that1.RewardsPerBlock) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.NodePrice, that1.NodePrice) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.AccumulatedFees, that1.AccumulatedFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.DeveloperFees, that1.DeveloperFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.LeaderFees, that1.LeaderFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.BaseRewards, that1.BaseRewards) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TopUpRewards, that1.TopUpRewards) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.ProtocolSustainabilityRewards, that1.ProtocolSustainabilityRewards) {
+			return false
+		}
+	}
+	if this.NumberOfBlocks != that1.NumberOfBlocks {
+		return false
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !this.Nodes[i].Equal(that1.Nodes[i]) {
+			return false
+		}
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !this.Addresses[i].Equal(that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NodeRewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&rewardTx.NodeRewardsBreakdown{")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "RewardAddress: "+fmt.Sprintf("%#v", this.RewardAddress)+",\n")
+	s = append(s, "BaseReward: "+fmt.Sprintf("%#v", this.BaseReward)+",\n")
+	s = append(s, "TopUpReward: "+fmt.Sprintf("%#v", this.TopUpReward)+",\n")
+	s = append(s, "LeaderFees: "+fmt.Sprintf("%#v", this.LeaderFees)+",\n")
+	s = append(s, "TopUpStake: "+fmt.Sprintf("%#v", this.TopUpStake)+",\n")
+	s = append(s, "NumSelectedInSuccessBlocks: "+fmt.Sprintf("%#v", this.NumSelectedInSuccessBlocks)+",\n")
+	s = append(s, "Rewarded: "+fmt.Sprintf("%#v", this.Rewarded)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressRewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&rewardTx.AddressRewardsBreakdown{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "BaseRewards: "+fmt.Sprintf("%#v", this.BaseRewards)+",\n")
+	s = append(s, "TopUpRewards: "+fmt.Sprintf("%#v", this.TopUpRewards)+",\n")
+	s = append(s, "LeaderFees: "+fmt.Sprintf("%#v", this.LeaderFees)+",\n")
+	s = append(s, "TotalRewards: "+fmt.Sprintf("%#v", this.TotalRewards)+",\n")
+	s = append(s, "NumNodes: "+fmt.Sprintf("%#v", this.NumNodes)+",\n")
+	s = append(s, "IsDelegationContract: "+fmt.Sprintf("%#v", this.IsDelegationContract)+",\n")
+	s = append(s, "Distributed: "+fmt.Sprintf("%#v", this.Distributed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochRewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&rewardTx.EpochRewardsBreakdown{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "RewardsVersion: "+fmt.Sprintf("%#v", this.RewardsVersion)+",\n")
+	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
+	s = append(s, "TotalToDistribute: "+fmt.Sprintf("%#v", this.TotalToDistribute)+",\n")
+	s = append(s, "TotalNewlyMinted: "+fmt.Sprintf("%#v", this.TotalNewlyMinted)+",\n")
+	s = append(s, "RewardsPerBlock: "+fmt.Sprintf("%#v", this.RewardsPerBlock)+",\n")
+	s = append(s, "NodePrice: "+fmt.Sprintf("%#v", this.NodePrice)+",\n")
+	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "LeaderFees: "+fmt.Sprintf("%#v", this.LeaderFees)+",\n")
+	s = append(s, "BaseRewards: "+fmt.Sprintf("%#v", this.BaseRewards)+",\n")
+	s = append(s, "TopUpRewards: "+fmt.Sprintf("%#v", this.TopUpRewards)+",\n")
+	s = append(s, "ProtocolSustainabilityRewards: "+fmt.Sprintf("%#v", this.ProtocolSustainabilityRewards)+",\n")
+	s = append(s, "NumberOfBlocks: "+fmt.Sprintf("%#v", this.NumberOfBlocks)+",\n")
+	if this.Nodes != nil {
+		s = append(s, "Nodes: "+fmt.Sprintf("%#v", this.Nodes)+",\n")
+	}
+	if this.Addresses != nil {
+		s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRewardsBreakdown(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *NodeRewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeRewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeRewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rewarded {
+		i--
+		if m.Rewarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NumSelectedInSuccessBlocks != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.NumSelectedInSuccessBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpStake)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpStake, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.LeaderFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.LeaderFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpReward)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpReward, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.BaseReward)
+		i -= size
+		if _, err := __caster.MarshalTo(m.BaseReward, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressRewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressRewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressRewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Distributed {
+		i--
+		if m.Distributed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsDelegationContract {
+		i--
+		if m.IsDelegationContract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumNodes != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.NumNodes))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.LeaderFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.LeaderFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.BaseRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.BaseRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochRewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NumberOfBlocks != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.NumberOfBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.ProtocolSustainabilityRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.ProtocolSustainabilityRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TopUpRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TopUpRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.BaseRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.BaseRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.LeaderFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.LeaderFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.DeveloperFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.DeveloperFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.AccumulatedFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.AccumulatedFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.NodePrice)
+		i -= size
+		if _, err := __caster.MarshalTo(m.NodePrice, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.RewardsPerBlock)
+		i -= size
+		if _, err := __caster.MarshalTo(m.RewardsPerBlock, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalNewlyMinted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalNewlyMinted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalToDistribute)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalToDistribute, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalSupply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalSupply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RewardsVersion != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.RewardsVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRewardsBreakdown(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardsBreakdown(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardsBreakdown(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NodeRewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.ShardID))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.BaseReward)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpReward)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.LeaderFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpStake)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.NumSelectedInSuccessBlocks != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.NumSelectedInSuccessBlocks))
+	}
+	if m.Rewarded {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddressRewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.BaseRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.LeaderFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.NumNodes != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.NumNodes))
+	}
+	if m.IsDelegationContract {
+		n += 2
+	}
+	if m.Distributed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EpochRewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.Epoch))
+	}
+	if m.RewardsVersion != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.RewardsVersion))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalSupply)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalToDistribute)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalNewlyMinted)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.RewardsPerBlock)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.NodePrice)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.AccumulatedFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.DeveloperFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.LeaderFees)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.BaseRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TopUpRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.ProtocolSustainabilityRewards)
+		n += 1 + l + sovRewardsBreakdown(uint64(l))
+	}
+	if m.NumberOfBlocks != 0 {
+		n += 1 + sovRewardsBreakdown(uint64(m.NumberOfBlocks))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovRewardsBreakdown(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 2 + l + sovRewardsBreakdown(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRewardsBreakdown(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardsBreakdown(x uint64) (n int) {
+	return sovRewardsBreakdown(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *NodeRewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeRewardsBreakdown{`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`RewardAddress:` + fmt.Sprintf("%v", this.RewardAddress) + `,`,
+		`BaseReward:` + fmt.Sprintf("%v", this.BaseReward) + `,`,
+		`TopUpReward:` + fmt.Sprintf("%v", this.TopUpReward) + `,`,
+		`LeaderFees:` + fmt.Sprintf("%v", this.LeaderFees) + `,`,
+		`TopUpStake:` + fmt.Sprintf("%v", this.TopUpStake) + `,`,
+		`NumSelectedInSuccessBlocks:` + fmt.Sprintf("%v", this.NumSelectedInSuccessBlocks) + `,`,
+		`Rewarded:` + fmt.Sprintf("%v", this.Rewarded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressRewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressRewardsBreakdown{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`BaseRewards:` + fmt.Sprintf("%v", this.BaseRewards) + `,`,
+		`TopUpRewards:` + fmt.Sprintf("%v", this.TopUpRewards) + `,`,
+		`LeaderFees:` + fmt.Sprintf("%v", this.LeaderFees) + `,`,
+		`TotalRewards:` + fmt.Sprintf("%v", this.TotalRewards) + `,`,
+		`NumNodes:` + fmt.Sprintf("%v", this.NumNodes) + `,`,
+		`IsDelegationContract:` + fmt.Sprintf("%v", this.IsDelegationContract) + `,`,
+		`Distributed:` + fmt.Sprintf("%v", this.Distributed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochRewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodes := "[]*NodeRewardsBreakdown{"
+	for _, f := range this.Nodes {
+		repeatedStringForNodes += strings.Replace(f.String(), "NodeRewardsBreakdown", "NodeRewardsBreakdown", 1) + ","
+	}
+	repeatedStringForNodes += "}"
+	repeatedStringForAddresses := "[]*AddressRewardsBreakdown{"
+	for _, f := range this.Addresses {
+		repeatedStringForAddresses += strings.Replace(f.String(), "AddressRewardsBreakdown", "AddressRewardsBreakdown", 1) + ","
+	}
+	repeatedStringForAddresses += "}"
+	s := strings.Join([]string{`&EpochRewardsBreakdown{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`RewardsVersion:` + fmt.Sprintf("%v", this.RewardsVersion) + `,`,
+		`TotalSupply:` + fmt.Sprintf("%v", this.TotalSupply) + `,`,
+		`TotalToDistribute:` + fmt.Sprintf("%v", this.TotalToDistribute) + `,`,
+		`TotalNewlyMinted:` + fmt.Sprintf("%v", this.TotalNewlyMinted) + `,`,
+		`RewardsPerBlock:` + fmt.Sprintf("%v", this.RewardsPerBlock) + `,`,
+		`NodePrice:` + fmt.Sprintf("%v", this.NodePrice) + `,`,
+		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`LeaderFees:` + fmt.Sprintf("%v", this.LeaderFees) + `,`,
+		`BaseRewards:` + fmt.Sprintf("%v", this.BaseRewards) + `,`,
+		`TopUpRewards:` + fmt.Sprintf("%v", this.TopUpRewards) + `,`,
+		`ProtocolSustainabilityRewards:` + fmt.Sprintf("%v", this.ProtocolSustainabilityRewards) + `,`,
+		`NumberOfBlocks:` + fmt.Sprintf("%v", this.NumberOfBlocks) + `,`,
+		`Nodes:` + repeatedStringForNodes + `,`,
+		`Addresses:` + repeatedStringForAddresses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRewardsBreakdown(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *NodeRewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeRewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeRewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = append(m.RewardAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.RewardAddress == nil {
+				m.RewardAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReward", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.BaseReward = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpReward", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpReward = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.LeaderFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpStake", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpStake = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSelectedInSuccessBlocks", wireType)
+			}
+			m.NumSelectedInSuccessBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSelectedInSuccessBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewarded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rewarded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.BaseRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.LeaderFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDelegationContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDelegationContract = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Distributed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochRewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsVersion", wireType)
+			}
+			m.RewardsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalSupply = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalToDistribute", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalToDistribute = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNewlyMinted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalNewlyMinted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerBlock", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.RewardsPerBlock = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePrice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.NodePrice = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.AccumulatedFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.DeveloperFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.LeaderFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.BaseRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TopUpRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolSustainabilityRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.ProtocolSustainabilityRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfBlocks", wireType)
+			}
+			m.NumberOfBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeRewardsBreakdown{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, &AddressRewardsBreakdown{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardsBreakdown(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardsBreakdown
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardsBreakdown
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardsBreakdown
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardsBreakdown        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardsBreakdown          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardsBreakdown = fmt.Errorf("proto: unexpected end of group")
+)
@@ -42,6 +42,7 @@ type ApiTransactionResult struct {
 	MiniBlockHash                     string                    `json:"miniblockHash,omitempty"`
 	Receipt                           *ReceiptApi               `json:"receipt,omitempty"`
 	SmartContractResults              []*ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	Logs                              *ApiLogs                  `json:"logs,omitempty"`
 	Status                            TxStatus                  `json:"status,omitempty"`
 }
 
@@ -73,6 +74,21 @@ type ApiSmartContractResult struct {
 	CodeMetadata   string            `json:"codeMetadata,omitempty"`
 	ReturnMessage  string            `json:"returnMessage,omitempty"`
 	OriginalSender string            `json:"originalSender,omitempty"`
+	Logs           *ApiLogs          `json:"logs,omitempty"`
+}
+
+// ApiLogs represents the logs generated by a transaction with changed fields' types in order to make it friendly for API's json
+type ApiLogs struct {
+	Address string    `json:"address"`
+	Events  []*Events `json:"events"`
+}
+
+// Events represents the events of a transaction log with changed fields' types in order to make it friendly for API's json
+type Events struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
 }
 
 // ReceiptApi represents a receipt with changed fields' types in order to make it friendly for API's json
@@ -2,6 +2,7 @@
 package transaction
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -23,3 +24,22 @@ func (l *Log) IsInterfaceNil() bool {
 func (e *Event) IsInterfaceNil() bool {
 	return e == nil
 }
+
+// ToApiLogs converts the log in a structure which is friendly for API's json, encoding the addresses with the
+// provided converter
+func (l *Log) ToApiLogs(pubkeyConverter core.PubkeyConverter) *ApiLogs {
+	apiLogs := &ApiLogs{
+		Address: pubkeyConverter.Encode(l.Address),
+		Events:  make([]*Events, 0, len(l.Events)),
+	}
+	for _, event := range l.Events {
+		apiLogs.Events = append(apiLogs.Events, &Events{
+			Address:    pubkeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return apiLogs
+}
@@ -1,9 +1,11 @@
 package transaction_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/stretchr/testify/require"
 )
@@ -39,3 +41,35 @@ func TestLog_GetEvents(t *testing.T) {
 	require.Equal(t, len(events), len(logEvents))
 	require.Equal(t, evIdentifier, logEvents[0].GetIdentifier())
 }
+
+func TestLog_ToApiLogs(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(4)
+	log := &transaction.Log{
+		Address: []byte("addr"),
+		Events: []*transaction.Event{
+			{
+				Address:    []byte("evad"),
+				Identifier: []byte("transfer"),
+				Topics:     [][]byte{[]byte("topic")},
+				Data:       []byte("data"),
+			},
+		},
+	}
+
+	apiLogs := log.ToApiLogs(converter)
+
+	expectedApiLogs := &transaction.ApiLogs{
+		Address: hex.EncodeToString([]byte("addr")),
+		Events: []*transaction.Events{
+			{
+				Address:    hex.EncodeToString([]byte("evad")),
+				Identifier: "transfer",
+				Topics:     [][]byte{[]byte("topic")},
+				Data:       []byte("data"),
+			},
+		},
+	}
+	require.Equal(t, expectedApiLogs, apiLogs)
+}
@@ -99,3 +99,6 @@ var ErrInvalidTimeout = errors.New("invalid timeout value")
 
 // ErrInvalidMaxHardCapForMissingNodes signals that the maximum hardcap value for missing nodes is invalid
 var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for missing nodes")
+
+// ErrInvalidProof signals that the provided Merkle proof could not be verified against the given root hash
+var ErrInvalidProof = errors.New("invalid Merkle proof")
@@ -619,7 +619,8 @@ func logMapWithTrace(message string, paramName string, hashes data.ModifiedHashe
 	}
 }
 
-// GetProof computes a Merkle proof for the node that is present at the given key
+// GetProof computes a Merkle proof for the node that is present at the given key. If the key is missing, the returned
+// proof holds the path down to the node that shows the key is not in the trie
 func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
 	tr.mutOperation.Lock()
 	defer tr.mutOperation.Unlock()
@@ -645,6 +646,9 @@ func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
 		proof = append(proof, encodedNode)
 
 		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage.Database())
+		if err == ErrNodeNotFound {
+			return proof, nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,103 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. proof.proto
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// VerifyProofAndGetValue verifies the Merkle proof for the given key against the provided root hash, without
+// needing the trie, and returns the value stored in the proved leaf. A nil value with no error means the proof shows
+// that the key is not in the trie
+func VerifyProofAndGetValue(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	wantHash := rootHash
+	hexKey := keyBytesToHex(key)
+	for _, encodedNode := range proof {
+		if !bytes.Equal(wantHash, hasher.Compute(string(encodedNode))) {
+			return nil, ErrInvalidProof
+		}
+
+		n, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		switch currentNode := n.(type) {
+		case *leafNode:
+			if !bytes.Equal(hexKey, currentNode.Key) {
+				return nil, nil
+			}
+
+			return currentNode.Value, nil
+		case *extensionNode:
+			if !bytes.HasPrefix(hexKey, currentNode.Key) {
+				return nil, nil
+			}
+
+			wantHash = currentNode.EncodedChild
+			hexKey = hexKey[len(currentNode.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[0]) >= len(currentNode.EncodedChildren) {
+				return nil, ErrInvalidProof
+			}
+
+			wantHash = currentNode.EncodedChildren[hexKey[0]]
+			if len(wantHash) == 0 {
+				return nil, nil
+			}
+			hexKey = hexKey[1:]
+		default:
+			return nil, ErrInvalidProof
+		}
+	}
+
+	return nil, ErrInvalidProof
+}
+
+type proofProvider struct {
+	trie data.Trie
+}
+
+// NewProofProvider creates a component able to compute Merkle proofs for any root hash still available in the
+// storage of the provided trie
+func NewProofProvider(tr data.Trie) (*proofProvider, error) {
+	if check.IfNil(tr) {
+		return nil, ErrNilTrie
+	}
+
+	return &proofProvider{
+		trie: tr,
+	}, nil
+}
+
+// GetProof returns the Merkle proof for the given key in the trie identified by the provided root hash
+func (pp *proofProvider) GetProof(rootHash []byte, key []byte) ([][]byte, error) {
+	tr, err := pp.trie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.GetProof(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *proofProvider) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,125 @@
+package trie_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyProofAndGetValue_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	value, err := trie.VerifyProofAndGetValue([]byte("root"), []byte("key"), nil, nil, &mock.KeccakMock{})
+
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+}
+
+func TestVerifyProofAndGetValue_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	for key, expectedValue := range map[string]string{"doe": "reindeer", "dog": "puppy", "ddog": "cat"} {
+		proof, err := tr.GetProof([]byte(key))
+		require.Nil(t, err)
+
+		value, err := trie.VerifyProofAndGetValue(rootHash, []byte(key), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(expectedValue), value)
+	}
+}
+
+func TestVerifyProofAndGetValue_MissingKeyShouldReturnNilValue(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	for _, key := range []string{"dogs", "do", "horse", "ddoge"} {
+		proof, err := tr.GetProof([]byte(key))
+		require.Nil(t, err)
+		require.NotEmpty(t, proof)
+
+		value, err := trie.VerifyProofAndGetValue(rootHash, []byte(key), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		assert.Nil(t, err)
+		assert.Nil(t, value)
+
+		value, err = trie.VerifyProofAndGetValue([]byte("wrong root hash"), []byte(key), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		assert.Equal(t, trie.ErrInvalidProof, err)
+		assert.Nil(t, value)
+	}
+}
+
+func TestVerifyProofAndGetValue_WrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProofAndGetValue([]byte("wrong root hash"), []byte("dog"), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestVerifyProofAndGetValue_ProofForAnotherKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProofAndGetValue(rootHash, []byte("doe"), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestVerifyProofAndGetValue_IncompleteProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProofAndGetValue(rootHash, []byte("dog"), proof[:len(proof)-1], &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestNewProofProvider_NilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pp, err := trie.NewProofProvider(nil)
+
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, trie.ErrNilTrie, err)
+}
+
+func TestProofProvider_GetProofShouldUseTheProvidedRootHash(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	oldRootHash, _ := tr.RootHash()
+
+	_ = tr.Update([]byte("dog"), []byte("big puppy"))
+	_ = tr.Commit()
+
+	pp, _ := trie.NewProofProvider(tr)
+	proof, err := pp.GetProof(oldRootHash, []byte("dog"))
+	require.Nil(t, err)
+
+	value, err := trie.VerifyProofAndGetValue(oldRootHash, []byte("dog"), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), value)
+}
@@ -140,6 +140,9 @@ var ErrNilPeerListCreator = errors.New("nil peer list creator provided")
 // ErrNilTrieDataGetter signals that a nil trie data getter has been provided
 var ErrNilTrieDataGetter = errors.New("nil trie data getter provided")
 
+// ErrNilTrieProofGetter signals that a nil trie proof getter has been provided
+var ErrNilTrieProofGetter = errors.New("nil trie proof getter provided")
+
 // ErrNilCurrBlockTxs signals that nil current blocks txs holder was provided
 var ErrNilCurrBlockTxs = errors.New("nil current block txs holder")
 
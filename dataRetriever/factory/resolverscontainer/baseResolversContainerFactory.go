@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
@@ -304,3 +305,46 @@ func (brcf *baseResolversContainerFactory) createTrieNodesResolver(
 
 	return resolver, nil
 }
+
+func (brcf *baseResolversContainerFactory) createTrieProofsResolver(topic string, trieId string) (dataRetriever.Resolver, error) {
+	// the topic is joined so the light clients, which are not part of the shard, can find the peers able to serve proofs
+	err := brcf.messenger.CreateTopic(topic, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resolverSender, err := brcf.createOneResolverSenderWithSpecifiedNumRequests(
+		topic,
+		EmptyExcludePeersOnTopic,
+		defaultTargetShardID,
+		0,
+		numIntraShardPeers+numCrossShardPeers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	proofProvider, err := trie.NewProofProvider(brcf.triesContainer.Get([]byte(trieId)))
+	if err != nil {
+		return nil, err
+	}
+
+	argTrieProof := resolvers.ArgTrieProofResolver{
+		SenderResolver:   resolverSender,
+		TrieProofGetter:  proofProvider,
+		Marshalizer:      brcf.marshalizer,
+		AntifloodHandler: brcf.inputAntifloodHandler,
+		Throttler:        brcf.throttler,
+	}
+	resolver, err := resolvers.NewTrieProofResolver(argTrieProof)
+	if err != nil {
+		return nil, err
+	}
+
+	err = brcf.messenger.RegisterMessageProcessor(resolver.RequestTopic(), resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolver, nil
+}
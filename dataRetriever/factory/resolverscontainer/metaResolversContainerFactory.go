@@ -273,6 +273,15 @@ func (mrcf *metaResolversContainerFactory) generateTrieNodesResolvers() error {
 	resolversSlice = append(resolversSlice, resolver)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierTrieProofs := factory.AccountTrieProofsTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	resolver, err = mrcf.createTrieProofsResolver(identifierTrieProofs, triesFactory.UserAccountTrie)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierTrieProofs)
+
 	return mrcf.container.AddMultiple(keys, resolversSlice)
 }
 
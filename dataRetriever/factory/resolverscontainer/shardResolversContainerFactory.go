@@ -206,6 +206,15 @@ func (srcf *shardResolversContainerFactory) generateTrieNodesResolvers() error {
 	resolversSlice = append(resolversSlice, resolver)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierTrieProofs := factory.AccountTrieProofsTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
+	resolver, err = srcf.createTrieProofsResolver(identifierTrieProofs, triesFactory.UserAccountTrie)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierTrieProofs)
+
 	return srcf.container.AddMultiple(keys, resolversSlice)
 }
 
@@ -256,6 +256,32 @@ func TestShardResolversContainerFactory_CreateRegisterTrieNodesFailsShouldErr(t
 	assert.Equal(t, errExpected, err)
 }
 
+func TestShardResolversContainerFactory_CreateTopicTrieProofsFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.Messenger = createStubTopicMessageHandlerForShard(factory.AccountTrieProofsTopic, "")
+	rcf, _ := resolverscontainer.NewShardResolversContainerFactory(args)
+
+	container, err := rcf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestShardResolversContainerFactory_CreateRegisterTrieProofsFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.Messenger = createStubTopicMessageHandlerForShard("", factory.AccountTrieProofsTopic)
+	rcf, _ := resolverscontainer.NewShardResolversContainerFactory(args)
+
+	container, err := rcf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
 func TestShardResolversContainerFactory_CreateShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -290,8 +316,9 @@ func TestShardResolversContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numResolverMiniBlocks := noOfShards + 2
 	numResolverMetaBlockHeaders := 1
 	numResolverTrieNodes := 1
+	numResolverTrieProofs := 1
 	totalResolvers := numResolverTxs + numResolverHeaders + numResolverMiniBlocks +
-		numResolverMetaBlockHeaders + numResolverSCRs + numResolverRewardTxs + numResolverTrieNodes
+		numResolverMetaBlockHeaders + numResolverSCRs + numResolverRewardTxs + numResolverTrieNodes + numResolverTrieProofs
 
 	assert.Equal(t, totalResolvers, container.Len())
 }
@@ -41,6 +41,10 @@ func (ut UnitType) String() string {
 		return "StatusMetricsUnit"
 	case ReceiptsUnit:
 		return "ReceiptsUnit"
+	case EconomicsBreakdownUnit:
+		return "EconomicsBreakdownUnit"
+	case LogsBloomUnit:
+		return "LogsBloomUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -85,6 +89,10 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// EconomicsBreakdownUnit is the per epoch economics and rewards breakdown storage unit identifier
+	EconomicsBreakdownUnit UnitType = 17
+	// LogsBloomUnit is the per block logs bloom storage unit identifier
+	LogsBloomUnit UnitType = 18
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -112,6 +120,12 @@ type Resolver interface {
 	IsInterfaceNil() bool
 }
 
+// TrieProofsResolver defines what a trie proofs resolver should do
+type TrieProofsResolver interface {
+	Resolver
+	RequestProof(rootHash []byte, key []byte) error
+}
+
 // TrieNodesResolver defines what a trie nodes resolver should do
 type TrieNodesResolver interface {
 	Resolver
@@ -331,6 +345,12 @@ type TrieDataGetter interface {
 	IsInterfaceNil() bool
 }
 
+// TrieProofGetter returns Merkle proofs for the keys of the trie identified by a root hash
+type TrieProofGetter interface {
+	GetProof(rootHash []byte, key []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
 // RequestedItemsHandler can determine if a certain key has or not been requested
 type RequestedItemsHandler interface {
 	Add(key string) error
@@ -0,0 +1,20 @@
+package mock
+
+// TrieProofGetterStub -
+type TrieProofGetterStub struct {
+	GetProofCalled func(rootHash []byte, key []byte) ([][]byte, error)
+}
+
+// GetProof -
+func (tpgs *TrieProofGetterStub) GetProof(rootHash []byte, key []byte) ([][]byte, error) {
+	if tpgs.GetProofCalled != nil {
+		return tpgs.GetProofCalled(rootHash, key)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (tpgs *TrieProofGetterStub) IsInterfaceNil() bool {
+	return tpgs == nil
+}
@@ -41,6 +41,8 @@ const (
 	NonceType RequestDataType = 3
 	// EpochType indicates that the request data object is of type epoch
 	EpochType RequestDataType = 4
+	// TrieProofType indicates that the request data object is a serialised trie proof request (root hash and key)
+	TrieProofType RequestDataType = 5
 )
 
 var RequestDataType_name = map[int32]string{
@@ -49,6 +51,7 @@ var RequestDataType_name = map[int32]string{
 	2: "HashArrayType",
 	3: "NonceType",
 	4: "EpochType",
+	5: "TrieProofType",
 }
 
 var RequestDataType_value = map[string]int32{
@@ -57,6 +60,7 @@ var RequestDataType_value = map[string]int32{
 	"HashArrayType": 2,
 	"NonceType":     3,
 	"EpochType":     4,
+	"TrieProofType": 5,
 }
 
 func (RequestDataType) EnumDescriptor() ([]byte, []int) {
@@ -128,27 +132,27 @@ func init() {
 func init() { proto.RegisterFile("requestData.proto", fileDescriptor_d2e280b7501d5666) }
 
 var fileDescriptor_d2e280b7501d5666 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xbf, 0x4e, 0x7a, 0x31,
-	0x14, 0x80, 0x7b, 0xf8, 0xf3, 0x0b, 0x14, 0xee, 0x0f, 0xe9, 0x60, 0x88, 0xc3, 0x81, 0x38, 0x11,
-	0x13, 0x21, 0x51, 0x5f, 0x40, 0xa2, 0x51, 0x17, 0x87, 0x1b, 0xe3, 0xe0, 0x56, 0xa0, 0x02, 0x09,
-	0xd2, 0x6b, 0xe9, 0x25, 0x61, 0x73, 0x71, 0xf7, 0x31, 0x7c, 0x14, 0x47, 0x46, 0x26, 0xe2, 0xed,
-	0x5d, 0x0c, 0x13, 0x8f, 0x60, 0x7a, 0xee, 0xa0, 0x71, 0x6a, 0xbf, 0xaf, 0x3d, 0x5f, 0x72, 0x78,
-	0xdd, 0xa8, 0xe7, 0x58, 0xcd, 0xed, 0x85, 0xb4, 0xb2, 0x13, 0x19, 0x6d, 0xb5, 0x28, 0xd2, 0x71,
-	0x70, 0x3c, 0x9a, 0xd8, 0x71, 0xdc, 0xef, 0x0c, 0xf4, 0x53, 0x77, 0xa4, 0x47, 0xba, 0x4b, 0xba,
-	0x1f, 0x3f, 0x12, 0x11, 0xd0, 0x2d, 0x9b, 0x3a, 0x7c, 0x05, 0x5e, 0x09, 0x7f, 0x5a, 0xa2, 0xc9,
-	0x8b, 0xf7, 0x72, 0x1a, 0xab, 0x46, 0xae, 0x05, 0xed, 0x6a, 0xaf, 0xbc, 0xdd, 0x34, 0x8b, 0x0b,
-	0x2f, 0xc2, 0xcc, 0x8b, 0x33, 0x5e, 0xb8, 0x5b, 0x46, 0xaa, 0x01, 0x2d, 0x68, 0xff, 0x3f, 0xd9,
-	0xcf, 0x32, 0x9d, 0x5f, 0x09, 0xff, 0xda, 0x2b, 0x6d, 0x37, 0xcd, 0x82, 0x5d, 0x46, 0x2a, 0xa4,
-	0xdf, 0x3e, 0x7b, 0x19, 0xe9, 0xc1, 0xb8, 0x91, 0x6f, 0x41, 0x3b, 0xc8, 0xb2, 0xca, 0x8b, 0x30,
-	0xf3, 0x47, 0x92, 0xd7, 0xfe, 0x34, 0x44, 0x8d, 0x57, 0x6e, 0x66, 0x0b, 0x39, 0x9d, 0x0c, 0x3d,
-	0xee, 0x31, 0x51, 0xe5, 0xa5, 0x6b, 0x39, 0x1f, 0x13, 0x81, 0xa8, 0xf3, 0xc0, 0xd3, 0xb9, 0x31,
-	0x72, 0x49, 0x2a, 0x27, 0x02, 0x5e, 0xbe, 0xd5, 0xb3, 0x81, 0x22, 0xcc, 0x7b, 0xa4, 0x38, 0x61,
-	0xa1, 0x77, 0xb5, 0x4a, 0x90, 0xad, 0x13, 0x64, 0xbb, 0x04, 0xe1, 0xc5, 0x21, 0xbc, 0x3b, 0x84,
-	0x0f, 0x87, 0xb0, 0x72, 0x08, 0x6b, 0x87, 0xf0, 0xe9, 0x10, 0xbe, 0x1c, 0xb2, 0x9d, 0x43, 0x78,
-	0x4b, 0x91, 0xad, 0x52, 0x64, 0xeb, 0x14, 0xd9, 0x43, 0x30, 0x94, 0x56, 0x86, 0xca, 0x9a, 0x89,
-	0x5a, 0x28, 0xd3, 0xff, 0x47, 0x3b, 0x9f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x94, 0x8d, 0xc0,
-	0xb8, 0x85, 0x01, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4f, 0x02, 0x31,
+	0x14, 0x80, 0xef, 0x01, 0x67, 0xa0, 0x70, 0x22, 0x1d, 0x0c, 0x71, 0x78, 0x10, 0x27, 0x62, 0x22,
+	0x24, 0xea, 0x1f, 0x90, 0x68, 0xd4, 0xc5, 0x98, 0x0b, 0x71, 0x70, 0x2b, 0x50, 0xe0, 0x12, 0xa4,
+	0x67, 0xe9, 0x91, 0xb0, 0xb9, 0xb8, 0xfb, 0x33, 0xfc, 0x29, 0x8e, 0x8c, 0x4c, 0x44, 0xca, 0x62,
+	0x98, 0xf8, 0x09, 0xa6, 0xef, 0x06, 0x8d, 0x53, 0xfb, 0x7d, 0xed, 0xfb, 0x86, 0xc7, 0x2a, 0x5a,
+	0xbe, 0x24, 0x72, 0x6a, 0xae, 0x84, 0x11, 0xcd, 0x58, 0x2b, 0xa3, 0xb8, 0x4f, 0xc7, 0xd1, 0xe9,
+	0x30, 0x32, 0xa3, 0xa4, 0xdb, 0xec, 0xa9, 0xe7, 0xd6, 0x50, 0x0d, 0x55, 0x8b, 0x74, 0x37, 0x19,
+	0x10, 0x11, 0xd0, 0x2d, 0x9d, 0x3a, 0x7e, 0x03, 0x56, 0x0c, 0x7f, 0x5b, 0xbc, 0xc6, 0xfc, 0x47,
+	0x31, 0x4e, 0x64, 0x35, 0x53, 0x87, 0x46, 0xa9, 0x5d, 0xd8, 0xae, 0x6a, 0xfe, 0xcc, 0x89, 0x30,
+	0xf5, 0xfc, 0x82, 0xe5, 0x3a, 0xf3, 0x58, 0x56, 0xa1, 0x0e, 0x8d, 0xfd, 0xb3, 0xc3, 0x34, 0xd3,
+	0xfc, 0x93, 0x70, 0xaf, 0xed, 0xfc, 0x76, 0x55, 0xcb, 0x99, 0x79, 0x2c, 0x43, 0xfa, 0xed, 0xb2,
+	0xd7, 0xb1, 0xea, 0x8d, 0xaa, 0xd9, 0x3a, 0x34, 0x82, 0x34, 0x2b, 0x9d, 0x08, 0x53, 0x7f, 0x62,
+	0x58, 0xf9, 0x5f, 0x83, 0x97, 0x59, 0xf1, 0x6e, 0x32, 0x13, 0xe3, 0xa8, 0xef, 0xf0, 0xc0, 0xe3,
+	0x25, 0x96, 0xbf, 0x15, 0xd3, 0x11, 0x11, 0xf0, 0x0a, 0x0b, 0x1c, 0x5d, 0x6a, 0x2d, 0xe6, 0xa4,
+	0x32, 0x3c, 0x60, 0x85, 0x7b, 0x35, 0xe9, 0x49, 0xc2, 0xac, 0x43, 0x8a, 0x13, 0xe6, 0xdc, 0x40,
+	0x47, 0x47, 0xf2, 0x41, 0x2b, 0x35, 0x20, 0xe5, 0xb7, 0x6f, 0x16, 0x6b, 0xf4, 0x96, 0x6b, 0xf4,
+	0x76, 0x6b, 0x84, 0x57, 0x8b, 0xf0, 0x61, 0x11, 0x3e, 0x2d, 0xc2, 0xc2, 0x22, 0x2c, 0x2d, 0xc2,
+	0x97, 0x45, 0xf8, 0xb6, 0xe8, 0xed, 0x2c, 0xc2, 0xfb, 0x06, 0xbd, 0xc5, 0x06, 0xbd, 0xe5, 0x06,
+	0xbd, 0xa7, 0xa0, 0x2f, 0x8c, 0x08, 0xa5, 0xd1, 0x91, 0x9c, 0x49, 0xdd, 0xdd, 0xa3, 0x35, 0x9c,
+	0xff, 0x0c, 0x00, 0x83, 0x47, 0xa7, 0xb3, 0x98, 0x01, 0x00, 0x00,
 }
 
 func (x RequestDataType) String() string {
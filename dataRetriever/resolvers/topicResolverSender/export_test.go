@@ -4,8 +4,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 )
 
-const TopicRequestSuffix = topicRequestSuffix
-
 func MakeDiffList(
 	allConnectedPeers []core.PeerID,
 	excludedConnectedPeers []core.PeerID,
@@ -15,8 +15,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 )
 
-// topicRequestSuffix represents the topic name suffix
-const topicRequestSuffix = "_REQUEST"
+// TopicRequestSuffix represents the topic name suffix used for requests
+const TopicRequestSuffix = "_REQUEST"
 
 const minPeersToQuery = 2
 
@@ -105,7 +105,7 @@ func (trs *topicResolverSender) SendOnRequestTopic(rd *dataRetriever.RequestData
 		return err
 	}
 
-	topicToSendRequest := trs.topicName + topicRequestSuffix
+	topicToSendRequest := trs.topicName + TopicRequestSuffix
 
 	crossPeers := trs.peerListCreator.PeerList()
 	numSentCross := trs.sendOnTopic(crossPeers, topicToSendRequest, buff, trs.numCrossShardPeers, "cross peer")
@@ -220,7 +220,7 @@ func (trs *topicResolverSender) SetResolverDebugHandler(handler dataRetriever.Re
 
 // RequestTopic returns the topic with the request suffix used for sending requests
 func (trs *topicResolverSender) RequestTopic() string {
-	return trs.topicName + topicRequestSuffix
+	return trs.topicName + TopicRequestSuffix
 }
 
 // TargetShardID returns the target shard ID for this resolver should serve data
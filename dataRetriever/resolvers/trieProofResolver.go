@@ -0,0 +1,149 @@
+package resolvers
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ dataRetriever.TrieProofsResolver = (*TrieProofResolver)(nil)
+
+// ArgTrieProofResolver is the argument structure used to create new TrieProofResolver instance
+type ArgTrieProofResolver struct {
+	SenderResolver   dataRetriever.TopicResolverSender
+	TrieProofGetter  dataRetriever.TrieProofGetter
+	Marshalizer      marshal.Marshalizer
+	AntifloodHandler dataRetriever.P2PAntifloodHandler
+	Throttler        dataRetriever.ResolverThrottler
+}
+
+// TrieProofResolver is a wrapper over Resolver that is specialized in resolving Merkle proof requests. It is used
+// by light clients which do not hold the state tries but verify the received proofs against the headers root hashes
+type TrieProofResolver struct {
+	dataRetriever.TopicResolverSender
+	messageProcessor
+	trieProofGetter dataRetriever.TrieProofGetter
+}
+
+// NewTrieProofResolver creates a new trie proof resolver
+func NewTrieProofResolver(arg ArgTrieProofResolver) (*TrieProofResolver, error) {
+	if check.IfNil(arg.SenderResolver) {
+		return nil, dataRetriever.ErrNilResolverSender
+	}
+	if check.IfNil(arg.TrieProofGetter) {
+		return nil, dataRetriever.ErrNilTrieProofGetter
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, dataRetriever.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.Throttler) {
+		return nil, dataRetriever.ErrNilThrottler
+	}
+
+	return &TrieProofResolver{
+		TopicResolverSender: arg.SenderResolver,
+		trieProofGetter:     arg.TrieProofGetter,
+		messageProcessor: messageProcessor{
+			marshalizer:      arg.Marshalizer,
+			antifloodHandler: arg.AntifloodHandler,
+			topic:            arg.SenderResolver.RequestTopic(),
+			throttler:        arg.Throttler,
+		},
+	}, nil
+}
+
+// ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to, usually a request topic)
+func (tpRes *TrieProofResolver) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := tpRes.canProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	tpRes.throttler.StartProcessing()
+	defer tpRes.throttler.EndProcessing()
+
+	rd, err := tpRes.parseReceivedMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	if rd.Type != dataRetriever.TrieProofType {
+		return dataRetriever.ErrRequestTypeNotImplemented
+	}
+
+	return tpRes.resolveProof(rd.Value, message)
+}
+
+func (tpRes *TrieProofResolver) resolveProof(requestBuff []byte, message p2p.MessageP2P) error {
+	trieProof := &trie.TrieProof{}
+	err := tpRes.marshalizer.Unmarshal(trieProof, requestBuff)
+	if err != nil {
+		return err
+	}
+
+	trieProof.Proof, err = tpRes.trieProofGetter.GetProof(trieProof.RootHash, trieProof.Key)
+	if err != nil {
+		tpRes.ResolverDebugHandler().LogFailedToResolveData(tpRes.topic, trieProof.Key, err)
+		return err
+	}
+
+	tpRes.ResolverDebugHandler().LogSucceededToResolveData(tpRes.topic, trieProof.Key)
+
+	buff, err := tpRes.marshalizer.Marshal(trieProof)
+	if err != nil {
+		return err
+	}
+
+	return tpRes.Send(buff, message.Peer())
+}
+
+// RequestDataFromHash is not supported as a proof request needs both the root hash and the key
+func (tpRes *TrieProofResolver) RequestDataFromHash(_ []byte, _ uint32) error {
+	return dataRetriever.ErrRequestTypeNotImplemented
+}
+
+// RequestProof requests the Merkle proof of the provided key in the trie identified by the root hash
+func (tpRes *TrieProofResolver) RequestProof(rootHash []byte, key []byte) error {
+	buff, err := tpRes.marshalizer.Marshal(&trie.TrieProof{
+		RootHash: rootHash,
+		Key:      key,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tpRes.SendOnRequestTopic(
+		&dataRetriever.RequestData{
+			Type:  dataRetriever.TrieProofType,
+			Value: buff,
+		},
+		[][]byte{key},
+	)
+}
+
+// SetNumPeersToQuery will set the number of intra shard and cross shard number of peer to query
+func (tpRes *TrieProofResolver) SetNumPeersToQuery(intra int, cross int) {
+	tpRes.TopicResolverSender.SetNumPeersToQuery(intra, cross)
+}
+
+// NumPeersToQuery will return the number of intra shard and cross shard number of peer to query
+func (tpRes *TrieProofResolver) NumPeersToQuery() (int, int) {
+	return tpRes.TopicResolverSender.NumPeersToQuery()
+}
+
+// SetResolverDebugHandler will set a resolver debug handler
+func (tpRes *TrieProofResolver) SetResolverDebugHandler(handler dataRetriever.ResolverDebugHandler) error {
+	return tpRes.TopicResolverSender.SetResolverDebugHandler(handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpRes *TrieProofResolver) IsInterfaceNil() bool {
+	return tpRes == nil
+}
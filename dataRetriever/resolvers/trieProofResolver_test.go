@@ -0,0 +1,150 @@
+package resolvers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgTrieProofResolver() resolvers.ArgTrieProofResolver {
+	return resolvers.ArgTrieProofResolver{
+		SenderResolver:   &mock.TopicResolverSenderStub{},
+		TrieProofGetter:  &mock.TrieProofGetterStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		Throttler:        &mock.ThrottlerStub{},
+	}
+}
+
+func TestNewTrieProofResolver_NilTrieProofGetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieProofResolver()
+	arg.TrieProofGetter = nil
+	tpRes, err := resolvers.NewTrieProofResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilTrieProofGetter, err)
+	assert.Nil(t, tpRes)
+}
+
+func TestNewTrieProofResolver_NilResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieProofResolver()
+	arg.SenderResolver = nil
+	tpRes, err := resolvers.NewTrieProofResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilResolverSender, err)
+	assert.Nil(t, tpRes)
+}
+
+func TestNewTrieProofResolver_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tpRes, err := resolvers.NewTrieProofResolver(createMockArgTrieProofResolver())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(tpRes))
+}
+
+func TestTrieProofResolver_ProcessReceivedMessageWrongTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieProofResolver()
+	tpRes, _ := resolvers.NewTrieProofResolver(arg)
+
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashType, Value: []byte("aaa")})
+	err := tpRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: data}, fromConnectedPeer)
+
+	assert.Equal(t, dataRetriever.ErrRequestTypeNotImplemented, err)
+	assert.True(t, arg.Throttler.(*mock.ThrottlerStub).StartWasCalled)
+	assert.True(t, arg.Throttler.(*mock.ThrottlerStub).EndWasCalled)
+}
+
+func TestTrieProofResolver_ProcessReceivedMessageGetProofErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgTrieProofResolver()
+	arg.TrieProofGetter = &mock.TrieProofGetterStub{
+		GetProofCalled: func(rootHash []byte, key []byte) ([][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	arg.SenderResolver = &mock.TopicResolverSenderStub{
+		SendCalled: func(buff []byte, peer core.PeerID) error {
+			assert.Fail(t, "should not send")
+			return nil
+		},
+	}
+	tpRes, _ := resolvers.NewTrieProofResolver(arg)
+
+	request, _ := arg.Marshalizer.Marshal(&trie.TrieProof{RootHash: []byte("root"), Key: []byte("key")})
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.TrieProofType, Value: request})
+	err := tpRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: data}, fromConnectedPeer)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTrieProofResolver_ProcessReceivedMessageShouldSendProof(t *testing.T) {
+	t.Parallel()
+
+	proof := [][]byte{[]byte("node1"), []byte("node2")}
+	var sentProof *trie.TrieProof
+	arg := createMockArgTrieProofResolver()
+	arg.TrieProofGetter = &mock.TrieProofGetterStub{
+		GetProofCalled: func(rootHash []byte, key []byte) ([][]byte, error) {
+			assert.Equal(t, []byte("root"), rootHash)
+			assert.Equal(t, []byte("key"), key)
+			return proof, nil
+		},
+	}
+	arg.SenderResolver = &mock.TopicResolverSenderStub{
+		SendCalled: func(buff []byte, peer core.PeerID) error {
+			sentProof = &trie.TrieProof{}
+			return arg.Marshalizer.Unmarshal(sentProof, buff)
+		},
+	}
+	tpRes, _ := resolvers.NewTrieProofResolver(arg)
+
+	request, _ := arg.Marshalizer.Marshal(&trie.TrieProof{RootHash: []byte("root"), Key: []byte("key")})
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.TrieProofType, Value: request})
+	err := tpRes.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: data}, fromConnectedPeer)
+
+	assert.Nil(t, err)
+	require.NotNil(t, sentProof)
+	assert.Equal(t, []byte("root"), sentProof.RootHash)
+	assert.Equal(t, []byte("key"), sentProof.Key)
+	assert.Equal(t, proof, sentProof.Proof)
+}
+
+func TestTrieProofResolver_RequestProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	requested := &dataRetriever.RequestData{}
+	arg := createMockArgTrieProofResolver()
+	arg.SenderResolver = &mock.TopicResolverSenderStub{
+		SendOnRequestTopicCalled: func(rd *dataRetriever.RequestData, hashes [][]byte) error {
+			requested = rd
+			return nil
+		},
+	}
+	tpRes, _ := resolvers.NewTrieProofResolver(arg)
+
+	err := tpRes.RequestProof([]byte("root"), []byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, dataRetriever.TrieProofType, requested.Type)
+
+	request := &trie.TrieProof{}
+	_ = arg.Marshalizer.Unmarshal(request, requested.Value)
+	assert.Equal(t, []byte("root"), request.RootHash)
+	assert.Equal(t, []byte("key"), request.Key)
+}
@@ -43,6 +43,9 @@ func checkArguments(args ArgsEpochStartBootstrap) error {
 	if check.IfNil(args.BlockSingleSigner) {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilBlockSingleSigner)
 	}
+	if check.IfNil(args.MultiSigVerifier) {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilMultiSigVerifier)
+	}
 	if check.IfNil(args.TxSignMarshalizer) {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilTxSignMarshalizer)
 	}
@@ -0,0 +1,529 @@
+package bootstrap
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+	"github.com/ElrondNetwork/elrond-go/fallback"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const shardIdSize = 4
+
+type snapshotData struct {
+	shardId            uint32
+	isShardIdSet       bool
+	epochStartMeta     *block.MetaBlock
+	epochStartMetaHash []byte
+	prevEpochStartMeta *block.MetaBlock
+	headers            map[string]data.HeaderHandler
+	pendingMiniBlocks  map[string]*block.MiniBlock
+	peerMiniBlocks     map[string]*block.MiniBlock
+	numTrieNodes       int
+}
+
+// bootstrapFromSnapshot loads the epoch start data and the state tries from a snapshot file produced by another
+// node instead of syncing them from the network. Each header, mini block and trie node is checked against its hash.
+// The epoch start meta block must be the one confirmed by the connected peers and must be signed by the consensus
+// group of the previous epoch, while the nodes configuration is rebuilt from its peer mini blocks. The tries are
+// recreated from the root hashes found in the epoch start headers.
+func (e *epochStartBootstrap) bootstrapFromSnapshot() (Parameters, error) {
+	log.Info("start in epoch bootstrap: importing snapshot", "file", e.importSnapshotFile)
+
+	snapshotData, err := e.readSnapshot()
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	defer e.cleanupMessengerTopics()
+
+	err = e.prepareFromSnapshot(snapshotData)
+	if err != nil {
+		return Parameters{}, err
+	}
+	e.setEpochStartMetrics()
+
+	if e.shardCoordinator.SelfId() == core.MetachainShardId {
+		err = e.importMetaFromSnapshot()
+	} else {
+		err = e.importShardFromSnapshot(snapshotData)
+	}
+	if err != nil {
+		return Parameters{}, err
+	}
+
+	log.Info("start in epoch bootstrap: snapshot imported",
+		"epoch", e.epochStartMeta.Epoch,
+		"shard", e.shardCoordinator.SelfId(),
+		"num trie nodes", snapshotData.numTrieNodes)
+
+	return Parameters{
+		Epoch:       e.baseData.lastEpoch,
+		SelfShardId: e.baseData.shardId,
+		NumOfShards: e.baseData.numberOfShards,
+		NodesConfig: e.nodesConfig,
+	}, nil
+}
+
+func (e *epochStartBootstrap) readSnapshot() (*snapshotData, error) {
+	file, err := os.Open(e.importSnapshotFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(file.Close())
+	}()
+
+	reader, err := snapshot.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(reader.Close())
+	}()
+
+	snapshotData := &snapshotData{
+		headers:           make(map[string]data.HeaderHandler),
+		pendingMiniBlocks: make(map[string]*block.MiniBlock),
+		peerMiniBlocks:    make(map[string]*block.MiniBlock),
+	}
+	for {
+		record, errRead := reader.Read()
+		if errRead == io.EOF {
+			return snapshotData, nil
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		err = e.processSnapshotRecord(record, snapshotData)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (e *epochStartBootstrap) processSnapshotRecord(record *snapshot.Record, snapshotData *snapshotData) error {
+	if record.Type != snapshot.SnapshotInfo && !snapshotData.isShardIdSet {
+		return fmt.Errorf("%w: snapshot info must be the first record", epochStart.ErrSnapshotMissingData)
+	}
+
+	switch record.Type {
+	case snapshot.SnapshotInfo:
+		return e.processSnapshotInfo(record, snapshotData)
+	case snapshot.EpochStartMetaBlock:
+		metaBlock, err := e.unmarshalMetaBlockFromSnapshot(record)
+		if err != nil {
+			return err
+		}
+		snapshotData.epochStartMeta = metaBlock
+		snapshotData.epochStartMetaHash = record.Key
+	case snapshot.PreviousEpochStartMetaBlock:
+		metaBlock, err := e.unmarshalMetaBlockFromSnapshot(record)
+		if err != nil {
+			return err
+		}
+		snapshotData.prevEpochStartMeta = metaBlock
+		snapshotData.headers[string(record.Key)] = metaBlock
+	case snapshot.MetaHeader:
+		metaBlock, err := e.unmarshalMetaBlockFromSnapshot(record)
+		if err != nil {
+			return err
+		}
+		snapshotData.headers[string(record.Key)] = metaBlock
+	case snapshot.ShardHeader:
+		header := &block.Header{}
+		err := e.unmarshalVerifiedRecord(record, header)
+		if err != nil {
+			return err
+		}
+		snapshotData.headers[string(record.Key)] = header
+	case snapshot.PendingMiniBlock:
+		miniBlock := &block.MiniBlock{}
+		err := e.unmarshalVerifiedRecord(record, miniBlock)
+		if err != nil {
+			return err
+		}
+		snapshotData.pendingMiniBlocks[string(record.Key)] = miniBlock
+	case snapshot.PeerMiniBlock:
+		miniBlock := &block.MiniBlock{}
+		err := e.unmarshalVerifiedRecord(record, miniBlock)
+		if err != nil {
+			return err
+		}
+		snapshotData.peerMiniBlocks[string(record.Key)] = miniBlock
+	case snapshot.UserTrieNode:
+		snapshotData.numTrieNodes++
+		return e.putTrieNodeFromSnapshot(record, factory.UserAccountTrie)
+	case snapshot.PeerTrieNode:
+		snapshotData.numTrieNodes++
+		return e.putTrieNodeFromSnapshot(record, factory.PeerAccountTrie)
+	}
+
+	return nil
+}
+
+func (e *epochStartBootstrap) processSnapshotInfo(record *snapshot.Record, snapshotData *snapshotData) error {
+	if snapshotData.isShardIdSet || len(record.Value) != shardIdSize {
+		return fmt.Errorf("%w: invalid snapshot info", epochStart.ErrSnapshotMissingData)
+	}
+
+	snapshotData.shardId = binary.BigEndian.Uint32(record.Value)
+	snapshotData.isShardIdSet = true
+
+	return e.createTriesComponentsForShardId(snapshotData.shardId)
+}
+
+func (e *epochStartBootstrap) unmarshalMetaBlockFromSnapshot(record *snapshot.Record) (*block.MetaBlock, error) {
+	metaBlock := &block.MetaBlock{}
+	err := e.unmarshalVerifiedRecord(record, metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+func (e *epochStartBootstrap) unmarshalVerifiedRecord(record *snapshot.Record, obj interface{}) error {
+	err := e.checkSnapshotRecordHash(record)
+	if err != nil {
+		return err
+	}
+
+	return e.marshalizer.Unmarshal(obj, record.Value)
+}
+
+func (e *epochStartBootstrap) checkSnapshotRecordHash(record *snapshot.Record) error {
+	computedHash := e.hasher.Compute(string(record.Value))
+	if !bytes.Equal(computedHash, record.Key) {
+		return fmt.Errorf("%w, record type %d, hash %x", epochStart.ErrSnapshotHashMismatch, record.Type, record.Key)
+	}
+
+	return nil
+}
+
+func (e *epochStartBootstrap) putTrieNodeFromSnapshot(record *snapshot.Record, trieId string) error {
+	err := e.checkSnapshotRecordHash(record)
+	if err != nil {
+		return err
+	}
+
+	return e.trieStorageManagers[trieId].Database().Put(record.Key, record.Value)
+}
+
+func (e *epochStartBootstrap) prepareFromSnapshot(snapshotData *snapshotData) error {
+	if snapshotData.epochStartMeta == nil || !snapshotData.epochStartMeta.IsStartOfEpochBlock() {
+		return fmt.Errorf("%w: epoch start meta block", epochStart.ErrSnapshotMissingData)
+	}
+
+	err := e.prepareComponentsToVerifySnapshot()
+	if err != nil {
+		return err
+	}
+
+	err = e.checkEpochStartMetaWithPeers(snapshotData.epochStartMetaHash)
+	if err != nil {
+		return err
+	}
+
+	e.epochStartMeta = snapshotData.epochStartMeta
+	e.addSnapshotDataToPools(snapshotData)
+	e.syncedHeaders = snapshotData.headers
+
+	prevEpochStartHash := e.epochStartMeta.EpochStart.Economics.PrevEpochStartHash
+	if e.epochStartMeta.Epoch == e.startEpoch+1 {
+		e.syncedHeaders[string(prevEpochStartHash)] = &block.MetaBlock{}
+	}
+	prevEpochStartMeta, ok := e.syncedHeaders[string(prevEpochStartHash)].(*block.MetaBlock)
+	if !ok {
+		return fmt.Errorf("%w: previous epoch start meta block", epochStart.ErrSnapshotMissingData)
+	}
+	e.prevEpochStartMeta = prevEpochStartMeta
+
+	e.baseData.numberOfShards = uint32(len(e.epochStartMeta.EpochStart.LastFinalizedHeaders))
+	e.baseData.lastEpoch = e.epochStartMeta.Epoch
+
+	pubKey, err := e.publicKey.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	err = e.processNodesConfig(pubKey)
+	if err != nil {
+		return err
+	}
+
+	err = e.verifyEpochStartMetaSignature()
+	if err != nil {
+		return err
+	}
+
+	shardId, err := e.computeShardIdFromSnapshot(pubKey, snapshotData.shardId)
+	if err != nil {
+		return err
+	}
+	e.baseData.shardId = shardId
+
+	e.shardCoordinator, err = sharding.NewMultiShardCoordinator(e.baseData.numberOfShards, e.baseData.shardId)
+	if err != nil {
+		return fmt.Errorf("%w numberOfShards=%v shardId=%v", err, e.baseData.numberOfShards, e.baseData.shardId)
+	}
+
+	return nil
+}
+
+// prepareComponentsToVerifySnapshot creates the components needed to check the snapshot against the network. The
+// tries components were already created for the snapshot shard so they are not recreated here.
+func (e *epochStartBootstrap) prepareComponentsToVerifySnapshot() error {
+	err := e.createDataPool()
+	if err != nil {
+		return err
+	}
+
+	err = e.createRequestHandler()
+	if err != nil {
+		return err
+	}
+
+	err = e.createEpochStartMetaSyncer()
+	if err != nil {
+		return err
+	}
+
+	return e.createSyncers()
+}
+
+func (e *epochStartBootstrap) checkEpochStartMetaWithPeers(epochStartMetaHash []byte) error {
+	confirmedMeta, err := e.epochStartMetaBlockSyncer.SyncEpochStartMeta(timeToWait)
+	if err != nil {
+		return err
+	}
+
+	confirmedHash, err := core.CalculateHash(e.marshalizer, e.hasher, confirmedMeta)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(confirmedHash, epochStartMetaHash) {
+		return fmt.Errorf("%w: snapshot hash %x, confirmed hash %x for epoch %d",
+			epochStart.ErrSnapshotEpochStartMetaMismatch, epochStartMetaHash, confirmedHash, confirmedMeta.Epoch)
+	}
+
+	return nil
+}
+
+// addSnapshotDataToPools makes the verified headers and peer mini blocks from the snapshot available to the syncers,
+// so only the missing ones are requested from the network
+func (e *epochStartBootstrap) addSnapshotDataToPools(snapshotData *snapshotData) {
+	for hash, header := range snapshotData.headers {
+		e.dataPool.Headers().AddHeader([]byte(hash), header)
+	}
+	e.dataPool.Headers().AddHeader(snapshotData.epochStartMetaHash, snapshotData.epochStartMeta)
+
+	for hash, miniBlock := range snapshotData.peerMiniBlocks {
+		_ = e.dataPool.MiniBlocks().Put([]byte(hash), miniBlock, miniBlock.Size())
+	}
+}
+
+func (e *epochStartBootstrap) verifyEpochStartMetaSignature() error {
+	fallbackHeaderValidator, err := fallback.NewFallbackHeaderValidator(e.dataPool.Headers(), e.marshalizer, disabled.NewChainStorer())
+	if err != nil {
+		return err
+	}
+
+	argsHeaderSigVerifier := &headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:             e.marshalizer,
+		Hasher:                  e.hasher,
+		NodesCoordinator:        e.nodesConfigHandler.NodesCoordinator(),
+		MultiSigVerifier:        e.multiSigVerifier,
+		SingleSigVerifier:       e.blockSingleSigner,
+		KeyGen:                  e.blockKeyGen,
+		FallbackHeaderValidator: fallbackHeaderValidator,
+	}
+	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(argsHeaderSigVerifier)
+	if err != nil {
+		return err
+	}
+
+	err = headerSigVerifier.VerifyRandSeedAndLeaderSignature(e.epochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	return headerSigVerifier.VerifySignature(e.epochStartMeta)
+}
+
+func (e *epochStartBootstrap) computeShardIdFromSnapshot(pubKey []byte, snapshotShardId uint32) (uint32, error) {
+	epochConfig, ok := e.nodesConfig.EpochsConfig[fmt.Sprint(e.epochStartMeta.Epoch)]
+	if !ok {
+		return 0, fmt.Errorf("%w: nodes config for epoch %d", epochStart.ErrSnapshotMissingData, e.epochStartMeta.Epoch)
+	}
+
+	shardId := snapshotShardId
+	newShardId, isWaiting := checkIfPubkeyIsInMap(pubKey, epochConfig.WaitingValidators)
+	newShardIdEligible, isEligible := checkIfPubkeyIsInMap(pubKey, epochConfig.EligibleValidators)
+	switch {
+	case isWaiting:
+		shardId = newShardId
+		e.nodeType = core.NodeTypeValidator
+	case isEligible:
+		shardId = newShardIdEligible
+		e.nodeType = core.NodeTypeValidator
+	}
+
+	shardId = e.applyShardIDAsObserverIfNeeded(shardId)
+	if shardId != snapshotShardId {
+		return 0, fmt.Errorf("%w: snapshot was exported for shard %s but the node starts in shard %s",
+			epochStart.ErrSnapshotShardMismatch, core.GetShardIDString(snapshotShardId), core.GetShardIDString(shardId))
+	}
+
+	return shardId, nil
+}
+
+func (e *epochStartBootstrap) importMetaFromSnapshot() error {
+	for _, epochStartData := range e.epochStartMeta.EpochStart.LastFinalizedHeaders {
+		_, ok := e.syncedHeaders[string(epochStartData.HeaderHash)].(*block.Header)
+		if !ok {
+			return fmt.Errorf("%w: last finalized header for shard %d", epochStart.ErrSnapshotMissingData, epochStartData.ShardID)
+		}
+	}
+
+	var err error
+	e.peerAccountTries, err = e.recreateTriesFromSnapshot(factory.PeerAccountTrie, e.epochStartMeta.ValidatorStatsRootHash, false)
+	if err != nil {
+		return err
+	}
+
+	e.userAccountTries, err = e.recreateTriesFromSnapshot(factory.UserAccountTrie, e.epochStartMeta.RootHash, true)
+	if err != nil {
+		return err
+	}
+
+	return e.saveDataForMeta()
+}
+
+func (e *epochStartBootstrap) importShardFromSnapshot(snapshotData *snapshotData) error {
+	epochStartData, err := e.findSelfShardEpochStartData()
+	if err != nil {
+		return err
+	}
+
+	ownShardHdr, ok := e.syncedHeaders[string(epochStartData.HeaderHash)].(*block.Header)
+	if !ok {
+		return fmt.Errorf("%w: own shard header", epochStart.ErrSnapshotMissingData)
+	}
+
+	neededMetaBlocks := [][]byte{epochStartData.LastFinishedMetaBlock, epochStartData.FirstPendingMetaBlock}
+	for _, hash := range neededMetaBlocks {
+		_, ok = e.syncedHeaders[string(hash)].(*block.MetaBlock)
+		if !ok {
+			return fmt.Errorf("%w: meta block %x", epochStart.ErrSnapshotMissingData, hash)
+		}
+	}
+
+	for _, mbHeader := range epochStartData.PendingMiniBlockHeaders {
+		_, ok = snapshotData.pendingMiniBlocks[string(mbHeader.Hash)]
+		if !ok {
+			return fmt.Errorf("%w: pending mini block %x", epochStart.ErrSnapshotMissingData, mbHeader.Hash)
+		}
+	}
+
+	e.userAccountTries, err = e.recreateTriesFromSnapshot(factory.UserAccountTrie, ownShardHdr.RootHash, true)
+	if err != nil {
+		return err
+	}
+
+	return e.saveDataForShard(ownShardHdr, snapshotData.pendingMiniBlocks)
+}
+
+// recreateTriesFromSnapshot recreates the trie with the provided root hash and, if required, the data tries of all its
+// accounts. Loading all their nodes guarantees that the imported state is complete.
+func (e *epochStartBootstrap) recreateTriesFromSnapshot(trieId string, rootHash []byte, withDataTries bool) (map[string]data.Trie, error) {
+	tries := make(map[string]data.Trie)
+
+	mainTrie, err := e.recreateCompleteTrie(trieId, rootHash)
+	if err != nil {
+		return nil, err
+	}
+	tries[string(rootHash)] = mainTrie
+
+	if !withDataTries {
+		return tries, nil
+	}
+
+	dataTriesRootHashes, err := getDataTriesRootHashes(mainTrie, rootHash, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		dataTrie, errRecreate := e.recreateCompleteTrie(trieId, dataTrieRootHash)
+		if errRecreate != nil {
+			return nil, errRecreate
+		}
+		tries[string(dataTrieRootHash)] = dataTrie
+	}
+
+	return tries, nil
+}
+
+func (e *epochStartBootstrap) recreateCompleteTrie(trieId string, rootHash []byte) (data.Trie, error) {
+	recreatedTrie, err := e.trieContainer.Get([]byte(trieId)).Recreate(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s trie with root hash %x: %s", epochStart.ErrSnapshotMissingData, trieId, rootHash, err.Error())
+	}
+
+	_, err = recreatedTrie.GetAllHashes()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s trie with root hash %x: %s", epochStart.ErrSnapshotMissingData, trieId, rootHash, err.Error())
+	}
+
+	return recreatedTrie, nil
+}
+
+func getDataTriesRootHashes(mainTrie data.Trie, rootHash []byte, marshalizer marshal.Marshalizer) ([][]byte, error) {
+	if isEmptyTrieRootHash(rootHash) {
+		return make([][]byte, 0), nil
+	}
+
+	leavesChannel, err := mainTrie.GetAllLeavesOnChannel(rootHash, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	rootHashes := make([][]byte, 0)
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		err = marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			log.Trace("this must be a leaf with code", "err", err)
+			continue
+		}
+
+		if !isEmptyTrieRootHash(account.RootHash) {
+			rootHashes = append(rootHashes, account.RootHash)
+		}
+	}
+
+	return rootHashes, nil
+}
+
+func isEmptyTrieRootHash(rootHash []byte) bool {
+	return len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash)
+}
@@ -0,0 +1,296 @@
+package bootstrap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSnapshotFile(t *testing.T, dir string, records []*snapshot.Record) string {
+	filename := filepath.Join(dir, "snapshot.bin")
+	file, err := os.Create(filename)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	bufWriter := bufio.NewWriter(file)
+	writer, err := snapshot.NewWriter(bufWriter)
+	require.Nil(t, err)
+	for _, record := range records {
+		require.Nil(t, writer.Write(record))
+	}
+	require.Nil(t, writer.Close())
+	require.Nil(t, bufWriter.Flush())
+
+	return filename
+}
+
+func createSnapshotInfoRecord(shardId uint32) *snapshot.Record {
+	value := make([]byte, shardIdSize)
+	binary.BigEndian.PutUint32(value, shardId)
+
+	return &snapshot.Record{Type: snapshot.SnapshotInfo, Value: value}
+}
+
+type recordsCollector struct {
+	records []*snapshot.Record
+}
+
+func (rc *recordsCollector) Write(record *snapshot.Record) error {
+	rc.records = append(rc.records, record)
+	return nil
+}
+
+func TestEpochStartBootstrap_BootstrapFromSnapshotMissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.ImportSnapshotFile = "missing_snapshot_file.bin"
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetCalled: func() (storage.LatestDataFromStorage, error) {
+			return storage.LatestDataFromStorage{}, errors.New("no storage")
+		},
+	}
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	params, err := epochStartProvider.Bootstrap()
+
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, Parameters{}, params)
+}
+
+func TestEpochStartBootstrap_ReadSnapshotMissingInfoShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "snapshot")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.ImportSnapshotFile = createSnapshotFile(t, dir, []*snapshot.Record{
+		{Type: snapshot.PeerMiniBlock, Value: []byte("mini block")},
+	})
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	_, err := epochStartProvider.readSnapshot()
+
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotMissingData))
+}
+
+func TestEpochStartBootstrap_ReadSnapshotHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "snapshot")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.GeneralConfig = testscommon.GetGeneralConfig()
+	metaBlockBytes, _ := args.Marshalizer.Marshal(&block.MetaBlock{Nonce: 1})
+	args.ImportSnapshotFile = createSnapshotFile(t, dir, []*snapshot.Record{
+		createSnapshotInfoRecord(0),
+		{Type: snapshot.EpochStartMetaBlock, Key: []byte("wrong hash"), Value: metaBlockBytes},
+	})
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	_, err := epochStartProvider.readSnapshot()
+
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotHashMismatch))
+}
+
+func TestEpochStartBootstrap_PrepareFromSnapshotMissingEpochStartMetaShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	err := epochStartProvider.prepareFromSnapshot(&snapshotData{
+		epochStartMeta: &block.MetaBlock{Nonce: 10},
+	})
+
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotMissingData))
+}
+
+func TestEpochStartBootstrap_ExportSnapshotWithoutStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetCalled: func() (storage.LatestDataFromStorage, error) {
+			return storage.LatestDataFromStorage{}, errors.New("no storage")
+		},
+	}
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	err := epochStartProvider.ExportSnapshot("snapshot.bin")
+
+	assert.Equal(t, epochStart.ErrNothingToExport, err)
+}
+
+func TestEpochStartBootstrap_ExportedTriesShouldBeImported(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "snapshot")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.GeneralConfig = testscommon.GetGeneralConfig()
+
+	exportingProvider, _ := NewEpochStartBootstrap(args)
+	require.Nil(t, exportingProvider.createTriesComponentsForShardId(0))
+	userTrie := exportingProvider.trieContainer.Get([]byte(factory.UserAccountTrie))
+
+	dataTrie, _ := userTrie.Recreate(make([]byte, 0))
+	_ = dataTrie.Update([]byte("key"), []byte("value"))
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	account := state.NewEmptyUserAccount()
+	account.RootHash = dataTrieRootHash
+	accountBytes, _ := args.Marshalizer.Marshal(account)
+	_ = userTrie.Update([]byte("address"), accountBytes)
+	_ = userTrie.Update([]byte("another address"), []byte("code"))
+	require.Nil(t, userTrie.Commit())
+	rootHash, _ := userTrie.RootHash()
+
+	collector := &recordsCollector{records: []*snapshot.Record{createSnapshotInfoRecord(0)}}
+	exporter := &snapshotExporter{epochStartBootstrap: exportingProvider, writer: collector}
+	err := exporter.exportTrie(snapshot.UserTrieNode, factory.UserAccountTrie, rootHash, true)
+	require.Nil(t, err)
+	assert.Equal(t, len(collector.records)-1, exporter.numTrieNodes)
+
+	args.ImportSnapshotFile = createSnapshotFile(t, dir, collector.records)
+	importingProvider, _ := NewEpochStartBootstrap(args)
+	snapshotData, err := importingProvider.readSnapshot()
+	require.Nil(t, err)
+	assert.Equal(t, exporter.numTrieNodes, snapshotData.numTrieNodes)
+
+	tries, err := importingProvider.recreateTriesFromSnapshot(factory.UserAccountTrie, rootHash, true)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(tries))
+
+	value, err := tries[string(dataTrieRootHash)].Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestEpochStartBootstrap_RecreateTriesFromIncompleteSnapshotShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "snapshot")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.GeneralConfig = testscommon.GetGeneralConfig()
+
+	exportingProvider, _ := NewEpochStartBootstrap(args)
+	require.Nil(t, exportingProvider.createTriesComponentsForShardId(0))
+	userTrie := exportingProvider.trieContainer.Get([]byte(factory.UserAccountTrie))
+	_ = userTrie.Update([]byte("key1"), []byte("value1"))
+	_ = userTrie.Update([]byte("key2"), []byte("value2"))
+	require.Nil(t, userTrie.Commit())
+	rootHash, _ := userTrie.RootHash()
+
+	collector := &recordsCollector{records: []*snapshot.Record{createSnapshotInfoRecord(0)}}
+	exporter := &snapshotExporter{epochStartBootstrap: exportingProvider, writer: collector}
+	require.Nil(t, exporter.exportTrie(snapshot.UserTrieNode, factory.UserAccountTrie, rootHash, false))
+	require.True(t, len(collector.records) > 2)
+
+	args.ImportSnapshotFile = createSnapshotFile(t, dir, collector.records[:len(collector.records)-1])
+	importingProvider, _ := NewEpochStartBootstrap(args)
+	_, err := importingProvider.readSnapshot()
+	require.Nil(t, err)
+
+	_, err = importingProvider.recreateTriesFromSnapshot(factory.UserAccountTrie, rootHash, false)
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotMissingData))
+}
+
+func TestEpochStartBootstrap_CheckEpochStartMetaWithPeersDifferentMetaShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+	epochStartProvider.epochStartMetaBlockSyncer = &mock.EpochStartMetaSyncerStub{
+		SyncEpochStartMetaCalled: func(_ time.Duration) (*block.MetaBlock, error) {
+			return &block.MetaBlock{Nonce: 10, Epoch: 2}, nil
+		},
+	}
+
+	snapshotMeta := &block.MetaBlock{Nonce: 5, Epoch: 1}
+	snapshotMetaHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, snapshotMeta)
+	err := epochStartProvider.checkEpochStartMetaWithPeers(snapshotMetaHash)
+
+	assert.True(t, errors.Is(err, epochStart.ErrSnapshotEpochStartMetaMismatch))
+}
+
+func TestEpochStartBootstrap_CheckEpochStartMetaWithPeersSameMetaShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+	epochStartProvider.epochStartMetaBlockSyncer = &mock.EpochStartMetaSyncerStub{
+		SyncEpochStartMetaCalled: func(_ time.Duration) (*block.MetaBlock, error) {
+			return &block.MetaBlock{Nonce: 5, Epoch: 1}, nil
+		},
+	}
+
+	snapshotMeta := &block.MetaBlock{Nonce: 5, Epoch: 1}
+	snapshotMetaHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, snapshotMeta)
+	err := epochStartProvider.checkEpochStartMetaWithPeers(snapshotMetaHash)
+
+	assert.Nil(t, err)
+}
+
+func TestEpochStartBootstrap_VerifyEpochStartMetaSignatureWithWrongConsensusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("consensus group not found")
+	args := createMockEpochStartBootstrapArgs()
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+	epochStartProvider.dataPool = testscommon.NewPoolsHolderMock()
+	epochStartProvider.nodesConfigHandler = &mock.NodesConfigHandlerStub{
+		NodesCoordinatorCalled: func() sharding.NodesCoordinator {
+			return &mock.NodesCoordinatorStub{
+				ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+					return nil, expectedErr
+				},
+			}
+		},
+	}
+	epochStartProvider.epochStartMeta = &block.MetaBlock{
+		Nonce:           5,
+		Epoch:           1,
+		PubKeysBitmap:   []byte{1},
+		EpochStart:      block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		LeaderSignature: []byte("signature"),
+	}
+
+	err := epochStartProvider.verifyEpochStartMetaSignature()
+
+	assert.Equal(t, expectedErr, err)
+}
@@ -14,6 +14,7 @@ import (
 // StartOfEpochNodesConfigHandler defines the methods to process nodesConfig from epoch start metablocks
 type StartOfEpochNodesConfigHandler interface {
 	NodesConfigFromMetaBlock(currMetaBlock *block.MetaBlock, prevMetaBlock *block.MetaBlock) (*sharding.NodesCoordinatorRegistry, uint32, error)
+	NodesCoordinator() sharding.NodesCoordinator
 	IsInterfaceNil() bool
 }
 
@@ -25,10 +26,9 @@ type EpochStartMetaBlockInterceptorProcessor interface {
 
 // StartInEpochNodesCoordinator defines the methods to process and save nodesCoordinator information to storage
 type StartInEpochNodesCoordinator interface {
+	sharding.NodesCoordinator
 	EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler)
 	NodesCoordinatorToRegistry() *sharding.NodesCoordinatorRegistry
-	ShardIdForEpoch(epoch uint32) (uint32, error)
-	IsInterfaceNil() bool
 }
 
 // Messenger defines which methods a p2p messenger should implement
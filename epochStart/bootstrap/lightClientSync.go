@@ -0,0 +1,98 @@
+package bootstrap
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// LightClientComponents holds the components a light client needs to keep following the metachain headers
+type LightClientComponents struct {
+	EpochStartMeta    *block.MetaBlock
+	NumOfShards       uint32
+	NodesCoordinator  sharding.NodesCoordinator
+	EpochStartHandler epochStart.ActionHandler
+	DataPool          dataRetriever.PoolsHolder
+	RequestHandler    process.RequestHandler
+	MiniBlocksSyncer  epochStart.PendingMiniBlocksSyncHandler
+}
+
+// SyncLightClientComponents syncs the last epoch start meta block confirmed by the connected peers and rebuilds the
+// validators of its epoch from the peer mini blocks, without syncing any state trie. The interceptors are kept
+// registered so the light client keeps receiving the headers and the mini blocks it requests.
+func (e *epochStartBootstrap) SyncLightClientComponents() (*LightClientComponents, error) {
+	e.useInMemoryTriesStorage()
+
+	err := e.createDataPool()
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.prepareComponentsToSyncFromNetwork()
+	if err != nil {
+		return nil, err
+	}
+
+	e.epochStartMeta, err = e.epochStartMetaBlockSyncer.SyncEpochStartMeta(timeToWait)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("light client: got epoch start meta header", "epoch", e.epochStartMeta.Epoch, "nonce", e.epochStartMeta.Nonce)
+
+	err = e.createSyncers()
+	if err != nil {
+		return nil, err
+	}
+
+	e.baseData.numberOfShards = uint32(len(e.epochStartMeta.EpochStart.LastFinalizedHeaders))
+	e.baseData.lastEpoch = e.epochStartMeta.Epoch
+	e.syncedHeaders, err = e.syncHeadersFrom(e.epochStartMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	prevEpochStartMetaHash := e.epochStartMeta.EpochStart.Economics.PrevEpochStartHash
+	prevEpochStartMeta, ok := e.syncedHeaders[string(prevEpochStartMetaHash)].(*block.MetaBlock)
+	if !ok {
+		return nil, epochStart.ErrWrongTypeAssertion
+	}
+	e.prevEpochStartMeta = prevEpochStartMeta
+
+	pubKeyBytes, err := e.publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.processNodesConfig(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesCoordinator := e.nodesConfigHandler.NodesCoordinator()
+	epochStartHandler, ok := nodesCoordinator.(epochStart.ActionHandler)
+	if !ok {
+		return nil, epochStart.ErrWrongTypeAssertion
+	}
+	epochStartHandler.EpochStartAction(e.epochStartMeta)
+
+	return &LightClientComponents{
+		EpochStartMeta:    e.epochStartMeta,
+		NumOfShards:       e.baseData.numberOfShards,
+		NodesCoordinator:  nodesCoordinator,
+		EpochStartHandler: epochStartHandler,
+		DataPool:          e.dataPool,
+		RequestHandler:    e.requestHandler,
+		MiniBlocksSyncer:  e.miniBlocksSyncer,
+	}, nil
+}
+
+// useInMemoryTriesStorage keeps the tries needed by the resolvers in memory, as a light client does not store any state
+func (e *epochStartBootstrap) useInMemoryTriesStorage() {
+	e.generalConfig.AccountsTrieStorage.DB.Type = string(storageUnit.MemoryDB)
+	e.generalConfig.PeerAccountsTrieStorage.DB.Type = string(storageUnit.MemoryDB)
+	e.generalConfig.TrieSnapshotDB.Type = string(storageUnit.MemoryDB)
+	e.generalConfig.EvictionWaitingList.DB.Type = string(storageUnit.MemoryDB)
+}
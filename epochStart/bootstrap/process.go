@@ -84,6 +84,7 @@ type epochStartBootstrap struct {
 	economicsData              process.EconomicsDataHandler
 	singleSigner               crypto.SingleSigner
 	blockSingleSigner          crypto.SingleSigner
+	multiSigVerifier           crypto.MultiSigVerifier
 	keyGen                     crypto.KeyGenerator
 	blockKeyGen                crypto.KeyGenerator
 	shardCoordinator           sharding.Coordinator
@@ -108,6 +109,7 @@ type epochStartBootstrap struct {
 	epochNotifier              process.EpochNotifier
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
+	importSnapshotFile         string
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -162,6 +164,7 @@ type ArgsEpochStartBootstrap struct {
 	EconomicsData              process.EconomicsDataHandler
 	SingleSigner               crypto.SingleSigner
 	BlockSingleSigner          crypto.SingleSigner
+	MultiSigVerifier           crypto.MultiSigVerifier
 	KeyGen                     crypto.KeyGenerator
 	BlockKeyGen                crypto.KeyGenerator
 	GenesisNodesConfig         sharding.GenesisNodesSetupHandler
@@ -179,6 +182,7 @@ type ArgsEpochStartBootstrap struct {
 	HeaderIntegrityVerifier    process.HeaderIntegrityVerifier
 	TxSignHasher               hashing.Hasher
 	EpochNotifier              process.EpochNotifier
+	ImportSnapshotFile         string
 }
 
 // NewEpochStartBootstrap will return a new instance of epochStartBootstrap
@@ -207,6 +211,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		blockKeyGen:                args.BlockKeyGen,
 		singleSigner:               args.SingleSigner,
 		blockSingleSigner:          args.BlockSingleSigner,
+		multiSigVerifier:           args.MultiSigVerifier,
 		rater:                      args.Rater,
 		destinationShardAsObserver: args.DestinationShardAsObserver,
 		uint64Converter:            args.Uint64Converter,
@@ -225,6 +230,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		epochNotifier:              args.EpochNotifier,
 		numConcurrentTrieSyncers:   args.GeneralConfig.TrieSync.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes:  args.GeneralConfig.TrieSync.MaxHardCapForMissingNodes,
+		importSnapshotFile:         args.ImportSnapshotFile,
 	}
 
 	whiteListCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(epochStartProvider.generalConfig.WhiteListPool))
@@ -319,8 +325,17 @@ func (e *epochStartBootstrap) GetTriesComponents() (state.TriesHolder, map[strin
 	return e.trieContainer, e.trieStorageManagers
 }
 
-// Bootstrap runs the fast bootstrap method from the network or local storage
+// Bootstrap runs the fast bootstrap method from the network, local storage or a snapshot file
 func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
+	if len(e.importSnapshotFile) > 0 {
+		e.initializeFromLocalStorage()
+		if !e.baseData.storageExists {
+			return e.bootstrapFromSnapshot()
+		}
+
+		log.Warn("local storage found, the snapshot file will not be imported", "file", e.importSnapshotFile)
+	}
+
 	if !e.generalConfig.GeneralSettings.StartInEpochEnabled {
 		log.Warn("fast bootstrap is disabled")
 
@@ -362,28 +377,9 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 		}, nil
 	}
 
-	defer func() {
-		log.Debug("unregistering all message processor and un-joining all topics")
-		errMessenger := e.messenger.UnregisterAllMessageProcessors()
-		log.LogIfError(errMessenger)
-
-		errMessenger = e.messenger.UnjoinAllTopics()
-		log.LogIfError(errMessenger)
-	}()
-
-	var err error
-	e.shardCoordinator, err = sharding.NewMultiShardCoordinator(e.genesisShardCoordinator.NumberOfShards(), core.MetachainShardId)
-	if err != nil {
-		return Parameters{}, err
-	}
+	defer e.cleanupMessengerTopics()
 
-	e.dataPool, err = factoryDataPool.NewDataPoolFromConfig(
-		factoryDataPool.ArgsDataPool{
-			Config:           &e.generalConfig,
-			EconomicsData:    e.economicsData,
-			ShardCoordinator: e.shardCoordinator,
-		},
-	)
+	err := e.createDataPool()
 	if err != nil {
 		return Parameters{}, err
 	}
@@ -434,6 +430,33 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 	return params, nil
 }
 
+func (e *epochStartBootstrap) cleanupMessengerTopics() {
+	log.Debug("unregistering all message processor and un-joining all topics")
+	errMessenger := e.messenger.UnregisterAllMessageProcessors()
+	log.LogIfError(errMessenger)
+
+	errMessenger = e.messenger.UnjoinAllTopics()
+	log.LogIfError(errMessenger)
+}
+
+func (e *epochStartBootstrap) createDataPool() error {
+	var err error
+	e.shardCoordinator, err = sharding.NewMultiShardCoordinator(e.genesisShardCoordinator.NumberOfShards(), core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	e.dataPool, err = factoryDataPool.NewDataPoolFromConfig(
+		factoryDataPool.ArgsDataPool{
+			Config:           &e.generalConfig,
+			EconomicsData:    e.economicsData,
+			ShardCoordinator: e.shardCoordinator,
+		},
+	)
+
+	return err
+}
+
 func (e *epochStartBootstrap) computeIfCurrentEpochIsSaved() bool {
 	e.initializeFromLocalStorage()
 	if !e.baseData.storageExists {
@@ -463,6 +486,11 @@ func (e *epochStartBootstrap) prepareComponentsToSyncFromNetwork() error {
 		return err
 	}
 
+	return e.createEpochStartMetaSyncer()
+}
+
+func (e *epochStartBootstrap) createEpochStartMetaSyncer() error {
+	var err error
 	argsEpochStartSyncer := ArgsNewEpochStartMetaSyncer{
 		RequestHandler:          e.requestHandler,
 		Messenger:               e.messenger,
@@ -706,6 +734,10 @@ func (e *epochStartBootstrap) requestAndProcessForMeta() error {
 		return err
 	}
 
+	return e.saveDataForMeta()
+}
+
+func (e *epochStartBootstrap) saveDataForMeta() error {
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: e.epochStartMeta,
 		PreviousEpochStart:  e.prevEpochStartMeta,
@@ -805,6 +837,10 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 	}
 	log.Debug("start in epoch bootstrap: syncUserAccountsState")
 
+	return e.saveDataForShard(ownShardHdr, pendingMiniBlocks)
+}
+
+func (e *epochStartBootstrap) saveDataForShard(ownShardHdr *block.Header, pendingMiniBlocks map[string]*block.MiniBlock) error {
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: e.epochStartMeta,
 		PreviousEpochStart:  e.prevEpochStartMeta,
@@ -123,6 +123,7 @@ func createMockEpochStartBootstrapArgs() ArgsEpochStartBootstrap {
 		EconomicsData:              &economicsmocks.EconomicsHandlerStub{},
 		SingleSigner:               &mock.SignerStub{},
 		BlockSingleSigner:          &mock.SignerStub{},
+		MultiSigVerifier:           &mock.MultiSigVerifierStub{},
 		KeyGen:                     &mock.KeyGenMock{},
 		BlockKeyGen:                &mock.KeyGenMock{},
 		GenesisNodesConfig:         &mock.NodesSetupStub{},
@@ -169,6 +170,17 @@ func TestNewEpochStartBootstrap_NilTxSignHasherShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, epochStart.ErrNilHasher))
 }
 
+func TestNewEpochStartBootstrap_NilMultiSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.MultiSigVerifier = nil
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	assert.Nil(t, epochStartProvider)
+	assert.True(t, errors.Is(err, epochStart.ErrNilMultiSigVerifier))
+}
+
 func TestNewEpochStartBootstrap_NilEpochNotifierShouldErr(t *testing.T) {
 	t.Parallel()
 
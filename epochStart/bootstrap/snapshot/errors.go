@@ -0,0 +1,21 @@
+package snapshot
+
+import "errors"
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNilReader signals that a nil reader has been provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrNilRecord signals that a nil record has been provided
+var ErrNilRecord = errors.New("nil record")
+
+// ErrInvalidSnapshotFile signals that the provided file is not a valid snapshot file
+var ErrInvalidSnapshotFile = errors.New("invalid snapshot file")
+
+// ErrInvalidRecordType signals that an invalid record type has been provided or read
+var ErrInvalidRecordType = errors.New("invalid record type")
+
+// ErrRecordTooLarge signals that a record exceeds the maximum accepted size
+var ErrRecordTooLarge = errors.New("record too large")
@@ -0,0 +1,96 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+type reader struct {
+	gzipReader *gzip.Reader
+	bufReader  *bufio.Reader
+}
+
+// NewReader creates a snapshot reader over the provided reader, checking that it holds a snapshot
+func NewReader(r io.Reader) (*reader, error) {
+	if r == nil {
+		return nil, ErrNilReader
+	}
+
+	header := make([]byte, len(magic))
+	_, err := io.ReadFull(r, header)
+	if err != nil || !bytes.Equal(header, []byte(magic)) {
+		return nil, ErrInvalidSnapshotFile
+	}
+
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotFile, err.Error())
+	}
+
+	return &reader{
+		gzipReader: gzipReader,
+		bufReader:  bufio.NewReader(gzipReader),
+	}, nil
+}
+
+// Read returns the next record from the snapshot or io.EOF if there are no more records
+func (sr *reader) Read() (*Record, error) {
+	recordType, err := sr.bufReader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	record := &Record{
+		Type: RecordType(recordType),
+	}
+	if !record.Type.isValid() {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidRecordType, recordType)
+	}
+
+	record.Key, err = sr.readBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	record.Value, err = sr.readBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (sr *reader) readBytes() ([]byte, error) {
+	length, err := binary.ReadUvarint(sr.bufReader)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if length > maxRecordPartSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrRecordTooLarge, length)
+	}
+
+	buff := make([]byte, length)
+	_, err = io.ReadFull(sr.bufReader, buff)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	return buff, nil
+}
+
+// Close releases the resources held by the reader. It does not close the underlying reader
+func (sr *reader) Close() error {
+	return sr.gzipReader.Close()
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
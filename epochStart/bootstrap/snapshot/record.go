@@ -0,0 +1,38 @@
+package snapshot
+
+// RecordType defines the type of the data held by a snapshot record
+type RecordType uint8
+
+const (
+	// SnapshotInfo is the first record of a snapshot and holds the exporting shard ID as value
+	SnapshotInfo RecordType = iota + 1
+	// EpochStartMetaBlock holds the epoch start meta block, keyed by its hash
+	EpochStartMetaBlock
+	// PreviousEpochStartMetaBlock holds the previous epoch start meta block, keyed by its hash
+	PreviousEpochStartMetaBlock
+	// ShardHeader holds a shard header needed for bootstrap, keyed by its hash
+	ShardHeader
+	// MetaHeader holds a meta header needed for bootstrap, keyed by its hash
+	MetaHeader
+	// PeerMiniBlock holds a peer mini block of an exported epoch start meta block, keyed by its hash
+	PeerMiniBlock
+	// PendingMiniBlock holds a pending mini block, keyed by its hash
+	PendingMiniBlock
+	// UserTrieNode holds a serialized node of the user accounts tries, keyed by its hash
+	UserTrieNode
+	// PeerTrieNode holds a serialized node of the peer accounts trie, keyed by its hash
+	PeerTrieNode
+)
+
+const lastRecordType = PeerTrieNode
+
+// Record is a single key-value entry of a snapshot file
+type Record struct {
+	Type  RecordType
+	Key   []byte
+	Value []byte
+}
+
+func (rt RecordType) isValid() bool {
+	return rt >= SnapshotInfo && rt <= lastRecordType
+}
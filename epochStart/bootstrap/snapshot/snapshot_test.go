@@ -0,0 +1,102 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWriter_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	w, err := NewWriter(nil)
+
+	assert.Nil(t, w)
+	assert.Equal(t, ErrNilWriter, err)
+}
+
+func TestNewReader_NilReaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewReader(nil)
+
+	assert.Nil(t, r)
+	assert.Equal(t, ErrNilReader, err)
+}
+
+func TestNewReader_InvalidHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewReader(bytes.NewBufferString("not a snapshot file"))
+
+	assert.Nil(t, r)
+	assert.Equal(t, ErrInvalidSnapshotFile, err)
+}
+
+func TestWriter_WriteInvalidRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	w, _ := NewWriter(&bytes.Buffer{})
+
+	err := w.Write(nil)
+	assert.Equal(t, ErrNilRecord, err)
+
+	err = w.Write(&Record{Type: 0})
+	assert.True(t, errors.Is(err, ErrInvalidRecordType))
+
+	err = w.Write(&Record{Type: lastRecordType + 1})
+	assert.True(t, errors.Is(err, ErrInvalidRecordType))
+}
+
+func TestWriterReader_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	records := []*Record{
+		{Type: SnapshotInfo, Key: []byte{}, Value: []byte{0, 0, 0, 1}},
+		{Type: EpochStartMetaBlock, Key: []byte("hash"), Value: []byte("meta block")},
+		{Type: PeerMiniBlock, Key: []byte{}, Value: bytes.Repeat([]byte("a"), 100000)},
+		{Type: UserTrieNode, Key: []byte("node hash"), Value: []byte{}},
+	}
+
+	buff := &bytes.Buffer{}
+	w, err := NewWriter(buff)
+	require.Nil(t, err)
+	for _, record := range records {
+		err = w.Write(record)
+		require.Nil(t, err)
+	}
+	err = w.Close()
+	require.Nil(t, err)
+
+	r, err := NewReader(buff)
+	require.Nil(t, err)
+	for _, expectedRecord := range records {
+		record, errRead := r.Read()
+		require.Nil(t, errRead)
+		assert.Equal(t, expectedRecord, record)
+	}
+
+	record, err := r.Read()
+	assert.Nil(t, record)
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, r.Close())
+}
+
+func TestReader_TruncatedRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	w, _ := NewWriter(buff)
+	_, _ = w.bufWriter.Write([]byte{byte(MetaHeader), 10, 'a'})
+	_ = w.Close()
+
+	r, _ := NewReader(buff)
+	record, err := r.Read()
+
+	assert.Nil(t, record)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
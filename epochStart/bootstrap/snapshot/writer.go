@@ -0,0 +1,85 @@
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const magic = "ERDSNAP1"
+const maxRecordPartSize = 64 * 1024 * 1024
+
+type writer struct {
+	gzipWriter *gzip.Writer
+	bufWriter  *bufio.Writer
+	lenBuff    []byte
+}
+
+// NewWriter creates a snapshot writer that writes compressed records on the provided writer
+func NewWriter(w io.Writer) (*writer, error) {
+	if w == nil {
+		return nil, ErrNilWriter
+	}
+
+	_, err := w.Write([]byte(magic))
+	if err != nil {
+		return nil, err
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+
+	return &writer{
+		gzipWriter: gzipWriter,
+		bufWriter:  bufio.NewWriter(gzipWriter),
+		lenBuff:    make([]byte, binary.MaxVarintLen64),
+	}, nil
+}
+
+// Write appends the provided record to the snapshot
+func (sw *writer) Write(record *Record) error {
+	if record == nil {
+		return ErrNilRecord
+	}
+	if !record.Type.isValid() {
+		return fmt.Errorf("%w: %d", ErrInvalidRecordType, record.Type)
+	}
+
+	err := sw.bufWriter.WriteByte(byte(record.Type))
+	if err != nil {
+		return err
+	}
+
+	err = sw.writeBytes(record.Key)
+	if err != nil {
+		return err
+	}
+
+	return sw.writeBytes(record.Value)
+}
+
+func (sw *writer) writeBytes(buff []byte) error {
+	if len(buff) > maxRecordPartSize {
+		return fmt.Errorf("%w: %d bytes", ErrRecordTooLarge, len(buff))
+	}
+
+	n := binary.PutUvarint(sw.lenBuff, uint64(len(buff)))
+	_, err := sw.bufWriter.Write(sw.lenBuff[:n])
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.bufWriter.Write(buff)
+	return err
+}
+
+// Close flushes the buffered records. It does not close the underlying writer
+func (sw *writer) Close() error {
+	err := sw.bufWriter.Flush()
+	if err != nil {
+		return err
+	}
+
+	return sw.gzipWriter.Close()
+}
@@ -0,0 +1,380 @@
+package bootstrap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+type snapshotWriter interface {
+	Write(record *snapshot.Record) error
+}
+
+type snapshotExporter struct {
+	*epochStartBootstrap
+	writer         snapshotWriter
+	storageService dataRetriever.StorageService
+	numTrieNodes   int
+}
+
+// ExportSnapshot writes the epoch start data and the state tries of the last epoch found in the local storage to
+// the provided file. The resulting file can be used by another node to bootstrap without syncing the state from
+// the network.
+func (e *epochStartBootstrap) ExportSnapshot(filename string) error {
+	e.initializeFromLocalStorage()
+	if !e.baseData.storageExists {
+		return epochStart.ErrNothingToExport
+	}
+
+	err := e.loadEpochStartDataFromStorage()
+	if err != nil {
+		return err
+	}
+
+	storageService, err := e.createExportStorageService()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(storageService.CloseAll())
+	}()
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(file.Close())
+	}()
+
+	bufWriter := bufio.NewWriter(file)
+	writer, err := snapshot.NewWriter(bufWriter)
+	if err != nil {
+		return err
+	}
+
+	exporter := &snapshotExporter{
+		epochStartBootstrap: e,
+		writer:              writer,
+		storageService:      storageService,
+	}
+	err = exporter.export()
+	if err != nil {
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	log.Info("snapshot exported",
+		"file", filename,
+		"epoch", e.epochStartMeta.Epoch,
+		"shard", core.GetShardIDString(e.baseData.shardId),
+		"num trie nodes", exporter.numTrieNodes)
+
+	return bufWriter.Flush()
+}
+
+func (e *epochStartBootstrap) loadEpochStartDataFromStorage() error {
+	storer, err := e.storageOpenerHandler.GetMostRecentBootstrapStorageUnit()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(storer.Close())
+	}()
+
+	_, e.nodesConfig, err = e.getLastBootstrapData(storer)
+	if err != nil {
+		return err
+	}
+
+	e.epochStartMeta, err = e.getEpochStartMetaFromStorage(storer)
+	if err != nil {
+		return err
+	}
+
+	e.baseData.numberOfShards = uint32(len(e.epochStartMeta.EpochStart.LastFinalizedHeaders))
+	e.shardCoordinator, err = sharding.NewMultiShardCoordinator(e.baseData.numberOfShards, e.baseData.shardId)
+
+	return err
+}
+
+func (e *epochStartBootstrap) createExportStorageService() (dataRetriever.StorageService, error) {
+	storageServiceFactory, err := storageFactory.NewStorageServiceFactory(
+		&e.generalConfig,
+		e.shardCoordinator,
+		e.pathManager,
+		&disabled.EpochStartNotifier{},
+		e.baseData.lastEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.shardCoordinator.SelfId() == core.MetachainShardId {
+		return storageServiceFactory.CreateForMeta()
+	}
+
+	return storageServiceFactory.CreateForShard()
+}
+
+func (se *snapshotExporter) export() error {
+	shardIdBytes := make([]byte, shardIdSize)
+	binary.BigEndian.PutUint32(shardIdBytes, se.shardCoordinator.SelfId())
+	err := se.writer.Write(&snapshot.Record{Type: snapshot.SnapshotInfo, Value: shardIdBytes})
+	if err != nil {
+		return err
+	}
+
+	err = se.exportEpochStartData()
+	if err != nil {
+		return err
+	}
+
+	if se.shardCoordinator.SelfId() == core.MetachainShardId {
+		err = se.exportMetaData()
+	} else {
+		err = se.exportShardData()
+	}
+	if err != nil {
+		return err
+	}
+
+	return se.exportTries()
+}
+
+func (se *snapshotExporter) exportEpochStartData() error {
+	epochStartMetaBytes, err := se.marshalizer.Marshal(se.epochStartMeta)
+	if err != nil {
+		return err
+	}
+	err = se.writer.Write(&snapshot.Record{
+		Type:  snapshot.EpochStartMetaBlock,
+		Key:   se.hasher.Compute(string(epochStartMetaBytes)),
+		Value: epochStartMetaBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = se.exportOptionalFromStorer(snapshot.MetaHeader, dataRetriever.MetaBlockUnit, se.epochStartMeta.PrevHash)
+	if err != nil {
+		return err
+	}
+
+	err = se.exportPeerMiniBlocks(se.epochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	if se.epochStartMeta.Epoch <= se.startEpoch+1 {
+		return nil
+	}
+
+	prevEpochStartHash := se.epochStartMeta.EpochStart.Economics.PrevEpochStartHash
+	prevEpochStartMetaBytes, err := se.storageService.GetStorer(dataRetriever.MetaBlockUnit).SearchFirst(prevEpochStartHash)
+	if err != nil {
+		return err
+	}
+	err = se.writer.Write(&snapshot.Record{Type: snapshot.PreviousEpochStartMetaBlock, Key: prevEpochStartHash, Value: prevEpochStartMetaBytes})
+	if err != nil {
+		return err
+	}
+
+	prevEpochStartMeta := &block.MetaBlock{}
+	err = se.marshalizer.Unmarshal(prevEpochStartMeta, prevEpochStartMetaBytes)
+	if err != nil {
+		return err
+	}
+
+	return se.exportPeerMiniBlocks(prevEpochStartMeta)
+}
+
+// exportPeerMiniBlocks exports the peer mini blocks the nodes configuration is rebuilt from. The ones missing from
+// the local storage are requested from the network by the importing node.
+func (se *snapshotExporter) exportPeerMiniBlocks(metaBlock *block.MetaBlock) error {
+	for _, mbHeader := range findPeerMiniBlockHeaders(metaBlock) {
+		err := se.exportOptionalFromStorer(snapshot.PeerMiniBlock, dataRetriever.MiniBlockUnit, mbHeader.Hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (se *snapshotExporter) exportMetaData() error {
+	for _, epochStartData := range se.epochStartMeta.EpochStart.LastFinalizedHeaders {
+		err := se.exportFromStorer(snapshot.ShardHeader, dataRetriever.BlockHeaderUnit, epochStartData.HeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (se *snapshotExporter) exportShardData() error {
+	epochStartData, err := se.findSelfShardEpochStartData()
+	if err != nil {
+		return err
+	}
+
+	err = se.exportFromStorer(snapshot.ShardHeader, dataRetriever.BlockHeaderUnit, epochStartData.HeaderHash)
+	if err != nil {
+		return err
+	}
+
+	neededMetaBlocks := [][]byte{epochStartData.LastFinishedMetaBlock, epochStartData.FirstPendingMetaBlock}
+	for _, hash := range neededMetaBlocks {
+		err = se.exportFromStorer(snapshot.MetaHeader, dataRetriever.MetaBlockUnit, hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, mbHeader := range epochStartData.PendingMiniBlockHeaders {
+		err = se.exportFromStorer(snapshot.PendingMiniBlock, dataRetriever.MiniBlockUnit, mbHeader.Hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (se *snapshotExporter) exportFromStorer(recordType snapshot.RecordType, unitType dataRetriever.UnitType, key []byte) error {
+	value, err := se.storageService.GetStorer(unitType).SearchFirst(key)
+	if err != nil {
+		log.Debug("snapshotExporter.exportFromStorer", "unit", unitType, "key", key, "error", err)
+		return err
+	}
+
+	return se.writer.Write(&snapshot.Record{Type: recordType, Key: key, Value: value})
+}
+
+func (se *snapshotExporter) exportOptionalFromStorer(recordType snapshot.RecordType, unitType dataRetriever.UnitType, key []byte) error {
+	value, err := se.storageService.GetStorer(unitType).SearchFirst(key)
+	if err != nil {
+		log.Debug("snapshotExporter: optional data not found in storage", "unit", unitType, "key", key, "error", err)
+		return nil
+	}
+
+	return se.writer.Write(&snapshot.Record{Type: recordType, Key: key, Value: value})
+}
+
+func (se *snapshotExporter) exportTries() error {
+	err := se.createTriesComponentsForShardId(se.shardCoordinator.SelfId())
+	if err != nil {
+		return err
+	}
+
+	if se.shardCoordinator.SelfId() == core.MetachainShardId {
+		err = se.exportTrie(snapshot.PeerTrieNode, factory.PeerAccountTrie, se.epochStartMeta.ValidatorStatsRootHash, false)
+		if err != nil {
+			return err
+		}
+
+		return se.exportTrie(snapshot.UserTrieNode, factory.UserAccountTrie, se.epochStartMeta.RootHash, true)
+	}
+
+	epochStartData, err := se.findSelfShardEpochStartData()
+	if err != nil {
+		return err
+	}
+
+	return se.exportTrie(snapshot.UserTrieNode, factory.UserAccountTrie, epochStartData.RootHash, true)
+}
+
+func (se *snapshotExporter) exportTrie(recordType snapshot.RecordType, trieId string, rootHash []byte, withDataTries bool) error {
+	if isEmptyTrieRootHash(rootHash) {
+		return nil
+	}
+
+	mainTrie, err := se.trieContainer.Get([]byte(trieId)).Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	err = se.exportTrieNodes(recordType, mainTrie)
+	if err != nil {
+		return err
+	}
+
+	if !withDataTries {
+		return nil
+	}
+
+	dataTriesRootHashes, err := getDataTriesRootHashes(mainTrie, rootHash, se.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		dataTrie, errRecreate := mainTrie.Recreate(dataTrieRootHash)
+		if errRecreate != nil {
+			return errRecreate
+		}
+
+		err = se.exportTrieNodes(recordType, dataTrie)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (se *snapshotExporter) exportTrieNodes(recordType snapshot.RecordType, tr data.Trie) error {
+	if check.IfNil(tr) {
+		return trie.ErrNilTrie
+	}
+
+	it, err := trie.NewIterator(tr)
+	if err != nil {
+		return err
+	}
+
+	for {
+		hash, errHash := it.GetHash()
+		if errHash != nil {
+			return errHash
+		}
+
+		encodedNode, errMarshal := it.MarshalizedNode()
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		err = se.writer.Write(&snapshot.Record{Type: recordType, Key: hash, Value: encodedNode})
+		if err != nil {
+			return err
+		}
+		se.numTrieNodes++
+
+		if !it.HasNext() {
+			return nil
+		}
+
+		err = it.Next()
+		if err != nil {
+			return err
+		}
+	}
+}
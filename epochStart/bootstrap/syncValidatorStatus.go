@@ -146,6 +146,11 @@ func (s *syncValidatorStatus) NodesConfigFromMetaBlock(
 	return nodesConfig, selfShardId, nil
 }
 
+// NodesCoordinator returns the nodes coordinator holding the validators of the processed epoch start meta blocks
+func (s *syncValidatorStatus) NodesCoordinator() sharding.NodesCoordinator {
+	return s.nodeCoordinator
+}
+
 func (s *syncValidatorStatus) processValidatorChangesFor(metaBlock *block.MetaBlock) error {
 	if metaBlock.GetEpoch() == 0 {
 		// no need to process for genesis - already created
@@ -29,6 +29,9 @@ var ErrNilTxSignMarshalizer = errors.New("nil tx sign marshalizer")
 // ErrNilStorage signals that nil storage has been provided
 var ErrNilStorage = errors.New("nil storage")
 
+// ErrNilEconomicsBreakdownStorage signals that a nil economics breakdown storage has been provided
+var ErrNilEconomicsBreakdownStorage = errors.New("nil economics breakdown storage")
+
 // ErrNilHeaderHandler signals that a nil header handler has been provided
 var ErrNilHeaderHandler = errors.New("nil header handler")
 
@@ -307,3 +310,22 @@ var ErrInvalidTotalToDistribute = errors.New("invalid total to distribute")
 
 // ErrInvalidRewardsPerBlock signals that the computed rewards per block are invalid
 var ErrInvalidRewardsPerBlock = errors.New("invalid rewards per block")
+
+// ErrSnapshotHashMismatch signals that data read from a snapshot file does not match its hash
+var ErrSnapshotHashMismatch = errors.New("snapshot data does not match its hash")
+
+// ErrSnapshotMissingData signals that a snapshot file does not contain all the data needed for bootstrap
+var ErrSnapshotMissingData = errors.New("missing data in snapshot")
+
+// ErrSnapshotShardMismatch signals that the snapshot was exported for another shard than the one the node starts in
+var ErrSnapshotShardMismatch = errors.New("snapshot shard mismatch")
+
+// ErrNothingToExport signals that there is no local data which could be exported in a snapshot
+var ErrNothingToExport = errors.New("nothing to export")
+
+// ErrNilMultiSigVerifier signals that a nil multi-signature verifier was provided
+var ErrNilMultiSigVerifier = errors.New("nil multi-signature verifier")
+
+// ErrSnapshotEpochStartMetaMismatch signals that the epoch start meta block from a snapshot file differs from the one
+// confirmed by the connected peers
+var ErrSnapshotEpochStartMetaMismatch = errors.New("snapshot epoch start meta block does not match the one confirmed by peers")
@@ -40,6 +40,7 @@ type BaseRewardsCreatorArgs struct {
 	DelegationSystemSCEnableEpoch uint32
 	UserAccountsDB                state.AccountsAdapter
 	RewardsFix1EpochEnable        uint32
+	EconomicsBreakdownStorage     storage.Storer
 }
 
 type baseRewardsCreator struct {
@@ -61,6 +62,9 @@ type baseRewardsCreator struct {
 	userAccountsDB                     state.AccountsAdapter
 	mutRewardsData                     sync.RWMutex
 	rewardsFix1EnableEpoch             uint32
+	economicsBreakdownStorage          storage.Storer
+	breakdownCollector                 *rewardsBreakdownCollector
+	epochRewardsBreakdown              *rewardTx.EpochRewardsBreakdown
 }
 
 // NewBaseRewardsCreator will create a new base rewards creator instance
@@ -103,6 +107,7 @@ func NewBaseRewardsCreator(args BaseRewardsCreatorArgs) (*baseRewardsCreator, er
 		userAccountsDB:                     args.UserAccountsDB,
 		mapBaseRewardsPerBlockPerValidator: make(map[uint32]*big.Int),
 		rewardsFix1EnableEpoch:             args.RewardsFix1EpochEnable,
+		economicsBreakdownStorage:          args.EconomicsBreakdownStorage,
 	}
 
 	return brc, nil
@@ -189,11 +194,13 @@ func (brc *baseRewardsCreator) GetRewardsTxs(body *block.Body) map[string]data.T
 }
 
 // SaveTxBlockToStorage saves created data to storage
-func (brc *baseRewardsCreator) SaveTxBlockToStorage(_ *block.MetaBlock, body *block.Body) {
+func (brc *baseRewardsCreator) SaveTxBlockToStorage(metaBlock *block.MetaBlock, body *block.Body) {
 	if check.IfNil(body) {
 		return
 	}
 
+	brc.saveEpochRewardsBreakdown(metaBlock)
+
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.RewardsBlock {
 			continue
@@ -223,12 +230,43 @@ func (brc *baseRewardsCreator) SaveTxBlockToStorage(_ *block.MetaBlock, body *bl
 	}
 }
 
+func (brc *baseRewardsCreator) saveEpochRewardsBreakdown(metaBlock *block.MetaBlock) {
+	if check.IfNil(metaBlock) {
+		return
+	}
+
+	brc.mutRewardsData.RLock()
+	breakdown := brc.epochRewardsBreakdown
+	brc.mutRewardsData.RUnlock()
+
+	if breakdown == nil || breakdown.Epoch != metaBlock.GetEpoch() {
+		log.Debug("baseRewardsCreator.saveEpochRewardsBreakdown - no rewards breakdown computed for epoch",
+			"epoch", metaBlock.GetEpoch())
+		return
+	}
+
+	marshalizedData, err := brc.marshalizer.Marshal(breakdown)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveEpochRewardsBreakdown", "epoch", breakdown.Epoch, "error", err.Error())
+		return
+	}
+
+	err = brc.economicsBreakdownStorage.Put(rewardTx.EpochRewardsBreakdownKey(breakdown.Epoch), marshalizedData)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveEpochRewardsBreakdown", "epoch", breakdown.Epoch, "error", err.Error())
+	}
+}
+
 // DeleteTxsFromStorage deletes data from storage
 func (brc *baseRewardsCreator) DeleteTxsFromStorage(metaBlock *block.MetaBlock, body *block.Body) {
 	if check.IfNil(metaBlock) || check.IfNil(body) {
 		return
 	}
 
+	if metaBlock.IsStartOfEpochBlock() {
+		_ = brc.economicsBreakdownStorage.Remove(rewardTx.EpochRewardsBreakdownKey(metaBlock.GetEpoch()))
+	}
+
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.RewardsBlock {
 			continue
@@ -311,6 +349,9 @@ func checkBaseArgs(args BaseRewardsCreatorArgs) error {
 	if check.IfNil(args.UserAccountsDB) {
 		return epochStart.ErrNilAccountsDB
 	}
+	if check.IfNil(args.EconomicsBreakdownStorage) {
+		return epochStart.ErrNilEconomicsBreakdownStorage
+	}
 
 	return nil
 }
@@ -321,6 +362,8 @@ func (brc *baseRewardsCreator) clean() {
 	brc.currTxs.Clean()
 	brc.accumulatedRewards = big.NewInt(0)
 	brc.protocolSustainabilityValue = big.NewInt(0)
+	brc.breakdownCollector = nil
+	brc.epochRewardsBreakdown = nil
 }
 
 func (brc *baseRewardsCreator) isSystemDelegationSC(address []byte) bool {
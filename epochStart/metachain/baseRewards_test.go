@@ -1131,6 +1131,7 @@ func getBaseRewardsArguments() BaseRewardsCreatorArgs {
 		ShardCoordinator:              shardCoordinator,
 		PubkeyConverter:               mock.NewPubkeyConverterMock(32),
 		RewardsStorage:                mock.NewStorerMock(),
+		EconomicsBreakdownStorage:     mock.NewStorerMock(),
 		MiniBlockStorage:              mock.NewStorerMock(),
 		Hasher:                        &mock.HasherMock{},
 		Marshalizer:                   &mock.MarshalizerMock{},
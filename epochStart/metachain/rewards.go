@@ -59,6 +59,7 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(
 
 	rc.clean()
 	rc.flagDelegationSystemSCEnabled.Toggle(metaBlock.GetEpoch() >= rc.delegationSystemSCEnableEpoch)
+	rc.breakdownCollector = newRewardsBreakdownCollector(metaBlock, computedEconomics, rewardsVersion1)
 
 	economicsData := metaBlock.EpochStart.Economics
 	log.Debug("rewardsCreator.CreateRewardsMiniBlocks",
@@ -90,6 +91,8 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.epochRewardsBreakdown = rc.breakdownCollector.finalize(rc.protocolSustainabilityValue)
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
@@ -147,6 +150,7 @@ func (rc *rewardsCreator) addValidatorRewardsToMiniBlocks(
 			continue
 		}
 		rc.currTxs.AddTx(rwdTxHash, rwdTx)
+		rc.breakdownCollector.setAddressDistribution(rwdTx.RcvAddr, mbId == rc.shardCoordinator.NumberOfShards(), true)
 
 		log.Debug("rewardsCreator.addValidatorRewardsToMiniBlocks",
 			"epoch", rwdTx.GetEpoch(),
@@ -173,11 +177,10 @@ func (rc *rewardsCreator) computeValidatorInfoPerRewardAddress(
 			protocolRewardValue := big.NewInt(0).Mul(rewardsPerBlockPerNodeForShard, big.NewInt(0).SetUint64(uint64(validatorInfo.NumSelectedInSuccessBlocks)))
 
 			isFix1Enabled := rc.isRewardsFix1Enabled(epoch)
-			if isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorSuccess == 0 {
-				protocolSustainabilityRwd.Value.Add(protocolSustainabilityRwd.Value, protocolRewardValue)
-				continue
-			}
-			if !isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorFailure == 0 {
+			isNotRewarded := isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorSuccess == 0
+			isNotRewarded = isNotRewarded || !isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorFailure == 0
+			rc.breakdownCollector.addNode(validatorInfo, protocolRewardValue, zero, zero, !isNotRewarded)
+			if isNotRewarded {
 				protocolSustainabilityRwd.Value.Add(protocolSustainabilityRwd.Value, protocolRewardValue)
 				continue
 			}
@@ -0,0 +1,154 @@
+package metachain
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+const (
+	rewardsVersion1 uint32 = 1
+	rewardsVersion2 uint32 = 2
+)
+
+// rewardsBreakdownCollector gathers the values computed by a rewards creator so that staking providers can later
+// reconcile the payouts of an epoch. It is not concurrent safe, the rewards creators use it under their own mutex.
+// The recording methods are no-ops on a nil collector, as the rewards computation can be run without one
+type rewardsBreakdownCollector struct {
+	breakdown *rewardTx.EpochRewardsBreakdown
+	addresses map[string]*rewardTx.AddressRewardsBreakdown
+}
+
+func newRewardsBreakdownCollector(
+	metaBlock *block.MetaBlock,
+	computedEconomics *block.Economics,
+	version uint32,
+) *rewardsBreakdownCollector {
+	breakdown := &rewardTx.EpochRewardsBreakdown{
+		Epoch:                         metaBlock.GetEpoch(),
+		RewardsVersion:                version,
+		TotalSupply:                   copyBigInt(computedEconomics.TotalSupply),
+		TotalToDistribute:             copyBigInt(computedEconomics.TotalToDistribute),
+		TotalNewlyMinted:              copyBigInt(computedEconomics.TotalNewlyMinted),
+		RewardsPerBlock:               copyBigInt(computedEconomics.RewardsPerBlock),
+		NodePrice:                     copyBigInt(computedEconomics.NodePrice),
+		AccumulatedFees:               copyBigInt(metaBlock.AccumulatedFeesInEpoch),
+		DeveloperFees:                 copyBigInt(metaBlock.DevFeesInEpoch),
+		LeaderFees:                    big.NewInt(0),
+		BaseRewards:                   big.NewInt(0),
+		TopUpRewards:                  big.NewInt(0),
+		ProtocolSustainabilityRewards: big.NewInt(0),
+		Nodes:                         make([]*rewardTx.NodeRewardsBreakdown, 0),
+		Addresses:                     make([]*rewardTx.AddressRewardsBreakdown, 0),
+	}
+
+	return &rewardsBreakdownCollector{
+		breakdown: breakdown,
+		addresses: make(map[string]*rewardTx.AddressRewardsBreakdown),
+	}
+}
+
+func (rbc *rewardsBreakdownCollector) setNumberOfBlocks(numBlocks uint64) {
+	rbc.breakdown.NumberOfBlocks = numBlocks
+}
+
+// addNode records the rewards of one node. The rewards of the nodes that were not rewarded went to the protocol
+// sustainability address so they are not aggregated on the node's reward address
+func (rbc *rewardsBreakdownCollector) addNode(
+	valInfo *state.ValidatorInfo,
+	baseReward *big.Int,
+	topUpReward *big.Int,
+	topUpStake *big.Int,
+	rewarded bool,
+) {
+	if rbc == nil {
+		return
+	}
+
+	leaderFees := copyBigInt(valInfo.AccumulatedFees)
+	rbc.breakdown.Nodes = append(rbc.breakdown.Nodes, &rewardTx.NodeRewardsBreakdown{
+		PubKey:                     valInfo.PublicKey,
+		ShardID:                    valInfo.ShardId,
+		RewardAddress:              valInfo.RewardAddress,
+		BaseReward:                 copyBigInt(baseReward),
+		TopUpReward:                copyBigInt(topUpReward),
+		LeaderFees:                 leaderFees,
+		TopUpStake:                 copyBigInt(topUpStake),
+		NumSelectedInSuccessBlocks: valInfo.NumSelectedInSuccessBlocks,
+		Rewarded:                   rewarded,
+	})
+	if !rewarded {
+		return
+	}
+
+	rbc.breakdown.BaseRewards.Add(rbc.breakdown.BaseRewards, baseReward)
+	rbc.breakdown.TopUpRewards.Add(rbc.breakdown.TopUpRewards, topUpReward)
+	rbc.breakdown.LeaderFees.Add(rbc.breakdown.LeaderFees, leaderFees)
+
+	addressRewards, ok := rbc.addresses[string(valInfo.RewardAddress)]
+	if !ok {
+		addressRewards = &rewardTx.AddressRewardsBreakdown{
+			Address:      valInfo.RewardAddress,
+			BaseRewards:  big.NewInt(0),
+			TopUpRewards: big.NewInt(0),
+			LeaderFees:   big.NewInt(0),
+			TotalRewards: big.NewInt(0),
+		}
+		rbc.addresses[string(valInfo.RewardAddress)] = addressRewards
+	}
+
+	addressRewards.NumNodes++
+	addressRewards.BaseRewards.Add(addressRewards.BaseRewards, baseReward)
+	addressRewards.TopUpRewards.Add(addressRewards.TopUpRewards, topUpReward)
+	addressRewards.LeaderFees.Add(addressRewards.LeaderFees, leaderFees)
+	addressRewards.TotalRewards.Add(addressRewards.TotalRewards, baseReward)
+	addressRewards.TotalRewards.Add(addressRewards.TotalRewards, topUpReward)
+	addressRewards.TotalRewards.Add(addressRewards.TotalRewards, leaderFees)
+}
+
+// setAddressDistribution marks whether the rewards of an address were sent in a reward transaction or moved to the
+// protocol sustainability address
+func (rbc *rewardsBreakdownCollector) setAddressDistribution(address []byte, isDelegationContract bool, distributed bool) {
+	if rbc == nil {
+		return
+	}
+
+	addressRewards, ok := rbc.addresses[string(address)]
+	if !ok {
+		return
+	}
+
+	addressRewards.IsDelegationContract = isDelegationContract
+	addressRewards.Distributed = distributed
+}
+
+// finalize sorts the recorded entries so that the stored breakdown does not depend on the maps iteration order
+func (rbc *rewardsBreakdownCollector) finalize(protocolSustainabilityRewards *big.Int) *rewardTx.EpochRewardsBreakdown {
+	rbc.breakdown.ProtocolSustainabilityRewards = copyBigInt(protocolSustainabilityRewards)
+
+	sort.Slice(rbc.breakdown.Nodes, func(i, j int) bool {
+		return bytes.Compare(rbc.breakdown.Nodes[i].PubKey, rbc.breakdown.Nodes[j].PubKey) < 0
+	})
+
+	rbc.breakdown.Addresses = make([]*rewardTx.AddressRewardsBreakdown, 0, len(rbc.addresses))
+	for _, addressRewards := range rbc.addresses {
+		rbc.breakdown.Addresses = append(rbc.breakdown.Addresses, addressRewards)
+	}
+	sort.Slice(rbc.breakdown.Addresses, func(i, j int) bool {
+		return bytes.Compare(rbc.breakdown.Addresses[i].Address, rbc.breakdown.Addresses[j].Address) < 0
+	})
+
+	return rbc.breakdown
+}
+
+func copyBigInt(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
@@ -105,6 +105,8 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 	miniBlocks := rc.initializeRewardsMiniBlocks()
 	rc.clean()
 	rc.flagDelegationSystemSCEnabled.Toggle(metaBlock.GetEpoch() >= rc.delegationSystemSCEnableEpoch)
+	rc.breakdownCollector = newRewardsBreakdownCollector(metaBlock, computedEconomics, rewardsVersion2)
+	rc.breakdownCollector.setNumberOfBlocks(rc.economicsDataProvider.NumberOfBlocks())
 
 	protRwdTx, protRwdShardId, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
 	if err != nil {
@@ -128,6 +130,8 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.epochRewardsBreakdown = rc.breakdownCollector.finalize(rc.protocolSustainabilityValue)
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
@@ -210,6 +214,7 @@ func (rc *rewardsCreatorV2) addValidatorRewardsToMiniBlocks(
 			"value", rwdTx.GetValue().String())
 
 		rc.currTxs.AddTx(rwdTxHash, rwdTx)
+		rc.breakdownCollector.setAddressDistribution(rwdTx.RcvAddr, mbId == rc.shardCoordinator.NumberOfShards(), true)
 		miniBlocks[mbId].TxHashes = append(miniBlocks[mbId].TxHashes, rwdTxHash)
 	}
 
@@ -226,7 +231,9 @@ func (rc *rewardsCreatorV2) computeValidatorInfoPerRewardAddress(
 
 	for _, nodeInfoList := range nodesRewardInfo {
 		for _, nodeInfo := range nodeInfoList {
-			if nodeInfo.valInfo.LeaderSuccess == 0 && nodeInfo.valInfo.ValidatorSuccess == 0 {
+			isRewarded := nodeInfo.valInfo.LeaderSuccess != 0 || nodeInfo.valInfo.ValidatorSuccess != 0
+			rc.breakdownCollector.addNode(nodeInfo.valInfo, nodeInfo.baseReward, nodeInfo.topUpReward, nodeInfo.topUpStake, isRewarded)
+			if !isRewarded {
 				accumulatedUnassigned.Add(accumulatedUnassigned, nodeInfo.fullRewards)
 				continue
 			}
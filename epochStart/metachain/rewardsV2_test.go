@@ -1513,6 +1513,68 @@ func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNewRewardsCreatorV2_CreateRewardsMiniBlocksShouldSaveRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	nbEligiblePerShard := uint32(10)
+	topUpStake := big.NewInt(2500)
+	vInfo := createDefaultValidatorInfo(nbEligiblePerShard, args.ShardCoordinator, args.NodesConfigProvider, 100, defaultBlocksPerShard)
+	args.StakingDataProvider = &mock.StakingDataProviderStub{
+		GetTotalTopUpStakeEligibleNodesCalled: func() *big.Int {
+			return big.NewInt(0).Mul(big.NewInt(int64(nbEligiblePerShard)), topUpStake)
+		},
+		GetNodeStakedTopUpCalled: func(blsKey []byte) (*big.Int, error) {
+			return big.NewInt(0).Set(topUpStake), nil
+		},
+	}
+	blocksPerShard := make(map[uint32]uint64)
+	for shardID := range createShardsMap(args.ShardCoordinator) {
+		blocksPerShard[shardID] = uint64(defaultBlocksPerShard)
+	}
+	args.EconomicsDataProvider.SetNumberOfBlocksPerShard(blocksPerShard)
+	args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(big.NewInt(1000000000))
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.Nil(t, err)
+
+	metaBlock := &block.MetaBlock{
+		Epoch:          2,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	miniBlocks, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	sumRewards := big.NewInt(0)
+	for _, mb := range miniBlocks {
+		for _, txHash := range mb.TxHashes {
+			tx, errGet := rwd.currTxs.GetTx(txHash)
+			require.Nil(t, errGet)
+			sumRewards.Add(sumRewards, tx.GetValue())
+		}
+	}
+
+	rwd.SaveTxBlockToStorage(metaBlock, &block.Body{MiniBlocks: miniBlocks})
+
+	buff, err := args.EconomicsBreakdownStorage.Get(rewardTx.EpochRewardsBreakdownKey(metaBlock.Epoch))
+	require.Nil(t, err)
+	breakdown := &rewardTx.EpochRewardsBreakdown{}
+	err = args.Marshalizer.Unmarshal(breakdown, buff)
+	require.Nil(t, err)
+
+	require.Equal(t, metaBlock.Epoch, breakdown.Epoch)
+	require.Equal(t, rewardsVersion2, breakdown.RewardsVersion)
+	require.Equal(t, int(nbEligiblePerShard)*len(vInfo), len(breakdown.Nodes))
+
+	sumAddressRewards := big.NewInt(0).Set(breakdown.ProtocolSustainabilityRewards)
+	for _, addressRewards := range breakdown.Addresses {
+		require.True(t, addressRewards.Distributed)
+		sumAddressRewards.Add(sumAddressRewards, addressRewards.TotalRewards)
+	}
+	require.Equal(t, sumRewards, sumAddressRewards)
+}
+
 func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks2169Nodes(t *testing.T) {
 	t.Parallel()
 
@@ -977,6 +977,20 @@ func (s *systemSCProcessor) updateOwnersForBlsKeys() error {
 	return nil
 }
 
+// ScheduledActivationsData returns the activations scheduled by the governance contract, as they are kept in its
+// storage. Empty data is returned if nothing was scheduled
+func (s *systemSCProcessor) ScheduledActivationsData() ([]byte, error) {
+	governanceAccount, err := s.getUserAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w when loading governance account", err)
+	}
+	if check.IfNil(governanceAccount.DataTrie()) {
+		return nil, nil
+	}
+
+	return governanceAccount.DataTrieTracker().RetrieveValue([]byte(systemSmartContracts.ScheduledActivationsKey))
+}
+
 func (s *systemSCProcessor) getValidatorSystemAccount() (state.UserAccountHandler, error) {
 	validatorAccount, err := s.userAccountsDB.LoadAccount(vm.ValidatorSCAddress)
 	if err != nil {
@@ -851,6 +851,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		ValidatorAccountsDB: peerAccountsDB,
 		ChanceComputer:      &mock.ChanceComputerStub{},
 		EpochNotifier:       epochNotifier,
+		ChainID:             []byte("chain ID"),
 	}
 	metaVmFactory, _ := metaProcess.NewVMContainerFactory(argsNewVMContainerFactory)
 
@@ -1499,3 +1500,24 @@ func TestSystemSCProcessor_ProcessSystemSmartContractJailAndUnStake(t *testing.T
 		assert.Equal(t, peerAcc.GetList(), string(core.LeavingList))
 	}
 }
+
+func TestSystemSCProcessor_ScheduledActivationsData(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(1000, createMemUnit())
+	s, _ := NewSystemSCProcessor(args)
+
+	data, err := s.ScheduledActivationsData()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(data))
+
+	scheduledActivations := []byte("scheduled activations")
+	account, _ := args.UserAccountsDB.LoadAccount(vm.GovernanceSCAddress)
+	governanceAccount := account.(state.UserAccountHandler)
+	_ = governanceAccount.DataTrieTracker().SaveKeyValue([]byte(systemSmartContracts.ScheduledActivationsKey), scheduledActivations)
+	_ = args.UserAccountsDB.SaveAccount(governanceAccount)
+
+	data, err = s.ScheduledActivationsData()
+	assert.Nil(t, err)
+	assert.Equal(t, scheduledActivations, data)
+}
@@ -10,6 +10,7 @@ type EpochNotifierStub struct {
 	CheckEpochCalled            func(epoch uint32)
 	CurrentEpochCalled          func() uint32
 	RegisterNotifyHandlerCalled func(handler core.EpochSubscriberHandler)
+	ActivationEpochCalled       func(featureName string, defaultEpoch uint32) uint32
 }
 
 // CheckEpoch -
@@ -39,6 +40,15 @@ func (ens *EpochNotifierStub) CurrentEpoch() uint32 {
 	return 0
 }
 
+// ActivationEpoch -
+func (ens *EpochNotifierStub) ActivationEpoch(featureName string, defaultEpoch uint32) uint32 {
+	if ens.ActivationEpochCalled != nil {
+		return ens.ActivationEpochCalled(featureName, defaultEpoch)
+	}
+
+	return defaultEpoch
+}
+
 // IsInterfaceNil -
 func (ens *EpochNotifierStub) IsInterfaceNil() bool {
 	return ens == nil
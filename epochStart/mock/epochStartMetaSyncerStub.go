@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// EpochStartMetaSyncerStub -
+type EpochStartMetaSyncerStub struct {
+	SyncEpochStartMetaCalled func(waitTime time.Duration) (*block.MetaBlock, error)
+}
+
+// SyncEpochStartMeta -
+func (esms *EpochStartMetaSyncerStub) SyncEpochStartMeta(waitTime time.Duration) (*block.MetaBlock, error) {
+	if esms.SyncEpochStartMetaCalled != nil {
+		return esms.SyncEpochStartMetaCalled(waitTime)
+	}
+
+	return &block.MetaBlock{}, nil
+}
+
+// IsInterfaceNil -
+func (esms *EpochStartMetaSyncerStub) IsInterfaceNil() bool {
+	return esms == nil
+}
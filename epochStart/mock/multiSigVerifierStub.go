@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// MultiSigVerifierStub -
+type MultiSigVerifierStub struct {
+	CreateCalled           func(pubKeys []string, index uint16) (crypto.MultiSigner, error)
+	SetAggregatedSigCalled func(sig []byte) error
+	VerifyCalled           func(msg []byte, bitmap []byte) error
+}
+
+// Create -
+func (mvs *MultiSigVerifierStub) Create(pubKeys []string, index uint16) (crypto.MultiSigner, error) {
+	if mvs.CreateCalled != nil {
+		return mvs.CreateCalled(pubKeys, index)
+	}
+
+	return nil, nil
+}
+
+// SetAggregatedSig -
+func (mvs *MultiSigVerifierStub) SetAggregatedSig(sig []byte) error {
+	if mvs.SetAggregatedSigCalled != nil {
+		return mvs.SetAggregatedSigCalled(sig)
+	}
+
+	return nil
+}
+
+// Verify -
+func (mvs *MultiSigVerifierStub) Verify(msg []byte, bitmap []byte) error {
+	if mvs.VerifyCalled != nil {
+		return mvs.VerifyCalled(msg, bitmap)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (mvs *MultiSigVerifierStub) IsInterfaceNil() bool {
+	return mvs == nil
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// NodesConfigHandlerStub -
+type NodesConfigHandlerStub struct {
+	NodesConfigFromMetaBlockCalled func(currMetaBlock *block.MetaBlock, prevMetaBlock *block.MetaBlock) (*sharding.NodesCoordinatorRegistry, uint32, error)
+	NodesCoordinatorCalled         func() sharding.NodesCoordinator
+}
+
+// NodesConfigFromMetaBlock -
+func (nchs *NodesConfigHandlerStub) NodesConfigFromMetaBlock(currMetaBlock *block.MetaBlock, prevMetaBlock *block.MetaBlock) (*sharding.NodesCoordinatorRegistry, uint32, error) {
+	if nchs.NodesConfigFromMetaBlockCalled != nil {
+		return nchs.NodesConfigFromMetaBlockCalled(currMetaBlock, prevMetaBlock)
+	}
+
+	return &sharding.NodesCoordinatorRegistry{}, 0, nil
+}
+
+// NodesCoordinator -
+func (nchs *NodesConfigHandlerStub) NodesCoordinator() sharding.NodesCoordinator {
+	if nchs.NodesCoordinatorCalled != nil {
+		return nchs.NodesCoordinatorCalled()
+	}
+
+	return &NodesCoordinatorStub{}
+}
+
+// IsInterfaceNil -
+func (nchs *NodesConfigHandlerStub) IsInterfaceNil() bool {
+	return nchs == nil
+}
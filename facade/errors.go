@@ -25,3 +25,9 @@ var ErrNilAccountState = errors.New("nil account state")
 
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
+
+// ErrNilScheduledActivationsHandler signals that a nil scheduled activations handler has been provided
+var ErrNilScheduledActivationsHandler = errors.New("nil scheduled activations handler")
+
+// ErrNilHealthReportHandler signals that a nil health report handler has been provided
+var ErrNilHealthReportHandler = errors.New("nil health report handler")
@@ -77,6 +77,18 @@ type NodeHandler interface {
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+
+	GetEpochEconomics(epoch uint32) (*api.EpochEconomics, error)
+	GetEpochRewards(epoch uint32) (*api.EpochRewards, error)
+
+	GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error)
+
+	GetConsensusRounds() []*api.ConsensusRound
+
+	GetAntifloodStatus() (*api.AntifloodStatus, error)
+	SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error
+	UnbanPeer(pid string) error
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -94,6 +106,19 @@ type ApiResolver interface {
 	IsInterfaceNil() bool
 }
 
+// ScheduledActivationsHandler defines the component able to provide the protocol activations scheduled by governance
+type ScheduledActivationsHandler interface {
+	GetScheduledActivations() []*api.ScheduledActivation
+	IsInterfaceNil() bool
+}
+
+// HealthReportHandler defines the component able to run the node's liveness and readiness checks
+type HealthReportHandler interface {
+	GetLivenessReport() *api.HealthReport
+	GetReadinessReport() *api.HealthReport
+	IsInterfaceNil() bool
+}
+
 // HardforkTrigger defines the structure used to trigger hardforks
 type HardforkTrigger interface {
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// HealthReportHandlerStub -
+type HealthReportHandlerStub struct {
+	GetLivenessReportCalled  func() *api.HealthReport
+	GetReadinessReportCalled func() *api.HealthReport
+}
+
+// GetLivenessReport -
+func (hrhs *HealthReportHandlerStub) GetLivenessReport() *api.HealthReport {
+	if hrhs.GetLivenessReportCalled != nil {
+		return hrhs.GetLivenessReportCalled()
+	}
+
+	return &api.HealthReport{Status: api.HealthStatusHealthy}
+}
+
+// GetReadinessReport -
+func (hrhs *HealthReportHandlerStub) GetReadinessReport() *api.HealthReport {
+	if hrhs.GetReadinessReportCalled != nil {
+		return hrhs.GetReadinessReportCalled()
+	}
+
+	return &api.HealthReport{Status: api.HealthStatusHealthy}
+}
+
+// IsInterfaceNil -
+func (hrhs *HealthReportHandlerStub) IsInterfaceNil() bool {
+	return hrhs == nil
+}
@@ -43,6 +43,14 @@ type NodeStub struct {
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
 	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
+	GetEpochEconomicsCalled                        func(epoch uint32) (*api.EpochEconomics, error)
+	GetEpochRewardsCalled                          func(epoch uint32) (*api.EpochRewards, error)
+	GetLogsCalled                                  func(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error)
+	GetConsensusRoundsCalled                       func() []*api.ConsensusRound
+	GetAntifloodStatusCalled                       func() (*api.AntifloodStatus, error)
+	SetAntifloodLimitsCalled                       func(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicLimitCalled                   func(topic string, maxMessagesPerPeer uint32) error
+	UnbanPeerCalled                                func(pid string) error
 }
 
 // GetUsername -
@@ -87,6 +95,78 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, err
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetEpochEconomics -
+func (ns *NodeStub) GetEpochEconomics(epoch uint32) (*api.EpochEconomics, error) {
+	if ns.GetEpochEconomicsCalled != nil {
+		return ns.GetEpochEconomicsCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetEpochRewards -
+func (ns *NodeStub) GetEpochRewards(epoch uint32) (*api.EpochRewards, error) {
+	if ns.GetEpochRewardsCalled != nil {
+		return ns.GetEpochRewardsCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetLogs -
+func (ns *NodeStub) GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error) {
+	if ns.GetLogsCalled != nil {
+		return ns.GetLogsCalled(address, identifier, fromNonce, toNonce)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRounds -
+func (ns *NodeStub) GetConsensusRounds() []*api.ConsensusRound {
+	if ns.GetConsensusRoundsCalled != nil {
+		return ns.GetConsensusRoundsCalled()
+	}
+
+	return make([]*api.ConsensusRound, 0)
+}
+
+// GetAntifloodStatus -
+func (ns *NodeStub) GetAntifloodStatus() (*api.AntifloodStatus, error) {
+	if ns.GetAntifloodStatusCalled != nil {
+		return ns.GetAntifloodStatusCalled()
+	}
+
+	return &api.AntifloodStatus{}, nil
+}
+
+// SetAntifloodLimits -
+func (ns *NodeStub) SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if ns.SetAntifloodLimitsCalled != nil {
+		return ns.SetAntifloodLimitsCalled(floodPreventer, maxMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return nil
+}
+
+// SetAntifloodTopicLimit -
+func (ns *NodeStub) SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error {
+	if ns.SetAntifloodTopicLimitCalled != nil {
+		return ns.SetAntifloodTopicLimitCalled(topic, maxMessagesPerPeer)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (ns *NodeStub) UnbanPeer(pid string) error {
+	if ns.UnbanPeerCalled != nil {
+		return ns.UnbanPeerCalled(pid)
+	}
+
+	return nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// ScheduledActivationsHandlerStub -
+type ScheduledActivationsHandlerStub struct {
+	GetScheduledActivationsCalled func() []*api.ScheduledActivation
+}
+
+// GetScheduledActivations -
+func (sahs *ScheduledActivationsHandlerStub) GetScheduledActivations() []*api.ScheduledActivation {
+	if sahs.GetScheduledActivationsCalled != nil {
+		return sahs.GetScheduledActivationsCalled()
+	}
+
+	return make([]*api.ScheduledActivation, 0)
+}
+
+// IsInterfaceNil -
+func (sahs *ScheduledActivationsHandlerStub) IsInterfaceNil() bool {
+	return sahs == nil
+}
@@ -65,6 +65,8 @@ type ArgNodeFacade struct {
 	ApiRoutesConfig        config.ApiRoutesConfig
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
+	ScheduledActivations   ScheduledActivationsHandler
+	HealthReports          HealthReportHandler
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -81,6 +83,8 @@ type nodeFacade struct {
 	restAPIServerDebugMode bool
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
+	scheduledActivations   ScheduledActivationsHandler
+	healthReports          HealthReportHandler
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -114,6 +118,12 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.PeerState) {
 		return nil, ErrNilPeerState
 	}
+	if check.IfNil(arg.ScheduledActivations) {
+		return nil, ErrNilScheduledActivationsHandler
+	}
+	if check.IfNil(arg.HealthReports) {
+		return nil, ErrNilHealthReportHandler
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -128,6 +138,8 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		endpointsThrottlers:    throttlersMap,
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
+		scheduledActivations:   arg.ScheduledActivations,
+		healthReports:          arg.HealthReports,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -396,6 +408,21 @@ func (nf *nodeFacade) IsSelfTrigger() bool {
 	return nf.node.IsSelfTrigger()
 }
 
+// GetScheduledActivations returns the protocol activations scheduled by the accepted governance proposals
+func (nf *nodeFacade) GetScheduledActivations() []*apiData.ScheduledActivation {
+	return nf.scheduledActivations.GetScheduledActivations()
+}
+
+// GetLivenessReport returns the results of the checks telling whether the node works at all
+func (nf *nodeFacade) GetLivenessReport() *apiData.HealthReport {
+	return nf.healthReports.GetLivenessReport()
+}
+
+// GetReadinessReport returns the results of the checks telling whether the node is able to serve requests
+func (nf *nodeFacade) GetReadinessReport() *apiData.HealthReport {
+	return nf.healthReports.GetReadinessReport()
+}
+
 // EncodeAddressPubkey will encode the provided address public key bytes to string
 func (nf *nodeFacade) EncodeAddressPubkey(pk []byte) (string, error) {
 	return nf.node.EncodeAddressPubkey(pk)
@@ -434,6 +461,46 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetEpochEconomics returns the economics values computed at the start of the provided epoch
+func (nf *nodeFacade) GetEpochEconomics(epoch uint32) (*apiData.EpochEconomics, error) {
+	return nf.node.GetEpochEconomics(epoch)
+}
+
+// GetEpochRewards returns the rewards computed for each node and reward address at the start of the provided epoch
+func (nf *nodeFacade) GetEpochRewards(epoch uint32) (*apiData.EpochRewards, error) {
+	return nf.node.GetEpochRewards(epoch)
+}
+
+// GetLogs returns the events generated in the provided range of blocks that match the address and identifier
+func (nf *nodeFacade) GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*apiData.LogEvent, error) {
+	return nf.node.GetLogs(address, identifier, fromNonce, toNonce)
+}
+
+// GetConsensusRounds returns the timeline of the last consensus rounds, as seen by the current node
+func (nf *nodeFacade) GetConsensusRounds() []*apiData.ConsensusRound {
+	return nf.node.GetConsensusRounds()
+}
+
+// GetAntifloodStatus returns the per-peer and per-topic counters of the input antiflood and the blacklisted peers
+func (nf *nodeFacade) GetAntifloodStatus() (*apiData.AntifloodStatus, error) {
+	return nf.node.GetAntifloodStatus()
+}
+
+// SetAntifloodLimits changes, at runtime, the limits of the provided input antiflood flood preventer
+func (nf *nodeFacade) SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	return nf.node.SetAntifloodLimits(floodPreventer, maxMessagesPerPeer, maxTotalSizePerPeer)
+}
+
+// SetAntifloodTopicLimit changes, at runtime, the maximum number of messages accepted from a peer on a topic
+func (nf *nodeFacade) SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error {
+	return nf.node.SetAntifloodTopicLimit(topic, maxMessagesPerPeer)
+}
+
+// UnbanPeer removes the provided peer from the antiflood black list
+func (nf *nodeFacade) UnbanPeer(pid string) error {
+	return nf.node.UnbanPeer(pid)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	apiData "github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -49,8 +50,10 @@ func createMockArguments() ArgNodeFacade {
 				},
 			},
 		}},
-		AccountsState: &mock.AccountsStub{},
-		PeerState:     &mock.AccountsStub{},
+		AccountsState:        &mock.AccountsStub{},
+		PeerState:            &mock.AccountsStub{},
+		ScheduledActivations: &mock.ScheduledActivationsHandlerStub{},
+		HealthReports:        &mock.HealthReportHandlerStub{},
 	}
 }
 
@@ -78,6 +81,28 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilScheduledActivationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ScheduledActivations = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilScheduledActivationsHandler, err)
+}
+
+func TestNewNodeFacade_WithNilHealthReportsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.HealthReports = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilHealthReportHandler, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -550,6 +575,48 @@ func TestNodeFacade_IsSelfTrigger(t *testing.T) {
 	assert.True(t, isSelf)
 }
 
+func TestNodeFacade_GetScheduledActivations(t *testing.T) {
+	t.Parallel()
+
+	activations := []*apiData.ScheduledActivation{
+		{Name: "HardFork", Epoch: 10},
+	}
+	arg := createMockArguments()
+	arg.ScheduledActivations = &mock.ScheduledActivationsHandlerStub{
+		GetScheduledActivationsCalled: func() []*apiData.ScheduledActivation {
+			return activations
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, activations, nf.GetScheduledActivations())
+}
+
+func TestNodeFacade_GetHealthReports(t *testing.T) {
+	t.Parallel()
+
+	livenessReport := &apiData.HealthReport{Status: apiData.HealthStatusHealthy}
+	readinessReport := &apiData.HealthReport{
+		Status: apiData.HealthStatusUnhealthy,
+		Checks: []*apiData.HealthCheckResult{
+			{Name: "syncStatus", Status: apiData.HealthStatusUnhealthy, Message: "node is syncing"},
+		},
+	}
+	arg := createMockArguments()
+	arg.HealthReports = &mock.HealthReportHandlerStub{
+		GetLivenessReportCalled: func() *apiData.HealthReport {
+			return livenessReport
+		},
+		GetReadinessReportCalled: func() *apiData.HealthReport {
+			return readinessReport
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, livenessReport, nf.GetLivenessReport())
+	assert.Equal(t, readinessReport, nf.GetReadinessReport())
+}
+
 func TestNodeFacade_EncodeDecodeAddressPubkey(t *testing.T) {
 	t.Parallel()
 
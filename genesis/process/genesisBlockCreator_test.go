@@ -50,6 +50,7 @@ func createMockArgument(
 
 	arg := ArgsGenesisBlockCreator{
 		GenesisTime:              0,
+		ChainID:                  "chain ID",
 		StartEpochNum:            0,
 		PubkeyConv:               mock.NewPubkeyConverterMock(32),
 		Blkc:                     &mock.BlockChainStub{},
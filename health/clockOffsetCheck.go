@@ -0,0 +1,54 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const clockOffsetCheckName = "ntpClockOffset"
+
+type clockOffsetCheck struct {
+	syncer         ClockOffsetProvider
+	maxClockOffset time.Duration
+}
+
+// NewClockOffsetCheck creates a check that fails when the local clock drifts too much from the NTP servers, as the
+// node would then propose and validate blocks in the wrong rounds
+func NewClockOffsetCheck(syncer ClockOffsetProvider, maxClockOffset time.Duration) (*clockOffsetCheck, error) {
+	if check.IfNil(syncer) {
+		return nil, errNilClockOffsetProvider
+	}
+	if maxClockOffset <= 0 {
+		return nil, fmt.Errorf("%w for the maximum clock offset: %v", errInvalidValue, maxClockOffset)
+	}
+
+	return &clockOffsetCheck{
+		syncer:         syncer,
+		maxClockOffset: maxClockOffset,
+	}, nil
+}
+
+// Name returns the name of the check
+func (coc *clockOffsetCheck) Name() string {
+	return clockOffsetCheckName
+}
+
+// CheckHealth returns an error if the absolute clock offset exceeds the maximum
+func (coc *clockOffsetCheck) CheckHealth() error {
+	offset := coc.syncer.ClockOffset()
+	if offset < 0 {
+		offset = -offset
+	}
+	if offset > coc.maxClockOffset {
+		return fmt.Errorf("%w: %v, maximum %v", errClockOffsetTooHigh, coc.syncer.ClockOffset(), coc.maxClockOffset)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (coc *clockOffsetCheck) IsInterfaceNil() bool {
+	return coc == nil
+}
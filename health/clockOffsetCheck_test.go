@@ -0,0 +1,31 @@
+package health
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClockOffsetCheck_InvalidArgumentsShouldErr(t *testing.T) {
+	coc, err := NewClockOffsetCheck(nil, time.Second)
+	require.Nil(t, coc)
+	require.Equal(t, errNilClockOffsetProvider, err)
+
+	coc, err = NewClockOffsetCheck(&dummyClockOffsetProvider{}, 0)
+	require.Nil(t, coc)
+	require.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestClockOffsetCheck_CheckHealth(t *testing.T) {
+	syncer := &dummyClockOffsetProvider{offset: time.Second}
+	coc, _ := NewClockOffsetCheck(syncer, time.Second)
+	require.Nil(t, coc.CheckHealth())
+
+	syncer.offset = -time.Second - time.Millisecond
+	require.True(t, errors.Is(coc.CheckHealth(), errClockOffsetTooHigh))
+
+	syncer.offset = time.Second + time.Millisecond
+	require.True(t, errors.Is(coc.CheckHealth(), errClockOffsetTooHigh))
+}
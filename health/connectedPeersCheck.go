@@ -0,0 +1,46 @@
+package health
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const connectedPeersCheckName = "connectedPeers"
+
+type connectedPeersCheck struct {
+	peersProvider     ConnectedPeersProvider
+	minConnectedPeers int
+}
+
+// NewConnectedPeersCheck creates a check that fails while the node is connected to fewer peers than the minimum
+func NewConnectedPeersCheck(peersProvider ConnectedPeersProvider, minConnectedPeers uint32) (*connectedPeersCheck, error) {
+	if check.IfNil(peersProvider) {
+		return nil, errNilConnectedPeersProvider
+	}
+
+	return &connectedPeersCheck{
+		peersProvider:     peersProvider,
+		minConnectedPeers: int(minConnectedPeers),
+	}, nil
+}
+
+// Name returns the name of the check
+func (cpc *connectedPeersCheck) Name() string {
+	return connectedPeersCheckName
+}
+
+// CheckHealth returns an error if the number of connected peers is below the minimum
+func (cpc *connectedPeersCheck) CheckHealth() error {
+	numConnectedPeers := len(cpc.peersProvider.ConnectedPeers())
+	if numConnectedPeers < cpc.minConnectedPeers {
+		return fmt.Errorf("%w: connected to %d, minimum %d", errNotEnoughConnectedPeers, numConnectedPeers, cpc.minConnectedPeers)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cpc *connectedPeersCheck) IsInterfaceNil() bool {
+	return cpc == nil
+}
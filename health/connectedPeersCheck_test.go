@@ -0,0 +1,26 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnectedPeersCheck_NilProviderShouldErr(t *testing.T) {
+	cpc, err := NewConnectedPeersCheck(nil, 1)
+
+	require.Nil(t, cpc)
+	require.Equal(t, errNilConnectedPeersProvider, err)
+}
+
+func TestConnectedPeersCheck_CheckHealth(t *testing.T) {
+	peersProvider := &dummyPeersProvider{peers: []core.PeerID{"peer1"}}
+	cpc, _ := NewConnectedPeersCheck(peersProvider, 2)
+
+	require.True(t, errors.Is(cpc.CheckHealth(), errNotEnoughConnectedPeers))
+
+	peersProvider.peers = append(peersProvider.peers, "peer2")
+	require.Nil(t, cpc.CheckHealth())
+}
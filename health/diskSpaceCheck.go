@@ -0,0 +1,63 @@
+package health
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/shirou/gopsutil/disk"
+)
+
+const diskSpaceCheckName = "diskSpace"
+
+type diskSpaceCheck struct {
+	path                string
+	minFreeSpaceInBytes uint64
+	getFreeSpace        func(path string) (uint64, error)
+}
+
+// NewDiskSpaceCheck creates a check that fails when the free space of the disk holding the provided path drops
+// below the minimum, as the node can not save its databases anymore
+func NewDiskSpaceCheck(path string, minFreeSpaceInBytes uint64) (*diskSpaceCheck, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%w for the disk space check path", errInvalidValue)
+	}
+
+	return &diskSpaceCheck{
+		path:                path,
+		minFreeSpaceInBytes: minFreeSpaceInBytes,
+		getFreeSpace:        getFreeDiskSpace,
+	}, nil
+}
+
+func getFreeDiskSpace(path string) (uint64, error) {
+	usage, err := disk.Usage(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return usage.Free, nil
+}
+
+// Name returns the name of the check
+func (dsc *diskSpaceCheck) Name() string {
+	return diskSpaceCheckName
+}
+
+// CheckHealth returns an error if the free disk space can not be read or if it is below the minimum
+func (dsc *diskSpaceCheck) CheckHealth() error {
+	freeSpace, err := dsc.getFreeSpace(dsc.path)
+	if err != nil {
+		return err
+	}
+	if freeSpace < dsc.minFreeSpaceInBytes {
+		return fmt.Errorf("%w: %s available, minimum %s", errNotEnoughDiskSpace,
+			core.ConvertBytes(freeSpace), core.ConvertBytes(dsc.minFreeSpaceInBytes))
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsc *diskSpaceCheck) IsInterfaceNil() bool {
+	return dsc == nil
+}
@@ -0,0 +1,41 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDiskSpaceCheck_EmptyPathShouldErr(t *testing.T) {
+	dsc, err := NewDiskSpaceCheck("", 1)
+
+	require.Nil(t, dsc)
+	require.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestDiskSpaceCheck_CheckHealth(t *testing.T) {
+	dsc, _ := NewDiskSpaceCheck(".", 100)
+
+	expectedErr := errors.New("expected error")
+	dsc.getFreeSpace = func(path string) (uint64, error) {
+		return 0, expectedErr
+	}
+	require.Equal(t, expectedErr, dsc.CheckHealth())
+
+	dsc.getFreeSpace = func(path string) (uint64, error) {
+		return 99, nil
+	}
+	require.True(t, errors.Is(dsc.CheckHealth(), errNotEnoughDiskSpace))
+
+	dsc.getFreeSpace = func(path string) (uint64, error) {
+		return 100, nil
+	}
+	require.Nil(t, dsc.CheckHealth())
+}
+
+func TestDiskSpaceCheck_CheckHealthOnRealDiskShouldWork(t *testing.T) {
+	dsc, _ := NewDiskSpaceCheck(".", 0)
+
+	require.Nil(t, dsc.CheckHealth())
+}
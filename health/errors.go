@@ -6,3 +6,18 @@ import (
 
 var errNilComponent = errors.New("component is nil")
 var errNotDiagnosableComponent = errors.New("component is not diagnosable")
+var errNilHealthChecker = errors.New("nil health checker")
+var errInvalidValue = errors.New("invalid value")
+var errNilStatusMetrics = errors.New("nil status metrics provider")
+var errNilConnectedPeersProvider = errors.New("nil connected peers provider")
+var errNilChainHandler = errors.New("nil chain handler")
+var errNilTrieStorageManager = errors.New("nil trie storage manager")
+var errNilPool = errors.New("nil pool")
+var errNilClockOffsetProvider = errors.New("nil clock offset provider")
+var errNodeIsSyncing = errors.New("node is syncing")
+var errSyncStatusUnavailable = errors.New("sync status is not available yet")
+var errNotEnoughConnectedPeers = errors.New("not enough connected peers")
+var errNotEnoughDiskSpace = errors.New("not enough free disk space")
+var errStateRootNotFound = errors.New("state root of the last committed block not found in the trie storage")
+var errPoolSaturated = errors.New("pool is saturated")
+var errClockOffsetTooHigh = errors.New("clock offset is too high")
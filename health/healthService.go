@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger/check"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 )
 
 var log = logger.GetOrCreate("health")
@@ -23,6 +24,9 @@ type healthService struct {
 	records                             *records
 	diagnosableComponents               []diagnosable
 	diagnosableComponentsMutex          sync.RWMutex
+	livenessChecks                      []HealthChecker
+	readinessChecks                     []HealthChecker
+	mutChecks                           sync.RWMutex
 	clock                               clock
 	memory                              memory
 	onMonitorContinuouslyBeginIteration func()
@@ -42,6 +46,8 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 		cancelFunction:                      func() {},
 		records:                             recordsObj,
 		diagnosableComponents:               make([]diagnosable, 0),
+		livenessChecks:                      make([]HealthChecker, 0),
+		readinessChecks:                     make([]HealthChecker, 0),
 		clock:                               &realClock{},
 		memory:                              &realMemory{},
 		onMonitorContinuouslyBeginIteration: func() {},
@@ -72,6 +78,80 @@ func (h *healthService) doRegisterComponent(component interface{}) error {
 	return nil
 }
 
+// RegisterLivenessCheck registers a check telling whether the node works at all. The liveness checks are also part of
+// the readiness report
+func (h *healthService) RegisterLivenessCheck(checker HealthChecker) error {
+	if check.IfNil(checker) {
+		return errNilHealthChecker
+	}
+
+	h.mutChecks.Lock()
+	h.livenessChecks = append(h.livenessChecks, checker)
+	h.mutChecks.Unlock()
+
+	return nil
+}
+
+// RegisterReadinessCheck registers a check telling whether the node is able to serve requests
+func (h *healthService) RegisterReadinessCheck(checker HealthChecker) error {
+	if check.IfNil(checker) {
+		return errNilHealthChecker
+	}
+
+	h.mutChecks.Lock()
+	h.readinessChecks = append(h.readinessChecks, checker)
+	h.mutChecks.Unlock()
+
+	return nil
+}
+
+// GetLivenessReport runs the liveness checks and returns their results
+func (h *healthService) GetLivenessReport() *api.HealthReport {
+	h.mutChecks.RLock()
+	checks := make([]HealthChecker, 0, len(h.livenessChecks))
+	checks = append(checks, h.livenessChecks...)
+	h.mutChecks.RUnlock()
+
+	return runChecks(checks)
+}
+
+// GetReadinessReport runs both the liveness and the readiness checks and returns their results
+func (h *healthService) GetReadinessReport() *api.HealthReport {
+	h.mutChecks.RLock()
+	checks := make([]HealthChecker, 0, len(h.livenessChecks)+len(h.readinessChecks))
+	checks = append(checks, h.livenessChecks...)
+	checks = append(checks, h.readinessChecks...)
+	h.mutChecks.RUnlock()
+
+	return runChecks(checks)
+}
+
+func runChecks(checks []HealthChecker) *api.HealthReport {
+	report := &api.HealthReport{
+		Status: api.HealthStatusHealthy,
+		Checks: make([]*api.HealthCheckResult, 0, len(checks)),
+	}
+
+	for _, checker := range checks {
+		result := &api.HealthCheckResult{
+			Name:   checker.Name(),
+			Status: api.HealthStatusHealthy,
+		}
+
+		err := checker.CheckHealth()
+		if err != nil {
+			result.Status = api.HealthStatusUnhealthy
+			result.Message = err.Error()
+			report.Status = api.HealthStatusUnhealthy
+			log.Debug("health check failed", "check", result.Name, "error", result.Message)
+		}
+
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
 // Start starts the health service
 func (h *healthService) Start() {
 	log.Info("healthService.Start()")
@@ -2,9 +2,11 @@ package health
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/stretchr/testify/require"
 )
 
@@ -105,6 +107,56 @@ func TestHealthService_MonitorContinuously(t *testing.T) {
 	require.Equal(t, 2, int(a.numDeepDiagnoses.Get()))
 }
 
+func TestHealthService_RegisterChecks_NilCheckerShouldErr(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	err := h.RegisterLivenessCheck(nil)
+	require.Equal(t, errNilHealthChecker, err)
+
+	err = h.RegisterReadinessCheck((*dummyHealthChecker)(nil))
+	require.Equal(t, errNilHealthChecker, err)
+}
+
+func TestHealthService_GetReportsWithoutChecksShouldBeHealthy(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	require.Equal(t, api.HealthStatusHealthy, h.GetLivenessReport().Status)
+	require.Equal(t, api.HealthStatusHealthy, h.GetReadinessReport().Status)
+	require.Empty(t, h.GetReadinessReport().Checks)
+}
+
+func TestHealthService_GetLivenessReportShouldOnlyRunLivenessChecks(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	liveness := &dummyHealthChecker{name: "liveness"}
+	readiness := &dummyHealthChecker{name: "readiness", err: errors.New("not ready")}
+	_ = h.RegisterLivenessCheck(liveness)
+	_ = h.RegisterReadinessCheck(readiness)
+
+	report := h.GetLivenessReport()
+
+	require.Equal(t, api.HealthStatusHealthy, report.Status)
+	require.Equal(t, []*api.HealthCheckResult{{Name: "liveness", Status: api.HealthStatusHealthy}}, report.Checks)
+	require.Equal(t, 1, int(liveness.numChecks.Get()))
+	require.Equal(t, 0, int(readiness.numChecks.Get()))
+}
+
+func TestHealthService_GetReadinessReportShouldRunAllChecks(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	_ = h.RegisterLivenessCheck(&dummyHealthChecker{name: "liveness"})
+	_ = h.RegisterReadinessCheck(&dummyHealthChecker{name: "readiness", err: errors.New("not ready")})
+
+	report := h.GetReadinessReport()
+
+	expectedChecks := []*api.HealthCheckResult{
+		{Name: "liveness", Status: api.HealthStatusHealthy},
+		{Name: "readiness", Status: api.HealthStatusUnhealthy, Message: "not ready"},
+	}
+	require.Equal(t, api.HealthStatusUnhealthy, report.Status)
+	require.Equal(t, expectedChecks, report.Checks)
+}
+
 func newHealthServiceToTest(highMemory int, intervalBase int) *healthService {
 	return NewHealthService(
 		config.HealthServiceConfig{
@@ -3,8 +3,43 @@ package health
 import (
 	"runtime"
 	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/counting"
 )
 
+// HealthChecker defines a check run each time the node's liveness or readiness is queried. A nil error means healthy,
+// otherwise the error describes the issue found
+type HealthChecker interface {
+	Name() string
+	CheckHealth() error
+	IsInterfaceNil() bool
+}
+
+// StatusMetricsProvider defines the component able to return the node's status metrics
+type StatusMetricsProvider interface {
+	StatusMetricsMapWithoutP2P() map[string]interface{}
+	IsInterfaceNil() bool
+}
+
+// ConnectedPeersProvider defines the component able to return the peers the node is connected to
+type ConnectedPeersProvider interface {
+	ConnectedPeers() []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// PoolCountsProvider defines a data pool able to return the number and the total size of the contained elements
+type PoolCountsProvider interface {
+	GetCounts() counting.CountsWithSize
+	IsInterfaceNil() bool
+}
+
+// ClockOffsetProvider defines the component able to return the offset between the local clock and the NTP servers
+type ClockOffsetProvider interface {
+	ClockOffset() time.Duration
+	IsInterfaceNil() bool
+}
+
 // diagnosable is an internal interface, which external components can implement in order to be "diagnosed" by the health service
 type diagnosable interface {
 	Diagnose(deep bool)
@@ -0,0 +1,82 @@
+package health
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const maxPercent = 100
+
+// ArgsPoolSaturationCheck is the DTO used to create a new pool saturation check
+type ArgsPoolSaturationCheck struct {
+	Name                 string
+	Pool                 PoolCountsProvider
+	Capacity             uint32
+	SizeInBytes          uint64
+	MaxSaturationPercent uint32
+}
+
+type poolSaturationCheck struct {
+	name                 string
+	pool                 PoolCountsProvider
+	capacity             uint64
+	sizeInBytes          uint64
+	maxSaturationPercent uint64
+}
+
+// NewPoolSaturationCheck creates a check that fails when the number of elements or the size of a pool reaches the
+// provided percent of its configured capacity, as the pool starts evicting the elements it receives
+func NewPoolSaturationCheck(args ArgsPoolSaturationCheck) (*poolSaturationCheck, error) {
+	if check.IfNil(args.Pool) {
+		return nil, errNilPool
+	}
+	if len(args.Name) == 0 {
+		return nil, fmt.Errorf("%w for the pool saturation check name", errInvalidValue)
+	}
+	if args.Capacity == 0 || args.SizeInBytes == 0 {
+		return nil, fmt.Errorf("%w for the pool capacity of %s", errInvalidValue, args.Name)
+	}
+	if args.MaxSaturationPercent == 0 || args.MaxSaturationPercent > maxPercent {
+		return nil, fmt.Errorf("%w for the maximum saturation percent of %s: %d",
+			errInvalidValue, args.Name, args.MaxSaturationPercent)
+	}
+
+	return &poolSaturationCheck{
+		name:                 args.Name,
+		pool:                 args.Pool,
+		capacity:             uint64(args.Capacity),
+		sizeInBytes:          args.SizeInBytes,
+		maxSaturationPercent: uint64(args.MaxSaturationPercent),
+	}, nil
+}
+
+// Name returns the name of the check
+func (psc *poolSaturationCheck) Name() string {
+	return psc.name
+}
+
+// CheckHealth returns an error if either the number of elements or the size of the pool is too close to the capacity
+func (psc *poolSaturationCheck) CheckHealth() error {
+	counts := psc.pool.GetCounts()
+	if check.IfNil(counts) {
+		return nil
+	}
+
+	numElements := uint64(counts.GetTotal())
+	if numElements*maxPercent >= psc.capacity*psc.maxSaturationPercent {
+		return fmt.Errorf("%w: %d elements, capacity %d", errPoolSaturated, numElements, psc.capacity)
+	}
+
+	size := uint64(counts.GetTotalSize())
+	if size*maxPercent >= psc.sizeInBytes*psc.maxSaturationPercent {
+		return fmt.Errorf("%w: %d bytes, capacity %d bytes", errPoolSaturated, size, psc.sizeInBytes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psc *poolSaturationCheck) IsInterfaceNil() bool {
+	return psc == nil
+}
@@ -0,0 +1,52 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPoolSaturationCheck() ArgsPoolSaturationCheck {
+	return ArgsPoolSaturationCheck{
+		Name:                 "txPool",
+		Pool:                 newDummyPool(0, 0),
+		Capacity:             100,
+		SizeInBytes:          1000,
+		MaxSaturationPercent: 90,
+	}
+}
+
+func TestNewPoolSaturationCheck_InvalidArgumentsShouldErr(t *testing.T) {
+	args := createMockArgsPoolSaturationCheck()
+	args.Pool = nil
+	psc, err := NewPoolSaturationCheck(args)
+	require.Nil(t, psc)
+	require.Equal(t, errNilPool, err)
+
+	args = createMockArgsPoolSaturationCheck()
+	args.Capacity = 0
+	_, err = NewPoolSaturationCheck(args)
+	require.True(t, errors.Is(err, errInvalidValue))
+
+	args = createMockArgsPoolSaturationCheck()
+	args.MaxSaturationPercent = 101
+	_, err = NewPoolSaturationCheck(args)
+	require.True(t, errors.Is(err, errInvalidValue))
+}
+
+func TestPoolSaturationCheck_CheckHealth(t *testing.T) {
+	args := createMockArgsPoolSaturationCheck()
+	psc, _ := NewPoolSaturationCheck(args)
+	require.Equal(t, "txPool", psc.Name())
+	require.Nil(t, psc.CheckHealth())
+
+	psc.pool = newDummyPool(89, 899)
+	require.Nil(t, psc.CheckHealth())
+
+	psc.pool = newDummyPool(90, 100)
+	require.True(t, errors.Is(psc.CheckHealth(), errPoolSaturated))
+
+	psc.pool = newDummyPool(10, 900)
+	require.True(t, errors.Is(psc.CheckHealth(), errPoolSaturated))
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const syncStatusCheckName = "syncStatus"
+
+type syncStatusCheck struct {
+	statusMetrics StatusMetricsProvider
+}
+
+// NewSyncStatusCheck creates a check that fails while the node is still syncing blocks, as reported by the
+// bootstrapper through the is-syncing status metric
+func NewSyncStatusCheck(statusMetrics StatusMetricsProvider) (*syncStatusCheck, error) {
+	if check.IfNil(statusMetrics) {
+		return nil, errNilStatusMetrics
+	}
+
+	return &syncStatusCheck{
+		statusMetrics: statusMetrics,
+	}, nil
+}
+
+// Name returns the name of the check
+func (ssc *syncStatusCheck) Name() string {
+	return syncStatusCheckName
+}
+
+// CheckHealth returns an error if the node is syncing or if it did not report its sync status yet
+func (ssc *syncStatusCheck) CheckHealth() error {
+	metrics := ssc.statusMetrics.StatusMetricsMapWithoutP2P()
+	isSyncing, ok := metrics[core.MetricIsSyncing].(uint64)
+	if !ok {
+		return errSyncStatusUnavailable
+	}
+	if isSyncing != 0 {
+		return errNodeIsSyncing
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssc *syncStatusCheck) IsInterfaceNil() bool {
+	return ssc == nil
+}
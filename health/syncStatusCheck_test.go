@@ -0,0 +1,28 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSyncStatusCheck_NilStatusMetricsShouldErr(t *testing.T) {
+	ssc, err := NewSyncStatusCheck(nil)
+
+	require.Nil(t, ssc)
+	require.Equal(t, errNilStatusMetrics, err)
+}
+
+func TestSyncStatusCheck_CheckHealth(t *testing.T) {
+	statusMetrics := &dummyStatusMetrics{metrics: make(map[string]interface{})}
+	ssc, _ := NewSyncStatusCheck(statusMetrics)
+
+	require.Equal(t, errSyncStatusUnavailable, ssc.CheckHealth())
+
+	statusMetrics.metrics[core.MetricIsSyncing] = uint64(1)
+	require.Equal(t, errNodeIsSyncing, ssc.CheckHealth())
+
+	statusMetrics.metrics[core.MetricIsSyncing] = uint64(0)
+	require.Nil(t, ssc.CheckHealth())
+}
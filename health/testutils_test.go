@@ -6,13 +6,22 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/counting"
+	"github.com/ElrondNetwork/elrond-go/data"
 )
 
 var _ record = (*dummyRecord)(nil)
 var _ diagnosable = (*dummyDiagnosable)(nil)
 var _ memory = (*dummyMemory)(nil)
 var _ clock = (*dummyClock)(nil)
+var _ HealthChecker = (*dummyHealthChecker)(nil)
+var _ StatusMetricsProvider = (*dummyStatusMetrics)(nil)
+var _ ConnectedPeersProvider = (*dummyPeersProvider)(nil)
+var _ PoolCountsProvider = (*dummyPool)(nil)
+var _ ClockOffsetProvider = (*dummyClockOffsetProvider)(nil)
+var _ data.StorageManager = (*dummyStorageManager)(nil)
 
 var dummySignal struct{}
 
@@ -157,3 +166,106 @@ func (dummy *dummyClock) getEventToHappenNoLock() (eventToHappen *dummyEvent, ev
 
 	return
 }
+
+type dummyHealthChecker struct {
+	name      string
+	err       error
+	numChecks atomic.Counter
+}
+
+// Name -
+func (dummy *dummyHealthChecker) Name() string {
+	return dummy.name
+}
+
+// CheckHealth -
+func (dummy *dummyHealthChecker) CheckHealth() error {
+	dummy.numChecks.Increment()
+	return dummy.err
+}
+
+// IsInterfaceNil -
+func (dummy *dummyHealthChecker) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyStatusMetrics struct {
+	metrics map[string]interface{}
+}
+
+// StatusMetricsMapWithoutP2P -
+func (dummy *dummyStatusMetrics) StatusMetricsMapWithoutP2P() map[string]interface{} {
+	return dummy.metrics
+}
+
+// IsInterfaceNil -
+func (dummy *dummyStatusMetrics) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyPeersProvider struct {
+	peers []core.PeerID
+}
+
+// ConnectedPeers -
+func (dummy *dummyPeersProvider) ConnectedPeers() []core.PeerID {
+	return dummy.peers
+}
+
+// IsInterfaceNil -
+func (dummy *dummyPeersProvider) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyPool struct {
+	counts *counting.ConcurrentShardedCountsWithSize
+}
+
+func newDummyPool(numElements int64, sizeInBytes int64) *dummyPool {
+	counts := counting.NewConcurrentShardedCountsWithSize()
+	counts.PutCounts("0", numElements, sizeInBytes)
+
+	return &dummyPool{
+		counts: counts,
+	}
+}
+
+// GetCounts -
+func (dummy *dummyPool) GetCounts() counting.CountsWithSize {
+	return dummy.counts
+}
+
+// IsInterfaceNil -
+func (dummy *dummyPool) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyClockOffsetProvider struct {
+	offset time.Duration
+}
+
+// ClockOffset -
+func (dummy *dummyClockOffsetProvider) ClockOffset() time.Duration {
+	return dummy.offset
+}
+
+// IsInterfaceNil -
+func (dummy *dummyClockOffsetProvider) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+// dummyStorageManager only implements the database getter, calling any other method panics
+type dummyStorageManager struct {
+	data.StorageManager
+	db data.DBWriteCacher
+}
+
+// Database -
+func (dummy *dummyStorageManager) Database() data.DBWriteCacher {
+	return dummy.db
+}
+
+// IsInterfaceNil -
+func (dummy *dummyStorageManager) IsInterfaceNil() bool {
+	return dummy == nil
+}
@@ -0,0 +1,74 @@
+package health
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+)
+
+const trieStorageCheckName = "trieStorage"
+
+type trieStorageCheck struct {
+	chainHandler data.ChainHandler
+	trieStorage  data.StorageManager
+}
+
+// NewTrieStorageCheck creates a check that fails when the state root of the last committed block can not be read
+// from the trie storage, meaning the node's state is either missing or not accessible anymore
+func NewTrieStorageCheck(chainHandler data.ChainHandler, trieStorage data.StorageManager) (*trieStorageCheck, error) {
+	if check.IfNil(chainHandler) {
+		return nil, errNilChainHandler
+	}
+	if check.IfNil(trieStorage) {
+		return nil, errNilTrieStorageManager
+	}
+
+	return &trieStorageCheck{
+		chainHandler: chainHandler,
+		trieStorage:  trieStorage,
+	}, nil
+}
+
+// Name returns the name of the check
+func (tsc *trieStorageCheck) Name() string {
+	return trieStorageCheckName
+}
+
+// CheckHealth returns an error if the root node of the last committed state can not be loaded. The empty state
+// has no stored root, so it is always considered healthy
+func (tsc *trieStorageCheck) CheckHealth() error {
+	header := tsc.chainHandler.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = tsc.chainHandler.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil
+	}
+
+	rootHash := header.GetRootHash()
+	if len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return nil
+	}
+
+	db := tsc.trieStorage.Database()
+	if check.IfNil(db) {
+		return errNilTrieStorageManager
+	}
+
+	_, err := db.Get(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w: block nonce %d, root hash %s, error %s",
+			errStateRootNotFound, header.GetNonce(), hex.EncodeToString(rootHash), err.Error())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsc *trieStorageCheck) IsInterfaceNil() bool {
+	return tsc == nil
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrieStorageCheck_NilArgumentsShouldErr(t *testing.T) {
+	tsc, err := NewTrieStorageCheck(nil, &dummyStorageManager{})
+	require.Nil(t, tsc)
+	require.Equal(t, errNilChainHandler, err)
+
+	tsc, err = NewTrieStorageCheck(blockchain.NewBlockChain(), nil)
+	require.Nil(t, tsc)
+	require.Equal(t, errNilTrieStorageManager, err)
+}
+
+func TestTrieStorageCheck_CheckHealthWithoutStoredStateShouldWork(t *testing.T) {
+	chain := blockchain.NewBlockChain()
+	tsc, _ := NewTrieStorageCheck(chain, &dummyStorageManager{db: memorydb.New()})
+
+	require.Nil(t, tsc.CheckHealth())
+
+	_ = chain.SetGenesisHeader(&block.Header{RootHash: trie.EmptyTrieHash})
+	require.Nil(t, tsc.CheckHealth())
+}
+
+func TestTrieStorageCheck_CheckHealth(t *testing.T) {
+	rootHash := []byte("root hash")
+	db := memorydb.New()
+	chain := blockchain.NewBlockChain()
+	_ = chain.SetGenesisHeader(&block.Header{RootHash: []byte("genesis root hash")})
+	tsc, _ := NewTrieStorageCheck(chain, &dummyStorageManager{db: db})
+
+	require.True(t, errors.Is(tsc.CheckHealth(), errStateRootNotFound))
+
+	_ = chain.SetCurrentBlockHeader(&block.Header{Nonce: 1, RootHash: rootHash})
+	require.True(t, errors.Is(tsc.CheckHealth(), errStateRootNotFound))
+
+	_ = db.Put(rootHash, []byte("root node"))
+	require.Nil(t, tsc.CheckHealth())
+}
@@ -0,0 +1,234 @@
+package componentHandler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/monitor"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/sender"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/peer"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// ArgHeartbeatV2 represents the heartbeat v2 creation argument
+type ArgHeartbeatV2 struct {
+	HeartbeatV2Config        config.HeartbeatV2Config
+	PrefsConfig              config.PreferencesConfig
+	Marshalizer              marshal.Marshalizer
+	Messenger                heartbeat.P2PMessenger
+	ShardCoordinator         sharding.Coordinator
+	NodesCoordinator         sharding.NodesCoordinator
+	EpochStartTrigger        sharding.EpochHandler
+	EpochStartRegistration   sharding.EpochStartEventNotifier
+	PeerSignatureHandler     crypto.PeerSignatureHandler
+	SingleSigner             crypto.SingleSigner
+	KeyGenerator             crypto.KeyGenerator
+	PrivKey                  crypto.PrivateKey
+	HardforkTrigger          heartbeat.HardforkTrigger
+	AntifloodHandler         heartbeat.P2PAntifloodHandler
+	ValidatorPubkeyConverter core.PubkeyConverter
+	Timer                    heartbeat.Timer
+	VersionNumber            string
+	PeerShardMapper          heartbeat.NetworkShardingCollector
+	SizeCheckDelta           uint32
+	CurrentBlockProvider     heartbeat.CurrentBlockProvider
+	RedundancyHandler        heartbeat.NodeRedundancyHandler
+}
+
+// HeartbeatV2Handler is the struct used to manage the heartbeat v2 subsystem consisting of a sender, the message
+// processors for the peer authentication and heartbeat v2 topics and a monitor aggregating the received messages
+type HeartbeatV2Handler struct {
+	monitor *monitor.HeartbeatV2Monitor
+	sender  *sender.Sender
+}
+
+// NewHeartbeatV2Handler will create a heartbeat v2 handler containing the sender, the processors and the monitor
+func NewHeartbeatV2Handler(arg ArgHeartbeatV2) (*HeartbeatV2Handler, error) {
+	if check.IfNil(arg.Messenger) {
+		return nil, heartbeat.ErrNilMessenger
+	}
+	if check.IfNil(arg.ShardCoordinator) {
+		return nil, heartbeat.ErrNilShardCoordinator
+	}
+	if check.IfNil(arg.EpochStartTrigger) {
+		return nil, heartbeat.ErrNilEpochStartTrigger
+	}
+
+	cfg := arg.HeartbeatV2Config
+	heartbeatTopic := core.HeartbeatV2Topic + arg.ShardCoordinator.CommunicationIdentifier(arg.ShardCoordinator.SelfId())
+	err := createTopicsIfNeeded(arg.Messenger, core.PeerAuthenticationTopic, heartbeatTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	peerAuthenticationCacher, err := createCacher(cfg.PeerAuthenticationPool)
+	if err != nil {
+		return nil, err
+	}
+	heartbeatCacher, err := createCacher(cfg.HeartbeatPool)
+	if err != nil {
+		return nil, err
+	}
+
+	netInputMarshalizer := arg.Marshalizer
+	if arg.SizeCheckDelta > 0 {
+		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(arg.Marshalizer, arg.SizeCheckDelta)
+	}
+
+	maxDurationPeerUnresponsive := time.Second * time.Duration(cfg.MaxDurationPeerUnresponsiveInSec)
+	verifier, err := process.NewPeerAuthenticationVerifier(arg.PeerSignatureHandler, arg.SingleSigner, arg.KeyGenerator)
+	if err != nil {
+		return nil, err
+	}
+
+	argPeerAuthenticationProcessor := process.ArgPeerAuthenticationMessageProcessor{
+		ArgBaseV2MessageProcessor: process.ArgBaseV2MessageProcessor{
+			Marshalizer:                 netInputMarshalizer,
+			AntifloodHandler:            arg.AntifloodHandler,
+			Cacher:                      peerAuthenticationCacher,
+			Timer:                       arg.Timer,
+			Topic:                       core.PeerAuthenticationTopic,
+			MaxDurationPeerUnresponsive: maxDurationPeerUnresponsive,
+		},
+		Verifier:                 verifier,
+		HardforkTrigger:          arg.HardforkTrigger,
+		NetworkShardingCollector: arg.PeerShardMapper,
+	}
+	peerAuthenticationProcessor, err := process.NewPeerAuthenticationMessageProcessor(argPeerAuthenticationProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	argHeartbeatProcessor := process.ArgHeartbeatV2MessageProcessor{
+		ArgBaseV2MessageProcessor: process.ArgBaseV2MessageProcessor{
+			Marshalizer:                 netInputMarshalizer,
+			AntifloodHandler:            arg.AntifloodHandler,
+			Cacher:                      heartbeatCacher,
+			Timer:                       arg.Timer,
+			Topic:                       heartbeatTopic,
+			MaxDurationPeerUnresponsive: maxDurationPeerUnresponsive,
+		},
+		NetworkShardingCollector: arg.PeerShardMapper,
+	}
+	heartbeatProcessor, err := process.NewHeartbeatV2MessageProcessor(argHeartbeatProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	argPeerTypeProvider := peer.ArgPeerTypeProvider{
+		NodesCoordinator:        arg.NodesCoordinator,
+		StartEpoch:              arg.EpochStartTrigger.MetaEpoch(),
+		EpochStartEventNotifier: arg.EpochStartRegistration,
+	}
+	peerTypeProvider, err := peer.NewPeerTypeProvider(argPeerTypeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	argMonitor := monitor.ArgHeartbeatV2Monitor{
+		PeerAuthenticationCacher:      peerAuthenticationCacher,
+		HeartbeatCacher:               heartbeatCacher,
+		Marshalizer:                   arg.Marshalizer,
+		PeerTypeProvider:              peerTypeProvider,
+		ValidatorPubkeyConverter:      arg.ValidatorPubkeyConverter,
+		Timer:                         arg.Timer,
+		MaxDurationPeerUnresponsive:   maxDurationPeerUnresponsive,
+		HideInactiveValidatorInterval: time.Second * time.Duration(cfg.HideInactiveValidatorIntervalInSec),
+	}
+	hbMonitor, err := monitor.NewHeartbeatV2Monitor(argMonitor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = arg.Messenger.RegisterMessageProcessor(core.PeerAuthenticationTopic, peerAuthenticationProcessor)
+	if err != nil {
+		return nil, err
+	}
+	err = arg.Messenger.RegisterMessageProcessor(heartbeatTopic, heartbeatProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	argSender := sender.ArgSender{
+		Messenger:                          arg.Messenger,
+		Marshalizer:                        arg.Marshalizer,
+		PeerAuthenticationTopic:            core.PeerAuthenticationTopic,
+		HeartbeatTopic:                     heartbeatTopic,
+		PeerAuthenticationTimeBetweenSends: time.Second * time.Duration(cfg.PeerAuthenticationTimeBetweenSendsInSec),
+		PeerAuthenticationTimeBetweenSendsWhenError: time.Second * time.Duration(cfg.PeerAuthenticationTimeBetweenSendsWhenErrorInSec),
+		PeerAuthenticationThresholdBetweenSends:     cfg.PeerAuthenticationThresholdBetweenSends,
+		HeartbeatTimeBetweenSends:                   time.Second * time.Duration(cfg.HeartbeatTimeBetweenSendsInSec),
+		HeartbeatTimeBetweenSendsWhenError:          time.Second * time.Duration(cfg.HeartbeatTimeBetweenSendsWhenErrorInSec),
+		HeartbeatThresholdBetweenSends:              cfg.HeartbeatThresholdBetweenSends,
+		VersionNumber:                               arg.VersionNumber,
+		NodeDisplayName:                             arg.PrefsConfig.NodeDisplayName,
+		Identity:                                    arg.PrefsConfig.Identity,
+		ShardCoordinator:                            arg.ShardCoordinator,
+		CurrentBlockProvider:                        arg.CurrentBlockProvider,
+		PeerSignatureHandler:                        arg.PeerSignatureHandler,
+		SingleSigner:                                arg.SingleSigner,
+		PrivateKey:                                  arg.PrivKey,
+		RedundancyHandler:                           arg.RedundancyHandler,
+		HardforkTrigger:                             arg.HardforkTrigger,
+	}
+	hbSender, err := sender.NewSender(argSender)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("heartbeat v2 components have been instantiated", "heartbeat topic", heartbeatTopic)
+
+	return &HeartbeatV2Handler{
+		monitor: hbMonitor,
+		sender:  hbSender,
+	}, nil
+}
+
+func createTopicsIfNeeded(messenger heartbeat.P2PMessenger, topics ...string) error {
+	for _, topic := range topics {
+		if messenger.HasTopicValidator(topic) {
+			return fmt.Errorf("%w for topic %s", heartbeat.ErrValidatorAlreadySet, topic)
+		}
+		if messenger.HasTopic(topic) {
+			continue
+		}
+
+		err := messenger.CreateTopic(topic, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createCacher(cacheConfig config.CacheConfig) (storage.Cacher, error) {
+	return storageUnit.NewCache(storageFactory.GetCacherFromConfig(cacheConfig))
+}
+
+// Monitor returns the monitor component
+func (handler *HeartbeatV2Handler) Monitor() *monitor.HeartbeatV2Monitor {
+	return handler.monitor
+}
+
+// Close will close the sender's go routine
+func (handler *HeartbeatV2Handler) Close() error {
+	log.Debug("calling close on heartbeat v2 system")
+
+	return handler.sender.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *HeartbeatV2Handler) IsInterfaceNil() bool {
+	return handler == nil
+}
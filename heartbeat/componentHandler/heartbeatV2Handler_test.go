@@ -0,0 +1,130 @@
+package componentHandler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgumentV2() ArgHeartbeatV2 {
+	arg := createMockArgument()
+	cacheConfig := config.CacheConfig{
+		Type:     "LRU",
+		Capacity: 1000,
+	}
+
+	return ArgHeartbeatV2{
+		HeartbeatV2Config: config.HeartbeatV2Config{
+			Enabled:                                 true,
+			PeerAuthenticationTimeBetweenSendsInSec: 5,
+			PeerAuthenticationTimeBetweenSendsWhenErrorInSec: 1,
+			PeerAuthenticationThresholdBetweenSends:          0.1,
+			HeartbeatTimeBetweenSendsInSec:                   2,
+			HeartbeatTimeBetweenSendsWhenErrorInSec:          1,
+			HeartbeatThresholdBetweenSends:                   0.1,
+			MaxDurationPeerUnresponsiveInSec:                 10,
+			HideInactiveValidatorIntervalInSec:               20,
+			PeerAuthenticationPool:                           cacheConfig,
+			HeartbeatPool:                                    cacheConfig,
+		},
+		PrefsConfig:            arg.PrefsConfig,
+		Marshalizer:            arg.Marshalizer,
+		Messenger:              arg.Messenger,
+		ShardCoordinator:       arg.ShardCoordinator,
+		NodesCoordinator:       arg.NodesCoordinator,
+		EpochStartTrigger:      arg.EpochStartTrigger,
+		EpochStartRegistration: arg.EpochStartRegistration,
+		PeerSignatureHandler:   arg.PeerSignatureHandler,
+		SingleSigner:           &mock.SinglesignMock{},
+		KeyGenerator:           &mock.KeyGenMock{},
+		PrivKey: &mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{
+					ToByteArrayHandler: func() ([]byte, error) {
+						return []byte("pk"), nil
+					},
+				}
+			},
+		},
+		HardforkTrigger:          arg.HardforkTrigger,
+		AntifloodHandler:         arg.AntifloodHandler,
+		ValidatorPubkeyConverter: arg.ValidatorPubkeyConverter,
+		Timer:                    arg.Timer,
+		VersionNumber:            arg.VersionNumber,
+		PeerShardMapper:          arg.PeerShardMapper,
+		SizeCheckDelta:           0,
+		CurrentBlockProvider:     arg.CurrentBlockProvider,
+		RedundancyHandler:        arg.RedundancyHandler,
+	}
+}
+
+func TestNewHeartbeatV2Handler_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgumentV2()
+	arg.Messenger = nil
+	hbh, err := NewHeartbeatV2Handler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.Equal(t, heartbeat.ErrNilMessenger, err)
+}
+
+func TestNewHeartbeatV2Handler_ValidatorAlreadySetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgumentV2()
+	arg.Messenger = &mock.MessengerStub{
+		HasTopicValidatorCalled: func(name string) bool {
+			return true
+		},
+	}
+	hbh, err := NewHeartbeatV2Handler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrValidatorAlreadySet))
+}
+
+func TestNewHeartbeatV2Handler_InvalidIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgumentV2()
+	arg.HeartbeatV2Config.HeartbeatTimeBetweenSendsInSec = 0
+	hbh, err := NewHeartbeatV2Handler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+}
+
+func TestNewHeartbeatV2Handler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgumentV2()
+	registeredTopics := make(map[string]struct{})
+	arg.Messenger = &mock.MessengerStub{
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopics[topic] = struct{}{}
+			return nil
+		},
+	}
+	hbh, err := NewHeartbeatV2Handler(arg)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(hbh))
+	require.NotNil(t, hbh.Monitor())
+	assert.Equal(t, 2, len(registeredTopics))
+
+	// wait for the sending go routine start
+	time.Sleep(time.Second)
+
+	err = hbh.Close()
+	assert.Nil(t, err)
+}
@@ -348,51 +348,274 @@ func (m *DbTimeStamp) GetTimestamp() int64 {
 	return 0
 }
 
+// HeartbeatV2 represents the lightweight liveness message that is periodically sent by each node
+type HeartbeatV2 struct {
+	Payload         []byte `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	VersionNumber   string `protobuf:"bytes,2,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
+	NodeDisplayName string `protobuf:"bytes,3,opt,name=NodeDisplayName,proto3" json:"NodeDisplayName,omitempty"`
+	Identity        string `protobuf:"bytes,4,opt,name=Identity,proto3" json:"Identity,omitempty"`
+	Nonce           uint64 `protobuf:"varint,5,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	ShardID         uint32 `protobuf:"varint,6,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+}
+
+func (m *HeartbeatV2) Reset()      { *m = HeartbeatV2{} }
+func (*HeartbeatV2) ProtoMessage() {}
+func (*HeartbeatV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c667767fb9826a9, []int{3}
+}
+func (m *HeartbeatV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeartbeatV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatV2.Merge(m, src)
+}
+func (m *HeartbeatV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatV2 proto.InternalMessageInfo
+
+func (m *HeartbeatV2) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *HeartbeatV2) GetVersionNumber() string {
+	if m != nil {
+		return m.VersionNumber
+	}
+	return ""
+}
+
+func (m *HeartbeatV2) GetNodeDisplayName() string {
+	if m != nil {
+		return m.NodeDisplayName
+	}
+	return ""
+}
+
+func (m *HeartbeatV2) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *HeartbeatV2) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *HeartbeatV2) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+// PeerAuthentication represents the signed message that binds a validator public key to a peer ID.
+// The message is self contained and can be verified without any network context
+type PeerAuthentication struct {
+	Pubkey           []byte `protobuf:"bytes,1,opt,name=Pubkey,proto3" json:"Pubkey,omitempty"`
+	Signature        []byte `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Pid              []byte `protobuf:"bytes,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Payload          []byte `protobuf:"bytes,4,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	PayloadSignature []byte `protobuf:"bytes,5,opt,name=PayloadSignature,proto3" json:"PayloadSignature,omitempty"`
+}
+
+func (m *PeerAuthentication) Reset()      { *m = PeerAuthentication{} }
+func (*PeerAuthentication) ProtoMessage() {}
+func (*PeerAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c667767fb9826a9, []int{4}
+}
+func (m *PeerAuthentication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerAuthentication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAuthentication.Merge(m, src)
+}
+func (m *PeerAuthentication) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAuthentication proto.InternalMessageInfo
+
+func (m *PeerAuthentication) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetPid() []byte {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetPayloadSignature() []byte {
+	if m != nil {
+		return m.PayloadSignature
+	}
+	return nil
+}
+
+// Payload represents the DTO used as payload for both HeartbeatV2 and PeerAuthentication messages
+type Payload struct {
+	Timestamp       int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	HardforkMessage string `protobuf:"bytes,2,opt,name=HardforkMessage,proto3" json:"HardforkMessage,omitempty"`
+}
+
+func (m *Payload) Reset()      { *m = Payload{} }
+func (*Payload) ProtoMessage() {}
+func (*Payload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c667767fb9826a9, []int{5}
+}
+func (m *Payload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Payload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payload.Merge(m, src)
+}
+func (m *Payload) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payload) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payload proto.InternalMessageInfo
+
+func (m *Payload) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Payload) GetHardforkMessage() string {
+	if m != nil {
+		return m.HardforkMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Heartbeat)(nil), "proto.Heartbeat")
 	proto.RegisterType((*HeartbeatDTO)(nil), "proto.HeartbeatDTO")
 	proto.RegisterType((*DbTimeStamp)(nil), "proto.DbTimeStamp")
+	proto.RegisterType((*HeartbeatV2)(nil), "proto.HeartbeatV2")
+	proto.RegisterType((*PeerAuthentication)(nil), "proto.PeerAuthentication")
+	proto.RegisterType((*Payload)(nil), "proto.Payload")
 }
 
 func init() { proto.RegisterFile("heartbeat.proto", fileDescriptor_3c667767fb9826a9) }
 
 var fileDescriptor_3c667767fb9826a9 = []byte{
-	// 553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbd, 0x6e, 0x13, 0x4f,
-	0x14, 0xc5, 0x3d, 0x59, 0x3b, 0xb1, 0xc7, 0xce, 0x3f, 0xf9, 0x8f, 0x10, 0x1a, 0x01, 0x1a, 0xad,
-	0x2c, 0x0a, 0x4b, 0x48, 0x69, 0xe8, 0xa8, 0xf8, 0x58, 0x09, 0x2c, 0x11, 0x63, 0x6d, 0x9c, 0x14,
-	0x74, 0x63, 0xef, 0x15, 0x19, 0xe1, 0x9d, 0x59, 0xed, 0xcc, 0x86, 0xb8, 0xa3, 0x42, 0xa2, 0xe3,
-	0x31, 0x78, 0x14, 0xca, 0x94, 0x29, 0xc9, 0xa6, 0xa1, 0xcc, 0x23, 0xa0, 0xb9, 0x8e, 0xbd, 0xb6,
-	0xf9, 0x10, 0xd5, 0xf8, 0x9c, 0x7b, 0xb4, 0xde, 0x39, 0xbf, 0xab, 0xa5, 0x7b, 0xa7, 0x20, 0x73,
-	0x37, 0x06, 0xe9, 0x0e, 0xb2, 0xdc, 0x38, 0xc3, 0x1a, 0x78, 0x74, 0x3f, 0x6f, 0xd1, 0xd6, 0xab,
-	0xc5, 0x88, 0x71, 0xba, 0x33, 0x94, 0xb3, 0xa9, 0x91, 0x09, 0x27, 0x21, 0xe9, 0x75, 0xe2, 0x85,
-	0x64, 0x77, 0xe9, 0xf6, 0xb0, 0x18, 0xbf, 0x87, 0x19, 0xdf, 0xc2, 0xc1, 0xad, 0x62, 0x0f, 0x68,
-	0xeb, 0x48, 0xbd, 0xd3, 0xd2, 0x15, 0x39, 0xf0, 0x00, 0x47, 0x95, 0xe1, 0x9f, 0x77, 0x74, 0x2a,
-	0xf3, 0xa4, 0x1f, 0xf1, 0x7a, 0x48, 0x7a, 0xbb, 0xf1, 0x42, 0xb2, 0x87, 0x74, 0xf7, 0x04, 0x72,
-	0xab, 0x8c, 0x1e, 0x14, 0xe9, 0x18, 0x72, 0xde, 0x08, 0x49, 0xaf, 0x15, 0xaf, 0x9b, 0xac, 0x47,
-	0xf7, 0x06, 0x26, 0x81, 0x48, 0xd9, 0x6c, 0x2a, 0x67, 0x03, 0x99, 0x02, 0xdf, 0xc6, 0xdc, 0xa6,
-	0xcd, 0xee, 0xd1, 0x66, 0x3f, 0x01, 0xed, 0x94, 0x9b, 0xf1, 0x1d, 0x8c, 0x2c, 0x35, 0xdb, 0xa7,
-	0xc1, 0x50, 0x25, 0xbc, 0x89, 0x6f, 0xe7, 0x7f, 0xb2, 0x3b, 0xb4, 0x31, 0x30, 0x7a, 0x02, 0xbc,
-	0x15, 0x92, 0x5e, 0x3d, 0x9e, 0x8b, 0xee, 0xa7, 0x06, 0xed, 0x2c, 0xbb, 0x88, 0x46, 0x6f, 0xd8,
-	0x53, 0x7a, 0xff, 0x50, 0x9e, 0x47, 0x45, 0x2e, 0x9d, 0x32, 0x7a, 0x08, 0x90, 0x1f, 0xeb, 0x1c,
-	0x6c, 0x66, 0xb4, 0x55, 0x67, 0x80, 0x15, 0x05, 0xf1, 0xdf, 0x22, 0xfe, 0x02, 0x87, 0xf2, 0xbc,
-	0xaf, 0xe5, 0xc4, 0xa9, 0x33, 0x18, 0xa9, 0x14, 0xb0, 0xbf, 0x20, 0xde, 0xb4, 0x59, 0x48, 0xdb,
-	0x23, 0xe3, 0xe4, 0xf4, 0x38, 0xc3, 0x54, 0x80, 0xa9, 0x55, 0xcb, 0x57, 0x86, 0x32, 0x32, 0x1f,
-	0x34, 0x66, 0xea, 0x98, 0x59, 0x37, 0x3d, 0x10, 0x7f, 0x1e, 0x39, 0x99, 0x66, 0x58, 0x6a, 0x10,
-	0x57, 0x06, 0xd6, 0x64, 0x9f, 0xe1, 0xbf, 0x62, 0x93, 0xcd, 0x78, 0xa9, 0xfd, 0xbb, 0xc6, 0x30,
-	0x01, 0x75, 0x06, 0xc9, 0x02, 0xda, 0x0e, 0x42, 0xdb, 0xb4, 0x7d, 0xf2, 0x85, 0x49, 0xb3, 0xc2,
-	0x55, 0xc9, 0xe6, 0x3c, 0xb9, 0x61, 0xff, 0x8a, 0xb9, 0xf5, 0x8f, 0x98, 0xe9, 0x1f, 0x31, 0xfb,
-	0x8e, 0x47, 0xb3, 0x0c, 0x78, 0x7b, 0x8e, 0x79, 0xa1, 0xd7, 0x56, 0xa0, 0xb3, 0xb1, 0x02, 0x21,
-	0x6d, 0xf7, 0xed, 0x89, 0x9c, 0xaa, 0x44, 0x3a, 0x93, 0xf3, 0x5d, 0xbc, 0xfa, 0xaa, 0xc5, 0x0e,
-	0x28, 0x7b, 0x2d, 0xad, 0x3b, 0xce, 0x9c, 0x4a, 0xc1, 0xb7, 0xe9, 0x4f, 0xfe, 0x1f, 0x16, 0xf8,
-	0x9b, 0x89, 0x7f, 0xe2, 0x4b, 0xd0, 0x60, 0x95, 0x45, 0x16, 0x7b, 0x73, 0x5e, 0x2b, 0x56, 0xb5,
-	0x64, 0xfb, 0x2b, 0x4b, 0xc6, 0xba, 0xb4, 0x33, 0x28, 0xd2, 0xbe, 0xb6, 0x4e, 0xea, 0x09, 0x58,
-	0xfe, 0x3f, 0x0e, 0xd7, 0xbc, 0xee, 0x23, 0xda, 0x8e, 0xc6, 0x15, 0xb4, 0x5b, 0xa4, 0x16, 0x91,
-	0x92, 0x0a, 0x29, 0x1a, 0xcf, 0x9f, 0x5c, 0x5c, 0x89, 0xda, 0xe5, 0x95, 0xa8, 0xdd, 0x5c, 0x09,
-	0xf2, 0xb1, 0x14, 0xe4, 0x6b, 0x29, 0xc8, 0xb7, 0x52, 0x90, 0x8b, 0x52, 0x90, 0xef, 0xa5, 0x20,
-	0x3f, 0x4a, 0x51, 0xbb, 0x29, 0x05, 0xf9, 0x72, 0x2d, 0x6a, 0x17, 0xd7, 0xa2, 0x76, 0x79, 0x2d,
-	0x6a, 0x6f, 0xeb, 0x89, 0x74, 0x72, 0xbc, 0x8d, 0x1f, 0x81, 0xc7, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xce, 0xb6, 0x0b, 0x17, 0x1e, 0x04, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3d, 0x6f, 0x13, 0x4b,
+	0x14, 0xf5, 0x78, 0x6d, 0xc7, 0x1e, 0x3b, 0x2f, 0x79, 0xa3, 0xa7, 0xa7, 0x11, 0xa0, 0x91, 0xb5,
+	0xa2, 0xb0, 0x40, 0x4a, 0x01, 0x1d, 0x15, 0x01, 0x4b, 0xc4, 0x12, 0x31, 0x66, 0xe3, 0xa4, 0xa0,
+	0x1b, 0x7b, 0x2f, 0xc9, 0x28, 0xde, 0x9d, 0xd5, 0xce, 0x6c, 0x88, 0x3b, 0x2a, 0x24, 0x3a, 0x7e,
+	0x03, 0x15, 0xff, 0x83, 0x86, 0x32, 0x65, 0x4a, 0xb2, 0x69, 0x28, 0xf3, 0x13, 0xd0, 0x8c, 0x3f,
+	0x76, 0xbd, 0x21, 0x21, 0xd5, 0xec, 0x3d, 0xf7, 0xe8, 0xee, 0xcc, 0x39, 0xf7, 0x5e, 0xbc, 0x71,
+	0x04, 0x3c, 0xd6, 0x23, 0xe0, 0x7a, 0x2b, 0x8a, 0xa5, 0x96, 0xa4, 0x6a, 0x0f, 0xf7, 0x73, 0x19,
+	0x37, 0x76, 0x16, 0x29, 0x42, 0xf1, 0xda, 0x80, 0x4f, 0x27, 0x92, 0xfb, 0x14, 0xb5, 0x51, 0xa7,
+	0xe5, 0x2d, 0x42, 0xf2, 0x3f, 0xae, 0x0d, 0x92, 0xd1, 0x31, 0x4c, 0x69, 0xd9, 0x26, 0xe6, 0x11,
+	0x79, 0x80, 0x1b, 0x7b, 0xe2, 0x30, 0xe4, 0x3a, 0x89, 0x81, 0x3a, 0x36, 0x95, 0x01, 0xa6, 0xde,
+	0xde, 0x11, 0x8f, 0xfd, 0x5e, 0x97, 0x56, 0xda, 0xa8, 0xb3, 0xee, 0x2d, 0x42, 0xf2, 0x10, 0xaf,
+	0x1f, 0x40, 0xac, 0x84, 0x0c, 0xfb, 0x49, 0x30, 0x82, 0x98, 0x56, 0xdb, 0xa8, 0xd3, 0xf0, 0x56,
+	0x41, 0xd2, 0xc1, 0x1b, 0x7d, 0xe9, 0x43, 0x57, 0xa8, 0x68, 0xc2, 0xa7, 0x7d, 0x1e, 0x00, 0xad,
+	0x59, 0x5e, 0x11, 0x26, 0xf7, 0x70, 0xbd, 0xe7, 0x43, 0xa8, 0x85, 0x9e, 0xd2, 0x35, 0x4b, 0x59,
+	0xc6, 0x64, 0x13, 0x3b, 0x03, 0xe1, 0xd3, 0xba, 0xbd, 0x9d, 0xf9, 0x24, 0xff, 0xe1, 0x6a, 0x5f,
+	0x86, 0x63, 0xa0, 0x8d, 0x36, 0xea, 0x54, 0xbc, 0x59, 0xe0, 0x7e, 0xaa, 0xe2, 0xd6, 0x52, 0x8b,
+	0xee, 0xf0, 0x0d, 0x79, 0x8e, 0xef, 0xef, 0xf2, 0xd3, 0x6e, 0x12, 0x73, 0x2d, 0x64, 0x38, 0x00,
+	0x88, 0xf7, 0xc3, 0x18, 0x54, 0x24, 0x43, 0x25, 0x4e, 0xc0, 0x4a, 0xe4, 0x78, 0xb7, 0x51, 0xcc,
+	0x03, 0x76, 0xf9, 0x69, 0x2f, 0xe4, 0x63, 0x2d, 0x4e, 0x60, 0x28, 0x02, 0xb0, 0xfa, 0x39, 0x5e,
+	0x11, 0x26, 0x6d, 0xdc, 0x1c, 0x4a, 0xcd, 0x27, 0xfb, 0x91, 0x65, 0x39, 0x96, 0x95, 0x87, 0x8c,
+	0x64, 0x36, 0xec, 0xca, 0x0f, 0xa1, 0xe5, 0x54, 0x2c, 0x67, 0x15, 0x34, 0x86, 0x98, 0x73, 0x4f,
+	0xf3, 0x20, 0xb2, 0xa2, 0x3a, 0x5e, 0x06, 0x58, 0x99, 0xd4, 0xb6, 0xfd, 0xab, 0x55, 0xb2, 0xee,
+	0x2d, 0x63, 0x73, 0x57, 0x0f, 0xc6, 0x20, 0x4e, 0xc0, 0x5f, 0x98, 0xb6, 0x66, 0x4d, 0x2b, 0xc2,
+	0x86, 0xf9, 0x52, 0x06, 0x51, 0xa2, 0x33, 0x66, 0x7d, 0xc6, 0x2c, 0xc0, 0xd7, 0x6d, 0x6e, 0xdc,
+	0xd1, 0x66, 0x7c, 0xa3, 0xcd, 0x46, 0xe3, 0xe1, 0x34, 0x02, 0xda, 0x9c, 0xd9, 0xbc, 0x88, 0x57,
+	0x5a, 0xa0, 0x55, 0x68, 0x81, 0x36, 0x6e, 0xf6, 0xd4, 0x01, 0x9f, 0x08, 0x9f, 0x6b, 0x19, 0xd3,
+	0x75, 0xfb, 0xf4, 0x3c, 0x44, 0xb6, 0x30, 0x79, 0xcd, 0x95, 0xde, 0x8f, 0xb4, 0x08, 0xc0, 0xa8,
+	0x69, 0x4e, 0xfa, 0x8f, 0x15, 0xf0, 0x0f, 0x19, 0x53, 0xf1, 0x15, 0x84, 0xa0, 0x84, 0xb2, 0x5e,
+	0x6c, 0xcc, 0xfc, 0xca, 0x41, 0x59, 0x93, 0x6d, 0xe6, 0x9a, 0x8c, 0xb8, 0xb8, 0xd5, 0x4f, 0x82,
+	0x5e, 0xa8, 0x34, 0x0f, 0xc7, 0xa0, 0xe8, 0xbf, 0x36, 0xb9, 0x82, 0xb9, 0x8f, 0x71, 0xb3, 0x3b,
+	0xca, 0x4c, 0x9b, 0x5b, 0xaa, 0x4c, 0x30, 0x6f, 0xba, 0x0c, 0x70, 0xbf, 0x23, 0xdc, 0x5c, 0x76,
+	0xed, 0xc1, 0x93, 0x5b, 0x66, 0xf8, 0x9a, 0x19, 0xe5, 0x3b, 0x9a, 0xe1, 0xfc, 0x7d, 0xe6, 0x2a,
+	0x05, 0xc1, 0x97, 0x8f, 0xaf, 0xe6, 0x1f, 0x9f, 0xdb, 0x07, 0xb5, 0x95, 0x7d, 0xe0, 0x7e, 0x45,
+	0x98, 0x18, 0x27, 0xb7, 0x13, 0x7d, 0x64, 0x4a, 0x8c, 0xed, 0x3c, 0xe5, 0xd6, 0x0e, 0xba, 0x79,
+	0xed, 0x94, 0x8b, 0x6b, 0x67, 0x3e, 0xf0, 0x4e, 0x36, 0xf0, 0x39, 0x51, 0x2a, 0xab, 0xa2, 0x3c,
+	0xc2, 0x9b, 0xf3, 0xcf, 0xac, 0x60, 0xd5, 0x52, 0xae, 0xe1, 0xee, 0xdb, 0x65, 0x95, 0xdb, 0x3d,
+	0x31, 0x1a, 0xee, 0xf0, 0xd8, 0x7f, 0x2f, 0xe3, 0xe3, 0x5d, 0x50, 0x8a, 0x1f, 0xc2, 0x5c, 0xeb,
+	0x22, 0xfc, 0xe2, 0xd9, 0xd9, 0x05, 0x2b, 0x9d, 0x5f, 0xb0, 0xd2, 0xd5, 0x05, 0x43, 0x1f, 0x53,
+	0x86, 0xbe, 0xa5, 0x0c, 0xfd, 0x48, 0x19, 0x3a, 0x4b, 0x19, 0xfa, 0x99, 0x32, 0xf4, 0x2b, 0x65,
+	0xa5, 0xab, 0x94, 0xa1, 0x2f, 0x97, 0xac, 0x74, 0x76, 0xc9, 0x4a, 0xe7, 0x97, 0xac, 0xf4, 0xae,
+	0xe2, 0x73, 0xcd, 0x47, 0x35, 0xbb, 0xc2, 0x9f, 0xfe, 0x1e, 0x00, 0xeb, 0xd4, 0x80, 0xf6, 0xdc,
+	0x05, 0x00, 0x00,
 }
 
 func (this *Heartbeat) Equal(that interface{}) bool {
@@ -539,6 +762,108 @@ func (this *DbTimeStamp) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HeartbeatV2) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HeartbeatV2)
+	if !ok {
+		that2, ok := that.(HeartbeatV2)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.VersionNumber != that1.VersionNumber {
+		return false
+	}
+	if this.NodeDisplayName != that1.NodeDisplayName {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	return true
+}
+func (this *PeerAuthentication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PeerAuthentication)
+	if !ok {
+		that2, ok := that.(PeerAuthentication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Pubkey, that1.Pubkey) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.Pid, that1.Pid) {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !bytes.Equal(this.PayloadSignature, that1.PayloadSignature) {
+		return false
+	}
+	return true
+}
+func (this *Payload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Payload)
+	if !ok {
+		that2, ok := that.(Payload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.HardforkMessage != that1.HardforkMessage {
+		return false
+	}
+	return true
+}
 func (this *Heartbeat) GoString() string {
 	if this == nil {
 		return "nil"
@@ -593,24 +918,64 @@ func (this *DbTimeStamp) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringHeartbeat(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *HeartbeatV2) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 10)
+	s = append(s, "&data.HeartbeatV2{")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "VersionNumber: "+fmt.Sprintf("%#v", this.VersionNumber)+",\n")
+	s = append(s, "NodeDisplayName: "+fmt.Sprintf("%#v", this.NodeDisplayName)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *Heartbeat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PeerAuthentication) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
+	s := make([]string, 0, 9)
+	s = append(s, "&data.PeerAuthentication{")
+	s = append(s, "Pubkey: "+fmt.Sprintf("%#v", this.Pubkey)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "PayloadSignature: "+fmt.Sprintf("%#v", this.PayloadSignature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Payload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&data.Payload{")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "HardforkMessage: "+fmt.Sprintf("%#v", this.HardforkMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringHeartbeat(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Heartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *Heartbeat) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -841,6 +1206,160 @@ func (m *DbTimeStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeartbeatV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeDisplayName) > 0 {
+		i -= len(m.NodeDisplayName)
+		copy(dAtA[i:], m.NodeDisplayName)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.NodeDisplayName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionNumber) > 0 {
+		i -= len(m.VersionNumber)
+		copy(dAtA[i:], m.VersionNumber)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.VersionNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerAuthentication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerAuthentication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerAuthentication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayloadSignature) > 0 {
+		i -= len(m.PayloadSignature)
+		copy(dAtA[i:], m.PayloadSignature)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.PayloadSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HardforkMessage) > 0 {
+		i -= len(m.HardforkMessage)
+		copy(dAtA[i:], m.HardforkMessage)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.HardforkMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHeartbeat(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHeartbeat(v)
 	base := offset
@@ -971,6 +1490,82 @@ func (m *DbTimeStamp) Size() (n int) {
 	return n
 }
 
+func (m *HeartbeatV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.VersionNumber)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.NodeDisplayName)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Nonce))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovHeartbeat(uint64(m.ShardID))
+	}
+	return n
+}
+
+func (m *PeerAuthentication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.PayloadSignature)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	return n
+}
+
+func (m *Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Timestamp))
+	}
+	l = len(m.HardforkMessage)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	return n
+}
+
 func sovHeartbeat(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1031,17 +1626,57 @@ func (this *DbTimeStamp) String() string {
 	}, "")
 	return s
 }
-func valueToStringHeartbeat(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *HeartbeatV2) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&HeartbeatV2{`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`VersionNumber:` + fmt.Sprintf("%v", this.VersionNumber) + `,`,
+		`NodeDisplayName:` + fmt.Sprintf("%v", this.NodeDisplayName) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *Heartbeat) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (this *PeerAuthentication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PeerAuthentication{`,
+		`Pubkey:` + fmt.Sprintf("%v", this.Pubkey) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`PayloadSignature:` + fmt.Sprintf("%v", this.PayloadSignature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Payload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Payload{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`HardforkMessage:` + fmt.Sprintf("%v", this.HardforkMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringHeartbeat(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Heartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1544,7 +2179,390 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeDisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeDisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsValidator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsValidator = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUptimeDowntime", wireType)
+			}
+			m.LastUptimeDowntime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUptimeDowntime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumInstances", wireType)
+			}
+			m.NumInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumInstances |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DbTimeStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DbTimeStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DbTimeStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeartbeatV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VersionNumber", wireType)
 			}
@@ -1576,7 +2594,7 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 			}
 			m.VersionNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NodeDisplayName", wireType)
 			}
@@ -1608,9 +2626,9 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 			}
 			m.NodeDisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,13 +2656,13 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerType = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHeartbeat
@@ -1654,29 +2672,88 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthHeartbeat
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthHeartbeat
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsValidator", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
 			}
-			var v int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHeartbeat
@@ -1686,17 +2763,31 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsValidator = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUptimeDowntime", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
 			}
-			m.LastUptimeDowntime = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHeartbeat
@@ -1706,16 +2797,31 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUptimeDowntime |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
 			}
-			m.GenesisTime = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHeartbeat
@@ -1725,16 +2831,31 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GenesisTime |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
 			}
-			m.Nonce = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = append(m.Pid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pid == nil {
+				m.Pid = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHeartbeat
@@ -1744,16 +2865,31 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumInstances", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
 			}
-			m.NumInstances = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSignature", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHeartbeat
@@ -1763,11 +2899,26 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumInstances |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadSignature = append(m.PayloadSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadSignature == nil {
+				m.PayloadSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHeartbeat(dAtA[iNdEx:])
@@ -1792,7 +2943,7 @@ func (m *HeartbeatDTO) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DbTimeStamp) Unmarshal(dAtA []byte) error {
+func (m *Payload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,10 +2966,10 @@ func (m *DbTimeStamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DbTimeStamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DbTimeStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1840,6 +2991,38 @@ func (m *DbTimeStamp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardforkMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardforkMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHeartbeat(dAtA[iNdEx:])
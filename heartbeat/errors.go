@@ -104,3 +104,36 @@ var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
 
 // ErrNilRedundancyHandler signals that a nil redundancy handler was provided
 var ErrNilRedundancyHandler = errors.New("nil redundancy handler")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilCacher signals that a nil cacher has been provided
+var ErrNilCacher = errors.New("nil cacher")
+
+// ErrInvalidTimeDuration signals that an invalid time duration was provided
+var ErrInvalidTimeDuration = errors.New("invalid time duration")
+
+// ErrInvalidThreshold signals that an invalid threshold was provided
+var ErrInvalidThreshold = errors.New("invalid threshold")
+
+// ErrEmptyTopic signals that an empty topic was provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrPeerAuthenticationPidMismatch signals that a received peer authentication message did not come from the correct originator
+var ErrPeerAuthenticationPidMismatch = errors.New("peer authentication peer id mismatch")
+
+// ErrMessageExpired signals that a received message carries a timestamp which is too old
+var ErrMessageExpired = errors.New("message expired")
+
+// ErrMessageFromFuture signals that a received message carries a timestamp from the future
+var ErrMessageFromFuture = errors.New("message from future")
+
+// ErrNilPeerAuthenticationVerifier signals that a nil peer authentication verifier has been provided
+var ErrNilPeerAuthenticationVerifier = errors.New("nil peer authentication verifier")
+
+// ErrNilEpochStartTrigger signals that a nil epoch start trigger has been provided
+var ErrNilEpochStartTrigger = errors.New("nil epoch start trigger")
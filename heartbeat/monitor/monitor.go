@@ -0,0 +1,265 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("heartbeat/monitor")
+
+// ArgHeartbeatV2Monitor represents the arguments for the heartbeat v2 monitor
+type ArgHeartbeatV2Monitor struct {
+	PeerAuthenticationCacher      storage.Cacher
+	HeartbeatCacher               storage.Cacher
+	Marshalizer                   marshal.Marshalizer
+	PeerTypeProvider              heartbeat.PeerTypeProviderHandler
+	ValidatorPubkeyConverter      core.PubkeyConverter
+	Timer                         heartbeat.Timer
+	MaxDurationPeerUnresponsive   time.Duration
+	HideInactiveValidatorInterval time.Duration
+}
+
+// HeartbeatV2Monitor aggregates the peer authentication and heartbeat v2 messages stored in the bounded caches
+// into the heartbeat status of each public key. No history is kept besides the caches contents, so the
+// total up time and down time are not computed
+type HeartbeatV2Monitor struct {
+	peerAuthenticationCacher      storage.Cacher
+	heartbeatCacher               storage.Cacher
+	marshalizer                   marshal.Marshalizer
+	peerTypeProvider              heartbeat.PeerTypeProviderHandler
+	validatorPubkeyConverter      core.PubkeyConverter
+	timer                         heartbeat.Timer
+	maxDurationPeerUnresponsive   time.Duration
+	hideInactiveValidatorInterval time.Duration
+}
+
+type peerInfo struct {
+	pid        []byte
+	lastSeen   time.Time
+	isActive   bool
+	heartbeat  *data.HeartbeatV2
+	numActives uint64
+}
+
+// NewHeartbeatV2Monitor creates a new instance of type HeartbeatV2Monitor
+func NewHeartbeatV2Monitor(arg ArgHeartbeatV2Monitor) (*HeartbeatV2Monitor, error) {
+	err := checkArgs(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeartbeatV2Monitor{
+		peerAuthenticationCacher:      arg.PeerAuthenticationCacher,
+		heartbeatCacher:               arg.HeartbeatCacher,
+		marshalizer:                   arg.Marshalizer,
+		peerTypeProvider:              arg.PeerTypeProvider,
+		validatorPubkeyConverter:      arg.ValidatorPubkeyConverter,
+		timer:                         arg.Timer,
+		maxDurationPeerUnresponsive:   arg.MaxDurationPeerUnresponsive,
+		hideInactiveValidatorInterval: arg.HideInactiveValidatorInterval,
+	}, nil
+}
+
+func checkArgs(arg ArgHeartbeatV2Monitor) error {
+	if check.IfNil(arg.PeerAuthenticationCacher) {
+		return fmt.Errorf("%w for PeerAuthenticationCacher", heartbeat.ErrNilCacher)
+	}
+	if check.IfNil(arg.HeartbeatCacher) {
+		return fmt.Errorf("%w for HeartbeatCacher", heartbeat.ErrNilCacher)
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.PeerTypeProvider) {
+		return heartbeat.ErrNilPeerTypeProvider
+	}
+	if check.IfNil(arg.ValidatorPubkeyConverter) {
+		return heartbeat.ErrNilPubkeyConverter
+	}
+	if check.IfNil(arg.Timer) {
+		return heartbeat.ErrNilTimer
+	}
+	if arg.MaxDurationPeerUnresponsive < time.Second {
+		return fmt.Errorf("%w for MaxDurationPeerUnresponsive", heartbeat.ErrInvalidTimeDuration)
+	}
+	if arg.HideInactiveValidatorInterval < time.Second {
+		return fmt.Errorf("%w for HideInactiveValidatorInterval", heartbeat.ErrInvalidTimeDuration)
+	}
+
+	return nil
+}
+
+// GetHeartbeats returns the heartbeat status
+func (monitor *HeartbeatV2Monitor) GetHeartbeats() []data.PubKeyHeartbeat {
+	peersByPubKey := monitor.aggregatePeers()
+
+	now := monitor.timer.Now()
+	status := make([]data.PubKeyHeartbeat, 0, len(peersByPubKey))
+	for pk, info := range peersByPubKey {
+		peerType, shardID := monitor.computePeerTypeAndShardID([]byte(pk))
+		if monitor.shouldSkip(info, peerType, now) {
+			continue
+		}
+
+		status = append(status, monitor.createPubKeyHeartbeat(pk, info, peerType, shardID, now))
+	}
+
+	for _, peerTypeInfo := range monitor.peerTypeProvider.GetAllPeerTypeInfos() {
+		_, found := peersByPubKey[peerTypeInfo.PublicKey]
+		if found {
+			continue
+		}
+
+		status = append(status, data.PubKeyHeartbeat{
+			PublicKey:       monitor.validatorPubkeyConverter.Encode([]byte(peerTypeInfo.PublicKey)),
+			ComputedShardID: peerTypeInfo.ShardId,
+			PeerType:        peerTypeInfo.PeerType,
+		})
+	}
+
+	sort.Slice(status, func(i, j int) bool {
+		return strings.Compare(status[i].PublicKey, status[j].PublicKey) < 0
+	})
+
+	return status
+}
+
+// aggregatePeers groups the cached messages by public key, keeping the most recently seen peer ID for each key
+// and counting how many peer IDs are actively using the same key
+func (monitor *HeartbeatV2Monitor) aggregatePeers() map[string]*peerInfo {
+	peersByPubKey := make(map[string]*peerInfo)
+	for _, pid := range monitor.peerAuthenticationCacher.Keys() {
+		value, ok := monitor.peerAuthenticationCacher.Peek(pid)
+		if !ok {
+			continue
+		}
+		peerAuthentication, ok := value.(*data.PeerAuthentication)
+		if !ok {
+			continue
+		}
+
+		info, err := monitor.createPeerInfo(peerAuthentication)
+		if err != nil {
+			log.Debug("could not compute peer info", "error", err)
+			continue
+		}
+
+		pk := string(peerAuthentication.Pubkey)
+		existing, found := peersByPubKey[pk]
+		if !found {
+			peersByPubKey[pk] = info
+			continue
+		}
+
+		numActives := existing.numActives + info.numActives
+		if info.lastSeen.After(existing.lastSeen) {
+			existing = info
+			peersByPubKey[pk] = info
+		}
+		existing.numActives = numActives
+	}
+
+	return peersByPubKey
+}
+
+func (monitor *HeartbeatV2Monitor) createPeerInfo(peerAuthentication *data.PeerAuthentication) (*peerInfo, error) {
+	info := &peerInfo{
+		pid: peerAuthentication.Pid,
+	}
+
+	payloadBytes := peerAuthentication.Payload
+	value, ok := monitor.heartbeatCacher.Peek(peerAuthentication.Pid)
+	if ok {
+		info.heartbeat, ok = value.(*data.HeartbeatV2)
+		if !ok {
+			return nil, fmt.Errorf("wrong type assertion for the heartbeat of peer %s",
+				core.PeerID(peerAuthentication.Pid).Pretty())
+		}
+		payloadBytes = info.heartbeat.Payload
+	}
+
+	payload := &data.Payload{}
+	err := monitor.marshalizer.Unmarshal(payload, payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	info.lastSeen = time.Unix(payload.Timestamp, 0)
+	info.isActive = monitor.timer.Now().Sub(info.lastSeen) <= monitor.maxDurationPeerUnresponsive
+	if info.isActive {
+		info.numActives = 1
+	}
+
+	return info, nil
+}
+
+func (monitor *HeartbeatV2Monitor) createPubKeyHeartbeat(
+	pk string,
+	info *peerInfo,
+	peerType string,
+	shardID uint32,
+	now time.Time,
+) data.PubKeyHeartbeat {
+	pubKeyHeartbeat := data.PubKeyHeartbeat{
+		PublicKey: monitor.validatorPubkeyConverter.Encode([]byte(pk)),
+		TimeStamp: info.lastSeen,
+		MaxInactiveTime: data.Duration{
+			Duration: maxDuration(0, now.Sub(info.lastSeen)),
+		},
+		IsActive:        info.isActive,
+		ComputedShardID: shardID,
+		PeerType:        peerType,
+		NumInstances:    info.numActives,
+	}
+
+	if info.heartbeat != nil {
+		pubKeyHeartbeat.ReceivedShardID = info.heartbeat.ShardID
+		pubKeyHeartbeat.VersionNumber = info.heartbeat.VersionNumber
+		pubKeyHeartbeat.NodeDisplayName = info.heartbeat.NodeDisplayName
+		pubKeyHeartbeat.Identity = info.heartbeat.Identity
+		pubKeyHeartbeat.Nonce = info.heartbeat.Nonce
+	}
+
+	return pubKeyHeartbeat
+}
+
+func (monitor *HeartbeatV2Monitor) shouldSkip(info *peerInfo, peerType string, now time.Time) bool {
+	isInactiveObserver := !info.isActive &&
+		peerType != string(core.EligibleList) &&
+		peerType != string(core.WaitingList)
+
+	return isInactiveObserver && now.Sub(info.lastSeen) > monitor.hideInactiveValidatorInterval
+}
+
+func (monitor *HeartbeatV2Monitor) computePeerTypeAndShardID(pubkey []byte) (string, uint32) {
+	peerType, shardID, err := monitor.peerTypeProvider.ComputeForPubKey(pubkey)
+	if err != nil {
+		log.Warn("heartbeat v2 monitor: compute peer type and shard", "error", err)
+		return string(core.ObserverList), 0
+	}
+
+	return string(peerType), shardID
+}
+
+func maxDuration(first time.Duration, second time.Duration) time.Duration {
+	if first > second {
+		return first
+	}
+
+	return second
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (monitor *HeartbeatV2Monitor) IsInterfaceNil() bool {
+	return monitor == nil
+}
@@ -0,0 +1,245 @@
+package monitor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNow = 10000
+
+func createMockArgHeartbeatV2Monitor() ArgHeartbeatV2Monitor {
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(testNow)
+
+	return ArgHeartbeatV2Monitor{
+		PeerAuthenticationCacher: testscommon.NewCacherMock(),
+		HeartbeatCacher:          testscommon.NewCacherMock(),
+		Marshalizer:              &mock.MarshalizerMock{},
+		PeerTypeProvider: &mock.PeerTypeProviderStub{
+			ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+				return core.EligibleList, 1, nil
+			},
+		},
+		ValidatorPubkeyConverter:      mock.NewPubkeyConverterMock(32),
+		Timer:                         timer,
+		MaxDurationPeerUnresponsive:   time.Minute,
+		HideInactiveValidatorInterval: time.Hour,
+	}
+}
+
+func addPeerAuthentication(t *testing.T, arg ArgHeartbeatV2Monitor, pk string, pid string, timestamp int64) {
+	payload, err := arg.Marshalizer.Marshal(&data.Payload{Timestamp: timestamp})
+	require.Nil(t, err)
+
+	arg.PeerAuthenticationCacher.Put([]byte(pid), &data.PeerAuthentication{
+		Pubkey:  []byte(pk),
+		Pid:     []byte(pid),
+		Payload: payload,
+	}, 0)
+}
+
+func addHeartbeat(t *testing.T, arg ArgHeartbeatV2Monitor, pid string, timestamp int64, nonce uint64) {
+	payload, err := arg.Marshalizer.Marshal(&data.Payload{Timestamp: timestamp})
+	require.Nil(t, err)
+
+	arg.HeartbeatCacher.Put([]byte(pid), &data.HeartbeatV2{
+		Payload:         payload,
+		VersionNumber:   "v1",
+		NodeDisplayName: "node " + pid,
+		Nonce:           nonce,
+		ShardID:         1,
+	}, 0)
+}
+
+func TestNewHeartbeatV2Monitor_NilPeerAuthenticationCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerAuthenticationCacher = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+
+	assert.Nil(t, monitor)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilCacher))
+}
+
+func TestNewHeartbeatV2Monitor_NilHeartbeatCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.HeartbeatCacher = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+
+	assert.Nil(t, monitor)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilCacher))
+}
+
+func TestNewHeartbeatV2Monitor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.Marshalizer = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+
+	assert.Nil(t, monitor)
+	assert.Equal(t, heartbeat.ErrNilMarshalizer, err)
+}
+
+func TestNewHeartbeatV2Monitor_NilPeerTypeProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerTypeProvider = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+
+	assert.Nil(t, monitor)
+	assert.Equal(t, heartbeat.ErrNilPeerTypeProvider, err)
+}
+
+func TestNewHeartbeatV2Monitor_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.ValidatorPubkeyConverter = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+
+	assert.Nil(t, monitor)
+	assert.Equal(t, heartbeat.ErrNilPubkeyConverter, err)
+}
+
+func TestNewHeartbeatV2Monitor_NilTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.Timer = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+
+	assert.Nil(t, monitor)
+	assert.Equal(t, heartbeat.ErrNilTimer, err)
+}
+
+func TestNewHeartbeatV2Monitor_InvalidDurationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.MaxDurationPeerUnresponsive = 0
+	monitor, err := NewHeartbeatV2Monitor(arg)
+	assert.Nil(t, monitor)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.HideInactiveValidatorInterval = 0
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.Nil(t, monitor)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsActiveAndInactivePeers(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	addPeerAuthentication(t, arg, "pk1", "pid1", testNow-1000)
+	addHeartbeat(t, arg, "pid1", testNow-10, 37)
+	addPeerAuthentication(t, arg, "pk2", "pid2", testNow-1000)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 2, len(heartbeats))
+
+	assert.Equal(t, arg.ValidatorPubkeyConverter.Encode([]byte("pk1")), heartbeats[0].PublicKey)
+	assert.True(t, heartbeats[0].IsActive)
+	assert.Equal(t, uint64(37), heartbeats[0].Nonce)
+	assert.Equal(t, "node pid1", heartbeats[0].NodeDisplayName)
+	assert.Equal(t, uint64(1), heartbeats[0].NumInstances)
+	assert.Equal(t, string(core.EligibleList), heartbeats[0].PeerType)
+	assert.Equal(t, time.Second*10, heartbeats[0].MaxInactiveTime.Duration)
+
+	assert.Equal(t, arg.ValidatorPubkeyConverter.Encode([]byte("pk2")), heartbeats[1].PublicKey)
+	assert.False(t, heartbeats[1].IsActive)
+	assert.Equal(t, uint64(0), heartbeats[1].NumInstances)
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsSameKeyOnMultiplePeersShouldCountInstances(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	addPeerAuthentication(t, arg, "pk", "pid1", testNow-100)
+	addHeartbeat(t, arg, "pid1", testNow-20, 10)
+	addPeerAuthentication(t, arg, "pk", "pid2", testNow-100)
+	addHeartbeat(t, arg, "pid2", testNow-5, 11)
+	addPeerAuthentication(t, arg, "pk", "pid3", testNow-1000)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 1, len(heartbeats))
+	assert.True(t, heartbeats[0].IsActive)
+	assert.Equal(t, uint64(2), heartbeats[0].NumInstances)
+	assert.Equal(t, uint64(11), heartbeats[0].Nonce)
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsShouldHideOldInactiveObservers(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			return core.ObserverList, 0, nil
+		},
+	}
+	addPeerAuthentication(t, arg, "pk1", "pid1", testNow-int64(arg.HideInactiveValidatorInterval.Seconds())-1)
+	addPeerAuthentication(t, arg, "pk2", "pid2", testNow-int64(arg.MaxDurationPeerUnresponsive.Seconds())-1)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 1, len(heartbeats))
+	assert.Equal(t, arg.ValidatorPubkeyConverter.Encode([]byte("pk2")), heartbeats[0].PublicKey)
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsShouldAddMissingValidators(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			return core.EligibleList, 0, nil
+		},
+		GetAllPeerTypeInfosCalled: func() []*state.PeerTypeInfo {
+			return []*state.PeerTypeInfo{
+				{PublicKey: "pk1", PeerType: string(core.EligibleList), ShardId: 0},
+				{PublicKey: "pk2", PeerType: string(core.WaitingList), ShardId: 2},
+			}
+		},
+	}
+	addPeerAuthentication(t, arg, "pk1", "pid1", testNow)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 2, len(heartbeats))
+	assert.True(t, heartbeats[0].IsActive)
+	assert.False(t, heartbeats[1].IsActive)
+	assert.Equal(t, uint32(2), heartbeats[1].ComputedShardID)
+	assert.Equal(t, string(core.WaitingList), heartbeats[1].PeerType)
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsWrongTypesShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerAuthenticationCacher.Put([]byte("pid1"), "wrong type", 0)
+	addPeerAuthentication(t, arg, "pk2", "pid2", testNow)
+	arg.HeartbeatCacher.Put([]byte("pid2"), "wrong type", 0)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	assert.Equal(t, 0, len(heartbeats))
+	assert.False(t, monitor.IsInterfaceNil())
+}
@@ -0,0 +1,107 @@
+package process
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const maxTimeDriftForPayload = time.Minute
+
+// ArgBaseV2MessageProcessor represents the arguments shared by the peer authentication and heartbeat v2 processors
+type ArgBaseV2MessageProcessor struct {
+	Marshalizer                 marshal.Marshalizer
+	AntifloodHandler            heartbeat.P2PAntifloodHandler
+	Cacher                      storage.Cacher
+	Timer                       heartbeat.Timer
+	Topic                       string
+	MaxDurationPeerUnresponsive time.Duration
+}
+
+type baseV2MessageProcessor struct {
+	marshalizer                 marshal.Marshalizer
+	antifloodHandler            heartbeat.P2PAntifloodHandler
+	cacher                      storage.Cacher
+	timer                       heartbeat.Timer
+	topic                       string
+	maxDurationPeerUnresponsive time.Duration
+}
+
+func newBaseV2MessageProcessor(arg ArgBaseV2MessageProcessor) (*baseV2MessageProcessor, error) {
+	if check.IfNil(arg.Marshalizer) {
+		return nil, heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, heartbeat.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.Cacher) {
+		return nil, heartbeat.ErrNilCacher
+	}
+	if check.IfNil(arg.Timer) {
+		return nil, heartbeat.ErrNilTimer
+	}
+	if len(arg.Topic) == 0 {
+		return nil, heartbeat.ErrEmptyTopic
+	}
+	if arg.MaxDurationPeerUnresponsive < time.Second {
+		return nil, fmt.Errorf("%w for MaxDurationPeerUnresponsive", heartbeat.ErrInvalidTimeDuration)
+	}
+
+	return &baseV2MessageProcessor{
+		marshalizer:                 arg.Marshalizer,
+		antifloodHandler:            arg.AntifloodHandler,
+		cacher:                      arg.Cacher,
+		timer:                       arg.Timer,
+		topic:                       arg.Topic,
+		maxDurationPeerUnresponsive: arg.MaxDurationPeerUnresponsive,
+	}, nil
+}
+
+func (bmp *baseV2MessageProcessor) checkAntiflood(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return heartbeat.ErrNilMessage
+	}
+	if message.Data() == nil {
+		return heartbeat.ErrNilDataToProcess
+	}
+
+	err := bmp.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	return bmp.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, bmp.topic, 1, uint64(len(message.Data())), message.SeqNo())
+}
+
+func (bmp *baseV2MessageProcessor) checkPayload(payloadBytes []byte) (*data.Payload, error) {
+	payload := &data.Payload{}
+	err := bmp.marshalizer.Unmarshal(payload, payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := bmp.timer.Now()
+	messageTime := time.Unix(payload.Timestamp, 0)
+	if messageTime.Add(bmp.maxDurationPeerUnresponsive).Before(now) {
+		return nil, fmt.Errorf("%w, message time %v, current time %v", heartbeat.ErrMessageExpired, messageTime, now)
+	}
+	if messageTime.After(now.Add(maxTimeDriftForPayload)) {
+		return nil, fmt.Errorf("%w, message time %v, current time %v", heartbeat.ErrMessageFromFuture, messageTime, now)
+	}
+
+	return payload, nil
+}
+
+func (bmp *baseV2MessageProcessor) blacklistPeers(message p2p.MessageP2P, fromConnectedPeer core.PeerID, reason string) {
+	//this situation is so severe that we have to black list both the message originator and the connected peer
+	//that disseminated this message.
+	bmp.antifloodHandler.BlacklistPeer(message.Peer(), reason, core.InvalidMessageBlacklistDuration)
+	bmp.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+}
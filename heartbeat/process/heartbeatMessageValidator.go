@@ -69,3 +69,61 @@ func trimLengths(heartbeat *data.Heartbeat) {
 		heartbeat.VersionNumber = heartbeat.VersionNumber[:maxSizeInBytes]
 	}
 }
+
+func verifyHeartbeatV2Lengths(heartbeat *data.HeartbeatV2) error {
+	err := VerifyHeartbeatProperyLen("Payload", heartbeat.Payload)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("NodeDisplayName", []byte(heartbeat.NodeDisplayName))
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Identity", []byte(heartbeat.Identity))
+	if err != nil {
+		return err
+	}
+
+	return VerifyHeartbeatProperyLen("VersionNumber", []byte(heartbeat.VersionNumber))
+}
+
+func verifyPeerAuthenticationLengths(peerAuthentication *data.PeerAuthentication) error {
+	err := VerifyHeartbeatProperyLen("Pubkey", peerAuthentication.Pubkey)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Signature", peerAuthentication.Signature)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Pid", peerAuthentication.Pid)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Payload", peerAuthentication.Payload)
+	if err != nil {
+		return err
+	}
+
+	return VerifyHeartbeatProperyLen("PayloadSignature", peerAuthentication.PayloadSignature)
+}
+
+// TrimHeartbeatV2Lengths will trim the string fields of the provided heartbeat v2 message to the accepted length
+func TrimHeartbeatV2Lengths(heartbeat *data.HeartbeatV2) {
+	if len(heartbeat.NodeDisplayName) > maxSizeInBytes {
+		heartbeat.NodeDisplayName = heartbeat.NodeDisplayName[:maxSizeInBytes]
+	}
+
+	if len(heartbeat.Identity) > maxSizeInBytes {
+		heartbeat.Identity = heartbeat.Identity[:maxSizeInBytes]
+	}
+
+	if len(heartbeat.VersionNumber) > maxSizeInBytes {
+		heartbeat.VersionNumber = heartbeat.VersionNumber[:maxSizeInBytes]
+	}
+}
@@ -0,0 +1,75 @@
+package process
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ArgHeartbeatV2MessageProcessor represents the arguments for the heartbeat v2 message processor
+type ArgHeartbeatV2MessageProcessor struct {
+	ArgBaseV2MessageProcessor
+	NetworkShardingCollector heartbeat.NetworkShardingCollector
+}
+
+// HeartbeatV2MessageProcessor validates the received heartbeat v2 messages and stores them,
+// keyed by the originator peer ID, in a bounded cache
+type HeartbeatV2MessageProcessor struct {
+	*baseV2MessageProcessor
+	networkShardingCollector heartbeat.NetworkShardingCollector
+}
+
+// NewHeartbeatV2MessageProcessor creates a new instance of type HeartbeatV2MessageProcessor
+func NewHeartbeatV2MessageProcessor(arg ArgHeartbeatV2MessageProcessor) (*HeartbeatV2MessageProcessor, error) {
+	base, err := newBaseV2MessageProcessor(arg.ArgBaseV2MessageProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.NetworkShardingCollector) {
+		return nil, heartbeat.ErrNilNetworkShardingCollector
+	}
+
+	return &HeartbeatV2MessageProcessor{
+		baseV2MessageProcessor:   base,
+		networkShardingCollector: arg.NetworkShardingCollector,
+	}, nil
+}
+
+// ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
+// by the p2p subsystem each time a new heartbeat v2 message arrives
+func (hmp *HeartbeatV2MessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := hmp.checkAntiflood(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	hb := &data.HeartbeatV2{}
+	err = hmp.marshalizer.Unmarshal(hb, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = verifyHeartbeatV2Lengths(hb)
+	if err != nil {
+		hmp.blacklistPeers(message, fromConnectedPeer, "blacklisted due to invalid heartbeat v2 message")
+		return err
+	}
+
+	_, err = hmp.checkPayload(hb.Payload)
+	if err != nil {
+		return err
+	}
+
+	// the message is signed at the p2p level by the originator so the peer ID can be safely used as key
+	hmp.cacher.Put(message.Peer().Bytes(), hb, hb.Size())
+	hmp.networkShardingCollector.UpdatePeerIdShardId(message.Peer(), hb.ShardID)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hmp *HeartbeatV2MessageProcessor) IsInterfaceNil() bool {
+	return hmp == nil
+}
@@ -0,0 +1,150 @@
+package process_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgHeartbeatV2MessageProcessor() process.ArgHeartbeatV2MessageProcessor {
+	return process.ArgHeartbeatV2MessageProcessor{
+		ArgBaseV2MessageProcessor: createMockArgBaseV2MessageProcessor(),
+		NetworkShardingCollector: &mock.NetworkShardingCollectorStub{
+			UpdatePeerIdShardIdCalled: func(pid core.PeerID, shardId uint32) {},
+		},
+	}
+}
+
+func createHeartbeatV2P2PMessage(t *testing.T, hb *data.HeartbeatV2, timestamp int64, pid core.PeerID) *mock.P2PMessageStub {
+	marshalizer := &mock.MarshalizerMock{}
+	payloadBytes, err := marshalizer.Marshal(&data.Payload{Timestamp: timestamp})
+	require.Nil(t, err)
+
+	hb.Payload = payloadBytes
+	buff, err := marshalizer.Marshal(hb)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewHeartbeatV2MessageProcessor_InvalidBaseArgShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2MessageProcessor()
+	arg.Cacher = nil
+	hmp, err := process.NewHeartbeatV2MessageProcessor(arg)
+
+	assert.Nil(t, hmp)
+	assert.Equal(t, heartbeat.ErrNilCacher, err)
+}
+
+func TestNewHeartbeatV2MessageProcessor_NilNetworkShardingCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2MessageProcessor()
+	arg.NetworkShardingCollector = nil
+	hmp, err := process.NewHeartbeatV2MessageProcessor(arg)
+
+	assert.Nil(t, hmp)
+	assert.Equal(t, heartbeat.ErrNilNetworkShardingCollector, err)
+}
+
+func TestHeartbeatV2MessageProcessor_ProcessReceivedMessageNilDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hmp, _ := process.NewHeartbeatV2MessageProcessor(createMockArgHeartbeatV2MessageProcessor())
+
+	err := hmp.ProcessReceivedMessage(&mock.P2PMessageStub{}, "")
+	assert.Equal(t, heartbeat.ErrNilDataToProcess, err)
+}
+
+func TestHeartbeatV2MessageProcessor_ProcessReceivedMessageAntifloodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgHeartbeatV2MessageProcessor()
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			return expectedErr
+		},
+	}
+	hmp, _ := process.NewHeartbeatV2MessageProcessor(arg)
+
+	p2pMsg := createHeartbeatV2P2PMessage(t, &data.HeartbeatV2{}, testTimestamp, "pid")
+	err := hmp.ProcessReceivedMessage(p2pMsg, "")
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestHeartbeatV2MessageProcessor_ProcessReceivedMessagePropertyTooLongShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2MessageProcessor()
+	numBlacklisted := 0
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			numBlacklisted++
+		},
+	}
+	hmp, _ := process.NewHeartbeatV2MessageProcessor(arg)
+
+	hb := &data.HeartbeatV2{
+		NodeDisplayName: strings.Repeat("a", 129),
+	}
+	p2pMsg := createHeartbeatV2P2PMessage(t, hb, testTimestamp, "pid")
+	err := hmp.ProcessReceivedMessage(p2pMsg, "connected")
+	assert.True(t, errors.Is(err, heartbeat.ErrPropertyTooLong))
+	assert.Equal(t, 2, numBlacklisted)
+}
+
+func TestHeartbeatV2MessageProcessor_ProcessReceivedMessageExpiredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2MessageProcessor()
+	hmp, _ := process.NewHeartbeatV2MessageProcessor(arg)
+
+	p2pMsg := createHeartbeatV2P2PMessage(t, &data.HeartbeatV2{}, 0, "pid")
+	err := hmp.ProcessReceivedMessage(p2pMsg, "")
+	assert.True(t, errors.Is(err, heartbeat.ErrMessageExpired))
+	assert.Equal(t, 0, arg.Cacher.Len())
+}
+
+func TestHeartbeatV2MessageProcessor_ProcessReceivedMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2MessageProcessor()
+	var updatedShardID uint32
+	arg.NetworkShardingCollector = &mock.NetworkShardingCollectorStub{
+		UpdatePeerIdShardIdCalled: func(pid core.PeerID, shardId uint32) {
+			updatedShardID = shardId
+		},
+	}
+	hmp, _ := process.NewHeartbeatV2MessageProcessor(arg)
+
+	hb := &data.HeartbeatV2{
+		VersionNumber: "v1",
+		Nonce:         37,
+		ShardID:       2,
+	}
+	p2pMsg := createHeartbeatV2P2PMessage(t, hb, testTimestamp, "pid")
+	err := hmp.ProcessReceivedMessage(p2pMsg, "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), updatedShardID)
+
+	value, ok := arg.Cacher.Get([]byte("pid"))
+	require.True(t, ok)
+	assert.Equal(t, uint64(37), value.(*data.HeartbeatV2).Nonce)
+	assert.False(t, hmp.IsInterfaceNil())
+}
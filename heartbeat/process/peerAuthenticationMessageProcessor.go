@@ -0,0 +1,104 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ArgPeerAuthenticationMessageProcessor represents the arguments for the peer authentication message processor
+type ArgPeerAuthenticationMessageProcessor struct {
+	ArgBaseV2MessageProcessor
+	Verifier                 *PeerAuthenticationVerifier
+	HardforkTrigger          heartbeat.HardforkTrigger
+	NetworkShardingCollector heartbeat.NetworkShardingCollector
+}
+
+// PeerAuthenticationMessageProcessor validates the received peer authentication messages and stores them,
+// keyed by the originator peer ID, in a bounded cache
+type PeerAuthenticationMessageProcessor struct {
+	*baseV2MessageProcessor
+	verifier                 *PeerAuthenticationVerifier
+	hardforkTrigger          heartbeat.HardforkTrigger
+	networkShardingCollector heartbeat.NetworkShardingCollector
+}
+
+// NewPeerAuthenticationMessageProcessor creates a new instance of type PeerAuthenticationMessageProcessor
+func NewPeerAuthenticationMessageProcessor(arg ArgPeerAuthenticationMessageProcessor) (*PeerAuthenticationMessageProcessor, error) {
+	base, err := newBaseV2MessageProcessor(arg.ArgBaseV2MessageProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Verifier) {
+		return nil, heartbeat.ErrNilPeerAuthenticationVerifier
+	}
+	if check.IfNil(arg.HardforkTrigger) {
+		return nil, heartbeat.ErrNilHardforkTrigger
+	}
+	if check.IfNil(arg.NetworkShardingCollector) {
+		return nil, heartbeat.ErrNilNetworkShardingCollector
+	}
+
+	return &PeerAuthenticationMessageProcessor{
+		baseV2MessageProcessor:   base,
+		verifier:                 arg.Verifier,
+		hardforkTrigger:          arg.HardforkTrigger,
+		networkShardingCollector: arg.NetworkShardingCollector,
+	}, nil
+}
+
+// ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
+// by the p2p subsystem each time a new peer authentication message arrives
+func (pamp *PeerAuthenticationMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := pamp.checkAntiflood(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	peerAuthentication := &data.PeerAuthentication{}
+	err = pamp.marshalizer.Unmarshal(peerAuthentication, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = pamp.verifier.Verify(peerAuthentication)
+	if err != nil {
+		pamp.blacklistPeers(message, fromConnectedPeer, "blacklisted due to invalid peer authentication message")
+		return err
+	}
+
+	payload, err := pamp.checkPayload(peerAuthentication.Payload)
+	if err != nil {
+		return err
+	}
+
+	isHardforkTrigger, err := pamp.hardforkTrigger.TriggerReceived(message.Data(), []byte(payload.HardforkMessage), peerAuthentication.Pubkey)
+	if isHardforkTrigger {
+		return err
+	}
+
+	if !bytes.Equal(peerAuthentication.Pid, message.Peer().Bytes()) {
+		pamp.blacklistPeers(message, fromConnectedPeer, "blacklisted due to inconsistent peer authentication message")
+
+		return fmt.Errorf("%w peer authentication pid %s, message pid %s",
+			heartbeat.ErrPeerAuthenticationPidMismatch,
+			p2p.PeerIdToShortString(core.PeerID(peerAuthentication.Pid)),
+			p2p.PeerIdToShortString(message.Peer()),
+		)
+	}
+
+	pamp.cacher.Put(peerAuthentication.Pid, peerAuthentication, peerAuthentication.Size())
+	pamp.networkShardingCollector.UpdatePeerIdPublicKey(message.Peer(), peerAuthentication.Pubkey)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pamp *PeerAuthenticationMessageProcessor) IsInterfaceNil() bool {
+	return pamp == nil
+}
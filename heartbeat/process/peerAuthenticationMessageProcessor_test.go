@@ -0,0 +1,291 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimestamp = 1000
+
+func createMockArgBaseV2MessageProcessor() process.ArgBaseV2MessageProcessor {
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(testTimestamp)
+
+	return process.ArgBaseV2MessageProcessor{
+		Marshalizer:                 &mock.MarshalizerMock{},
+		AntifloodHandler:            &mock.P2PAntifloodHandlerStub{},
+		Cacher:                      testscommon.NewCacherMock(),
+		Timer:                       timer,
+		Topic:                       "topic",
+		MaxDurationPeerUnresponsive: time.Minute,
+	}
+}
+
+func createMockArgPeerAuthenticationMessageProcessor() process.ArgPeerAuthenticationMessageProcessor {
+	verifier, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		createMockKeyGenerator(),
+	)
+
+	return process.ArgPeerAuthenticationMessageProcessor{
+		ArgBaseV2MessageProcessor: createMockArgBaseV2MessageProcessor(),
+		Verifier:                  verifier,
+		HardforkTrigger:           &mock.HardforkTriggerStub{},
+		NetworkShardingCollector: &mock.NetworkShardingCollectorStub{
+			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
+		},
+	}
+}
+
+func createPeerAuthenticationP2PMessage(t *testing.T, timestamp int64, pid core.PeerID) p2p.MessageP2P {
+	marshalizer := &mock.MarshalizerMock{}
+	payloadBytes, err := marshalizer.Marshal(&data.Payload{Timestamp: timestamp})
+	require.Nil(t, err)
+
+	msg := createValidPeerAuthentication()
+	msg.Pid = pid.Bytes()
+	msg.Payload = payloadBytes
+	buff, err := marshalizer.Marshal(msg)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.Marshalizer = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilMarshalizer, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilAntifloodHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.AntifloodHandler = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilAntifloodHandler, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.Cacher = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilCacher, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.Timer = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilTimer, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_EmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.Topic = ""
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrEmptyTopic, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_InvalidMaxDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.MaxDurationPeerUnresponsive = time.Millisecond
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.Verifier = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilPeerAuthenticationVerifier, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilHardforkTriggerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.HardforkTrigger = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
+}
+
+func TestNewPeerAuthenticationMessageProcessor_NilNetworkShardingCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.NetworkShardingCollector = nil
+	pamp, err := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	assert.Nil(t, pamp)
+	assert.Equal(t, heartbeat.ErrNilNetworkShardingCollector, err)
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(createMockArgPeerAuthenticationMessageProcessor())
+
+	err := pamp.ProcessReceivedMessage(nil, "")
+	assert.Equal(t, heartbeat.ErrNilMessage, err)
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageAntifloodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+			return expectedErr
+		},
+	}
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	err := pamp.ProcessReceivedMessage(createPeerAuthenticationP2PMessage(t, testTimestamp, "pid"), "")
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageInvalidSignatureShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	blacklisted := make(map[core.PeerID]struct{})
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			blacklisted[peer] = struct{}{}
+		},
+	}
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	msg := createValidPeerAuthentication()
+	msg.Signature = []byte("invalid")
+	buff, _ := arg.Marshalizer.Marshal(msg)
+	p2pMsg := &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: "originator",
+	}
+
+	err := pamp.ProcessReceivedMessage(p2pMsg, "connected")
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, len(blacklisted))
+	assert.Equal(t, 0, arg.Cacher.Len())
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageExpiredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	p2pMsg := createPeerAuthenticationP2PMessage(t, testTimestamp-int64(arg.MaxDurationPeerUnresponsive.Seconds())-1, "pid")
+	err := pamp.ProcessReceivedMessage(p2pMsg, "")
+	assert.True(t, errors.Is(err, heartbeat.ErrMessageExpired))
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageFromFutureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	p2pMsg := createPeerAuthenticationP2PMessage(t, testTimestamp+3600, "pid")
+	err := pamp.ProcessReceivedMessage(p2pMsg, "")
+	assert.True(t, errors.Is(err, heartbeat.ErrMessageFromFuture))
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessagePidMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	p2pMsg := createPeerAuthenticationP2PMessage(t, testTimestamp, "pid")
+	p2pMsg.(*mock.P2PMessageStub).PeerField = "other pid"
+	err := pamp.ProcessReceivedMessage(p2pMsg, "")
+	assert.True(t, errors.Is(err, heartbeat.ErrPeerAuthenticationPidMismatch))
+	assert.Equal(t, 0, arg.Cacher.Len())
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageHardforkTriggerShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	arg.HardforkTrigger = &mock.HardforkTriggerStub{
+		TriggerReceivedCalled: func(payload []byte, data []byte, pkBytes []byte) (bool, error) {
+			return true, nil
+		},
+	}
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	err := pamp.ProcessReceivedMessage(createPeerAuthenticationP2PMessage(t, testTimestamp, "pid"), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, arg.Cacher.Len())
+}
+
+func TestPeerAuthenticationMessageProcessor_ProcessReceivedMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationMessageProcessor()
+	updatePeerIdPublicKeyCalled := false
+	arg.NetworkShardingCollector = &mock.NetworkShardingCollectorStub{
+		UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {
+			updatePeerIdPublicKeyCalled = true
+			assert.Equal(t, core.PeerID("pid"), pid)
+			assert.Equal(t, []byte("pk"), pk)
+		},
+	}
+	pamp, _ := process.NewPeerAuthenticationMessageProcessor(arg)
+
+	err := pamp.ProcessReceivedMessage(createPeerAuthenticationP2PMessage(t, testTimestamp, "pid"), "")
+	assert.Nil(t, err)
+	assert.True(t, updatePeerIdPublicKeyCalled)
+
+	value, ok := arg.Cacher.Get([]byte("pid"))
+	require.True(t, ok)
+	assert.Equal(t, []byte("pk"), value.(*data.PeerAuthentication).Pubkey)
+}
@@ -0,0 +1,74 @@
+package process
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// PeerAuthenticationVerifier is able to check that a peer authentication message correctly binds the contained
+// public key to the contained peer ID. The verification does not require any network context so it can be
+// done offline on any stored or exported message
+type PeerAuthenticationVerifier struct {
+	peerSignatureHandler crypto.PeerSignatureHandler
+	singleSigner         crypto.SingleSigner
+	keyGenerator         crypto.KeyGenerator
+}
+
+// NewPeerAuthenticationVerifier creates a new instance of type PeerAuthenticationVerifier
+func NewPeerAuthenticationVerifier(
+	peerSignatureHandler crypto.PeerSignatureHandler,
+	singleSigner crypto.SingleSigner,
+	keyGenerator crypto.KeyGenerator,
+) (*PeerAuthenticationVerifier, error) {
+	if check.IfNil(peerSignatureHandler) {
+		return nil, heartbeat.ErrNilPeerSignatureHandler
+	}
+	if check.IfNil(singleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
+	if check.IfNil(keyGenerator) {
+		return nil, heartbeat.ErrNilKeyGenerator
+	}
+
+	return &PeerAuthenticationVerifier{
+		peerSignatureHandler: peerSignatureHandler,
+		singleSigner:         singleSigner,
+		keyGenerator:         keyGenerator,
+	}, nil
+}
+
+// Verify checks the lengths of the fields, the signature over the peer ID and the signature over the payload
+func (pav *PeerAuthenticationVerifier) Verify(peerAuthentication *data.PeerAuthentication) error {
+	if peerAuthentication == nil {
+		return heartbeat.ErrNilMessage
+	}
+
+	err := verifyPeerAuthenticationLengths(peerAuthentication)
+	if err != nil {
+		return err
+	}
+
+	err = pav.peerSignatureHandler.VerifyPeerSignature(
+		peerAuthentication.Pubkey,
+		core.PeerID(peerAuthentication.Pid),
+		peerAuthentication.Signature,
+	)
+	if err != nil {
+		return err
+	}
+
+	pk, err := pav.keyGenerator.PublicKeyFromByteArray(peerAuthentication.Pubkey)
+	if err != nil {
+		return err
+	}
+
+	return pav.singleSigner.Verify(pk, peerAuthentication.Payload, peerAuthentication.PayloadSignature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pav *PeerAuthenticationVerifier) IsInterfaceNil() bool {
+	return pav == nil
+}
@@ -0,0 +1,144 @@
+package process_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockKeyGenerator() *mock.KeyGenMock {
+	return &mock.KeyGenMock{
+		PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+			return &mock.PublicKeyMock{}, nil
+		},
+	}
+}
+
+func createValidPeerAuthentication() *data.PeerAuthentication {
+	return &data.PeerAuthentication{
+		Pubkey:           []byte("pk"),
+		Signature:        []byte("signed"),
+		Pid:              []byte("pid"),
+		Payload:          []byte("payload"),
+		PayloadSignature: []byte("signed"),
+	}
+}
+
+func TestNewPeerAuthenticationVerifier_NilPeerSignatureHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, err := process.NewPeerAuthenticationVerifier(nil, &mock.SinglesignMock{}, createMockKeyGenerator())
+
+	assert.Nil(t, pav)
+	assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
+}
+
+func TestNewPeerAuthenticationVerifier_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, err := process.NewPeerAuthenticationVerifier(&mock.PeerSignatureHandler{}, nil, createMockKeyGenerator())
+
+	assert.Nil(t, pav)
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+}
+
+func TestNewPeerAuthenticationVerifier_NilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, err := process.NewPeerAuthenticationVerifier(&mock.PeerSignatureHandler{}, &mock.SinglesignMock{}, nil)
+
+	assert.Nil(t, pav)
+	assert.Equal(t, heartbeat.ErrNilKeyGenerator, err)
+}
+
+func TestPeerAuthenticationVerifier_VerifyNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		createMockKeyGenerator(),
+	)
+
+	assert.Equal(t, heartbeat.ErrNilMessage, pav.Verify(nil))
+}
+
+func TestPeerAuthenticationVerifier_VerifyPropertyTooLongShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		createMockKeyGenerator(),
+	)
+	msg := createValidPeerAuthentication()
+	msg.Payload = []byte(strings.Repeat("a", 129))
+
+	err := pav.Verify(msg)
+	assert.True(t, errors.Is(err, heartbeat.ErrPropertyTooLong))
+}
+
+func TestPeerAuthenticationVerifier_VerifyInvalidPeerSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		createMockKeyGenerator(),
+	)
+	msg := createValidPeerAuthentication()
+	msg.Signature = []byte("invalid")
+
+	assert.Equal(t, crypto.ErrSigNotValid, pav.Verify(msg))
+}
+
+func TestPeerAuthenticationVerifier_VerifyInvalidPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	pav, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		&mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return nil, expectedErr
+			},
+		},
+	)
+
+	assert.Equal(t, expectedErr, pav.Verify(createValidPeerAuthentication()))
+}
+
+func TestPeerAuthenticationVerifier_VerifyInvalidPayloadSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pav, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		createMockKeyGenerator(),
+	)
+	msg := createValidPeerAuthentication()
+	msg.PayloadSignature = []byte("invalid")
+
+	assert.Equal(t, crypto.ErrSigNotValid, pav.Verify(msg))
+}
+
+func TestPeerAuthenticationVerifier_VerifyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pav, _ := process.NewPeerAuthenticationVerifier(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.SinglesignMock{},
+		createMockKeyGenerator(),
+	)
+
+	assert.False(t, pav.IsInterfaceNil())
+	assert.Nil(t, pav.Verify(createValidPeerAuthentication()))
+}
@@ -0,0 +1,82 @@
+package sender
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const minTimeBetweenSends = time.Second
+const minThresholdBetweenSends = 0.0
+const maxThresholdBetweenSends = 1.0
+
+// argBaseSender represents the arguments shared by the peer authentication and heartbeat senders
+type argBaseSender struct {
+	messenger                 heartbeat.P2PMessenger
+	marshalizer               marshal.Marshalizer
+	topic                     string
+	timeBetweenSends          time.Duration
+	timeBetweenSendsWhenError time.Duration
+	thresholdBetweenSends     float64
+}
+
+type baseSender struct {
+	timerHandler
+	messenger                 heartbeat.P2PMessenger
+	marshalizer               marshal.Marshalizer
+	topic                     string
+	timeBetweenSends          time.Duration
+	timeBetweenSendsWhenError time.Duration
+	thresholdBetweenSends     float64
+}
+
+func createBaseSender(args argBaseSender) baseSender {
+	return baseSender{
+		timerHandler:              &timerWrapper{timer: time.NewTimer(args.timeBetweenSends)},
+		messenger:                 args.messenger,
+		marshalizer:               args.marshalizer,
+		topic:                     args.topic,
+		timeBetweenSends:          args.timeBetweenSends,
+		timeBetweenSendsWhenError: args.timeBetweenSendsWhenError,
+		thresholdBetweenSends:     args.thresholdBetweenSends,
+	}
+}
+
+func checkBaseSenderArgs(args argBaseSender) error {
+	if check.IfNil(args.messenger) {
+		return heartbeat.ErrNilMessenger
+	}
+	if check.IfNil(args.marshalizer) {
+		return heartbeat.ErrNilMarshalizer
+	}
+	if len(args.topic) == 0 {
+		return heartbeat.ErrEmptyTopic
+	}
+	if args.timeBetweenSends < minTimeBetweenSends {
+		return fmt.Errorf("%w for timeBetweenSends", heartbeat.ErrInvalidTimeDuration)
+	}
+	if args.timeBetweenSendsWhenError < minTimeBetweenSends {
+		return fmt.Errorf("%w for timeBetweenSendsWhenError", heartbeat.ErrInvalidTimeDuration)
+	}
+	if args.thresholdBetweenSends < minThresholdBetweenSends || args.thresholdBetweenSends > maxThresholdBetweenSends {
+		return fmt.Errorf("%w for thresholdBetweenSends, received %f, min allowed %f, max allowed %f",
+			heartbeat.ErrInvalidThreshold, args.thresholdBetweenSends, minThresholdBetweenSends, maxThresholdBetweenSends)
+	}
+
+	return nil
+}
+
+// computeRandomDuration adds a random delta of at most thresholdBetweenSends * timeBetweenSends so that the nodes
+// in the network will not broadcast their messages at the same time
+func (bs *baseSender) computeRandomDuration() time.Duration {
+	maxDelta := int64(float64(bs.timeBetweenSends) * bs.thresholdBetweenSends)
+	if maxDelta <= 0 {
+		return bs.timeBetweenSends
+	}
+
+	return bs.timeBetweenSends + time.Duration(rand.Int63n(maxDelta))
+}
@@ -0,0 +1,106 @@
+package sender
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockBaseArgs() argBaseSender {
+	return argBaseSender{
+		messenger:                 &mock.MessengerStub{},
+		marshalizer:               &mock.MarshalizerMock{},
+		topic:                     "topic",
+		timeBetweenSends:          time.Second,
+		timeBetweenSendsWhenError: time.Second,
+		thresholdBetweenSends:     0.1,
+	}
+}
+
+func TestCheckBaseSenderArgs_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.messenger = nil
+
+	assert.Equal(t, heartbeat.ErrNilMessenger, checkBaseSenderArgs(args))
+}
+
+func TestCheckBaseSenderArgs_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.marshalizer = nil
+
+	assert.Equal(t, heartbeat.ErrNilMarshalizer, checkBaseSenderArgs(args))
+}
+
+func TestCheckBaseSenderArgs_EmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.topic = ""
+
+	assert.Equal(t, heartbeat.ErrEmptyTopic, checkBaseSenderArgs(args))
+}
+
+func TestCheckBaseSenderArgs_InvalidTimeBetweenSendsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.timeBetweenSends = time.Second - time.Nanosecond
+
+	err := checkBaseSenderArgs(args)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+}
+
+func TestCheckBaseSenderArgs_InvalidTimeBetweenSendsWhenErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.timeBetweenSendsWhenError = time.Second - time.Nanosecond
+
+	err := checkBaseSenderArgs(args)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+}
+
+func TestCheckBaseSenderArgs_InvalidThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.thresholdBetweenSends = -0.1
+	err := checkBaseSenderArgs(args)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidThreshold))
+
+	args.thresholdBetweenSends = 1.1
+	err = checkBaseSenderArgs(args)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidThreshold))
+}
+
+func TestCheckBaseSenderArgs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, checkBaseSenderArgs(createMockBaseArgs()))
+}
+
+func TestBaseSender_ComputeRandomDuration(t *testing.T) {
+	t.Parallel()
+
+	args := createMockBaseArgs()
+	args.timeBetweenSends = time.Second * 10
+	args.thresholdBetweenSends = 0.5
+	bs := createBaseSender(args)
+
+	for i := 0; i < 100; i++ {
+		duration := bs.computeRandomDuration()
+		assert.True(t, duration >= args.timeBetweenSends)
+		assert.True(t, duration < args.timeBetweenSends+time.Second*5)
+	}
+
+	bs.thresholdBetweenSends = 0
+	assert.Equal(t, args.timeBetweenSends, bs.computeRandomDuration())
+}
@@ -0,0 +1,121 @@
+package sender
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// argHeartbeatSender represents the arguments for the heartbeat sender
+type argHeartbeatSender struct {
+	argBaseSender
+	versionNumber        string
+	nodeDisplayName      string
+	identity             string
+	shardCoordinator     sharding.Coordinator
+	currentBlockProvider heartbeat.CurrentBlockProvider
+}
+
+type heartbeatSender struct {
+	baseSender
+	versionNumber        string
+	nodeDisplayName      string
+	identity             string
+	shardCoordinator     sharding.Coordinator
+	currentBlockProvider heartbeat.CurrentBlockProvider
+}
+
+// newHeartbeatSender creates a new instance of type heartbeatSender
+func newHeartbeatSender(args argHeartbeatSender) (*heartbeatSender, error) {
+	err := checkHeartbeatSenderArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &heartbeatSender{
+		baseSender:           createBaseSender(args.argBaseSender),
+		versionNumber:        args.versionNumber,
+		nodeDisplayName:      args.nodeDisplayName,
+		identity:             args.identity,
+		shardCoordinator:     args.shardCoordinator,
+		currentBlockProvider: args.currentBlockProvider,
+	}, nil
+}
+
+func checkHeartbeatSenderArgs(args argHeartbeatSender) error {
+	err := checkBaseSenderArgs(args.argBaseSender)
+	if err != nil {
+		return err
+	}
+	err = process.VerifyHeartbeatProperyLen("application version string", []byte(args.versionNumber))
+	if err != nil {
+		return err
+	}
+	if check.IfNil(args.shardCoordinator) {
+		return heartbeat.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.currentBlockProvider) {
+		return fmt.Errorf("%w for currentBlockProvider", heartbeat.ErrNilCurrentBlockProvider)
+	}
+
+	return nil
+}
+
+// Execute will handle the execution of a cycle in which the heartbeat message will be sent
+func (sender *heartbeatSender) Execute() {
+	duration := sender.computeRandomDuration()
+	err := sender.execute()
+	if err != nil {
+		duration = sender.timeBetweenSendsWhenError
+		log.Error("error sending heartbeat message", "error", err, "next send will be in", duration)
+	} else {
+		log.Debug("heartbeat message sent", "next send will be in", duration)
+	}
+
+	sender.CreateNewTimer(duration)
+}
+
+func (sender *heartbeatSender) execute() error {
+	payload := &heartbeatData.Payload{
+		Timestamp: time.Now().Unix(),
+	}
+	payloadBytes, err := sender.marshalizer.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	nonce := uint64(0)
+	currentBlock := sender.currentBlockProvider.GetCurrentBlockHeader()
+	if !check.IfNil(currentBlock) {
+		nonce = currentBlock.GetNonce()
+	}
+
+	msg := &heartbeatData.HeartbeatV2{
+		Payload:         payloadBytes,
+		VersionNumber:   sender.versionNumber,
+		NodeDisplayName: sender.nodeDisplayName,
+		Identity:        sender.identity,
+		Nonce:           nonce,
+		ShardID:         sender.shardCoordinator.SelfId(),
+	}
+	process.TrimHeartbeatV2Lengths(msg)
+
+	msgBytes, err := sender.marshalizer.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	sender.messenger.Broadcast(sender.topic, msgBytes)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *heartbeatSender) IsInterfaceNil() bool {
+	return sender == nil
+}
@@ -0,0 +1,116 @@
+package sender
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockHeartbeatSenderArgs() argHeartbeatSender {
+	return argHeartbeatSender{
+		argBaseSender:        createMockBaseArgs(),
+		versionNumber:        "v1",
+		nodeDisplayName:      "node",
+		identity:             "identity",
+		shardCoordinator:     &mock.ShardCoordinatorMock{},
+		currentBlockProvider: &mock.CurrentBlockProviderStub{},
+	}
+}
+
+func TestNewHeartbeatSender_VersionNumberTooLongShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatSenderArgs()
+	args.versionNumber = strings.Repeat("a", 129)
+	sender, err := newHeartbeatSender(args)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrPropertyTooLong))
+}
+
+func TestNewHeartbeatSender_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatSenderArgs()
+	args.shardCoordinator = nil
+	sender, err := newHeartbeatSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilShardCoordinator, err)
+}
+
+func TestNewHeartbeatSender_NilCurrentBlockProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatSenderArgs()
+	args.currentBlockProvider = nil
+	sender, err := newHeartbeatSender(args)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilCurrentBlockProvider))
+}
+
+func TestNewHeartbeatSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sender, err := newHeartbeatSender(createMockHeartbeatSenderArgs())
+
+	assert.NotNil(t, sender)
+	assert.Nil(t, err)
+	assert.False(t, sender.IsInterfaceNil())
+}
+
+func TestHeartbeatSender_ExecuteShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatSenderArgs()
+	var broadcastBuff []byte
+	args.messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Equal(t, args.topic, topic)
+			broadcastBuff = buff
+		},
+	}
+	args.currentBlockProvider = &mock.CurrentBlockProviderStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 37}
+		},
+	}
+	args.nodeDisplayName = strings.Repeat("a", 200)
+	sender, _ := newHeartbeatSender(args)
+
+	err := sender.execute()
+	require.Nil(t, err)
+
+	msg := &heartbeatData.HeartbeatV2{}
+	err = args.marshalizer.Unmarshal(msg, broadcastBuff)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(37), msg.Nonce)
+	assert.Equal(t, args.versionNumber, msg.VersionNumber)
+	assert.Equal(t, args.identity, msg.Identity)
+	assert.Equal(t, 128, len(msg.NodeDisplayName))
+}
+
+func TestHeartbeatSender_ExecuteMarshalErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatSenderArgs()
+	args.marshalizer = &mock.MarshalizerMock{Fail: true}
+	args.messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not called broadcast")
+		},
+	}
+	sender, _ := newHeartbeatSender(args)
+
+	err := sender.execute()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,22 @@
+package sender
+
+import "time"
+
+type senderHandler interface {
+	ExecutionReadyChannel() <-chan time.Time
+	Execute()
+	Close()
+	IsInterfaceNil() bool
+}
+
+type timerHandler interface {
+	CreateNewTimer(duration time.Duration)
+	ExecutionReadyChannel() <-chan time.Time
+	Close()
+}
+
+type hardforkHandler interface {
+	ShouldTriggerHardfork() <-chan struct{}
+	Execute()
+	Close()
+}
@@ -0,0 +1,171 @@
+package sender
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// argPeerAuthenticationSender represents the arguments for the peer authentication sender
+type argPeerAuthenticationSender struct {
+	argBaseSender
+	peerSignatureHandler crypto.PeerSignatureHandler
+	singleSigner         crypto.SingleSigner
+	privKey              crypto.PrivateKey
+	redundancyHandler    heartbeat.NodeRedundancyHandler
+	hardforkTrigger      heartbeat.HardforkTrigger
+}
+
+type peerAuthenticationSender struct {
+	baseSender
+	peerSignatureHandler crypto.PeerSignatureHandler
+	singleSigner         crypto.SingleSigner
+	privKey              crypto.PrivateKey
+	publicKey            crypto.PublicKey
+	observerPublicKey    crypto.PublicKey
+	redundancy           heartbeat.NodeRedundancyHandler
+	hardforkTrigger      heartbeat.HardforkTrigger
+}
+
+// newPeerAuthenticationSender will create a new instance of type peerAuthenticationSender
+func newPeerAuthenticationSender(args argPeerAuthenticationSender) (*peerAuthenticationSender, error) {
+	err := checkPeerAuthenticationSenderArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	observerPrivateKey := args.redundancyHandler.ObserverPrivateKey()
+	if check.IfNil(observerPrivateKey) {
+		return nil, fmt.Errorf("%w for redundancyHandler.ObserverPrivateKey()", heartbeat.ErrNilPrivateKey)
+	}
+
+	sender := &peerAuthenticationSender{
+		baseSender:           createBaseSender(args.argBaseSender),
+		peerSignatureHandler: args.peerSignatureHandler,
+		singleSigner:         args.singleSigner,
+		privKey:              args.privKey,
+		publicKey:            args.privKey.GeneratePublic(),
+		observerPublicKey:    observerPrivateKey.GeneratePublic(),
+		redundancy:           args.redundancyHandler,
+		hardforkTrigger:      args.hardforkTrigger,
+	}
+
+	return sender, nil
+}
+
+func checkPeerAuthenticationSenderArgs(args argPeerAuthenticationSender) error {
+	err := checkBaseSenderArgs(args.argBaseSender)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(args.peerSignatureHandler) {
+		return heartbeat.ErrNilPeerSignatureHandler
+	}
+	if check.IfNil(args.singleSigner) {
+		return heartbeat.ErrNilSingleSigner
+	}
+	if check.IfNil(args.privKey) {
+		return fmt.Errorf("%w for privKey", heartbeat.ErrNilPrivateKey)
+	}
+	if check.IfNil(args.redundancyHandler) {
+		return heartbeat.ErrNilRedundancyHandler
+	}
+	if check.IfNil(args.hardforkTrigger) {
+		return heartbeat.ErrNilHardforkTrigger
+	}
+
+	return nil
+}
+
+// Execute will handle the execution of a cycle in which the peer authentication message will be sent
+func (sender *peerAuthenticationSender) Execute() {
+	duration := sender.computeRandomDuration()
+	err := sender.execute()
+	if err != nil {
+		duration = sender.timeBetweenSendsWhenError
+		log.Error("error sending peer authentication message", "error", err, "next send will be in", duration)
+	} else {
+		log.Debug("peer authentication message sent", "next send will be in", duration)
+	}
+
+	sender.CreateNewTimer(duration)
+}
+
+func (sender *peerAuthenticationSender) execute() error {
+	sk, pk := sender.getCurrentPrivateAndPublicKeys()
+
+	pkBytes, err := pk.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	payload := &heartbeatData.Payload{
+		Timestamp: time.Now().Unix(),
+	}
+
+	triggerMessage, isHardforkTriggered := sender.hardforkTrigger.RecordedTriggerMessage()
+	if isHardforkTriggered {
+		isPayloadRecorded := len(triggerMessage) != 0
+		if isPayloadRecorded {
+			// the recorded trigger message is broadcast as it is so that it will be spread in an epidemic manner
+			log.Debug("broadcasting stored hardfork message")
+			sender.messenger.Broadcast(sender.topic, triggerMessage)
+		} else {
+			payload.HardforkMessage = string(sender.hardforkTrigger.CreateData())
+		}
+	}
+
+	payloadBytes, err := sender.marshalizer.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	pid := sender.messenger.ID()
+	msg := &heartbeatData.PeerAuthentication{
+		Pubkey:  pkBytes,
+		Pid:     pid.Bytes(),
+		Payload: payloadBytes,
+	}
+
+	msg.Signature, err = sender.peerSignatureHandler.GetPeerSignature(sk, msg.Pid)
+	if err != nil {
+		return err
+	}
+
+	msg.PayloadSignature, err = sender.singleSigner.Sign(sk, payloadBytes)
+	if err != nil {
+		return err
+	}
+
+	msgBytes, err := sender.marshalizer.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	sender.messenger.Broadcast(sender.topic, msgBytes)
+
+	return nil
+}
+
+// ShouldTriggerHardfork signals when hardfork message should be sent
+func (sender *peerAuthenticationSender) ShouldTriggerHardfork() <-chan struct{} {
+	return sender.hardforkTrigger.NotifyTriggerReceived()
+}
+
+func (sender *peerAuthenticationSender) getCurrentPrivateAndPublicKeys() (crypto.PrivateKey, crypto.PublicKey) {
+	shouldUseOriginalKeys := !sender.redundancy.IsRedundancyNode() || !sender.redundancy.IsMainMachineActive()
+	if shouldUseOriginalKeys {
+		return sender.privKey, sender.publicKey
+	}
+
+	return sender.redundancy.ObserverPrivateKey(), sender.observerPublicKey
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *peerAuthenticationSender) IsInterfaceNil() bool {
+	return sender == nil
+}
@@ -0,0 +1,259 @@
+package sender
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockPeerAuthenticationSenderArgs() argPeerAuthenticationSender {
+	return argPeerAuthenticationSender{
+		argBaseSender:        createMockBaseArgs(),
+		peerSignatureHandler: &mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		singleSigner:         &mock.SinglesignMock{},
+		privKey: &mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{
+					ToByteArrayHandler: func() ([]byte, error) {
+						return []byte("pk"), nil
+					},
+				}
+			},
+		},
+		redundancyHandler: &mock.RedundancyHandlerStub{},
+		hardforkTrigger:   &mock.HardforkTriggerStub{},
+	}
+}
+
+func TestNewPeerAuthenticationSender_NilPeerSignatureHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.peerSignatureHandler = nil
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
+}
+
+func TestNewPeerAuthenticationSender_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.singleSigner = nil
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+}
+
+func TestNewPeerAuthenticationSender_NilPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.privKey = nil
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilPrivateKey))
+}
+
+func TestNewPeerAuthenticationSender_NilRedundancyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.redundancyHandler = nil
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilRedundancyHandler, err)
+}
+
+func TestNewPeerAuthenticationSender_NilObserverPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.redundancyHandler = &mock.RedundancyHandlerStub{
+		ObserverPrivateKeyCalled: func() crypto.PrivateKey {
+			return nil
+		},
+	}
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.True(t, errors.Is(err, heartbeat.ErrNilPrivateKey))
+}
+
+func TestNewPeerAuthenticationSender_NilHardforkTriggerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.hardforkTrigger = nil
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
+}
+
+func TestNewPeerAuthenticationSender_InvalidBaseArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.messenger = nil
+	sender, err := newPeerAuthenticationSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilMessenger, err)
+}
+
+func TestPeerAuthenticationSender_ExecuteShouldBroadcastSignedMessage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	var broadcastTopic string
+	var broadcastBuff []byte
+	args.messenger = &mock.MessengerStub{
+		IDCalled: func() core.PeerID {
+			return "pid"
+		},
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastTopic = topic
+			broadcastBuff = buff
+		},
+	}
+	sender, _ := newPeerAuthenticationSender(args)
+
+	err := sender.execute()
+	require.Nil(t, err)
+	assert.Equal(t, args.topic, broadcastTopic)
+
+	msg := &data.PeerAuthentication{}
+	err = args.marshalizer.Unmarshal(msg, broadcastBuff)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("pk"), msg.Pubkey)
+	assert.Equal(t, []byte("pid"), msg.Pid)
+	assert.Equal(t, []byte("signed"), msg.Signature)
+	assert.Equal(t, []byte("signed"), msg.PayloadSignature)
+
+	payload := &data.Payload{}
+	err = args.marshalizer.Unmarshal(payload, msg.Payload)
+	require.Nil(t, err)
+	assert.True(t, payload.Timestamp > 0)
+	assert.Empty(t, payload.HardforkMessage)
+}
+
+func TestPeerAuthenticationSender_ExecuteSignErrorShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	args.singleSigner = &mock.SinglesignFailMock{}
+	args.messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not called broadcast")
+		},
+	}
+	sender, _ := newPeerAuthenticationSender(args)
+
+	err := sender.execute()
+	assert.NotNil(t, err)
+}
+
+func TestPeerAuthenticationSender_ExecuteHardforkTriggeredShouldAddHardforkData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	var broadcastBuff []byte
+	args.messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastBuff = buff
+		},
+	}
+	args.hardforkTrigger = &mock.HardforkTriggerStub{
+		RecordedTriggerMessageCalled: func() ([]byte, bool) {
+			return nil, true
+		},
+		CreateDataCalled: func() []byte {
+			return []byte("hardfork data")
+		},
+	}
+	sender, _ := newPeerAuthenticationSender(args)
+
+	err := sender.execute()
+	require.Nil(t, err)
+
+	msg := &data.PeerAuthentication{}
+	_ = args.marshalizer.Unmarshal(msg, broadcastBuff)
+	payload := &data.Payload{}
+	_ = args.marshalizer.Unmarshal(payload, msg.Payload)
+	assert.Equal(t, "hardfork data", payload.HardforkMessage)
+}
+
+func TestPeerAuthenticationSender_ExecuteHardforkRecordedMessageShouldBroadcastIt(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	recordedMessage := []byte("recorded message")
+	broadcastBuffs := make([][]byte, 0)
+	args.messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastBuffs = append(broadcastBuffs, buff)
+		},
+	}
+	args.hardforkTrigger = &mock.HardforkTriggerStub{
+		RecordedTriggerMessageCalled: func() ([]byte, bool) {
+			return recordedMessage, true
+		},
+	}
+	sender, _ := newPeerAuthenticationSender(args)
+
+	err := sender.execute()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(broadcastBuffs))
+	assert.Equal(t, recordedMessage, broadcastBuffs[0])
+}
+
+func TestPeerAuthenticationSender_ExecuteRedundancyNodeShouldUseObserverKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerAuthenticationSenderArgs()
+	var broadcastBuff []byte
+	args.messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastBuff = buff
+		},
+	}
+	args.redundancyHandler = &mock.RedundancyHandlerStub{
+		IsRedundancyNodeCalled: func() bool {
+			return true
+		},
+		IsMainMachineActiveCalled: func() bool {
+			return true
+		},
+		ObserverPrivateKeyCalled: func() crypto.PrivateKey {
+			return &mock.PrivateKeyStub{
+				GeneratePublicHandler: func() crypto.PublicKey {
+					return &mock.PublicKeyMock{
+						ToByteArrayHandler: func() ([]byte, error) {
+							return []byte("observer pk"), nil
+						},
+					}
+				},
+			}
+		},
+	}
+	sender, _ := newPeerAuthenticationSender(args)
+
+	err := sender.execute()
+	require.Nil(t, err)
+
+	msg := &data.PeerAuthentication{}
+	_ = args.marshalizer.Unmarshal(msg, broadcastBuff)
+	assert.Equal(t, []byte("observer pk"), msg.Pubkey)
+}
@@ -0,0 +1,77 @@
+package sender
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type senderHandlerStub struct {
+	executionReadyChannel chan time.Time
+	numExecuteCalls       uint32
+	numCloseCalls         uint32
+}
+
+func newSenderHandlerStub() *senderHandlerStub {
+	return &senderHandlerStub{
+		executionReadyChannel: make(chan time.Time),
+	}
+}
+
+func (stub *senderHandlerStub) ExecutionReadyChannel() <-chan time.Time {
+	return stub.executionReadyChannel
+}
+
+func (stub *senderHandlerStub) Execute() {
+	atomic.AddUint32(&stub.numExecuteCalls, 1)
+}
+
+func (stub *senderHandlerStub) Close() {
+	atomic.AddUint32(&stub.numCloseCalls, 1)
+}
+
+func (stub *senderHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type hardforkHandlerStub struct {
+	senderHandlerStub
+	shouldTriggerHardfork chan struct{}
+}
+
+func (stub *hardforkHandlerStub) ShouldTriggerHardfork() <-chan struct{} {
+	return stub.shouldTriggerHardfork
+}
+
+func TestRoutineHandler_ShouldExecuteAtStartAndOnEachTimerTick(t *testing.T) {
+	t.Parallel()
+
+	peerAuthentication := newSenderHandlerStub()
+	hb := newSenderHandlerStub()
+	hardfork := &hardforkHandlerStub{shouldTriggerHardfork: make(chan struct{})}
+
+	handler := newRoutineHandler(peerAuthentication, hb, hardfork)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&peerAuthentication.numExecuteCalls))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&hb.numExecuteCalls))
+
+	peerAuthentication.executionReadyChannel <- time.Now()
+	hb.executionReadyChannel <- time.Now()
+	hb.executionReadyChannel <- time.Now()
+	hardfork.shouldTriggerHardfork <- struct{}{}
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&peerAuthentication.numExecuteCalls))
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&hb.numExecuteCalls))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&hardfork.numExecuteCalls))
+
+	handler.closeProcessLoop()
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&peerAuthentication.numCloseCalls))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&hb.numCloseCalls))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&hardfork.numCloseCalls))
+}
@@ -0,0 +1,96 @@
+package sender
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgSender represents the arguments for the heartbeat v2 sender
+type ArgSender struct {
+	Messenger                                   heartbeat.P2PMessenger
+	Marshalizer                                 marshal.Marshalizer
+	PeerAuthenticationTopic                     string
+	HeartbeatTopic                              string
+	PeerAuthenticationTimeBetweenSends          time.Duration
+	PeerAuthenticationTimeBetweenSendsWhenError time.Duration
+	PeerAuthenticationThresholdBetweenSends     float64
+	HeartbeatTimeBetweenSends                   time.Duration
+	HeartbeatTimeBetweenSendsWhenError          time.Duration
+	HeartbeatThresholdBetweenSends              float64
+	VersionNumber                               string
+	NodeDisplayName                             string
+	Identity                                    string
+	ShardCoordinator                            sharding.Coordinator
+	CurrentBlockProvider                        heartbeat.CurrentBlockProvider
+	PeerSignatureHandler                        crypto.PeerSignatureHandler
+	SingleSigner                                crypto.SingleSigner
+	PrivateKey                                  crypto.PrivateKey
+	RedundancyHandler                           heartbeat.NodeRedundancyHandler
+	HardforkTrigger                             heartbeat.HardforkTrigger
+}
+
+// Sender defines the component which sends the peer authentication and the heartbeat v2 messages
+type Sender struct {
+	routineHandler *routineHandler
+}
+
+// NewSender creates a new instance of Sender
+func NewSender(args ArgSender) (*Sender, error) {
+	pas, err := newPeerAuthenticationSender(argPeerAuthenticationSender{
+		argBaseSender: argBaseSender{
+			messenger:                 args.Messenger,
+			marshalizer:               args.Marshalizer,
+			topic:                     args.PeerAuthenticationTopic,
+			timeBetweenSends:          args.PeerAuthenticationTimeBetweenSends,
+			timeBetweenSendsWhenError: args.PeerAuthenticationTimeBetweenSendsWhenError,
+			thresholdBetweenSends:     args.PeerAuthenticationThresholdBetweenSends,
+		},
+		peerSignatureHandler: args.PeerSignatureHandler,
+		singleSigner:         args.SingleSigner,
+		privKey:              args.PrivateKey,
+		redundancyHandler:    args.RedundancyHandler,
+		hardforkTrigger:      args.HardforkTrigger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hbs, err := newHeartbeatSender(argHeartbeatSender{
+		argBaseSender: argBaseSender{
+			messenger:                 args.Messenger,
+			marshalizer:               args.Marshalizer,
+			topic:                     args.HeartbeatTopic,
+			timeBetweenSends:          args.HeartbeatTimeBetweenSends,
+			timeBetweenSendsWhenError: args.HeartbeatTimeBetweenSendsWhenError,
+			thresholdBetweenSends:     args.HeartbeatThresholdBetweenSends,
+		},
+		versionNumber:        args.VersionNumber,
+		nodeDisplayName:      args.NodeDisplayName,
+		identity:             args.Identity,
+		shardCoordinator:     args.ShardCoordinator,
+		currentBlockProvider: args.CurrentBlockProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sender{
+		routineHandler: newRoutineHandler(pas, hbs, pas),
+	}, nil
+}
+
+// Close closes the internal components
+func (sender *Sender) Close() error {
+	sender.routineHandler.closeProcessLoop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *Sender) IsInterfaceNil() bool {
+	return sender == nil
+}
@@ -0,0 +1,67 @@
+package sender
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockSenderArgs() ArgSender {
+	return ArgSender{
+		Messenger:                          &mock.MessengerStub{},
+		Marshalizer:                        &mock.MarshalizerMock{},
+		PeerAuthenticationTopic:            "pa-topic",
+		HeartbeatTopic:                     "hb-topic",
+		PeerAuthenticationTimeBetweenSends: time.Second,
+		PeerAuthenticationTimeBetweenSendsWhenError: time.Second,
+		PeerAuthenticationThresholdBetweenSends:     0.1,
+		HeartbeatTimeBetweenSends:                   time.Second,
+		HeartbeatTimeBetweenSendsWhenError:          time.Second,
+		HeartbeatThresholdBetweenSends:              0.1,
+		VersionNumber:                               "v1",
+		NodeDisplayName:                             "node",
+		Identity:                                    "identity",
+		ShardCoordinator:                            &mock.ShardCoordinatorMock{},
+		CurrentBlockProvider:                        &mock.CurrentBlockProviderStub{},
+		PeerSignatureHandler:                        &mock.PeerSignatureHandler{},
+		SingleSigner:                                &mock.SinglesignMock{},
+		PrivateKey:                                  createMockPeerAuthenticationSenderArgs().privKey,
+		RedundancyHandler:                           &mock.RedundancyHandlerStub{},
+		HardforkTrigger:                             &mock.HardforkTriggerStub{},
+	}
+}
+
+func TestNewSender_InvalidPeerAuthenticationArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSenderArgs()
+	args.PeerAuthenticationTopic = ""
+	sender, err := NewSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrEmptyTopic, err)
+}
+
+func TestNewSender_InvalidHeartbeatArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSenderArgs()
+	args.HeartbeatTopic = ""
+	sender, err := NewSender(args)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrEmptyTopic, err)
+}
+
+func TestNewSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sender, err := NewSender(createMockSenderArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, sender.IsInterfaceNil())
+	assert.Nil(t, sender.Close())
+}
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
@@ -43,11 +45,14 @@ func initNodesAndTest(
 
 	concMap := &sync.Map{}
 
+	advertiserAddr := getConnectableAddress(advertiser)
 	nodes := createNodes(
 		int(numNodes),
 		int(consensusSize),
 		roundTime,
-		getConnectableAddress(advertiser),
+		func() p2p.Messenger {
+			return integrationTests.CreateMessengerWithKadDht(advertiserAddr)
+		},
 		consensusType,
 	)
 
@@ -234,3 +239,95 @@ func TestConsensusBLSNotEnoughValidators(t *testing.T) {
 
 	runConsensusWithNotEnoughValidators(t, blsConsensusType)
 }
+
+func waitForCommittedBlocks(mutex *sync.Mutex, totalCalled *int, numCommittedBlocks int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		mutex.Lock()
+		isReached := *totalCalled >= numCommittedBlocks
+		mutex.Unlock()
+		if isReached {
+			return true
+		}
+
+		time.Sleep(integrationTests.StepDelay)
+	}
+
+	return false
+}
+
+func runConsensusAfterNetworkPartition(t *testing.T, consensusType string) {
+	numNodes := uint32(4)
+	consensusSize := uint32(4)
+	roundTime := uint64(1000)
+	numBlocksToCommit := 3
+
+	network := integrationTests.CreateSimulatedNetwork(memp2p.LinkConditions{
+		Latency: time.Millisecond * 20,
+		Jitter:  time.Millisecond * 20,
+	})
+	nodes := createNodes(
+		int(numNodes),
+		int(consensusSize),
+		roundTime,
+		func() p2p.Messenger {
+			return integrationTests.CreateSimulatedMessenger(network)
+		},
+		consensusType,
+	)[0]
+	displayAndStartNodes(nodes)
+
+	defer func() {
+		for _, n := range nodes {
+			_ = n.mesenger.Close()
+		}
+	}()
+
+	// the block processor mock can not revert a processed block, so the current block is set only on commit,
+	// otherwise the nodes would diverge on the blocks processed but not committed during the partition
+	for _, n := range nodes {
+		n.blkProcessor.ProcessBlockCalled = func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+			return nil
+		}
+	}
+
+	mutex := &sync.Mutex{}
+	nonceForRoundMap := make(map[uint64]uint64)
+	totalCalled := 0
+	err := startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	assert.Nil(t, err)
+
+	roundDuration := time.Duration(roundTime) * time.Millisecond
+	timeout := roundDuration * time.Duration(numBlocksToCommit+3)
+	isReached := waitForCommittedBlocks(mutex, &totalCalled, numBlocksToCommit*int(numNodes), timeout)
+	assert.True(t, isReached, "consensus should work before the network partition")
+
+	fmt.Println("Partitioning the network...")
+	network.Partition(
+		[]core.PeerID{nodes[0].mesenger.ID(), nodes[1].mesenger.ID()},
+		[]core.PeerID{nodes[2].mesenger.ID(), nodes[3].mesenger.ID()},
+	)
+	// let the round in progress end before checking that no block is committed anymore
+	time.Sleep(2 * roundDuration)
+	mutex.Lock()
+	numCommittedBeforePartition := totalCalled
+	mutex.Unlock()
+
+	time.Sleep(4 * roundDuration)
+	mutex.Lock()
+	assert.Equal(t, numCommittedBeforePartition, totalCalled, "no partition holds enough signers to commit blocks")
+	mutex.Unlock()
+
+	fmt.Println("Healing the network...")
+	network.Heal()
+	isReached = waitForCommittedBlocks(mutex, &totalCalled, numCommittedBeforePartition+numBlocksToCommit*int(numNodes), timeout)
+	assert.True(t, isReached, "consensus should recover after the network is healed")
+}
+
+func TestConsensusBLSRecoversAfterNetworkPartition(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runConsensusAfterNetworkPartition(t, blsConsensusType)
+}
@@ -239,7 +239,7 @@ func createConsensusOnlyNode(
 	nodesCoordinator sharding.NodesCoordinator,
 	shardId uint32,
 	selfId uint32,
-	initialAddr string,
+	messenger p2p.Messenger,
 	consensusSize uint32,
 	roundTime uint64,
 	privKey crypto.PrivateKey,
@@ -256,7 +256,6 @@ func createConsensusOnlyNode(
 	testHasher := createHasher(consensusType)
 	testMarshalizer := &marshal.GogoProtoMarshalizer{}
 
-	messenger := integrationTests.CreateMessengerWithKadDht(initialAddr)
 	rootHash := []byte("roothash")
 
 	blockChain := createTestBlockChain()
@@ -437,7 +436,7 @@ func createNodes(
 	nodesPerShard int,
 	consensusSize int,
 	roundTime uint64,
-	serviceID string,
+	createMessenger func() p2p.Messenger,
 	consensusType string,
 ) map[uint32][]*testNode {
 
@@ -488,7 +487,7 @@ func createNodes(
 			nodesCoordinator,
 			testNodeObject.shardId,
 			uint32(i),
-			serviceID,
+			createMessenger(),
 			uint32(consensusSize),
 			roundTime,
 			kp.sk,
@@ -62,6 +62,16 @@ type Facade interface {
 	GetAllESDTTokens(address string) ([]string, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
+	GetEpochEconomics(epoch uint32) (*dataApi.EpochEconomics, error)
+	GetEpochRewards(epoch uint32) (*dataApi.EpochRewards, error)
+	GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*dataApi.LogEvent, error)
+	GetConsensusRounds() []*dataApi.ConsensusRound
+	GetAntifloodStatus() (*dataApi.AntifloodStatus, error)
+	SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error
+	UnbanPeer(pid string) error
+	GetLivenessReport() *dataApi.HealthReport
+	GetReadinessReport() *dataApi.HealthReport
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 	GetTotalStakedValue() (*big.Int, error)
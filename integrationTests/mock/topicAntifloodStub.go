@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core"
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
@@ -28,6 +31,11 @@ func (t *TopicAntiFloodStub) ResetForNotRegisteredTopics() {
 func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// GetTopicsStatus -
+func (t *TopicAntiFloodStub) GetTopicsStatus() []*api.TopicFloodPreventerStatus {
+	return make([]*api.TopicFloodPreventerStatus, 0)
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
@@ -191,6 +191,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		EconomicsData:              nodeToJoinLate.EconomicsData,
 		SingleSigner:               &mock.SignerMock{},
 		BlockSingleSigner:          &mock.SignerMock{},
+		MultiSigVerifier:           nodeToJoinLate.MultiSigner,
 		KeyGen:                     &mock.KeyGenMock{},
 		BlockKeyGen:                &mock.KeyGenMock{},
 		LatestStorageDataProvider:  &mock.LatestStorageDataProviderStub{},
@@ -0,0 +1,96 @@
+package networkPartition
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSyncWorksInShard_AfterNetworkPartitionIsHealed tests the following scenario:
+// 1. All the shard nodes sync the blocks proposed on a network with latency, jitter and a bandwidth cap
+// 2. The network is split in two partitions, only the partition holding the proposer receives the new blocks
+// 3. After the network is healed, the nodes from the other partition should sync the missed blocks
+func TestSyncWorksInShard_AfterNetworkPartitionIsHealed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	maxShards := uint32(1)
+	shardId := uint32(0)
+	numNodesPerShard := 6
+
+	network := integrationTests.CreateSimulatedNetwork(memp2p.LinkConditions{
+		Latency:                 time.Millisecond * 10,
+		Jitter:                  time.Millisecond * 10,
+		BandwidthBytesPerSecond: 1024 * 1024,
+	})
+
+	nodes := make([]*integrationTests.TestProcessorNode, numNodesPerShard)
+	for i := 0; i < numNodesPerShard; i++ {
+		nodes[i] = integrationTests.NewTestSyncNodeOnSimulatedNetwork(
+			maxShards,
+			shardId,
+			shardId,
+			network,
+		)
+	}
+
+	idxProposerShard0 := 0
+	idxProposers := []int{idxProposerShard0}
+
+	defer func() {
+		for _, n := range nodes {
+			_ = n.Messenger.Close()
+		}
+	}()
+
+	for _, n := range nodes {
+		_ = n.StartSync()
+	}
+
+	round := uint64(0)
+	nonce := uint64(0)
+	round = integrationTests.IncrementAndPrintRound(round)
+	integrationTests.UpdateRound(nodes, round)
+	nonce++
+
+	proposeBlocks := func(numBlocks int) {
+		for i := 0; i < numBlocks; i++ {
+			integrationTests.ProposeBlock(nodes, idxProposers, round, nonce)
+
+			time.Sleep(integrationTests.SyncDelay)
+
+			round = integrationTests.IncrementAndPrintRound(round)
+			integrationTests.UpdateRound(nodes, round)
+			nonce++
+		}
+	}
+
+	proposeBlocks(2)
+	err := integrationTests.WaitForNodesToHaveSameLastBlock(nodes, time.Second*5)
+	assert.Nil(t, err)
+
+	fmt.Println("Partitioning the network...")
+	proposerPartition := nodes[:numNodesPerShard/2]
+	isolatedPartition := nodes[numNodesPerShard/2:]
+	integrationTests.PartitionNodes(network, proposerPartition, isolatedPartition)
+
+	proposeBlocks(3)
+	err = integrationTests.WaitForNodesToHaveSameLastBlock(proposerPartition, time.Second*5)
+	assert.Nil(t, err)
+	for _, n := range isolatedPartition {
+		assert.Equal(t, uint64(2), n.BlockChain.GetCurrentBlockHeader().GetNonce())
+	}
+
+	fmt.Println("Healing the network...")
+	network.Heal()
+
+	proposeBlocks(2)
+	err = integrationTests.WaitForNodesToHaveSameLastBlock(nodes, time.Second*10)
+	assert.Nil(t, err)
+	assert.Equal(t, nonce-1, nodes[numNodesPerShard-1].BlockChain.GetCurrentBlockHeader().GetNonce())
+}
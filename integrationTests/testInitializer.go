@@ -340,6 +340,9 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.StatusMetricsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ReceiptsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.EconomicsBreakdownUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.TxLogsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.LogsBloomUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -534,6 +537,7 @@ func CreateFullGenesisBlocks(
 
 	argsGenesis := genesisProcess.ArgsGenesisBlockCreator{
 		GenesisTime:              0,
+		ChainID:                  string(ChainID),
 		StartEpochNum:            0,
 		Accounts:                 accounts,
 		PubkeyConv:               TestAddressPubkeyConverter,
@@ -633,6 +637,7 @@ func CreateGenesisMetaBlock(
 
 	argsMetaGenesis := genesisProcess.ArgsGenesisBlockCreator{
 		GenesisTime:              0,
+		ChainID:                  string(ChainID),
 		Accounts:                 accounts,
 		TrieStorageManagers:      trieStorageManagers,
 		PubkeyConv:               pubkeyConv,
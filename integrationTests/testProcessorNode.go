@@ -804,6 +804,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 			ValidatorAccountsDB: tpn.PeerState,
 			ChanceComputer:      tpn.NodesCoordinator,
 			EpochNotifier:       tpn.EpochNotifier,
+			ChainID:             tpn.ChainID,
 		}
 		vmFactory, _ = metaProcess.NewVMContainerFactory(argsNewVmFactory)
 	} else {
@@ -1505,6 +1506,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		ValidatorAccountsDB: tpn.PeerState,
 		ChanceComputer:      &mock.RaterMock{},
 		EpochNotifier:       tpn.EpochNotifier,
+		ChainID:             tpn.ChainID,
 	}
 	vmFactory, _ := metaProcess.NewVMContainerFactory(argsVMContainerFactory)
 
@@ -1831,6 +1833,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 				ShardCoordinator:              tpn.ShardCoordinator,
 				PubkeyConverter:               TestAddressPubkeyConverter,
 				RewardsStorage:                rewardsStorage,
+				EconomicsBreakdownStorage:     tpn.Storage.GetStorer(dataRetriever.EconomicsBreakdownUnit),
 				MiniBlockStorage:              miniBlockStorage,
 				Hasher:                        TestHasher,
 				Marshalizer:                   TestMarshalizer,
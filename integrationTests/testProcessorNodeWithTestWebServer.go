@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	nodeFacade "github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/update/activation"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -81,10 +83,12 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 			SameSourceResetIntervalInSec: 1,
 			EndpointsThrottlers:          []config.EndpointsThrottlersConfig{},
 		},
-		FacadeConfig:    config.FacadeConfig{},
-		ApiRoutesConfig: createTestApiConfig(),
-		AccountsState:   tpn.AccntState,
-		PeerState:       tpn.PeerState,
+		FacadeConfig:         config.FacadeConfig{},
+		ApiRoutesConfig:      createTestApiConfig(),
+		AccountsState:        tpn.AccntState,
+		PeerState:            tpn.PeerState,
+		ScheduledActivations: activation.NewDisabledScheduledActivationsHandler(),
+		HealthReports:        health.NewHealthService(config.HealthServiceConfig{}, ""),
 	}
 }
 
@@ -92,7 +96,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 	routes := map[string][]string{
 		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
 		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
-		"hardfork":    {"/trigger"},
+		"hardfork":    {"/trigger", "/activations"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
@@ -0,0 +1,106 @@
+package integrationTests
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+// simulatedMessenger is an in-memory messenger which, same as the libp2p messenger, accepts message processors on
+// topics it did not create. The resolvers register on the request topics only to process the direct requests
+type simulatedMessenger struct {
+	*memp2p.Messenger
+}
+
+// RegisterMessageProcessor creates the topic, if missing, and registers the message processor on it
+func (sm *simulatedMessenger) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if !sm.HasTopic(topic) {
+		err := sm.CreateTopic(topic, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sm.Messenger.RegisterMessageProcessor(topic, handler)
+}
+
+// CreateSimulatedNetwork creates an in-memory network in which all the links share the provided conditions
+func CreateSimulatedNetwork(conditions memp2p.LinkConditions) *memp2p.Network {
+	network := memp2p.NewNetwork()
+	err := network.SetDefaultLinkConditions(conditions)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+
+	return network
+}
+
+// CreateSimulatedMessenger creates a messenger connected to the provided in-memory network
+func CreateSimulatedMessenger(network *memp2p.Network) p2p.Messenger {
+	messenger, err := memp2p.NewMessenger(network)
+	if err != nil {
+		fmt.Println(err.Error())
+		return nil
+	}
+
+	return &simulatedMessenger{Messenger: messenger}
+}
+
+// PartitionNodes splits the simulated network in groups of nodes that can not reach each other
+func PartitionNodes(network *memp2p.Network, groups ...[]*TestProcessorNode) {
+	partition := make([][]core.PeerID, 0, len(groups))
+	for _, group := range groups {
+		peers := make([]core.PeerID, 0, len(group))
+		for _, n := range group {
+			peers = append(peers, n.Messenger.ID())
+		}
+		partition = append(partition, peers)
+	}
+
+	network.Partition(partition...)
+}
+
+// WaitForNodesToHaveSameLastBlock waits until all the provided nodes have the same current block, returning an
+// error if this does not happen in the given time
+func WaitForNodesToHaveSameLastBlock(nodes []*TestProcessorNode, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if haveSameLastBlock(nodes) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("nodes did not reach the same last block in %v", timeout)
+		}
+
+		time.Sleep(StepDelay)
+	}
+}
+
+func haveSameLastBlock(nodes []*TestProcessorNode) bool {
+	var expectedHash []byte
+	for _, n := range nodes {
+		header := n.BlockChain.GetCurrentBlockHeader()
+		if check.IfNil(header) {
+			return false
+		}
+
+		hash, err := core.CalculateHash(TestMarshalizer, TestHasher, header)
+		if err != nil {
+			return false
+		}
+		if expectedHash == nil {
+			expectedHash = hash
+			continue
+		}
+		if !bytes.Equal(expectedHash, hash) {
+			return false
+		}
+	}
+
+	return true
+}
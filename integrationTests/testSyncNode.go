@@ -12,6 +12,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -27,7 +29,30 @@ func NewTestSyncNode(
 	txSignPrivKeyShardId uint32,
 	initialNodeAddr string,
 ) *TestProcessorNode {
+	messenger := CreateMessengerWithKadDht(initialNodeAddr)
+
+	return newTestSyncNodeWithMessenger(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
+}
+
+// NewTestSyncNodeOnSimulatedNetwork returns a new TestProcessorNode instance with sync capabilities, connected
+// to the provided in-memory simulated network
+func NewTestSyncNodeOnSimulatedNetwork(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	network *memp2p.Network,
+) *TestProcessorNode {
+	messenger := CreateSimulatedMessenger(network)
 
+	return newTestSyncNodeWithMessenger(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
+}
+
+func newTestSyncNodeWithMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
+) *TestProcessorNode {
 	shardCoordinator, _ := sharding.NewMultiShardCoordinator(maxShards, nodeShardId)
 	pkBytes := make([]byte, 128)
 	pkBytes = []byte("afafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafafaf")
@@ -67,8 +92,6 @@ func NewTestSyncNode(
 		},
 	}
 
-	messenger := CreateMessengerWithKadDht(initialNodeAddr)
-
 	tpn := &TestProcessorNode{
 		ShardCoordinator: shardCoordinator,
 		Messenger:        messenger,
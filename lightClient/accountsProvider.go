@@ -0,0 +1,82 @@
+package lightClient
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsAccountsProvider holds the arguments needed to create an accounts provider
+type ArgsAccountsProvider struct {
+	HeadersTracker   HeadersTracker
+	ProofsRequester  ProofsRequester
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+}
+
+type accountsProvider struct {
+	headersTracker   HeadersTracker
+	proofsRequester  ProofsRequester
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+}
+
+// NewAccountsProvider creates a component able to answer account queries without holding the state tries
+func NewAccountsProvider(args ArgsAccountsProvider) (*accountsProvider, error) {
+	if check.IfNil(args.HeadersTracker) {
+		return nil, ErrNilHeadersTracker
+	}
+	if check.IfNil(args.ProofsRequester) {
+		return nil, ErrNilProofsRequester
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &accountsProvider{
+		headersTracker:   args.HeadersTracker,
+		proofsRequester:  args.ProofsRequester,
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
+	}, nil
+}
+
+// GetAccount returns the account stored at the provided address in the state of the last verified header of the
+// address' shard. The account is rebuilt from a value proved by a full node against the header's root hash, while
+// ErrAccountNotFound is returned if the proof shows the address is not in the state
+func (ap *accountsProvider) GetAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID := ap.shardCoordinator.ComputeId(address)
+	rootHash, err := ap.headersTracker.GetRootHash(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountBytes, err := ap.proofsRequester.GetVerifiedValue(shardID, rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountBytes) == 0 {
+		return nil, ErrAccountNotFound
+	}
+
+	err = ap.marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ap *accountsProvider) IsInterfaceNil() bool {
+	return ap == nil
+}
@@ -0,0 +1,113 @@
+package lightClient
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/lightClient/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAccountsProvider() ArgsAccountsProvider {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+
+	return ArgsAccountsProvider{
+		HeadersTracker:   &mock.HeadersTrackerStub{},
+		ProofsRequester:  &mock.ProofsRequesterStub{},
+		Marshalizer:      &testscommon.ProtoMarshalizerMock{},
+		ShardCoordinator: shardCoordinator,
+	}
+}
+
+func TestNewAccountsProvider_NilProofsRequesterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsProvider()
+	args.ProofsRequester = nil
+	ap, err := NewAccountsProvider(args)
+
+	assert.True(t, check.IfNil(ap))
+	assert.Equal(t, ErrNilProofsRequester, err)
+}
+
+func TestAccountsProvider_GetAccountNoRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsProvider()
+	args.HeadersTracker = &mock.HeadersTrackerStub{
+		GetRootHashCalled: func(shardID uint32) ([]byte, error) {
+			return nil, ErrNoRootHashForShard
+		},
+	}
+	ap, _ := NewAccountsProvider(args)
+
+	account, err := ap.GetAccount([]byte("address"))
+
+	assert.True(t, check.IfNil(account))
+	assert.True(t, errors.Is(err, ErrNoRootHashForShard))
+}
+
+func TestAccountsProvider_GetAccountProvedAbsentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsProvider()
+	args.HeadersTracker = &mock.HeadersTrackerStub{
+		GetRootHashCalled: func(shardID uint32) ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+	}
+	args.ProofsRequester = &mock.ProofsRequesterStub{
+		GetVerifiedValueCalled: func(shardID uint32, rootHash []byte, key []byte) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	ap, _ := NewAccountsProvider(args)
+
+	account, err := ap.GetAccount([]byte("address"))
+
+	assert.True(t, check.IfNil(account))
+	assert.Equal(t, ErrAccountNotFound, err)
+}
+
+func TestAccountsProvider_GetAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address1")
+	rootHash := []byte("root hash")
+	args := createMockArgsAccountsProvider()
+	expectedShardID := args.ShardCoordinator.ComputeId(address)
+
+	storedAccount, _ := state.NewUserAccount(address)
+	_ = storedAccount.AddToBalance(big.NewInt(10))
+	storedAccount.IncreaseNonce(7)
+	accountBytes, _ := args.Marshalizer.Marshal(storedAccount)
+
+	args.HeadersTracker = &mock.HeadersTrackerStub{
+		GetRootHashCalled: func(shardID uint32) ([]byte, error) {
+			assert.Equal(t, expectedShardID, shardID)
+			return rootHash, nil
+		},
+	}
+	args.ProofsRequester = &mock.ProofsRequesterStub{
+		GetVerifiedValueCalled: func(shardID uint32, providedRootHash []byte, key []byte) ([]byte, error) {
+			assert.Equal(t, expectedShardID, shardID)
+			assert.Equal(t, rootHash, providedRootHash)
+			assert.Equal(t, address, key)
+			return accountBytes, nil
+		},
+	}
+	ap, _ := NewAccountsProvider(args)
+
+	account, err := ap.GetAccount(address)
+	require.Nil(t, err)
+
+	assert.Equal(t, address, account.AddressBytes())
+	assert.Equal(t, uint64(7), account.GetNonce())
+	assert.Equal(t, big.NewInt(10), account.GetBalance())
+}
@@ -0,0 +1,72 @@
+package lightClient
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilHeaderSigVerifier signals that a nil header signature verifier has been provided
+var ErrNilHeaderSigVerifier = errors.New("nil header signature verifier")
+
+// ErrNilEpochStartHandler signals that a nil epoch start handler has been provided
+var ErrNilEpochStartHandler = errors.New("nil epoch start handler")
+
+// ErrNilMiniBlocksSyncer signals that a nil mini blocks syncer has been provided
+var ErrNilMiniBlocksSyncer = errors.New("nil mini blocks syncer")
+
+// ErrNilStartHeader signals that a nil start header has been provided
+var ErrNilStartHeader = errors.New("nil start header")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilHeadersTracker signals that a nil headers tracker has been provided
+var ErrNilHeadersTracker = errors.New("nil headers tracker")
+
+// ErrNilProofsRequester signals that a nil proofs requester has been provided
+var ErrNilProofsRequester = errors.New("nil proofs requester")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidNumPeersToQuery signals that an invalid number of peers to query has been provided
+var ErrInvalidNumPeersToQuery = errors.New("invalid number of peers to query")
+
+// ErrHeaderNotConsecutive signals that the received header does not follow the last tracked header
+var ErrHeaderNotConsecutive = errors.New("header is not consecutive to the last tracked header")
+
+// ErrShardHeaderNotNotarized signals that the received shard header was not notarized by a tracked metachain header
+var ErrShardHeaderNotNotarized = errors.New("shard header not notarized")
+
+// ErrNoRootHashForShard signals that no verified header is available for the requested shard
+var ErrNoRootHashForShard = errors.New("no verified root hash for shard")
+
+// ErrInvalidShardId signals that an invalid shard ID has been provided
+var ErrInvalidShardId = errors.New("invalid shard ID")
+
+// ErrAccountNotFound signals that the proof received for an address shows the account is not in the state
+var ErrAccountNotFound = errors.New("account not found")
+
+// ErrNoPeersToRequestFrom signals that no peers were found to request the proof from
+var ErrNoPeersToRequestFrom = errors.New("no peers to request from")
+
+// ErrProofRequestTimeout signals that no valid proof was received in the allotted time
+var ErrProofRequestTimeout = errors.New("timeout while waiting for a valid proof")
+
+// ErrNilHeadersPool signals that a nil headers pool has been provided
+var ErrNilHeadersPool = errors.New("nil headers pool")
+
+// ErrNilHeadersProcessor signals that a nil headers processor has been provided
+var ErrNilHeadersProcessor = errors.New("nil headers processor")
+
+// ErrNilRequestHandler signals that a nil request handler has been provided
+var ErrNilRequestHandler = errors.New("nil request handler")
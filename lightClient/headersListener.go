@@ -0,0 +1,66 @@
+package lightClient
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// ArgsHeadersListener holds the arguments needed to create a headers listener
+type ArgsHeadersListener struct {
+	HeadersPool      dataRetriever.HeadersPool
+	HeadersProcessor HeadersProcessor
+}
+
+type headersListener struct {
+	headersProcessor HeadersProcessor
+}
+
+// NewHeadersListener creates a component that forwards the metachain and shard headers added in the headers pool by
+// the interceptors to the headers processor. No block bodies are requested
+func NewHeadersListener(args ArgsHeadersListener) (*headersListener, error) {
+	if check.IfNil(args.HeadersPool) {
+		return nil, ErrNilHeadersPool
+	}
+	if check.IfNil(args.HeadersProcessor) {
+		return nil, ErrNilHeadersProcessor
+	}
+
+	hl := &headersListener{
+		headersProcessor: args.HeadersProcessor,
+	}
+	args.HeadersPool.RegisterHandler(hl.receivedHeader)
+
+	return hl, nil
+}
+
+func (hl *headersListener) receivedHeader(headerHandler data.HeaderHandler, headerHash []byte) {
+	var err error
+	switch header := headerHandler.(type) {
+	case *block.MetaBlock:
+		err = hl.headersProcessor.ProcessMetaHeader(header)
+	case *block.Header:
+		err = hl.headersProcessor.ProcessShardHeader(header)
+		if errors.Is(err, ErrShardHeaderNotNotarized) {
+			return
+		}
+	default:
+		return
+	}
+
+	if err != nil {
+		log.Debug("light client: received header not tracked",
+			"shard", headerHandler.GetShardID(),
+			"nonce", headerHandler.GetNonce(),
+			"hash", headerHash,
+			"error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hl *headersListener) IsInterfaceNil() bool {
+	return hl == nil
+}
@@ -0,0 +1,394 @@
+package lightClient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("lightClient")
+
+const maxPendingHeaders = 100
+const timeToSyncPeerMiniBlocks = time.Minute
+
+// ArgsHeadersTracker holds the arguments needed to create a headers tracker
+type ArgsHeadersTracker struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	HeaderSigVerifier process.InterceptedHeaderSigVerifier
+	EpochStartHandler epochStart.ActionHandler
+	MiniBlocksSyncer  epochStart.PendingMiniBlocksSyncHandler
+	RequestHandler    MetaHeadersRequester
+	StartMetaHeader   *block.MetaBlock
+}
+
+type headersTracker struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	headerSigVerifier process.InterceptedHeaderSigVerifier
+	epochStartHandler epochStart.ActionHandler
+	miniBlocksSyncer  epochStart.PendingMiniBlocksSyncHandler
+	requestHandler    MetaHeadersRequester
+
+	// mutProcessing serializes the metachain headers processing, which can block while the peer mini blocks are
+	// synced, so mutHeaders is only held while the tracked headers are read or updated
+	mutProcessing      sync.Mutex
+	pendingMetaHeaders map[uint64]*block.MetaBlock
+
+	mutHeaders            sync.RWMutex
+	lastMetaHeader        *block.MetaBlock
+	lastMetaHeaderHash    []byte
+	notarizedShardHeaders map[string]block.ShardData
+	pendingShardHeaders   map[string]*block.Header
+	lastShardHeaders      map[uint32]*block.Header
+}
+
+// NewHeadersTracker creates a component that follows the metachain starting from a trusted metachain header. Each
+// received metachain header has to extend the last tracked one and its signatures are checked against the consensus
+// group computed by the nodes coordinator, which is updated with the validator set changes at each epoch start.
+// Shard headers are accepted only after they are notarized by a verified metachain header, so their root hashes can
+// be used to verify the Merkle proofs of the accounts. Missing metachain headers are requested by nonce.
+func NewHeadersTracker(args ArgsHeadersTracker) (*headersTracker, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.HeaderSigVerifier) {
+		return nil, ErrNilHeaderSigVerifier
+	}
+	if args.EpochStartHandler == nil {
+		return nil, ErrNilEpochStartHandler
+	}
+	if check.IfNil(args.MiniBlocksSyncer) {
+		return nil, ErrNilMiniBlocksSyncer
+	}
+	if check.IfNil(args.RequestHandler) {
+		return nil, ErrNilRequestHandler
+	}
+	if args.StartMetaHeader == nil {
+		return nil, ErrNilStartHeader
+	}
+
+	startHeaderHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, args.StartMetaHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &headersTracker{
+		marshalizer:           args.Marshalizer,
+		hasher:                args.Hasher,
+		headerSigVerifier:     args.HeaderSigVerifier,
+		epochStartHandler:     args.EpochStartHandler,
+		miniBlocksSyncer:      args.MiniBlocksSyncer,
+		requestHandler:        args.RequestHandler,
+		lastMetaHeader:        args.StartMetaHeader,
+		lastMetaHeaderHash:    startHeaderHash,
+		pendingMetaHeaders:    make(map[uint64]*block.MetaBlock),
+		notarizedShardHeaders: make(map[string]block.ShardData),
+		pendingShardHeaders:   make(map[string]*block.Header),
+		lastShardHeaders:      make(map[uint32]*block.Header),
+	}, nil
+}
+
+// ProcessMetaHeader verifies and tracks the provided metachain header. Headers received ahead of time are kept
+// until the missing ones, which are requested, arrive
+func (ht *headersTracker) ProcessMetaHeader(header *block.MetaBlock) error {
+	if header == nil {
+		return ErrNilHeader
+	}
+
+	ht.mutProcessing.Lock()
+	defer ht.mutProcessing.Unlock()
+
+	lastNonce := ht.LastMetaHeader().Nonce
+	if header.Nonce <= lastNonce {
+		return nil
+	}
+	if header.Nonce > lastNonce+1 {
+		ht.addPendingMetaHeader(header)
+		ht.requestMissingMetaHeaders(lastNonce, header.Nonce)
+		return nil
+	}
+
+	err := ht.applyMetaHeader(header)
+	if err != nil {
+		return err
+	}
+
+	ht.applyPendingMetaHeaders()
+
+	return nil
+}
+
+func (ht *headersTracker) addPendingMetaHeader(header *block.MetaBlock) {
+	if len(ht.pendingMetaHeaders) >= maxPendingHeaders {
+		log.Debug("headersTracker: too many pending metachain headers, dropping", "nonce", header.Nonce)
+		return
+	}
+
+	ht.pendingMetaHeaders[header.Nonce] = header
+}
+
+func (ht *headersTracker) requestMissingMetaHeaders(lastNonce uint64, receivedNonce uint64) {
+	numRequested := 0
+	for nonce := lastNonce + 1; nonce < receivedNonce && numRequested < maxPendingHeaders; nonce++ {
+		_, isPending := ht.pendingMetaHeaders[nonce]
+		if isPending {
+			continue
+		}
+
+		ht.requestHandler.RequestMetaHeaderByNonce(nonce)
+		numRequested++
+	}
+}
+
+func (ht *headersTracker) applyPendingMetaHeaders() {
+	for {
+		header, ok := ht.pendingMetaHeaders[ht.LastMetaHeader().Nonce+1]
+		if !ok {
+			break
+		}
+
+		delete(ht.pendingMetaHeaders, header.Nonce)
+		err := ht.applyMetaHeader(header)
+		if err != nil {
+			log.Debug("headersTracker.applyPendingMetaHeaders", "nonce", header.Nonce, "error", err)
+			break
+		}
+	}
+
+	lastNonce := ht.LastMetaHeader().Nonce
+	for nonce := range ht.pendingMetaHeaders {
+		if nonce <= lastNonce {
+			delete(ht.pendingMetaHeaders, nonce)
+		}
+	}
+}
+
+// applyMetaHeader must be called while holding mutProcessing. The header signatures are checked before the validator
+// set changes it carries are applied, so only verified headers can change the next epoch consensus
+func (ht *headersTracker) applyMetaHeader(header *block.MetaBlock) error {
+	ht.mutHeaders.RLock()
+	lastMetaHeaderHash := ht.lastMetaHeaderHash
+	ht.mutHeaders.RUnlock()
+
+	if !bytes.Equal(header.PrevHash, lastMetaHeaderHash) {
+		return fmt.Errorf("%w, nonce %d", ErrHeaderNotConsecutive, header.Nonce)
+	}
+
+	headerHash, err := core.CalculateHash(ht.marshalizer, ht.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	err = ht.verifyHeaderSignatures(header)
+	if err != nil {
+		return err
+	}
+
+	if header.IsStartOfEpochBlock() {
+		err = ht.prepareValidatorsForEpoch(header)
+		if err != nil {
+			return err
+		}
+
+		ht.epochStartHandler.EpochStartAction(header)
+	}
+
+	ht.mutHeaders.Lock()
+	ht.lastMetaHeader = header
+	ht.lastMetaHeaderHash = headerHash
+	for _, shardData := range header.ShardInfo {
+		ht.notarizedShardHeaders[string(shardData.HeaderHash)] = shardData
+	}
+	ht.applyPendingShardHeaders()
+	ht.mutHeaders.Unlock()
+
+	log.Debug("light client: metachain header verified", "epoch", header.Epoch, "nonce", header.Nonce, "hash", headerHash)
+
+	return nil
+}
+
+// prepareValidatorsForEpoch fetches the peer mini blocks of the epoch start header and feeds them to the nodes
+// coordinator, the same way the epoch start bootstrapper does
+func (ht *headersTracker) prepareValidatorsForEpoch(header *block.MetaBlock) error {
+	peerMiniBlockHeaders := make([]block.MiniBlockHeader, 0)
+	for _, mbHeader := range header.MiniBlockHeaders {
+		if mbHeader.Type != block.PeerBlock {
+			continue
+		}
+
+		mbHeader.SenderShardID = core.MetachainShardId
+		peerMiniBlockHeaders = append(peerMiniBlockHeaders, mbHeader)
+	}
+
+	ht.miniBlocksSyncer.ClearFields()
+	ctx, cancel := context.WithTimeout(context.Background(), timeToSyncPeerMiniBlocks)
+	err := ht.miniBlocksSyncer.SyncPendingMiniBlocks(peerMiniBlockHeaders, ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	peerMiniBlocks, err := ht.miniBlocksSyncer.GetMiniBlocks()
+	if err != nil {
+		return err
+	}
+
+	body := &block.Body{MiniBlocks: make([]*block.MiniBlock, 0, len(peerMiniBlocks))}
+	for _, mbHeader := range peerMiniBlockHeaders {
+		body.MiniBlocks = append(body.MiniBlocks, peerMiniBlocks[string(mbHeader.Hash)])
+	}
+
+	ht.epochStartHandler.EpochStartPrepare(header, body)
+
+	return nil
+}
+
+func (ht *headersTracker) verifyHeaderSignatures(header data.HeaderHandler) error {
+	err := ht.headerSigVerifier.VerifyRandSeedAndLeaderSignature(header)
+	if err != nil {
+		return err
+	}
+
+	return ht.headerSigVerifier.VerifySignature(header)
+}
+
+// ProcessShardHeader verifies and tracks the provided shard header. A shard header that is not yet notarized is kept
+// until a verified metachain header notarizes it or a higher header of its shard is applied
+func (ht *headersTracker) ProcessShardHeader(header *block.Header) error {
+	if header == nil {
+		return ErrNilHeader
+	}
+
+	headerHash, err := core.CalculateHash(ht.marshalizer, ht.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	ht.mutHeaders.Lock()
+	defer ht.mutHeaders.Unlock()
+
+	_, isNotarized := ht.notarizedShardHeaders[string(headerHash)]
+	if !isNotarized {
+		ht.addPendingShardHeader(header, headerHash)
+		return fmt.Errorf("%w, shard %d, nonce %d", ErrShardHeaderNotNotarized, header.ShardID, header.Nonce)
+	}
+
+	return ht.applyShardHeader(header, headerHash)
+}
+
+// addPendingShardHeader keeps the provided header until it is notarized. If too many headers are pending, the one
+// with the lowest nonce is evicted, so headers which will never be notarized, as the forked ones, cannot stop the
+// tracking of the new headers
+func (ht *headersTracker) addPendingShardHeader(header *block.Header, headerHash []byte) {
+	if len(ht.pendingShardHeaders) >= maxPendingHeaders {
+		ht.evictLowestPendingShardHeader()
+	}
+
+	ht.pendingShardHeaders[string(headerHash)] = header
+}
+
+func (ht *headersTracker) evictLowestPendingShardHeader() {
+	lowestHash := ""
+	var lowestHeader *block.Header
+	for hash, header := range ht.pendingShardHeaders {
+		if lowestHeader == nil || header.Nonce < lowestHeader.Nonce {
+			lowestHash = hash
+			lowestHeader = header
+		}
+	}
+	if lowestHeader == nil {
+		return
+	}
+
+	log.Debug("headersTracker: too many pending shard headers, evicting", "shard", lowestHeader.ShardID, "nonce", lowestHeader.Nonce)
+	delete(ht.pendingShardHeaders, lowestHash)
+}
+
+func (ht *headersTracker) applyPendingShardHeaders() {
+	for hash, header := range ht.pendingShardHeaders {
+		_, isNotarized := ht.notarizedShardHeaders[hash]
+		if !isNotarized {
+			continue
+		}
+
+		delete(ht.pendingShardHeaders, hash)
+		err := ht.applyShardHeader(header, []byte(hash))
+		if err != nil {
+			log.Debug("headersTracker.applyPendingShardHeaders", "shard", header.ShardID, "nonce", header.Nonce, "error", err)
+		}
+	}
+}
+
+func (ht *headersTracker) applyShardHeader(header *block.Header, headerHash []byte) error {
+	err := ht.verifyHeaderSignatures(header)
+	if err != nil {
+		return err
+	}
+
+	lastHeader, ok := ht.lastShardHeaders[header.ShardID]
+	if ok && lastHeader.Nonce >= header.Nonce {
+		return nil
+	}
+
+	ht.lastShardHeaders[header.ShardID] = header
+	for hash, shardData := range ht.notarizedShardHeaders {
+		if shardData.ShardID == header.ShardID && shardData.Nonce <= header.Nonce {
+			delete(ht.notarizedShardHeaders, hash)
+		}
+	}
+	for hash, pendingHeader := range ht.pendingShardHeaders {
+		if pendingHeader.ShardID == header.ShardID && pendingHeader.Nonce <= header.Nonce {
+			delete(ht.pendingShardHeaders, hash)
+		}
+	}
+
+	log.Debug("light client: shard header verified", "shard", header.ShardID, "nonce", header.Nonce, "hash", headerHash)
+
+	return nil
+}
+
+// GetRootHash returns the state root hash of the last verified header of the provided shard
+func (ht *headersTracker) GetRootHash(shardID uint32) ([]byte, error) {
+	ht.mutHeaders.RLock()
+	defer ht.mutHeaders.RUnlock()
+
+	if shardID == core.MetachainShardId {
+		return ht.lastMetaHeader.RootHash, nil
+	}
+
+	header, ok := ht.lastShardHeaders[shardID]
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrNoRootHashForShard, shardID)
+	}
+
+	return header.RootHash, nil
+}
+
+// LastMetaHeader returns the last verified metachain header
+func (ht *headersTracker) LastMetaHeader() *block.MetaBlock {
+	ht.mutHeaders.RLock()
+	defer ht.mutHeaders.RUnlock()
+
+	return ht.lastMetaHeader
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ht *headersTracker) IsInterfaceNil() bool {
+	return ht == nil
+}
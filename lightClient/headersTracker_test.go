@@ -0,0 +1,325 @@
+package lightClient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/lightClient/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsHeadersTracker() ArgsHeadersTracker {
+	return ArgsHeadersTracker{
+		Marshalizer:       &testscommon.ProtoMarshalizerMock{},
+		Hasher:            sha256.Sha256{},
+		HeaderSigVerifier: &mock.HeaderSigVerifierStub{},
+		EpochStartHandler: &genericMocks.ActionHandlerStub{},
+		MiniBlocksSyncer:  &mock.PendingMiniBlockSyncHandlerStub{},
+		RequestHandler:    &mock.MetaHeadersRequesterStub{},
+		StartMetaHeader:   &block.MetaBlock{Nonce: 10, RootHash: []byte("start root hash")},
+	}
+}
+
+func createNextMetaHeader(args ArgsHeadersTracker, prevHeader *block.MetaBlock) *block.MetaBlock {
+	prevHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, prevHeader)
+
+	return &block.MetaBlock{
+		Nonce:    prevHeader.Nonce + 1,
+		Round:    prevHeader.Round + 1,
+		PrevHash: prevHash,
+		RootHash: []byte("root hash"),
+	}
+}
+
+func TestNewHeadersTracker_NilHeaderSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	args.HeaderSigVerifier = nil
+	ht, err := NewHeadersTracker(args)
+
+	assert.True(t, check.IfNil(ht))
+	assert.Equal(t, ErrNilHeaderSigVerifier, err)
+}
+
+func TestNewHeadersTracker_NilRequestHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	args.RequestHandler = nil
+	ht, err := NewHeadersTracker(args)
+
+	assert.True(t, check.IfNil(ht))
+	assert.Equal(t, ErrNilRequestHandler, err)
+}
+
+func TestNewHeadersTracker_NilStartHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	args.StartMetaHeader = nil
+	ht, err := NewHeadersTracker(args)
+
+	assert.True(t, check.IfNil(ht))
+	assert.Equal(t, ErrNilStartHeader, err)
+}
+
+func TestHeadersTracker_ProcessMetaHeaderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	ht, _ := NewHeadersTracker(args)
+
+	header := createNextMetaHeader(args, args.StartMetaHeader)
+	err := ht.ProcessMetaHeader(header)
+	require.Nil(t, err)
+
+	rootHash, err := ht.GetRootHash(core.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, header.RootHash, rootHash)
+}
+
+func TestHeadersTracker_ProcessMetaHeaderWrongPrevHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	ht, _ := NewHeadersTracker(args)
+
+	header := createNextMetaHeader(args, args.StartMetaHeader)
+	header.PrevHash = []byte("another hash")
+	err := ht.ProcessMetaHeader(header)
+
+	assert.True(t, errors.Is(err, ErrHeaderNotConsecutive))
+	assert.Equal(t, args.StartMetaHeader, ht.LastMetaHeader())
+}
+
+func TestHeadersTracker_ProcessMetaHeaderInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid signature")
+	args := createMockArgsHeadersTracker()
+	args.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(header data.HeaderHandler) error {
+			return expectedErr
+		},
+	}
+	ht, _ := NewHeadersTracker(args)
+
+	err := ht.ProcessMetaHeader(createNextMetaHeader(args, args.StartMetaHeader))
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, args.StartMetaHeader, ht.LastMetaHeader())
+}
+
+func TestHeadersTracker_ProcessMetaHeaderOutOfOrderShouldApplyPendingHeaders(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	ht, _ := NewHeadersTracker(args)
+
+	header1 := createNextMetaHeader(args, args.StartMetaHeader)
+	header2 := createNextMetaHeader(args, header1)
+
+	err := ht.ProcessMetaHeader(header2)
+	require.Nil(t, err)
+	assert.Equal(t, args.StartMetaHeader, ht.LastMetaHeader())
+
+	err = ht.ProcessMetaHeader(header1)
+	require.Nil(t, err)
+	assert.Equal(t, header2, ht.LastMetaHeader())
+}
+
+func TestHeadersTracker_ProcessEpochStartMetaHeaderShouldUpdateValidators(t *testing.T) {
+	t.Parallel()
+
+	peerMiniBlock := &block.MiniBlock{Type: block.PeerBlock}
+	calls := make([]string, 0)
+	args := createMockArgsHeadersTracker()
+	args.MiniBlocksSyncer = &mock.PendingMiniBlockSyncHandlerStub{
+		GetMiniBlocksCalled: func() (map[string]*block.MiniBlock, error) {
+			return map[string]*block.MiniBlock{"peer mb": peerMiniBlock}, nil
+		},
+	}
+	args.EpochStartHandler = &genericMocks.ActionHandlerStub{
+		EpochStartPrepareCalled: func(metaHdr data.HeaderHandler, body data.BodyHandler) {
+			assert.Equal(t, []*block.MiniBlock{peerMiniBlock}, body.(*block.Body).MiniBlocks)
+			calls = append(calls, "prepare")
+		},
+		EpochStartActionCalled: func(hdr data.HeaderHandler) {
+			calls = append(calls, "action")
+		},
+	}
+	args.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(header data.HeaderHandler) error {
+			calls = append(calls, "verify")
+			return nil
+		},
+	}
+	ht, _ := NewHeadersTracker(args)
+
+	header := createNextMetaHeader(args, args.StartMetaHeader)
+	header.Epoch = 1
+	header.EpochStart.LastFinalizedHeaders = []block.EpochStartShardData{{ShardID: 0}}
+	header.MiniBlockHeaders = []block.MiniBlockHeader{
+		{Hash: []byte("tx mb"), Type: block.TxBlock},
+		{Hash: []byte("peer mb"), Type: block.PeerBlock},
+	}
+	err := ht.ProcessMetaHeader(header)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"verify", "prepare", "action"}, calls)
+}
+
+func TestHeadersTracker_ProcessEpochStartMetaHeaderWithInvalidSignatureShouldNotUpdateValidators(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid signature")
+	args := createMockArgsHeadersTracker()
+	args.MiniBlocksSyncer = &mock.PendingMiniBlockSyncHandlerStub{
+		SyncPendingMiniBlocksCalled: func(_ []block.MiniBlockHeader, _ context.Context) error {
+			assert.Fail(t, "should not sync the peer mini blocks of an unverified header")
+			return nil
+		},
+	}
+	args.EpochStartHandler = &genericMocks.ActionHandlerStub{
+		EpochStartPrepareCalled: func(_ data.HeaderHandler, _ data.BodyHandler) {
+			assert.Fail(t, "should not prepare the validators from an unverified header")
+		},
+	}
+	args.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(_ data.HeaderHandler) error {
+			return expectedErr
+		},
+	}
+	ht, _ := NewHeadersTracker(args)
+
+	header := createNextMetaHeader(args, args.StartMetaHeader)
+	header.Epoch = 1
+	header.EpochStart.LastFinalizedHeaders = []block.EpochStartShardData{{ShardID: 0}}
+	err := ht.ProcessMetaHeader(header)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, args.StartMetaHeader, ht.LastMetaHeader())
+}
+
+func TestHeadersTracker_SyncingPeerMiniBlocksShouldNotBlockReaders(t *testing.T) {
+	t.Parallel()
+
+	chSyncStarted := make(chan struct{})
+	chReleaseSync := make(chan struct{})
+	args := createMockArgsHeadersTracker()
+	args.MiniBlocksSyncer = &mock.PendingMiniBlockSyncHandlerStub{
+		SyncPendingMiniBlocksCalled: func(_ []block.MiniBlockHeader, _ context.Context) error {
+			close(chSyncStarted)
+			<-chReleaseSync
+			return nil
+		},
+	}
+	ht, _ := NewHeadersTracker(args)
+
+	header := createNextMetaHeader(args, args.StartMetaHeader)
+	header.Epoch = 1
+	header.EpochStart.LastFinalizedHeaders = []block.EpochStartShardData{{ShardID: 0}}
+	chDone := make(chan error)
+	go func() {
+		chDone <- ht.ProcessMetaHeader(header)
+	}()
+
+	<-chSyncStarted
+	rootHash, err := ht.GetRootHash(core.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, args.StartMetaHeader.RootHash, rootHash)
+
+	close(chReleaseSync)
+	assert.Nil(t, <-chDone)
+	assert.Equal(t, header, ht.LastMetaHeader())
+}
+
+func TestHeadersTracker_ProcessMetaHeaderAheadShouldRequestMissingHeaders(t *testing.T) {
+	t.Parallel()
+
+	requestedNonces := make([]uint64, 0)
+	args := createMockArgsHeadersTracker()
+	args.RequestHandler = &mock.MetaHeadersRequesterStub{
+		RequestMetaHeaderByNonceCalled: func(nonce uint64) {
+			requestedNonces = append(requestedNonces, nonce)
+		},
+	}
+	ht, _ := NewHeadersTracker(args)
+
+	err := ht.ProcessMetaHeader(&block.MetaBlock{Nonce: args.StartMetaHeader.Nonce + 4})
+
+	assert.Nil(t, err)
+	expectedNonces := []uint64{args.StartMetaHeader.Nonce + 1, args.StartMetaHeader.Nonce + 2, args.StartMetaHeader.Nonce + 3}
+	assert.Equal(t, expectedNonces, requestedNonces)
+}
+
+func TestHeadersTracker_ProcessShardHeaderShouldWaitForNotarization(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	ht, _ := NewHeadersTracker(args)
+
+	shardHeader := &block.Header{ShardID: 1, Nonce: 5, RootHash: []byte("shard root hash")}
+	shardHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, shardHeader)
+
+	err := ht.ProcessShardHeader(shardHeader)
+	assert.True(t, errors.Is(err, ErrShardHeaderNotNotarized))
+	_, err = ht.GetRootHash(1)
+	assert.True(t, errors.Is(err, ErrNoRootHashForShard))
+
+	metaHeader := createNextMetaHeader(args, args.StartMetaHeader)
+	metaHeader.ShardInfo = []block.ShardData{{HeaderHash: shardHeaderHash, ShardID: 1, Nonce: 5}}
+	err = ht.ProcessMetaHeader(metaHeader)
+	require.Nil(t, err)
+
+	rootHash, err := ht.GetRootHash(1)
+	assert.Nil(t, err)
+	assert.Equal(t, shardHeader.RootHash, rootHash)
+}
+
+func TestHeadersTracker_ProcessShardHeaderAfterManyForkedHeadersShouldAdvance(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHeadersTracker()
+	ht, _ := NewHeadersTracker(args)
+
+	numForkedHeaders := maxPendingHeaders + 50
+	for i := 1; i <= numForkedHeaders; i++ {
+		forkedHeader := &block.Header{ShardID: 1, Nonce: uint64(i), RootHash: []byte("forked root hash")}
+		err := ht.ProcessShardHeader(forkedHeader)
+		assert.True(t, errors.Is(err, ErrShardHeaderNotNotarized))
+	}
+	assert.Equal(t, maxPendingHeaders, len(ht.pendingShardHeaders))
+
+	prevMetaHeader := args.StartMetaHeader
+	for nonce := uint64(numForkedHeaders + 1); nonce <= uint64(numForkedHeaders+2); nonce++ {
+		shardHeader := &block.Header{ShardID: 1, Nonce: nonce, RootHash: []byte(fmt.Sprintf("root hash %d", nonce))}
+		shardHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, shardHeader)
+		err := ht.ProcessShardHeader(shardHeader)
+		assert.True(t, errors.Is(err, ErrShardHeaderNotNotarized))
+
+		metaHeader := createNextMetaHeader(args, prevMetaHeader)
+		metaHeader.ShardInfo = []block.ShardData{{HeaderHash: shardHeaderHash, ShardID: 1, Nonce: nonce}}
+		err = ht.ProcessMetaHeader(metaHeader)
+		require.Nil(t, err)
+		prevMetaHeader = metaHeader
+
+		rootHash, err := ht.GetRootHash(1)
+		assert.Nil(t, err)
+		assert.Equal(t, shardHeader.RootHash, rootHash)
+	}
+
+	// the forked headers below the applied nonce are no longer kept
+	assert.Equal(t, 0, len(ht.pendingShardHeaders))
+}
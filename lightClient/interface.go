@@ -0,0 +1,41 @@
+package lightClient
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// Messenger defines the p2p functionality needed by the light client
+type Messenger interface {
+	CreateTopic(name string, createChannelForTopic bool) error
+	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
+	ConnectedPeersOnTopic(topic string) []core.PeerID
+	SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error
+	IsInterfaceNil() bool
+}
+
+// HeadersTracker provides the root hashes of the last verified headers
+type HeadersTracker interface {
+	GetRootHash(shardID uint32) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// ProofsRequester fetches values from full peers and verifies their Merkle proofs
+type ProofsRequester interface {
+	GetVerifiedValue(shardID uint32, rootHash []byte, key []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// MetaHeadersRequester requests the missing metachain headers from the network
+type MetaHeadersRequester interface {
+	RequestMetaHeaderByNonce(nonce uint64)
+	IsInterfaceNil() bool
+}
+
+// HeadersProcessor verifies and tracks the received headers
+type HeadersProcessor interface {
+	ProcessMetaHeader(header *block.MetaBlock) error
+	ProcessShardHeader(header *block.Header) error
+	IsInterfaceNil() bool
+}
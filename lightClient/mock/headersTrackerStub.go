@@ -0,0 +1,20 @@
+package mock
+
+// HeadersTrackerStub -
+type HeadersTrackerStub struct {
+	GetRootHashCalled func(shardID uint32) ([]byte, error)
+}
+
+// GetRootHash -
+func (hts *HeadersTrackerStub) GetRootHash(shardID uint32) ([]byte, error) {
+	if hts.GetRootHashCalled != nil {
+		return hts.GetRootHashCalled(shardID)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (hts *HeadersTrackerStub) IsInterfaceNil() bool {
+	return hts == nil
+}
@@ -0,0 +1,55 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// MessengerStub -
+type MessengerStub struct {
+	CreateTopicCalled              func(name string, createChannelForTopic bool) error
+	RegisterMessageProcessorCalled func(topic string, handler p2p.MessageProcessor) error
+	ConnectedPeersOnTopicCalled    func(topic string) []core.PeerID
+	SendToConnectedPeerCalled      func(topic string, buff []byte, peerID core.PeerID) error
+}
+
+// CreateTopic -
+func (ms *MessengerStub) CreateTopic(name string, createChannelForTopic bool) error {
+	if ms.CreateTopicCalled != nil {
+		return ms.CreateTopicCalled(name, createChannelForTopic)
+	}
+
+	return nil
+}
+
+// RegisterMessageProcessor -
+func (ms *MessengerStub) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if ms.RegisterMessageProcessorCalled != nil {
+		return ms.RegisterMessageProcessorCalled(topic, handler)
+	}
+
+	return nil
+}
+
+// ConnectedPeersOnTopic -
+func (ms *MessengerStub) ConnectedPeersOnTopic(topic string) []core.PeerID {
+	if ms.ConnectedPeersOnTopicCalled != nil {
+		return ms.ConnectedPeersOnTopicCalled(topic)
+	}
+
+	return make([]core.PeerID, 0)
+}
+
+// SendToConnectedPeer -
+func (ms *MessengerStub) SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error {
+	if ms.SendToConnectedPeerCalled != nil {
+		return ms.SendToConnectedPeerCalled(topic, buff, peerID)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ms *MessengerStub) IsInterfaceNil() bool {
+	return ms == nil
+}
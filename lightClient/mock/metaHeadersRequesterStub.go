@@ -0,0 +1,18 @@
+package mock
+
+// MetaHeadersRequesterStub -
+type MetaHeadersRequesterStub struct {
+	RequestMetaHeaderByNonceCalled func(nonce uint64)
+}
+
+// RequestMetaHeaderByNonce -
+func (mhrs *MetaHeadersRequesterStub) RequestMetaHeaderByNonce(nonce uint64) {
+	if mhrs.RequestMetaHeaderByNonceCalled != nil {
+		mhrs.RequestMetaHeaderByNonceCalled(nonce)
+	}
+}
+
+// IsInterfaceNil -
+func (mhrs *MetaHeadersRequesterStub) IsInterfaceNil() bool {
+	return mhrs == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// ProofsRequesterStub -
+type ProofsRequesterStub struct {
+	GetVerifiedValueCalled func(shardID uint32, rootHash []byte, key []byte) ([]byte, error)
+}
+
+// GetVerifiedValue -
+func (prs *ProofsRequesterStub) GetVerifiedValue(shardID uint32, rootHash []byte, key []byte) ([]byte, error) {
+	if prs.GetVerifiedValueCalled != nil {
+		return prs.GetVerifiedValueCalled(shardID, rootHash, key)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (prs *ProofsRequesterStub) IsInterfaceNil() bool {
+	return prs == nil
+}
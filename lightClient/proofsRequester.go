@@ -0,0 +1,242 @@
+package lightClient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/random"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const minRequestTimeout = time.Millisecond
+
+// ArgsProofsRequester holds the arguments needed to create a proofs requester
+type ArgsProofsRequester struct {
+	Messenger        Messenger
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	ShardCoordinator sharding.Coordinator
+	RequestTimeout   time.Duration
+	NumPeersToQuery  int
+}
+
+type proofsRequester struct {
+	messenger        Messenger
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	shardCoordinator sharding.Coordinator
+	requestTimeout   time.Duration
+	numPeersToQuery  int
+	randomizer       *random.ConcurrentSafeIntRandomizer
+
+	mutWaiters sync.Mutex
+	waiters    map[string][]chan []byte
+}
+
+// NewProofsRequester creates a component that requests Merkle proofs from the full nodes of each shard, on the
+// account trie proofs topics, and accepts only the responses that verify against the requested root hash
+func NewProofsRequester(args ArgsProofsRequester) (*proofsRequester, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.RequestTimeout < minRequestTimeout {
+		return nil, ErrInvalidRequestTimeout
+	}
+	if args.NumPeersToQuery < 1 {
+		return nil, ErrInvalidNumPeersToQuery
+	}
+
+	pr := &proofsRequester{
+		messenger:        args.Messenger,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		shardCoordinator: args.ShardCoordinator,
+		requestTimeout:   args.RequestTimeout,
+		numPeersToQuery:  args.NumPeersToQuery,
+		randomizer:       &random.ConcurrentSafeIntRandomizer{},
+		waiters:          make(map[string][]chan []byte),
+	}
+
+	shardIDs := make([]uint32, 0, pr.shardCoordinator.NumberOfShards()+1)
+	for shardID := uint32(0); shardID < pr.shardCoordinator.NumberOfShards(); shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	for _, shardID := range shardIDs {
+		topic := proofsTopic(shardID)
+		err := pr.messenger.CreateTopic(topic, false)
+		if err != nil {
+			return nil, err
+		}
+
+		err = pr.messenger.RegisterMessageProcessor(topic, pr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pr, nil
+}
+
+func proofsTopic(shardID uint32) string {
+	return factory.AccountTrieProofsTopic + core.CommunicationIdentifierBetweenShards(shardID, core.MetachainShardId)
+}
+
+// GetVerifiedValue requests the Merkle proof of the key in the trie identified by the root hash from the peers of
+// the provided shard and returns the value from the first proof that verifies
+func (pr *proofsRequester) GetVerifiedValue(shardID uint32, rootHash []byte, key []byte) ([]byte, error) {
+	if shardID >= pr.shardCoordinator.NumberOfShards() && shardID != core.MetachainShardId {
+		return nil, ErrInvalidShardId
+	}
+
+	buff, err := pr.createRequest(rootHash, key)
+	if err != nil {
+		return nil, err
+	}
+
+	waiterKey := string(rootHash) + string(key)
+	chValue := pr.addWaiter(waiterKey)
+	defer pr.removeWaiter(waiterKey, chValue)
+
+	topic := proofsTopic(shardID)
+	numSent := pr.sendToRandomPeers(topic+topicResolverSender.TopicRequestSuffix, pr.messenger.ConnectedPeersOnTopic(topic), buff)
+	if numSent == 0 {
+		return nil, ErrNoPeersToRequestFrom
+	}
+
+	select {
+	case value := <-chValue:
+		return value, nil
+	case <-time.After(pr.requestTimeout):
+		return nil, ErrProofRequestTimeout
+	}
+}
+
+func (pr *proofsRequester) createRequest(rootHash []byte, key []byte) ([]byte, error) {
+	trieProofBuff, err := pr.marshalizer.Marshal(&trie.TrieProof{
+		RootHash: rootHash,
+		Key:      key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr.marshalizer.Marshal(&dataRetriever.RequestData{
+		Type:  dataRetriever.TrieProofType,
+		Value: trieProofBuff,
+	})
+}
+
+func (pr *proofsRequester) sendToRandomPeers(topic string, peers []core.PeerID, buff []byte) int {
+	indexes := make([]int, len(peers))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	numSent := 0
+	for _, index := range random.FisherYatesShuffle(indexes, pr.randomizer) {
+		err := pr.messenger.SendToConnectedPeer(topic, buff, peers[index])
+		if err != nil {
+			log.Trace("proofsRequester.sendToRandomPeers", "peer", peers[index].Pretty(), "error", err)
+			continue
+		}
+
+		numSent++
+		if numSent == pr.numPeersToQuery {
+			break
+		}
+	}
+
+	return numSent
+}
+
+func (pr *proofsRequester) addWaiter(waiterKey string) chan []byte {
+	chValue := make(chan []byte, 1)
+
+	pr.mutWaiters.Lock()
+	pr.waiters[waiterKey] = append(pr.waiters[waiterKey], chValue)
+	pr.mutWaiters.Unlock()
+
+	return chValue
+}
+
+func (pr *proofsRequester) removeWaiter(waiterKey string, chValue chan []byte) {
+	pr.mutWaiters.Lock()
+	defer pr.mutWaiters.Unlock()
+
+	waiters := pr.waiters[waiterKey]
+	for i := range waiters {
+		if waiters[i] == chValue {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+
+	if len(waiters) == 0 {
+		delete(pr.waiters, waiterKey)
+		return
+	}
+	pr.waiters[waiterKey] = waiters
+}
+
+// ProcessReceivedMessage handles the proofs sent by the full nodes. Proofs that were not requested are ignored while
+// proofs that do not verify against the requested root hash are rejected
+func (pr *proofsRequester) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	if check.IfNil(message) {
+		return p2p.ErrNilMessage
+	}
+
+	trieProof := &trie.TrieProof{}
+	err := pr.marshalizer.Unmarshal(trieProof, message.Data())
+	if err != nil {
+		return err
+	}
+
+	waiterKey := string(trieProof.RootHash) + string(trieProof.Key)
+	pr.mutWaiters.Lock()
+	_, isRequested := pr.waiters[waiterKey]
+	pr.mutWaiters.Unlock()
+	if !isRequested {
+		return nil
+	}
+
+	value, err := trie.VerifyProofAndGetValue(trieProof.RootHash, trieProof.Key, trieProof.Proof, pr.marshalizer, pr.hasher)
+	if err != nil {
+		return err
+	}
+
+	pr.mutWaiters.Lock()
+	for _, chValue := range pr.waiters[waiterKey] {
+		select {
+		case chValue <- value:
+		default:
+		}
+	}
+	pr.mutWaiters.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *proofsRequester) IsInterfaceNil() bool {
+	return pr == nil
+}
@@ -0,0 +1,179 @@
+package lightClient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/lightClient/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsProofsRequester() ArgsProofsRequester {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+
+	return ArgsProofsRequester{
+		Messenger:        &mock.MessengerStub{},
+		Marshalizer:      &testscommon.ProtoMarshalizerMock{},
+		Hasher:           sha256.Sha256{},
+		ShardCoordinator: shardCoordinator,
+		RequestTimeout:   time.Second,
+		NumPeersToQuery:  2,
+	}
+}
+
+func createTrieWithAccount(args ArgsProofsRequester, key []byte, value []byte) data.Trie {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, 5)
+	_ = tr.Update([]byte("another key"), []byte("another value"))
+	_ = tr.Update(key, value)
+	_ = tr.Commit()
+
+	return tr
+}
+
+// respondWithProof simulates the full node answering a proof request on the response topic
+func respondWithProof(args ArgsProofsRequester, requester p2p.MessageProcessor, tr data.Trie, requestBuff []byte, alterProof bool) {
+	rd := &dataRetriever.RequestData{}
+	_ = args.Marshalizer.Unmarshal(rd, requestBuff)
+	trieProof := &trie.TrieProof{}
+	_ = args.Marshalizer.Unmarshal(trieProof, rd.Value)
+
+	trieProof.Proof, _ = tr.GetProof(trieProof.Key)
+	if alterProof {
+		trieProof.Proof[0] = []byte("altered")
+	}
+	buff, _ := args.Marshalizer.Marshal(trieProof)
+
+	_ = requester.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, "")
+}
+
+func TestNewProofsRequester_InvalidNumPeersToQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsProofsRequester()
+	args.NumPeersToQuery = 0
+	pr, err := NewProofsRequester(args)
+
+	assert.True(t, check.IfNil(pr))
+	assert.Equal(t, ErrInvalidNumPeersToQuery, err)
+}
+
+func TestNewProofsRequester_ShouldRegisterOnAllProofsTopics(t *testing.T) {
+	t.Parallel()
+
+	registeredTopics := make([]string, 0)
+	args := createMockArgsProofsRequester()
+	args.Messenger = &mock.MessengerStub{
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopics = append(registeredTopics, topic)
+			return nil
+		},
+	}
+	pr, err := NewProofsRequester(args)
+
+	assert.False(t, check.IfNil(pr))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		factory.AccountTrieProofsTopic + "_0_META",
+		factory.AccountTrieProofsTopic + "_1_META",
+		factory.AccountTrieProofsTopic + "_META",
+	}, registeredTopics)
+}
+
+func TestProofsRequester_GetVerifiedValueNoPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := NewProofsRequester(createMockArgsProofsRequester())
+
+	value, err := pr.GetVerifiedValue(0, []byte("root hash"), []byte("key"))
+
+	assert.Nil(t, value)
+	assert.Equal(t, ErrNoPeersToRequestFrom, err)
+}
+
+func TestProofsRequester_GetVerifiedValueShouldWork(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("address")
+	expectedValue := []byte("account")
+	args := createMockArgsProofsRequester()
+	tr := createTrieWithAccount(args, key, expectedValue)
+	rootHash, _ := tr.RootHash()
+
+	var pr *proofsRequester
+	args.Messenger = &mock.MessengerStub{
+		ConnectedPeersOnTopicCalled: func(topic string) []core.PeerID {
+			assert.Equal(t, factory.AccountTrieProofsTopic+"_1_META", topic)
+			return []core.PeerID{"peer"}
+		},
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			assert.Equal(t, factory.AccountTrieProofsTopic+"_1_META_REQUEST", topic)
+			go respondWithProof(args, pr, tr, buff, false)
+			return nil
+		},
+	}
+	pr, _ = NewProofsRequester(args)
+
+	value, err := pr.GetVerifiedValue(1, rootHash, key)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedValue, value)
+}
+
+func TestProofsRequester_GetVerifiedValueInvalidProofShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("address")
+	args := createMockArgsProofsRequester()
+	args.RequestTimeout = 100 * time.Millisecond
+	tr := createTrieWithAccount(args, key, []byte("account"))
+	rootHash, _ := tr.RootHash()
+
+	var pr *proofsRequester
+	args.Messenger = &mock.MessengerStub{
+		ConnectedPeersOnTopicCalled: func(topic string) []core.PeerID {
+			return []core.PeerID{"peer"}
+		},
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			go respondWithProof(args, pr, tr, buff, true)
+			return nil
+		},
+	}
+	pr, _ = NewProofsRequester(args)
+
+	value, err := pr.GetVerifiedValue(0, rootHash, key)
+
+	assert.Nil(t, value)
+	assert.Equal(t, ErrProofRequestTimeout, err)
+}
+
+func TestProofsRequester_ProcessReceivedMessageInvalidProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("address")
+	args := createMockArgsProofsRequester()
+	tr := createTrieWithAccount(args, key, []byte("account"))
+	rootHash, _ := tr.RootHash()
+	proof, _ := tr.GetProof(key)
+	proof[0] = []byte("altered")
+	buff, _ := args.Marshalizer.Marshal(&trie.TrieProof{RootHash: rootHash, Key: key, Proof: proof})
+
+	pr, _ := NewProofsRequester(args)
+	chValue := pr.addWaiter(string(rootHash) + string(key))
+
+	err := pr.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, "")
+	require.Equal(t, trie.ErrInvalidProof, err)
+	assert.Equal(t, 0, len(chValue))
+}
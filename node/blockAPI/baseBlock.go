@@ -6,6 +6,8 @@ import (
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -34,6 +36,7 @@ type baseAPIBockProcessor struct {
 	historyRepo              dblookupext.HistoryRepository
 	// TODO: use an interface instead of this function
 	unmarshalTx              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	addressPubkeyConverter   core.PubkeyConverter
 }
 
 var log = logger.GetOrCreate("node/blockAPI")
@@ -88,6 +91,8 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 	}
 	log.Debug(fmt.Sprintf("GetBulkFromEpoch took %s", time.Since(start)))
 
+	marshalizedLogs := bap.getLogsFromStorage(miniblock.TxHashes, epoch)
+
 	start = time.Now()
 	txs := make([]*transaction.ApiTransactionResult, 0)
 	for txHash, txBytes := range marshalizedTxs {
@@ -103,6 +108,7 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 		tx.MiniBlockHash = hex.EncodeToString(miniblockHash)
 		tx.SourceShard = miniblock.SenderShardID
 		tx.DestinationShard = miniblock.ReceiverShardID
+		tx.Logs = bap.unmarshalApiLogs(txHash, marshalizedLogs[txHash])
 
 		tx.Status = (&transaction.StatusComputer{
 			MiniblockType:    miniblock.Type,
@@ -120,6 +126,39 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 	return txs
 }
 
+func (bap *baseAPIBockProcessor) getLogsFromStorage(txHashes [][]byte, epoch uint32) map[string][]byte {
+	logsStorer := bap.store.GetStorer(dataRetriever.TxLogsUnit)
+	if check.IfNil(logsStorer) || check.IfNil(bap.addressPubkeyConverter) {
+		return nil
+	}
+
+	marshalizedLogs, err := logsStorer.GetBulkFromEpoch(txHashes, epoch)
+	if err != nil {
+		log.Debug("cannot get from storage transactions logs",
+			"error", err.Error())
+		return nil
+	}
+
+	return marshalizedLogs
+}
+
+func (bap *baseAPIBockProcessor) unmarshalApiLogs(txHash string, logsBytes []byte) *transaction.ApiLogs {
+	if len(logsBytes) == 0 {
+		return nil
+	}
+
+	txLog := &transaction.Log{}
+	err := bap.marshalizer.Unmarshal(txLog, logsBytes)
+	if err != nil {
+		log.Warn("cannot unmarshal transaction logs",
+			"hash", hex.EncodeToString([]byte(txHash)),
+			"error", err.Error())
+		return nil
+	}
+
+	return txLog.ToApiLogs(bap.addressPubkeyConverter)
+}
+
 func (bap *baseAPIBockProcessor) getFromStorer(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
 	if !bap.hasDbLookupExtensions {
 		return bap.store.Get(unit, key)
@@ -263,3 +263,51 @@ func TestBaseApiBlockProcessor_GetInvalidTxFromMiniBlock(t *testing.T) {
 	assert.EqualValues(t, mbTxs[0].Type, txType)
 	assert.EqualValues(t, mbTxs[0].Receiver, recvAddress)
 }
+
+func TestBaseApiBlockProcessor_GetTxsFromMiniBlockShouldPutLogs(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(1)
+	marshalizer := &mock.MarshalizerFake{}
+	txHash := "txHash"
+	mbHash := "mbHash"
+
+	mb := block.MiniBlock{
+		TxHashes: [][]byte{[]byte(txHash)},
+		Type:     block.TxBlock,
+	}
+	txLog := &transaction.Log{
+		Address: []byte("contract"),
+		Events: []*transaction.Event{
+			{Address: []byte("contract"), Identifier: []byte("transfer")},
+		},
+	}
+
+	txBytes, _ := marshalizer.Marshal(&transaction.Transaction{Nonce: 1})
+	mbBytes, _ := marshalizer.Marshal(&mb)
+	logBytes, _ := marshalizer.Marshal(txLog)
+
+	baseAPIBlock := createMockArgumentsWithTx(0, 0, "receiver", mbBytes, block.TxBlock, txHash, txBytes, marshalizer)
+	baseAPIBlock.addressPubkeyConverter = mock.NewPubkeyConverterMock(8)
+	baseAPIBlock.store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetBulkFromEpochCalled: func(keys [][]byte, epoch uint32) (map[string][]byte, error) {
+					if unitType == dataRetriever.TxLogsUnit {
+						return map[string][]byte{txHash: logBytes}, nil
+					}
+					return map[string][]byte{txHash: txBytes}, nil
+				},
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					return mbBytes, nil
+				},
+			}
+		},
+	}
+
+	mbTxs := baseAPIBlock.getTxsByMb(&block.MiniBlockHeader{Hash: []byte(mbHash), Type: block.TxBlock}, epoch)
+
+	expectedLogs := txLog.ToApiLogs(baseAPIBlock.addressPubkeyConverter)
+	assert.Equal(t, 1, len(mbTxs))
+	assert.Equal(t, expectedLogs, mbTxs[0].Logs)
+}
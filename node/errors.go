@@ -228,3 +228,24 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilNodeRedundancyHandler signals that provided node redundancy handler is nil
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrEconomicsBreakdownOnlyOnMetachain signals that the epoch economics breakdown was requested from a shard node
+var ErrEconomicsBreakdownOnlyOnMetachain = errors.New("the epoch economics breakdown is only available on metachain nodes")
+
+// ErrEconomicsBreakdownNotFound signals that no economics breakdown was stored for the requested epoch
+var ErrEconomicsBreakdownNotFound = errors.New("economics breakdown not found")
+
+// ErrEmptyLogsFilter signals that neither an address nor an identifier was provided for the logs search
+var ErrEmptyLogsFilter = errors.New("an address or an identifier must be provided")
+
+// ErrInvalidNoncesRange signals that an invalid range of nonces was provided
+var ErrInvalidNoncesRange = errors.New("invalid range of nonces")
+
+// ErrNilConsensusRoundsRecorder signals that a nil consensus rounds recorder has been provided
+var ErrNilConsensusRoundsRecorder = errors.New("nil consensus rounds recorder")
+
+// ErrNilAntifloodIntrospector signals that a nil antiflood introspector has been provided
+var ErrNilAntifloodIntrospector = errors.New("nil antiflood introspector")
+
+// ErrAntifloodIntrospectionNotAvailable signals that the node was not set up with an antiflood introspector
+var ErrAntifloodIntrospectionNotAvailable = errors.New("antiflood introspection is not available")
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/monitor"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -50,6 +52,16 @@ type P2PAntifloodHandler interface {
 	IsInterfaceNil() bool
 }
 
+// AntifloodIntrospector defines the behavior of a component able to expose the state of the input antiflood and to
+// change its limits at runtime
+type AntifloodIntrospector interface {
+	GetAntifloodStatus() *api.AntifloodStatus
+	SetFloodPreventerLimits(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	UnblacklistPeer(peer core.PeerID) error
+	IsInterfaceNil() bool
+}
+
 // Accumulator defines the interface able to accumulate data and periodically evict them
 type Accumulator interface {
 	AddData(data interface{})
@@ -83,3 +95,10 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// HeartbeatV2Handler defines the behavior of a heartbeat v2 handler
+type HeartbeatV2Handler interface {
+	Monitor() *monitor.HeartbeatV2Monitor
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// AntifloodIntrospectorStub -
+type AntifloodIntrospectorStub struct {
+	GetAntifloodStatusCalled      func() *api.AntifloodStatus
+	SetFloodPreventerLimitsCalled func(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
+	SetMaxMessagesForTopicCalled  func(topic string, maxNum uint32)
+	UnblacklistPeerCalled         func(peer core.PeerID) error
+}
+
+// GetAntifloodStatus -
+func (ais *AntifloodIntrospectorStub) GetAntifloodStatus() *api.AntifloodStatus {
+	if ais.GetAntifloodStatusCalled != nil {
+		return ais.GetAntifloodStatusCalled()
+	}
+
+	return &api.AntifloodStatus{}
+}
+
+// SetFloodPreventerLimits -
+func (ais *AntifloodIntrospectorStub) SetFloodPreventerLimits(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if ais.SetFloodPreventerLimitsCalled != nil {
+		return ais.SetFloodPreventerLimitsCalled(name, baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return nil
+}
+
+// SetMaxMessagesForTopic -
+func (ais *AntifloodIntrospectorStub) SetMaxMessagesForTopic(topic string, maxNum uint32) {
+	if ais.SetMaxMessagesForTopicCalled != nil {
+		ais.SetMaxMessagesForTopicCalled(topic, maxNum)
+	}
+}
+
+// UnblacklistPeer -
+func (ais *AntifloodIntrospectorStub) UnblacklistPeer(peer core.PeerID) error {
+	if ais.UnblacklistPeerCalled != nil {
+		return ais.UnblacklistPeerCalled(peer)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ais *AntifloodIntrospectorStub) IsInterfaceNil() bool {
+	return ais == nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -27,6 +28,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	disabledSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -54,12 +56,21 @@ import (
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmProcess "github.com/ElrondNetwork/elrond-go/vm/process"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
 const SendTransactionsPipe = "send transactions pipe"
 
+const equivocationRoundsToKeep = 10
+
+const maxEquivocationProofsToKeep = 1000
+
+const defaultConsensusRoundsToKeep = 500
+
 var log = logger.GetOrCreate("node")
 var numSecondsBetweenPrints = 20
 
@@ -159,6 +170,7 @@ type Node struct {
 	queryHandlers    map[string]debug.QueryHandler
 
 	heartbeatHandler        HeartbeatHandler
+	heartbeatV2Handler      HeartbeatV2Handler
 	peerHonestyHandler      consensus.PeerHonestyHandler
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 
@@ -170,6 +182,12 @@ type Node struct {
 	txVersionChecker          process.TxVersionCheckerHandler
 	isInImportMode            bool
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	roundsRecorder            spos.RoundsRecorder
+	antifloodIntrospector     AntifloodIntrospector
+	equivocationProofs        slashing.ProofsProvider
+
+	optimisticSignatureVerification bool
+	syncPipelineWindowSize          uint64
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -307,6 +325,16 @@ func (n *Node) StartConsensus() error {
 		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(n.internalMarshalizer, n.sizeCheckDelta)
 	}
 
+	equivocationDetector, err := n.createEquivocationDetector()
+	if err != nil {
+		return err
+	}
+
+	err = n.createRoundsRecorderIfMissing()
+	if err != nil {
+		return err
+	}
+
 	workerArgs := &spos.WorkerArgs{
 		ConsensusService:         consensusService,
 		BlockChain:               n.blkc,
@@ -330,6 +358,8 @@ func (n *Node) StartConsensus() error {
 		SignatureSize:            n.validatorSignatureSize,
 		PublicKeySize:            n.publicKeySize,
 		NodeRedundancyHandler:    n.nodeRedundancyHandler,
+		EquivocationDetector:     equivocationDetector,
+		RoundsRecorder:           n.roundsRecorder,
 	}
 
 	worker, err := spos.NewWorker(workerArgs)
@@ -387,6 +417,7 @@ func (n *Node) StartConsensus() error {
 		n.indexer,
 		n.chainID,
 		n.messenger.ID(),
+		n.optimisticSignatureVerification,
 	)
 	if err != nil {
 		return err
@@ -686,6 +717,7 @@ func (n *Node) createShardBootstrapper(rounder consensus.Rounder) (process.Boots
 		MiniblocksProvider:  n.miniblocksProvider,
 		Uint64Converter:     n.uint64ByteSliceConverter,
 		Indexer:             n.indexer,
+		PipelineWindowSize:  n.syncPipelineWindowSize,
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -748,6 +780,7 @@ func (n *Node) createMetaChainBootstrapper(rounder consensus.Rounder) (process.B
 		MiniblocksProvider:  n.miniblocksProvider,
 		Uint64Converter:     n.uint64ByteSliceConverter,
 		Indexer:             n.indexer,
+		PipelineWindowSize:  n.syncPipelineWindowSize,
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
@@ -836,6 +869,183 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor) error
 	return n.messenger.RegisterMessageProcessor(n.consensusTopic, messageProcessor)
 }
 
+// createEquivocationDetector creates the component that issues equivocation proofs for the consensus messages seen
+// by this node. The valid proofs received on the proof topics are collected for the slashing reporter
+func (n *Node) createEquivocationDetector() (spos.EquivocationDetector, error) {
+	proofVerifier, err := n.createEquivocationProofVerifier()
+	if err != nil {
+		return nil, err
+	}
+
+	proofSender, err := slashing.NewProofSender(slashing.ArgsProofSender{
+		Messenger:        n.messenger,
+		Marshalizer:      n.internalMarshalizer,
+		ShardCoordinator: n.shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.createEquivocationProofTopics(proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return spos.NewEquivocationDetector(spos.ArgsEquivocationDetector{
+		ProofVerifier:   proofVerifier,
+		ProofSender:     proofSender,
+		MaxRoundsToKeep: equivocationRoundsToKeep,
+	})
+}
+
+func (n *Node) createEquivocationProofVerifier() (slashing.ProofVerifier, error) {
+	sigVerifier, err := vmProcess.NewMessageSigVerifier(n.keyGen, n.singleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       n.internalMarshalizer,
+		Hasher:            n.hasher,
+		SignatureVerifier: sigVerifier,
+		ChainID:           n.chainID,
+	})
+}
+
+// createRoundsRecorderIfMissing creates an in memory consensus rounds recorder when none was provided through options
+func (n *Node) createRoundsRecorderIfMissing() error {
+	if !check.IfNil(n.roundsRecorder) {
+		return nil
+	}
+
+	roundsRecorder, err := spos.NewRoundsRecorder(spos.ArgsRoundsRecorder{
+		MaxRoundsToKeep: defaultConsensusRoundsToKeep,
+		Marshalizer:     &marshal.JsonMarshalizer{},
+	})
+	if err != nil {
+		return err
+	}
+
+	n.roundsRecorder = roundsRecorder
+
+	return nil
+}
+
+// GetConsensusRounds returns the consensus rounds recorded by the current node
+func (n *Node) GetConsensusRounds() []*api.ConsensusRound {
+	if check.IfNil(n.roundsRecorder) {
+		return make([]*api.ConsensusRound, 0)
+	}
+
+	return n.roundsRecorder.GetRounds()
+}
+
+// createEquivocationProofTopics creates the topics on which the equivocation proofs are exchanged and registers on
+// each of them a processor which stores the valid proofs in a cache shared by all the topics
+func (n *Node) createEquivocationProofTopics(proofVerifier slashing.ProofVerifier) error {
+	if !check.IfNil(n.equivocationProofs) {
+		return nil
+	}
+
+	proofsCacher, err := lrucache.NewCache(maxEquivocationProofsToKeep)
+	if err != nil {
+		return err
+	}
+
+	for _, topic := range slashing.ProofTopics(n.shardCoordinator) {
+		if !n.messenger.HasTopic(topic) {
+			err = n.messenger.CreateTopic(topic, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		if n.messenger.HasTopicValidator(topic) {
+			continue
+		}
+
+		proofProcessor, errCreate := slashing.NewProofProcessor(slashing.ArgsProofProcessor{
+			Marshalizer:      n.internalMarshalizer,
+			ProofVerifier:    proofVerifier,
+			ProofsCacher:     proofsCacher,
+			AntifloodHandler: n.inputAntifloodHandler,
+			Topic:            topic,
+		})
+		if errCreate != nil {
+			return errCreate
+		}
+
+		err = n.messenger.RegisterMessageProcessor(topic, proofProcessor)
+		if err != nil {
+			return err
+		}
+
+		n.equivocationProofs = proofProcessor
+	}
+
+	return nil
+}
+
+// StartSlashingReporter starts the component that sends a slash transaction, signed with the configured reporter
+// wallet, for every valid equivocation proof received. The reporter wallet must be in the node's own shard as its
+// nonce can only be read from this shard's state, so the reporter can only run on shard nodes
+func (n *Node) StartSlashingReporter(reporterConfig config.SlashingReporterConfig) error {
+	if !reporterConfig.Enabled {
+		return nil
+	}
+	if check.IfNil(n.keyGenForAccounts) {
+		return ErrNilKeyGenForBalances
+	}
+
+	encodedSk, _, err := core.LoadSkPkFromPemFile(reporterConfig.WalletKeyPemFile, 0)
+	if err != nil {
+		return err
+	}
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return fmt.Errorf("%w for encoded reporter secret key", err)
+	}
+	reporterSk, err := n.keyGenForAccounts.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return err
+	}
+
+	proofVerifier, err := n.createEquivocationProofVerifier()
+	if err != nil {
+		return err
+	}
+	err = n.createEquivocationProofTopics(proofVerifier)
+	if err != nil {
+		return err
+	}
+
+	submitter, err := slashing.NewSlashTxSubmitter(slashing.ArgsSlashTxSubmitter{
+		ProofsProvider:    n.equivocationProofs,
+		Accounts:          n.accounts,
+		TxSender:          n,
+		Marshalizer:       n.internalMarshalizer,
+		TxSignMarshalizer: n.txSignMarshalizer,
+		TxSigner:          n.txSingleSigner,
+		PrivateKey:        reporterSk,
+		AddressConverter:  n.addressPubkeyConverter,
+		ShardCoordinator:  n.shardCoordinator,
+		ReceiverAddress:   vm.ValidatorSCAddress,
+		ChainID:           n.chainID,
+		MinTxVersion:      n.minTransactionVersion,
+		GasPrice:          reporterConfig.GasPrice,
+		GasLimit:          reporterConfig.GasLimit,
+		SubmitInterval:    time.Duration(reporterConfig.IntervalInSeconds) * time.Second,
+		ResendTimeout:     time.Duration(reporterConfig.ResendTimeoutInSeconds) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+
+	submitter.StartSubmitting()
+
+	return nil
+}
+
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
 func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
@@ -1257,8 +1467,47 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 	return err
 }
 
+// StartHeartbeatV2 starts the node's peer authentication and heartbeat v2 processing/signaling module
+func (n *Node) StartHeartbeatV2(hbConfig config.HeartbeatV2Config, versionNumber string, prefsConfig config.PreferencesConfig) error {
+	if !hbConfig.Enabled {
+		return nil
+	}
+
+	arg := componentHandler.ArgHeartbeatV2{
+		HeartbeatV2Config:        hbConfig,
+		PrefsConfig:              prefsConfig,
+		Marshalizer:              n.internalMarshalizer,
+		Messenger:                n.messenger,
+		ShardCoordinator:         n.shardCoordinator,
+		NodesCoordinator:         n.nodesCoordinator,
+		EpochStartTrigger:        n.epochStartTrigger,
+		EpochStartRegistration:   n.epochStartRegistrationHandler,
+		PeerSignatureHandler:     n.peerSigHandler,
+		SingleSigner:             n.singleSigner,
+		KeyGenerator:             n.keyGen,
+		PrivKey:                  n.privKey,
+		HardforkTrigger:          n.hardforkTrigger,
+		AntifloodHandler:         n.inputAntifloodHandler,
+		ValidatorPubkeyConverter: n.validatorPubkeyConverter,
+		Timer:                    &heartbeatProcess.RealTimer{},
+		VersionNumber:            versionNumber,
+		PeerShardMapper:          n.networkShardingCollector,
+		SizeCheckDelta:           n.sizeCheckDelta,
+		CurrentBlockProvider:     n.blkc,
+		RedundancyHandler:        n.nodeRedundancyHandler,
+	}
+
+	var err error
+	n.heartbeatV2Handler, err = componentHandler.NewHeartbeatV2Handler(arg)
+
+	return err
+}
+
 // GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
+	if !check.IfNil(n.heartbeatV2Handler) && !check.IfNil(n.heartbeatV2Handler.Monitor()) {
+		return n.heartbeatV2Handler.Monitor().GetHeartbeats()
+	}
 	if check.IfNil(n.heartbeatHandler) {
 		return make([]heartbeatData.PubKeyHeartbeat, 0)
 	}
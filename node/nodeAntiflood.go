@@ -0,0 +1,59 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetAntifloodStatus returns the per-peer and per-topic counters of the input antiflood and the blacklisted peers
+func (n *Node) GetAntifloodStatus() (*api.AntifloodStatus, error) {
+	if check.IfNil(n.antifloodIntrospector) {
+		return nil, ErrAntifloodIntrospectionNotAvailable
+	}
+
+	return n.antifloodIntrospector.GetAntifloodStatus(), nil
+}
+
+// SetAntifloodLimits changes the maximum number of messages and the maximum total size accepted from a peer by the
+// flood preventer with the provided name
+func (n *Node) SetAntifloodLimits(floodPreventer string, maxMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if check.IfNil(n.antifloodIntrospector) {
+		return ErrAntifloodIntrospectionNotAvailable
+	}
+
+	return n.antifloodIntrospector.SetFloodPreventerLimits(floodPreventer, maxMessagesPerPeer, maxTotalSizePerPeer)
+}
+
+// SetAntifloodTopicLimit changes the maximum number of messages accepted from a peer on the provided topic
+func (n *Node) SetAntifloodTopicLimit(topic string, maxMessagesPerPeer uint32) error {
+	if check.IfNil(n.antifloodIntrospector) {
+		return ErrAntifloodIntrospectionNotAvailable
+	}
+	if len(topic) == 0 {
+		return fmt.Errorf("%w, empty topic", ErrInvalidValue)
+	}
+	if maxMessagesPerPeer == 0 {
+		return fmt.Errorf("%w, the maximum number of messages per peer should be positive", ErrInvalidValue)
+	}
+
+	n.antifloodIntrospector.SetMaxMessagesForTopic(topic, maxMessagesPerPeer)
+
+	return nil
+}
+
+// UnbanPeer removes the peer, provided as b58-encoded string, from the antiflood black list
+func (n *Node) UnbanPeer(pid string) error {
+	if check.IfNil(n.antifloodIntrospector) {
+		return ErrAntifloodIntrospectionNotAvailable
+	}
+
+	peerID, err := core.NewPeerID(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s: %s", ErrInvalidValue, pid, err.Error())
+	}
+
+	return n.antifloodIntrospector.UnblacklistPeer(peerID)
+}
@@ -0,0 +1,79 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_AntifloodMethodsWithoutIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	status, err := n.GetAntifloodStatus()
+	assert.Nil(t, status)
+	assert.Equal(t, node.ErrAntifloodIntrospectionNotAvailable, err)
+	assert.Equal(t, node.ErrAntifloodIntrospectionNotAvailable, n.SetAntifloodLimits("fast_reacting", 1, 1))
+	assert.Equal(t, node.ErrAntifloodIntrospectionNotAvailable, n.SetAntifloodTopicLimit("topic", 1))
+	assert.Equal(t, node.ErrAntifloodIntrospectionNotAvailable, n.UnbanPeer("pid"))
+}
+
+func TestNode_GetAntifloodStatusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &api.AntifloodStatus{
+		BlacklistedPeers: []*api.BlacklistedPeer{{Pid: "pid", Reason: "flooding"}},
+	}
+	n, _ := node.NewNode(node.WithAntifloodIntrospector(&mock.AntifloodIntrospectorStub{
+		GetAntifloodStatusCalled: func() *api.AntifloodStatus {
+			return expectedStatus
+		},
+	}))
+
+	status, err := n.GetAntifloodStatus()
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStatus, status)
+}
+
+func TestNode_SetAntifloodTopicLimitInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithAntifloodIntrospector(&mock.AntifloodIntrospectorStub{
+		SetMaxMessagesForTopicCalled: func(topic string, maxNum uint32) {
+			assert.Fail(t, "should have not been called")
+		},
+	}))
+
+	err := n.SetAntifloodTopicLimit("", 10)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+
+	err = n.SetAntifloodTopicLimit("topic", 0)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+}
+
+func TestNode_UnbanPeerShouldDecodeThePeerID(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("a peer")
+	var unbannedPid core.PeerID
+	n, _ := node.NewNode(node.WithAntifloodIntrospector(&mock.AntifloodIntrospectorStub{
+		UnblacklistPeerCalled: func(peer core.PeerID) error {
+			unbannedPid = peer
+			return nil
+		},
+	}))
+
+	err := n.UnbanPeer("0OIl")
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+
+	err = n.UnbanPeer(pid.Pretty())
+	assert.Nil(t, err)
+	assert.Equal(t, pid, unbannedPid)
+}
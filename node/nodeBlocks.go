@@ -34,6 +34,7 @@ func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 		Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
 		HistoryRepo:              n.historyRepository,
 		UnmarshalTx:              n.unmarshalTransaction,
+		AddressPubkeyConverter:   n.addressPubkeyConverter,
 	}
 
 	if n.shardCoordinator.SelfId() != core.MetachainShardId {
@@ -0,0 +1,112 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetEpochEconomics returns the economics values computed at the start of the provided epoch
+func (n *Node) GetEpochEconomics(epoch uint32) (*api.EpochEconomics, error) {
+	breakdown, err := n.getEpochRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.EpochEconomics{
+		Epoch:                         breakdown.Epoch,
+		RewardsVersion:                breakdown.RewardsVersion,
+		TotalSupply:                   bigIntToString(breakdown.TotalSupply),
+		TotalToDistribute:             bigIntToString(breakdown.TotalToDistribute),
+		Inflation:                     bigIntToString(breakdown.TotalNewlyMinted),
+		RewardsPerBlock:               bigIntToString(breakdown.RewardsPerBlock),
+		NodePrice:                     bigIntToString(breakdown.NodePrice),
+		AccumulatedFees:               bigIntToString(breakdown.AccumulatedFees),
+		DeveloperFees:                 bigIntToString(breakdown.DeveloperFees),
+		LeaderFees:                    bigIntToString(breakdown.LeaderFees),
+		BaseRewards:                   bigIntToString(breakdown.BaseRewards),
+		TopUpRewards:                  bigIntToString(breakdown.TopUpRewards),
+		ProtocolSustainabilityRewards: bigIntToString(breakdown.ProtocolSustainabilityRewards),
+		NumberOfBlocks:                breakdown.NumberOfBlocks,
+	}, nil
+}
+
+// GetEpochRewards returns the rewards computed for each node and each reward address at the start of the provided epoch
+func (n *Node) GetEpochRewards(epoch uint32) (*api.EpochRewards, error) {
+	breakdown, err := n.getEpochRewardsBreakdown(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	epochRewards := &api.EpochRewards{
+		Epoch:     breakdown.Epoch,
+		Nodes:     make([]*api.NodeRewards, 0, len(breakdown.Nodes)),
+		Addresses: make([]*api.AddressRewards, 0, len(breakdown.Addresses)),
+	}
+
+	for _, nodeRewards := range breakdown.Nodes {
+		epochRewards.Nodes = append(epochRewards.Nodes, &api.NodeRewards{
+			BlsKey:                     n.validatorPubkeyConverter.Encode(nodeRewards.PubKey),
+			Shard:                      nodeRewards.ShardID,
+			RewardAddress:              n.addressPubkeyConverter.Encode(nodeRewards.RewardAddress),
+			BaseReward:                 bigIntToString(nodeRewards.BaseReward),
+			TopUpReward:                bigIntToString(nodeRewards.TopUpReward),
+			LeaderFees:                 bigIntToString(nodeRewards.LeaderFees),
+			TopUpStake:                 bigIntToString(nodeRewards.TopUpStake),
+			NumSelectedInSuccessBlocks: nodeRewards.NumSelectedInSuccessBlocks,
+			Rewarded:                   nodeRewards.Rewarded,
+		})
+	}
+
+	for _, addressRewards := range breakdown.Addresses {
+		epochRewards.Addresses = append(epochRewards.Addresses, &api.AddressRewards{
+			Address:              n.addressPubkeyConverter.Encode(addressRewards.Address),
+			BaseRewards:          bigIntToString(addressRewards.BaseRewards),
+			TopUpRewards:         bigIntToString(addressRewards.TopUpRewards),
+			LeaderFees:           bigIntToString(addressRewards.LeaderFees),
+			TotalRewards:         bigIntToString(addressRewards.TotalRewards),
+			NumNodes:             addressRewards.NumNodes,
+			IsDelegationContract: addressRewards.IsDelegationContract,
+			Distributed:          addressRewards.Distributed,
+		})
+	}
+
+	return epochRewards, nil
+}
+
+func (n *Node) getEpochRewardsBreakdown(epoch uint32) (*rewardTx.EpochRewardsBreakdown, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrEconomicsBreakdownOnlyOnMetachain
+	}
+
+	storer := n.store.GetStorer(dataRetriever.EconomicsBreakdownUnit)
+	if check.IfNil(storer) {
+		return nil, ErrEconomicsBreakdownOnlyOnMetachain
+	}
+
+	buff, err := storer.Get(rewardTx.EpochRewardsBreakdownKey(epoch))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d", ErrEconomicsBreakdownNotFound, epoch)
+	}
+
+	breakdown := &rewardTx.EpochRewardsBreakdown{}
+	err = n.internalMarshalizer.Unmarshal(breakdown, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return breakdown, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,141 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithEconomicsBreakdownStorer(selfShardID uint32, storer storage.Storer) *node.Node {
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: selfShardID}),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(96)),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				if unitType == dataRetriever.EconomicsBreakdownUnit {
+					return storer
+				}
+				return nil
+			},
+		}),
+	)
+
+	return n
+}
+
+func createEpochRewardsBreakdown(epoch uint32) *rewardTx.EpochRewardsBreakdown {
+	return &rewardTx.EpochRewardsBreakdown{
+		Epoch:                         epoch,
+		RewardsVersion:                2,
+		TotalSupply:                   big.NewInt(20000),
+		TotalToDistribute:             big.NewInt(1000),
+		TotalNewlyMinted:              big.NewInt(800),
+		RewardsPerBlock:               big.NewInt(10),
+		NodePrice:                     big.NewInt(2500),
+		AccumulatedFees:               big.NewInt(300),
+		DeveloperFees:                 big.NewInt(100),
+		LeaderFees:                    big.NewInt(20),
+		BaseRewards:                   big.NewInt(600),
+		TopUpRewards:                  big.NewInt(200),
+		ProtocolSustainabilityRewards: big.NewInt(180),
+		NumberOfBlocks:                100,
+		Nodes: []*rewardTx.NodeRewardsBreakdown{
+			{
+				PubKey:                     []byte("bls key"),
+				ShardID:                    1,
+				RewardAddress:              []byte("owner"),
+				BaseReward:                 big.NewInt(600),
+				TopUpReward:                big.NewInt(200),
+				LeaderFees:                 big.NewInt(20),
+				TopUpStake:                 big.NewInt(5000),
+				NumSelectedInSuccessBlocks: 80,
+				Rewarded:                   true,
+			},
+		},
+		Addresses: []*rewardTx.AddressRewardsBreakdown{
+			{
+				Address:      []byte("owner"),
+				BaseRewards:  big.NewInt(600),
+				TopUpRewards: big.NewInt(200),
+				LeaderFees:   big.NewInt(20),
+				TotalRewards: big.NewInt(820),
+				NumNodes:     1,
+				Distributed:  true,
+			},
+		},
+	}
+}
+
+func TestNode_GetEpochEconomicsNotOnMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithEconomicsBreakdownStorer(0, mock.NewStorerMock())
+
+	economics, err := n.GetEpochEconomics(1)
+	assert.Nil(t, economics)
+	assert.Equal(t, node.ErrEconomicsBreakdownOnlyOnMetachain, err)
+}
+
+func TestNode_GetEpochRewardsMissingEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithEconomicsBreakdownStorer(core.MetachainShardId, mock.NewStorerMock())
+
+	rewards, err := n.GetEpochRewards(4)
+	assert.Nil(t, rewards)
+	assert.True(t, errors.Is(err, node.ErrEconomicsBreakdownNotFound))
+}
+
+func TestNode_GetEpochEconomicsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	storer := mock.NewStorerMock()
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(createEpochRewardsBreakdown(epoch))
+	_ = storer.Put(rewardTx.EpochRewardsBreakdownKey(epoch), buff)
+	n := createNodeWithEconomicsBreakdownStorer(core.MetachainShardId, storer)
+
+	economics, err := n.GetEpochEconomics(epoch)
+	require.Nil(t, err)
+	assert.Equal(t, epoch, economics.Epoch)
+	assert.Equal(t, uint32(2), economics.RewardsVersion)
+	assert.Equal(t, "20000", economics.TotalSupply)
+	assert.Equal(t, "800", economics.Inflation)
+	assert.Equal(t, "180", economics.ProtocolSustainabilityRewards)
+	assert.Equal(t, uint64(100), economics.NumberOfBlocks)
+}
+
+func TestNode_GetEpochRewardsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	storer := mock.NewStorerMock()
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(createEpochRewardsBreakdown(epoch))
+	_ = storer.Put(rewardTx.EpochRewardsBreakdownKey(epoch), buff)
+	n := createNodeWithEconomicsBreakdownStorer(core.MetachainShardId, storer)
+
+	rewards, err := n.GetEpochRewards(epoch)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(rewards.Nodes))
+	require.Equal(t, 1, len(rewards.Addresses))
+
+	assert.Equal(t, hex.EncodeToString([]byte("bls key")), rewards.Nodes[0].BlsKey)
+	assert.Equal(t, hex.EncodeToString([]byte("owner")), rewards.Nodes[0].RewardAddress)
+	assert.Equal(t, "5000", rewards.Nodes[0].TopUpStake)
+	assert.True(t, rewards.Nodes[0].Rewarded)
+	assert.Equal(t, hex.EncodeToString([]byte("owner")), rewards.Addresses[0].Address)
+	assert.Equal(t, "820", rewards.Addresses[0].TotalRewards)
+	assert.True(t, rewards.Addresses[0].Distributed)
+}
@@ -0,0 +1,118 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+)
+
+// MaxBlocksForLogsSearch defines the maximum number of blocks that can be searched for logs in one request
+const MaxBlocksForLogsSearch = 10000
+
+// GetLogs returns the events generated in the provided range of blocks that match the provided address and
+// identifier. The per block logs bloom is used in order to skip the blocks that do not contain matching events
+func (n *Node) GetLogs(address string, identifier string, fromNonce uint64, toNonce uint64) ([]*api.LogEvent, error) {
+	if len(address) == 0 && len(identifier) == 0 {
+		return nil, ErrEmptyLogsFilter
+	}
+	if fromNonce > toNonce || toNonce-fromNonce >= MaxBlocksForLogsSearch {
+		return nil, fmt.Errorf("%w, at most %d blocks can be searched", ErrInvalidNoncesRange, MaxBlocksForLogsSearch)
+	}
+
+	var addressBytes []byte
+	var err error
+	if len(address) > 0 {
+		addressBytes, err = n.addressPubkeyConverter.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	identifierBytes := []byte(identifier)
+
+	nonceToHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(n.shardCoordinator.SelfId())
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		nonceToHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	logEvents := make([]*api.LogEvent, 0)
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		headerHash, errGet := n.store.Get(nonceToHashUnit, n.uint64ByteSliceConverter.ToByteSlice(nonce))
+		if errGet != nil {
+			continue
+		}
+
+		blockEvents, errGet := n.getBlockLogEvents(nonce, headerHash, addressBytes, identifierBytes)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		logEvents = append(logEvents, blockEvents...)
+	}
+
+	return logEvents, nil
+}
+
+func (n *Node) getBlockLogEvents(nonce uint64, headerHash []byte, address []byte, identifier []byte) ([]*api.LogEvent, error) {
+	logsBloomBuff, err := n.store.Get(dataRetriever.LogsBloomUnit, headerHash)
+	if err != nil {
+		// no logs were generated in this block
+		return nil, nil
+	}
+
+	logsBloom := &transaction.LogsBloom{}
+	err = n.internalMarshalizer.Unmarshal(logsBloom, logsBloomBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	mayContain, err := transactionLog.LogsBloomMayContain(logsBloom, address, identifier)
+	if err != nil || !mayContain {
+		return nil, err
+	}
+
+	logEvents := make([]*api.LogEvent, 0)
+	for _, txHash := range logsBloom.TxHashes {
+		txLogBuff, errGet := n.store.Get(dataRetriever.TxLogsUnit, txHash)
+		if errGet != nil {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err = n.internalMarshalizer.Unmarshal(txLog, txLogBuff)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range txLog.Events {
+			if !eventMatches(txLog, event, address, identifier) {
+				continue
+			}
+
+			logEvents = append(logEvents, &api.LogEvent{
+				BlockNonce: nonce,
+				BlockHash:  hex.EncodeToString(headerHash),
+				TxHash:     hex.EncodeToString(txHash),
+				LogAddress: n.addressPubkeyConverter.Encode(txLog.Address),
+				Address:    n.addressPubkeyConverter.Encode(event.Address),
+				Identifier: string(event.Identifier),
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+		}
+	}
+
+	return logEvents, nil
+}
+
+func eventMatches(txLog *transaction.Log, event *transaction.Event, address []byte, identifier []byte) bool {
+	addressMatches := len(address) == 0 || bytes.Equal(txLog.Address, address) || bytes.Equal(event.Address, address)
+	identifierMatches := len(identifier) == 0 || bytes.Equal(event.Identifier, identifier)
+
+	return addressMatches && identifierMatches
+}
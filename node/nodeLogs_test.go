@@ -0,0 +1,95 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLogs_EmptyFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	logEvents, err := n.GetLogs("", "", 1, 2)
+
+	assert.Nil(t, logEvents)
+	assert.Equal(t, node.ErrEmptyLogsFilter, err)
+}
+
+func TestGetLogs_InvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	_, err := n.GetLogs("", "transfer", 5, 4)
+	assert.True(t, errors.Is(err, node.ErrInvalidNoncesRange))
+
+	_, err = n.GetLogs("", "transfer", 0, node.MaxBlocksForLogsSearch)
+	assert.True(t, errors.Is(err, node.ErrInvalidNoncesRange))
+}
+
+func TestGetLogs_ShouldReturnMatchingEvents(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	uint64Converter := mock.NewNonceHashConverterMock()
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	logsStorer := mock.NewStorerMock()
+	nonceToHashStorer := mock.NewStorerMock()
+	logsBloomStorer := mock.NewStorerMock()
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TxLogsUnit, logsStorer)
+	store.AddStorer(dataRetriever.LogsBloomUnit, logsBloomStorer)
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardCoordinator.SelfId()), nonceToHashStorer)
+
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      logsStorer,
+		Marshalizer: marshalizer,
+	})
+	tx := &transaction.Transaction{RcvAddr: []byte("contract")}
+	_ = txLogProcessor.SaveLog([]byte("tx1"), tx, []*vmcommon.LogEntry{
+		{Address: []byte("contract"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+		{Address: []byte("contract"), Identifier: []byte("burn")},
+	})
+	_ = txLogProcessor.SaveLog([]byte("tx2"), tx, []*vmcommon.LogEntry{
+		{Address: []byte("contract"), Identifier: []byte("mint")},
+	})
+
+	for nonce, txHash := range map[uint64][]byte{3: []byte("tx1"), 4: []byte("tx2")} {
+		headerHash := []byte{byte(nonce)}
+		_ = nonceToHashStorer.Put(uint64Converter.ToByteSlice(nonce), headerHash)
+		logsBloom, _ := transactionLog.CreateLogsBloom([][]byte{txHash}, logsStorer, marshalizer)
+		logsBloomBuff, _ := marshalizer.Marshal(logsBloom)
+		_ = logsBloomStorer.Put(headerHash, logsBloomBuff)
+	}
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, 90),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(8)),
+		node.WithShardCoordinator(shardCoordinator),
+		node.WithDataStore(store),
+		node.WithUint64ByteSliceConverter(uint64Converter),
+	)
+
+	logEvents, err := n.GetLogs(hex.EncodeToString([]byte("contract")), "transfer", 1, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(logEvents))
+	assert.Equal(t, uint64(3), logEvents[0].BlockNonce)
+	assert.Equal(t, hex.EncodeToString([]byte("tx1")), logEvents[0].TxHash)
+	assert.Equal(t, "transfer", logEvents[0].Identifier)
+	assert.Equal(t, [][]byte{[]byte("topic")}, logEvents[0].Topics)
+
+	logEvents, err = n.GetLogs(hex.EncodeToString([]byte("contract")), "", 1, 10)
+	require.Nil(t, err)
+	assert.Equal(t, 3, len(logEvents))
+}
@@ -28,6 +28,7 @@ func TestMemoryConfig(t *testing.T) {
 	plannedMemory += nodeConfig.MetaBlockStorage.Cache.SizeInBytes
 	plannedMemory += nodeConfig.TxStorage.Cache.SizeInBytes
 	plannedMemory += nodeConfig.TxLogsStorage.Cache.SizeInBytes
+	plannedMemory += nodeConfig.LogsBloomStorage.Cache.SizeInBytes
 	plannedMemory += nodeConfig.UnsignedTransactionStorage.Cache.SizeInBytes
 	plannedMemory += nodeConfig.RewardTxStorage.Cache.SizeInBytes
 	plannedMemory += nodeConfig.ShardHdrNonceHashStorage.Cache.SizeInBytes
@@ -33,7 +33,7 @@ func (n *Node) GetTransaction(txHash string, withResults bool) (*transaction.Api
 		return n.lookupHistoricalTransaction(hash, withResults)
 	}
 
-	return n.getTransactionFromStorage(hash)
+	return n.getTransactionFromStorage(hash, withResults)
 }
 
 func (n *Node) optionallyGetTransactionFromPool(hash []byte) (*transaction.ApiTransactionResult, error) {
@@ -125,7 +125,7 @@ func putMiniblockFieldsInTransaction(tx *transaction.ApiTransactionResult, minib
 	return tx
 }
 
-func (n *Node) getTransactionFromStorage(hash []byte) (*transaction.ApiTransactionResult, error) {
+func (n *Node) getTransactionFromStorage(hash []byte, withResults bool) (*transaction.ApiTransactionResult, error) {
 	txBytes, txType, found := n.getTxBytesFromStorage(hash)
 	if !found {
 		return nil, ErrTransactionNotFound
@@ -145,6 +145,10 @@ func (n *Node) getTransactionFromStorage(hash []byte) (*transaction.ApiTransacti
 		SelfShard:        n.shardCoordinator.SelfId(),
 	}).ComputeStatusWhenInStorageNotKnowingMiniblock()
 
+	if withResults {
+		tx.Logs = n.searchApiLogsInStorage(hash)
+	}
+
 	return tx, nil
 }
 
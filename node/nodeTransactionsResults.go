@@ -3,6 +3,7 @@ package node
 import (
 	"encoding/hex"
 
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -11,6 +12,8 @@ import (
 )
 
 func (n *Node) putResultsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+	tx.Logs = n.getApiLogsFromStorage(hash, epoch)
+
 	resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(hash, epoch)
 	if err != nil {
 		return
@@ -74,7 +77,10 @@ func (n *Node) putSmartContractResultsInTransaction(
 				continue
 			}
 
-			tx.SmartContractResults = append(tx.SmartContractResults, n.adaptSmartContractResult(scrHash, scr))
+			apiSCR := n.adaptSmartContractResult(scrHash, scr)
+			apiSCR.Logs = n.getApiLogsFromStorage(scrHash, scrHashesE.Epoch)
+
+			tx.SmartContractResults = append(tx.SmartContractResults, apiSCR)
 		}
 	}
 }
@@ -95,6 +101,49 @@ func (n *Node) getScrFromStorage(hash []byte, epoch uint32) (*smartContractResul
 	return scr, nil
 }
 
+func (n *Node) getApiLogsFromStorage(hash []byte, epoch uint32) *transaction.ApiLogs {
+	logsStorer := n.store.GetStorer(dataRetriever.TxLogsUnit)
+	if check.IfNil(logsStorer) {
+		return nil
+	}
+
+	logsBytes, err := logsStorer.GetFromEpoch(hash, epoch)
+	if err != nil {
+		// the transaction did not generate logs
+		return nil
+	}
+
+	return n.unmarshalApiLogs(hash, logsBytes)
+}
+
+func (n *Node) searchApiLogsInStorage(hash []byte) *transaction.ApiLogs {
+	logsStorer := n.store.GetStorer(dataRetriever.TxLogsUnit)
+	if check.IfNil(logsStorer) {
+		return nil
+	}
+
+	logsBytes, err := logsStorer.SearchFirst(hash)
+	if err != nil {
+		// the transaction did not generate logs
+		return nil
+	}
+
+	return n.unmarshalApiLogs(hash, logsBytes)
+}
+
+func (n *Node) unmarshalApiLogs(hash []byte, logsBytes []byte) *transaction.ApiLogs {
+	txLog := &transaction.Log{}
+	err := n.internalMarshalizer.Unmarshal(txLog, logsBytes)
+	if err != nil {
+		log.Warn("cannot unmarshal transaction logs",
+			"hash", hex.EncodeToString(hash),
+			"error", err.Error())
+		return nil
+	}
+
+	return txLog.ToApiLogs(n.addressPubkeyConverter)
+}
+
 func (n *Node) adaptSmartContractResult(scrHash []byte, scr *smartContractResult.SmartContractResult) *transaction.ApiSmartContractResult {
 	apiSCR := &transaction.ApiSmartContractResult{
 		Hash:           hex.EncodeToString(scrHash),
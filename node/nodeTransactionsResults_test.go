@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -96,9 +97,27 @@ func TestPutEventsInTransactionSmartContractResults(t *testing.T) {
 		OriginalTxHash: txHash,
 	}
 
+	scr1Log := &transaction.Log{
+		Address: []byte("receiver"),
+		Events: []*transaction.Event{
+			{Address: []byte("receiver"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+		},
+	}
+
 	marshalizerdMock := &mock.MarshalizerFake{}
 	dataStore := &mock.ChainStorerMock{
 		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType == dataRetriever.TxLogsUnit {
+				return &mock.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						if bytes.Equal(key, scrHash1) {
+							return marshalizerdMock.Marshal(scr1Log)
+						}
+						return nil, errors.New("key not found")
+					},
+				}
+			}
+
 			return &mock.StorerStub{
 				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
 					switch {
@@ -152,6 +171,16 @@ func TestPutEventsInTransactionSmartContractResults(t *testing.T) {
 			RcvAddr:        n.addressPubkeyConverter.Encode(scr1.RcvAddr),
 			RelayerAddr:    n.addressPubkeyConverter.Encode(scr1.RelayerAddr),
 			OriginalSender: n.addressPubkeyConverter.Encode(scr1.OriginalSender),
+			Logs: &transaction.ApiLogs{
+				Address: n.addressPubkeyConverter.Encode(scr1Log.Address),
+				Events: []*transaction.Events{
+					{
+						Address:    n.addressPubkeyConverter.Encode(scr1Log.Events[0].Address),
+						Identifier: "transfer",
+						Topics:     [][]byte{[]byte("topic")},
+					},
+				},
+			},
 		},
 		{
 			Hash:           hex.EncodeToString(scrHash2),
@@ -162,4 +191,5 @@ func TestPutEventsInTransactionSmartContractResults(t *testing.T) {
 	tx := &transaction.ApiTransactionResult{}
 	n.putResultsInTransaction(txHash, tx, epoch)
 	require.Equal(t, expectedSCRS, tx.SmartContractResults)
+	require.Nil(t, tx.Logs)
 }
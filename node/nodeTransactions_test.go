@@ -179,6 +179,28 @@ func TestNode_GetTransaction_FromStorage(t *testing.T) {
 	require.Nil(t, tx)
 }
 
+func TestNode_GetTransaction_FromStorageWithResultsShouldPutLogs(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, _ := createNode(t, 0, false)
+
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("alice")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), tx, n.internalMarshalizer)
+	txLog := &transaction.Log{
+		Address: []byte("alice"),
+		Events:  []*transaction.Event{{Address: []byte("alice"), Identifier: []byte("transfer")}},
+	}
+	_ = chainStorer.Logs.PutWithMarshalizer([]byte("a"), txLog, n.internalMarshalizer)
+
+	actualTx, err := n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	require.Nil(t, actualTx.Logs)
+
+	actualTx, err = n.GetTransaction(hex.EncodeToString([]byte("a")), true)
+	require.Nil(t, err)
+	require.Equal(t, txLog.ToApiLogs(n.addressPubkeyConverter), actualTx.Logs)
+}
+
 func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,7 @@ import (
 	elasticIndexer "github.com/ElrondNetwork/elastic-indexer-go"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
@@ -3021,3 +3022,60 @@ func TestNode_StartHeartbeat(t *testing.T) {
 
 	time.Sleep(time.Second)
 }
+
+func TestNode_StartHeartbeatV2DisabledShouldNotCreateComponents(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	err := n.StartHeartbeatV2(config.HeartbeatV2Config{Enabled: false}, "1.0", config.PreferencesConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(n.GetHeartbeats()))
+}
+
+func TestNode_GetConsensusRoundsWithoutRecorderShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	rounds := n.GetConsensusRounds()
+	assert.NotNil(t, rounds)
+	assert.Equal(t, 0, len(rounds))
+}
+
+func TestNode_GetConsensusRoundsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	roundsRecorder, _ := spos.NewRoundsRecorder(spos.ArgsRoundsRecorder{
+		MaxRoundsToKeep: 10,
+		Marshalizer:     &mock.MarshalizerFake{},
+	})
+	roundsRecorder.SetRoundOutcome(4, []byte("leader"), 3, 3, spos.RoundOutcomeCommitted)
+	n, _ := node.NewNode(node.WithConsensusRoundsRecorder(roundsRecorder))
+
+	rounds := n.GetConsensusRounds()
+	require.Equal(t, 1, len(rounds))
+	assert.Equal(t, int64(4), rounds[0].Round)
+	assert.Equal(t, spos.RoundOutcomeCommitted, rounds[0].Outcome)
+}
+
+func TestNode_StartSlashingReporterDisabledShouldNotCreateAnything(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	err := n.StartSlashingReporter(config.SlashingReporterConfig{Enabled: false})
+	assert.Nil(t, err)
+}
+
+func TestNode_StartSlashingReporterMissingWalletKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithKeyGenForAccounts(&mock.KeyGenMock{}))
+
+	err := n.StartSlashingReporter(config.SlashingReporterConfig{
+		Enabled:          true,
+		WalletKeyPemFile: "missing.pem",
+	})
+	assert.NotNil(t, err)
+}
@@ -801,3 +801,42 @@ func WithNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHan
 		return nil
 	}
 }
+
+// WithConsensusRoundsRecorder sets up the component that records the consensus rounds timeline
+func WithConsensusRoundsRecorder(roundsRecorder spos.RoundsRecorder) Option {
+	return func(n *Node) error {
+		if check.IfNil(roundsRecorder) {
+			return ErrNilConsensusRoundsRecorder
+		}
+		n.roundsRecorder = roundsRecorder
+		return nil
+	}
+}
+
+// WithOptimisticSignatureVerification sets up whether the consensus leader verifies the aggregated signature
+// instead of each received signature share
+func WithOptimisticSignatureVerification(enabled bool) Option {
+	return func(n *Node) error {
+		n.optimisticSignatureVerification = enabled
+		return nil
+	}
+}
+
+// WithSyncPipelineWindowSize sets up the number of upcoming nonces handled ahead of execution by the pipelined sync
+func WithSyncPipelineWindowSize(windowSize uint64) Option {
+	return func(n *Node) error {
+		n.syncPipelineWindowSize = windowSize
+		return nil
+	}
+}
+
+// WithAntifloodIntrospector sets up the component used to inspect and tune the input antiflood at runtime
+func WithAntifloodIntrospector(antifloodIntrospector AntifloodIntrospector) Option {
+	return func(n *Node) error {
+		if check.IfNil(antifloodIntrospector) {
+			return ErrNilAntifloodIntrospector
+		}
+		n.antifloodIntrospector = antifloodIntrospector
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
@@ -1533,3 +1534,78 @@ func TestWithNodeRedundancyHandler_OkNodeRedundancyHandlerShouldWork(t *testing.
 	assert.Equal(t, nodeRedundancyHandler, node.nodeRedundancyHandler)
 	assert.Nil(t, err)
 }
+
+func TestWithConsensusRoundsRecorder_NilRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithConsensusRoundsRecorder(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilConsensusRoundsRecorder, err)
+}
+
+func TestWithConsensusRoundsRecorder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	roundsRecorder, _ := spos.NewRoundsRecorder(spos.ArgsRoundsRecorder{
+		MaxRoundsToKeep: 10,
+		Marshalizer:     &mock.MarshalizerFake{},
+	})
+	opt := WithConsensusRoundsRecorder(roundsRecorder)
+	err := opt(node)
+
+	assert.Equal(t, roundsRecorder, node.roundsRecorder)
+	assert.Nil(t, err)
+}
+
+func TestWithOptimisticSignatureVerification_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithOptimisticSignatureVerification(true)
+	err := opt(node)
+
+	assert.True(t, node.optimisticSignatureVerification)
+	assert.Nil(t, err)
+}
+
+func TestWithSyncPipelineWindowSize_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSyncPipelineWindowSize(100)
+	err := opt(node)
+
+	assert.Equal(t, uint64(100), node.syncPipelineWindowSize)
+	assert.Nil(t, err)
+}
+
+func TestWithAntifloodIntrospector_NilIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAntifloodIntrospector(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilAntifloodIntrospector, err)
+}
+
+func TestWithAntifloodIntrospector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	introspector := &mock.AntifloodIntrospectorStub{}
+	opt := WithAntifloodIntrospector(introspector)
+	err := opt(node)
+
+	assert.True(t, node.antifloodIntrospector == introspector)
+	assert.Nil(t, err)
+}
@@ -140,3 +140,18 @@ var ErrUnsupportedMessageVersion = errors.New("unsupported message version")
 
 // ErrNilSyncTimer signals that a nil sync timer was provided
 var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilPeerHonestyScoreHandler signals that a nil peer honesty score handler was provided
+var ErrNilPeerHonestyScoreHandler = errors.New("nil peer honesty score handler")
+
+// ErrKadDhtNotEnabled signals that an operation requiring the kad-dht peer discovery was called while it is disabled
+var ErrKadDhtNotEnabled = errors.New("kad-dht peer discovery is not enabled")
+
+// ErrUnsupportedCompression signals that an unsupported payload compression algorithm was requested or detected
+var ErrUnsupportedCompression = errors.New("unsupported compression")
+
+// ErrDecompressedPayloadTooLarge signals that a compressed payload would exceed the maximum allowed size once decompressed
+var ErrDecompressedPayloadTooLarge = errors.New("decompressed payload too large")
+
+// ErrNilPayloadDecompressor signals that a nil payload decompressor was provided
+var ErrNilPayloadDecompressor = errors.New("nil payload decompressor")
@@ -0,0 +1,221 @@
+package addressBook
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var log = logger.GetOrCreate("p2p/libp2p/addressbook")
+
+const minPeersToKeep = 1
+const filePermissions = 0644
+const dirPermissions = 0755
+
+// PeerRecord holds what the node knows about a peer it has been connected to
+type PeerRecord struct {
+	Pid          string   `json:"pid"`
+	Addresses    []string `json:"addresses"`
+	LastSeen     int64    `json:"lastSeen"`
+	LatencyMs    int64    `json:"latencyMs"`
+	HonestyScore float64  `json:"honestyScore"`
+}
+
+// ArgsAddressBook represents the argument structure used to create a new address book
+type ArgsAddressBook struct {
+	FilePath    string
+	MaxPeers    int
+	Marshalizer p2p.Marshalizer
+}
+
+type peersFile struct {
+	Peers []*PeerRecord `json:"peers"`
+}
+
+type addressBook struct {
+	filePath    string
+	maxPeers    int
+	marshalizer p2p.Marshalizer
+	mutRecords  sync.RWMutex
+	records     map[string]*PeerRecord
+}
+
+// NewAddressBook creates a peer address book persisted in the provided file. The records already saved in the
+// file are loaded, so the node can reconnect to the peers it knew about before being restarted
+func NewAddressBook(args ArgsAddressBook) (*addressBook, error) {
+	if len(args.FilePath) == 0 {
+		return nil, fmt.Errorf("%w, empty address book file path", p2p.ErrInvalidValue)
+	}
+	if args.MaxPeers < minPeersToKeep {
+		return nil, fmt.Errorf("%w, address book max peers should be at least %d", p2p.ErrInvalidValue, minPeersToKeep)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, fmt.Errorf("%w when creating the address book", p2p.ErrNilMarshalizer)
+	}
+
+	ab := &addressBook{
+		filePath:    args.FilePath,
+		maxPeers:    args.MaxPeers,
+		marshalizer: args.Marshalizer,
+		records:     make(map[string]*PeerRecord),
+	}
+	ab.load()
+
+	return ab, nil
+}
+
+func (ab *addressBook) load() {
+	buff, err := ioutil.ReadFile(ab.filePath)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.Warn("addressBook.load", "file", ab.filePath, "error", err.Error())
+		return
+	}
+
+	content := &peersFile{}
+	err = ab.marshalizer.Unmarshal(content, buff)
+	if err != nil {
+		log.Warn("addressBook.load: corrupted file, starting with an empty address book",
+			"file", ab.filePath, "error", err.Error())
+		return
+	}
+
+	for _, record := range content.Peers {
+		if record == nil || len(record.Pid) == 0 {
+			continue
+		}
+		ab.records[record.Pid] = record
+	}
+
+	log.Debug("addressBook.load", "file", ab.filePath, "num peers", len(ab.records))
+}
+
+// Upsert adds or replaces the record of a peer
+func (ab *addressBook) Upsert(record PeerRecord) {
+	if len(record.Pid) == 0 {
+		return
+	}
+
+	ab.mutRecords.Lock()
+	ab.records[record.Pid] = &record
+	ab.mutRecords.Unlock()
+}
+
+// Remove deletes the record of a peer
+func (ab *addressBook) Remove(pid string) {
+	ab.mutRecords.Lock()
+	delete(ab.records, pid)
+	ab.mutRecords.Unlock()
+}
+
+// Get returns the record of a peer, if existing
+func (ab *addressBook) Get(pid string) (PeerRecord, bool) {
+	ab.mutRecords.RLock()
+	defer ab.mutRecords.RUnlock()
+
+	record, found := ab.records[pid]
+	if !found {
+		return PeerRecord{}, false
+	}
+
+	return *record, true
+}
+
+// BestPeers returns at most maxNum records, the best peers first. A peer is better than another one if it has a
+// higher honesty score, then if it has a lower latency and then if it was seen more recently
+func (ab *addressBook) BestPeers(maxNum int) []PeerRecord {
+	ab.mutRecords.RLock()
+	records := ab.sortedRecordsNoLock()
+	ab.mutRecords.RUnlock()
+
+	if maxNum < len(records) {
+		records = records[:maxNum]
+	}
+
+	result := make([]PeerRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, *record)
+	}
+
+	return result
+}
+
+func (ab *addressBook) sortedRecordsNoLock() []*PeerRecord {
+	records := make([]*PeerRecord, 0, len(ab.records))
+	for _, record := range ab.records {
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return isBetter(records[i], records[j])
+	})
+
+	return records
+}
+
+func isBetter(first *PeerRecord, second *PeerRecord) bool {
+	if first.HonestyScore != second.HonestyScore {
+		return first.HonestyScore > second.HonestyScore
+	}
+	if first.LatencyMs != second.LatencyMs {
+		return first.LatencyMs < second.LatencyMs
+	}
+	if first.LastSeen != second.LastSeen {
+		return first.LastSeen > second.LastSeen
+	}
+
+	return first.Pid < second.Pid
+}
+
+// Save persists the best records, at most the configured maximum number of peers, in the address book file
+func (ab *addressBook) Save() error {
+	ab.mutRecords.Lock()
+	records := ab.sortedRecordsNoLock()
+	if len(records) > ab.maxPeers {
+		for _, record := range records[ab.maxPeers:] {
+			delete(ab.records, record.Pid)
+		}
+		records = records[:ab.maxPeers]
+	}
+	buff, err := ab.marshalizer.Marshal(&peersFile{Peers: records})
+	ab.mutRecords.Unlock()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(ab.filePath), dirPermissions)
+	if err != nil {
+		return err
+	}
+
+	// the content is written in a temporary file first, so a crash while saving will not corrupt the existing file
+	tempFilePath := ab.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, buff, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, ab.filePath)
+}
+
+// Len returns the number of known peers
+func (ab *addressBook) Len() int {
+	ab.mutRecords.RLock()
+	defer ab.mutRecords.RUnlock()
+
+	return len(ab.records)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ab *addressBook) IsInterfaceNil() bool {
+	return ab == nil
+}
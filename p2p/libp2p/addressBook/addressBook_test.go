@@ -0,0 +1,162 @@
+package addressBook
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAddressBook(t *testing.T) (ArgsAddressBook, func()) {
+	dir, err := ioutil.TempDir("", "addressBook")
+	require.Nil(t, err)
+
+	args := ArgsAddressBook{
+		FilePath:    filepath.Join(dir, "peers.json"),
+		MaxPeers:    3,
+		Marshalizer: &marshal.JsonMarshalizer{},
+	}
+
+	return args, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestNewAddressBook_EmptyFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	args.FilePath = ""
+
+	ab, err := NewAddressBook(args)
+
+	assert.True(t, check.IfNil(ab))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewAddressBook_InvalidMaxPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	args.MaxPeers = 0
+
+	ab, err := NewAddressBook(args)
+
+	assert.True(t, check.IfNil(ab))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewAddressBook_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	args.Marshalizer = nil
+
+	ab, err := NewAddressBook(args)
+
+	assert.True(t, check.IfNil(ab))
+	assert.True(t, errors.Is(err, p2p.ErrNilMarshalizer))
+}
+
+func TestNewAddressBook_MissingFileShouldStartEmpty(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+
+	ab, err := NewAddressBook(args)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(ab))
+	assert.Equal(t, 0, ab.Len())
+}
+
+func TestNewAddressBook_CorruptedFileShouldStartEmpty(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	_ = ioutil.WriteFile(args.FilePath, []byte("not a json"), filePermissions)
+
+	ab, err := NewAddressBook(args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, ab.Len())
+}
+
+func TestAddressBook_BestPeersShouldSortByScoreLatencyAndLastSeen(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	ab, _ := NewAddressBook(args)
+
+	ab.Upsert(PeerRecord{Pid: "slow", HonestyScore: 10, LatencyMs: 200, LastSeen: 5})
+	ab.Upsert(PeerRecord{Pid: "dishonest", HonestyScore: -50, LatencyMs: 1, LastSeen: 5})
+	ab.Upsert(PeerRecord{Pid: "fast", HonestyScore: 10, LatencyMs: 20, LastSeen: 1})
+	ab.Upsert(PeerRecord{Pid: "fast recent", HonestyScore: 10, LatencyMs: 20, LastSeen: 2})
+	ab.Upsert(PeerRecord{Pid: ""})
+
+	best := ab.BestPeers(10)
+	require.Equal(t, 4, len(best))
+	assert.Equal(t, "fast recent", best[0].Pid)
+	assert.Equal(t, "fast", best[1].Pid)
+	assert.Equal(t, "slow", best[2].Pid)
+	assert.Equal(t, "dishonest", best[3].Pid)
+
+	best = ab.BestPeers(1)
+	require.Equal(t, 1, len(best))
+	assert.Equal(t, "fast recent", best[0].Pid)
+}
+
+func TestAddressBook_SaveShouldPersistTheBestPeers(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	ab, _ := NewAddressBook(args)
+	for i, pid := range []string{"a", "b", "c", "d"} {
+		ab.Upsert(PeerRecord{
+			Pid:          pid,
+			Addresses:    []string{"/ip4/127.0.0.1/tcp/1000" + pid},
+			HonestyScore: float64(i),
+		})
+	}
+
+	err := ab.Save()
+	require.Nil(t, err)
+	assert.Equal(t, 3, ab.Len())
+
+	reloaded, _ := NewAddressBook(args)
+	assert.Equal(t, 3, reloaded.Len())
+	_, found := reloaded.Get("a")
+	assert.False(t, found)
+	record, found := reloaded.Get("d")
+	assert.True(t, found)
+	assert.Equal(t, []string{"/ip4/127.0.0.1/tcp/1000d"}, record.Addresses)
+	assert.Equal(t, float64(3), record.HonestyScore)
+}
+
+func TestAddressBook_Remove(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockArgsAddressBook(t)
+	defer cleanup()
+	ab, _ := NewAddressBook(args)
+	ab.Upsert(PeerRecord{Pid: "a"})
+
+	ab.Remove("a")
+
+	_, found := ab.Get("a")
+	assert.False(t, found)
+}
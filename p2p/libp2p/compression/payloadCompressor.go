@@ -0,0 +1,181 @@
+package compression
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+const (
+	// NoCompression marks an uncompressed topic message payload
+	NoCompression = uint32(0)
+	// SnappyCompression marks a topic message payload compressed with snappy
+	SnappyCompression = uint32(1)
+)
+
+const (
+	// NoneAlgorithm is the configuration name used when the outgoing payloads should not be compressed
+	NoneAlgorithm = "none"
+	// SnappyAlgorithm is the configuration name of the snappy compression
+	SnappyAlgorithm = "snappy"
+)
+
+// SnappyProtocolID is advertised by the peers able to receive snappy compressed direct messages
+const SnappyProtocolID = protocol.ID("/erd/compression/snappy/1.0.0")
+
+// ArgsPayloadCompressor represents the DTO used to create a payload compressor
+type ArgsPayloadCompressor struct {
+	Algorithm           string
+	MinPayloadSize      int
+	MaxDecompressedSize int
+}
+
+// Statistics holds the compression counters gathered since the previous reset
+type Statistics struct {
+	NumCompressed            uint64
+	NumNotCompressed         uint64
+	NumDecompressed          uint64
+	BytesBeforeCompression   uint64
+	BytesAfterCompression    uint64
+	BytesBeforeDecompression uint64
+	BytesAfterDecompression  uint64
+	CompressionDuration      time.Duration
+	DecompressionDuration    time.Duration
+}
+
+type payloadCompressor struct {
+	algorithm           uint32
+	protocolID          protocol.ID
+	minPayloadSize      int
+	maxDecompressedSize int
+
+	numCompressed            uint64
+	numNotCompressed         uint64
+	numDecompressed          uint64
+	bytesBeforeCompression   uint64
+	bytesAfterCompression    uint64
+	bytesBeforeDecompression uint64
+	bytesAfterDecompression  uint64
+	compressionDuration      int64
+	decompressionDuration    int64
+}
+
+// NewPayloadCompressor creates a component able to compress the outgoing payloads with the configured algorithm. The
+// decompression works for all the known algorithms, regardless of the configured one
+func NewPayloadCompressor(args ArgsPayloadCompressor) (*payloadCompressor, error) {
+	if args.MinPayloadSize < 0 {
+		return nil, fmt.Errorf("%w for MinPayloadSize: %d", p2p.ErrInvalidValue, args.MinPayloadSize)
+	}
+	if args.MaxDecompressedSize < 1 {
+		return nil, fmt.Errorf("%w for MaxDecompressedSize: %d", p2p.ErrInvalidValue, args.MaxDecompressedSize)
+	}
+
+	pc := &payloadCompressor{
+		minPayloadSize:      args.MinPayloadSize,
+		maxDecompressedSize: args.MaxDecompressedSize,
+	}
+
+	switch args.Algorithm {
+	case NoneAlgorithm:
+		pc.algorithm = NoCompression
+	case SnappyAlgorithm:
+		pc.algorithm = SnappyCompression
+		pc.protocolID = SnappyProtocolID
+	default:
+		return nil, fmt.Errorf("%w: %s", p2p.ErrUnsupportedCompression, args.Algorithm)
+	}
+
+	return pc, nil
+}
+
+// Compress compresses the payload if it is large enough and if the compressed form is smaller. Returns the payload
+// to be sent along with the algorithm used, NoCompression meaning the payload was left as it was
+func (pc *payloadCompressor) Compress(payload []byte) ([]byte, uint32) {
+	if pc.algorithm == NoCompression || len(payload) < pc.minPayloadSize {
+		atomic.AddUint64(&pc.numNotCompressed, 1)
+		return payload, NoCompression
+	}
+
+	start := time.Now()
+	compressed := snappy.Encode(nil, payload)
+	atomic.AddInt64(&pc.compressionDuration, int64(time.Since(start)))
+
+	if len(compressed) >= len(payload) {
+		atomic.AddUint64(&pc.numNotCompressed, 1)
+		return payload, NoCompression
+	}
+
+	atomic.AddUint64(&pc.numCompressed, 1)
+	atomic.AddUint64(&pc.bytesBeforeCompression, uint64(len(payload)))
+	atomic.AddUint64(&pc.bytesAfterCompression, uint64(len(compressed)))
+
+	return compressed, pc.algorithm
+}
+
+// Decompress restores a payload compressed with the provided algorithm
+func (pc *payloadCompressor) Decompress(algorithm uint32, payload []byte) ([]byte, error) {
+	switch algorithm {
+	case NoCompression:
+		return payload, nil
+	case SnappyCompression:
+		return pc.decompressSnappy(payload)
+	default:
+		return nil, fmt.Errorf("%w: algorithm %d", p2p.ErrUnsupportedCompression, algorithm)
+	}
+}
+
+func (pc *payloadCompressor) decompressSnappy(payload []byte) ([]byte, error) {
+	decodedLen, err := snappy.DecodedLen(payload)
+	if err != nil {
+		return nil, err
+	}
+	//the check is done before allocating the decompression buffer so a small malicious payload can not make this
+	// node allocate large amounts of memory
+	if decodedLen > pc.maxDecompressedSize {
+		return nil, fmt.Errorf("%w: maximum %d, got %d", p2p.ErrDecompressedPayloadTooLarge,
+			pc.maxDecompressedSize, decodedLen)
+	}
+
+	start := time.Now()
+	decompressed, err := snappy.Decode(nil, payload)
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt64(&pc.decompressionDuration, int64(time.Since(start)))
+
+	atomic.AddUint64(&pc.numDecompressed, 1)
+	atomic.AddUint64(&pc.bytesBeforeDecompression, uint64(len(payload)))
+	atomic.AddUint64(&pc.bytesAfterDecompression, uint64(len(decompressed)))
+
+	return decompressed, nil
+}
+
+// ProtocolID returns the protocol ID that should be advertised so the other peers will know they can send compressed
+// direct messages to this peer. Returns an empty string if the outgoing payloads are not compressed
+func (pc *payloadCompressor) ProtocolID() protocol.ID {
+	return pc.protocolID
+}
+
+// ResetStatistics resets the compression counters returning their previous values
+func (pc *payloadCompressor) ResetStatistics() Statistics {
+	return Statistics{
+		NumCompressed:            atomic.SwapUint64(&pc.numCompressed, 0),
+		NumNotCompressed:         atomic.SwapUint64(&pc.numNotCompressed, 0),
+		NumDecompressed:          atomic.SwapUint64(&pc.numDecompressed, 0),
+		BytesBeforeCompression:   atomic.SwapUint64(&pc.bytesBeforeCompression, 0),
+		BytesAfterCompression:    atomic.SwapUint64(&pc.bytesAfterCompression, 0),
+		BytesBeforeDecompression: atomic.SwapUint64(&pc.bytesBeforeDecompression, 0),
+		BytesAfterDecompression:  atomic.SwapUint64(&pc.bytesAfterDecompression, 0),
+		CompressionDuration:      time.Duration(atomic.SwapInt64(&pc.compressionDuration, 0)),
+		DecompressionDuration:    time.Duration(atomic.SwapInt64(&pc.decompressionDuration, 0)),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *payloadCompressor) IsInterfaceNil() bool {
+	return pc == nil
+}
@@ -0,0 +1,143 @@
+package compression_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPayloadCompressor() compression.ArgsPayloadCompressor {
+	return compression.ArgsPayloadCompressor{
+		Algorithm:           compression.SnappyAlgorithm,
+		MinPayloadSize:      100,
+		MaxDecompressedSize: 1 << 20,
+	}
+}
+
+func TestNewPayloadCompressor_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPayloadCompressor()
+	args.MinPayloadSize = -1
+	pc, err := compression.NewPayloadCompressor(args)
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	args = createMockArgsPayloadCompressor()
+	args.MaxDecompressedSize = 0
+	pc, err = compression.NewPayloadCompressor(args)
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	args = createMockArgsPayloadCompressor()
+	args.Algorithm = "zstd"
+	pc, err = compression.NewPayloadCompressor(args)
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
+func TestNewPayloadCompressor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pc, err := compression.NewPayloadCompressor(createMockArgsPayloadCompressor())
+	assert.False(t, check.IfNil(pc))
+	assert.Nil(t, err)
+	assert.Equal(t, compression.SnappyProtocolID, pc.ProtocolID())
+
+	args := createMockArgsPayloadCompressor()
+	args.Algorithm = compression.NoneAlgorithm
+	pc, err = compression.NewPayloadCompressor(args)
+	assert.False(t, check.IfNil(pc))
+	assert.Nil(t, err)
+	assert.Equal(t, "", string(pc.ProtocolID()))
+}
+
+func TestPayloadCompressor_CompressShouldSkipSmallOrIncompressiblePayloads(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := compression.NewPayloadCompressor(createMockArgsPayloadCompressor())
+
+	small := bytes.Repeat([]byte("a"), 99)
+	payload, algorithm := pc.Compress(small)
+	assert.Equal(t, compression.NoCompression, algorithm)
+	assert.Equal(t, small, payload)
+
+	incompressible := make([]byte, 1000)
+	_, _ = rand.Read(incompressible)
+	payload, algorithm = pc.Compress(incompressible)
+	assert.Equal(t, compression.NoCompression, algorithm)
+	assert.Equal(t, incompressible, payload)
+
+	stats := pc.ResetStatistics()
+	assert.Equal(t, uint64(2), stats.NumNotCompressed)
+	assert.Equal(t, uint64(0), stats.NumCompressed)
+}
+
+func TestPayloadCompressor_NoneAlgorithmShouldNotCompress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPayloadCompressor()
+	args.Algorithm = compression.NoneAlgorithm
+	pc, _ := compression.NewPayloadCompressor(args)
+
+	large := bytes.Repeat([]byte("a"), 1000)
+	payload, algorithm := pc.Compress(large)
+	assert.Equal(t, compression.NoCompression, algorithm)
+	assert.Equal(t, large, payload)
+
+	decompressed, err := pc.Decompress(compression.SnappyCompression, snappy.Encode(nil, large))
+	assert.Nil(t, err)
+	assert.Equal(t, large, decompressed)
+}
+
+func TestPayloadCompressor_CompressDecompressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := compression.NewPayloadCompressor(createMockArgsPayloadCompressor())
+
+	large := bytes.Repeat([]byte("trie node "), 1000)
+	compressed, algorithm := pc.Compress(large)
+	require.Equal(t, compression.SnappyCompression, algorithm)
+	assert.True(t, len(compressed) < len(large))
+
+	decompressed, err := pc.Decompress(algorithm, compressed)
+	require.Nil(t, err)
+	assert.Equal(t, large, decompressed)
+
+	stats := pc.ResetStatistics()
+	assert.Equal(t, uint64(1), stats.NumCompressed)
+	assert.Equal(t, uint64(1), stats.NumDecompressed)
+	assert.Equal(t, uint64(len(large)), stats.BytesBeforeCompression)
+	assert.Equal(t, uint64(len(compressed)), stats.BytesAfterCompression)
+	assert.Equal(t, uint64(len(compressed)), stats.BytesBeforeDecompression)
+	assert.Equal(t, uint64(len(large)), stats.BytesAfterDecompression)
+	assert.Equal(t, compression.Statistics{}, pc.ResetStatistics())
+}
+
+func TestPayloadCompressor_DecompressErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPayloadCompressor()
+	args.MaxDecompressedSize = 1000
+	pc, _ := compression.NewPayloadCompressor(args)
+
+	decompressed, err := pc.Decompress(37, []byte("data"))
+	assert.Nil(t, decompressed)
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+
+	decompressed, err = pc.Decompress(compression.SnappyCompression, snappy.Encode(nil, make([]byte, 1001)))
+	assert.Nil(t, decompressed)
+	assert.True(t, errors.Is(err, p2p.ErrDecompressedPayloadTooLarge))
+
+	decompressed, err = pc.Decompress(compression.SnappyCompression, []byte{0xff})
+	assert.Nil(t, decompressed)
+	assert.NotNil(t, err)
+}
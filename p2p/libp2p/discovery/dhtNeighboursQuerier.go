@@ -0,0 +1,157 @@
+package discovery
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pb "github.com/libp2p/go-libp2p-kad-dht/pb"
+)
+
+// kadProtocolSuffix is appended by the kad-dht implementation to the configured protocol prefix
+const kadProtocolSuffix = "/kad/1.0.0"
+const randomTargetLength = 32
+
+// ArgDhtNeighboursQuerier represents the DTO used to create a DHT neighbours querier
+type ArgDhtNeighboursQuerier struct {
+	Host       host.Host
+	ProtocolID string
+	Timeout    time.Duration
+}
+
+// dhtNeighboursQuerier is able to ask a remote peer about the peers it holds in its kad-dht routing table. It speaks the
+// FIND_NODE request of the kad-dht protocol directly, so it does not alter the routing table of the local DHT
+type dhtNeighboursQuerier struct {
+	host     host.Host
+	protocol protocol.ID
+	timeout  time.Duration
+}
+
+// NewDhtNeighboursQuerier creates a new DHT neighbours querier
+func NewDhtNeighboursQuerier(arg ArgDhtNeighboursQuerier) (*dhtNeighboursQuerier, error) {
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if len(arg.ProtocolID) == 0 {
+		return nil, fmt.Errorf("%w for the protocol ID", p2p.ErrInvalidValue)
+	}
+	if arg.Timeout <= 0 {
+		return nil, fmt.Errorf("%w for the timeout", p2p.ErrInvalidValue)
+	}
+
+	return &dhtNeighboursQuerier{
+		host:     arg.Host,
+		protocol: protocol.ID(arg.ProtocolID + kadProtocolSuffix),
+		timeout:  arg.Timeout,
+	}, nil
+}
+
+// QueryNeighbours sends numQueries FIND_NODE requests, each one for a random target, to the provided peer and returns
+// all the distinct peers it responded with. Using random targets makes the responses cover different buckets of the
+// remote routing table
+func (dnq *dhtNeighboursQuerier) QueryNeighbours(pid peer.ID, numQueries int) ([]peer.AddrInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dnq.timeout)
+	defer cancel()
+
+	stream, err := dnq.host.NewStream(ctx, pid, dnq.protocol)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+	_ = stream.SetDeadline(time.Now().Add(dnq.timeout))
+
+	reader := bufio.NewReader(stream)
+	neighbours := make(map[peer.ID]peer.AddrInfo)
+	for i := 0; i < numQueries; i++ {
+		closerPeers, errQuery := dnq.findNode(stream, reader)
+		if errQuery != nil {
+			_ = stream.Reset()
+			return nil, errQuery
+		}
+
+		for _, info := range closerPeers {
+			if info.ID == pid || info.ID == dnq.host.ID() {
+				continue
+			}
+			neighbours[info.ID] = *info
+		}
+	}
+
+	result := make([]peer.AddrInfo, 0, len(neighbours))
+	for _, info := range neighbours {
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+func (dnq *dhtNeighboursQuerier) findNode(stream network.Stream, reader *bufio.Reader) ([]*peer.AddrInfo, error) {
+	target := make([]byte, randomTargetLength)
+	_, err := rand.Read(target)
+	if err != nil {
+		return nil, err
+	}
+
+	request := pb.NewMessage(pb.Message_FIND_NODE, target, 0)
+	err = writeDelimitedMessage(stream, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.Message{}
+	err = readDelimitedMessage(reader, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb.PBPeersToPeerInfos(response.GetCloserPeers()), nil
+}
+
+func writeDelimitedMessage(w io.Writer, message *pb.Message) error {
+	buff, err := message.Marshal()
+	if err != nil {
+		return err
+	}
+
+	prefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, uint64(len(buff)))
+
+	_, err = w.Write(append(prefix[:n], buff...))
+
+	return err
+}
+
+func readDelimitedMessage(r *bufio.Reader, message *pb.Message) error {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if length > network.MessageSizeMax {
+		return fmt.Errorf("%w, received message of %d bytes", p2p.ErrInvalidValue, length)
+	}
+
+	buff := make([]byte, length)
+	_, err = io.ReadFull(r, buff)
+	if err != nil {
+		return err
+	}
+
+	return message.Unmarshal(buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dnq *dhtNeighboursQuerier) IsInterfaceNil() bool {
+	return dnq == nil
+}
@@ -0,0 +1,144 @@
+package discovery_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/libp2p/go-libp2p-kad-dht/pb"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProtocolID = "/erd/kad/1.0.0"
+
+func createDhtNeighboursQuerierArgument() discovery.ArgDhtNeighboursQuerier {
+	netw := mocknet.New(context.Background())
+	h, _ := netw.GenPeer()
+
+	return discovery.ArgDhtNeighboursQuerier{
+		Host:       h,
+		ProtocolID: testProtocolID,
+		Timeout:    time.Second,
+	}
+}
+
+// handleFindNode emulates the kad-dht FIND_NODE handler by responding with the provided peers to every request
+func handleFindNode(t *testing.T, closerPeers []peer.AddrInfo) network.StreamHandler {
+	return func(stream network.Stream) {
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		reader := bufio.NewReader(stream)
+		for {
+			length, err := binary.ReadUvarint(reader)
+			if err != nil {
+				return
+			}
+			buff := make([]byte, length)
+			_, err = io.ReadFull(reader, buff)
+			require.Nil(t, err)
+
+			request := &pb.Message{}
+			require.Nil(t, request.Unmarshal(buff))
+			assert.Equal(t, pb.Message_FIND_NODE, request.GetType())
+
+			response := pb.NewMessage(pb.Message_FIND_NODE, request.GetKey(), 0)
+			response.CloserPeers = pb.RawPeerInfosToPBPeers(closerPeers)
+			responseBuff, _ := response.Marshal()
+			prefix := make([]byte, binary.MaxVarintLen64)
+			n := binary.PutUvarint(prefix, uint64(len(responseBuff)))
+			_, err = stream.Write(append(prefix[:n], responseBuff...))
+			require.Nil(t, err)
+		}
+	}
+}
+
+func TestNewDhtNeighboursQuerier_NilHostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDhtNeighboursQuerierArgument()
+	arg.Host = nil
+
+	dnq, err := discovery.NewDhtNeighboursQuerier(arg)
+
+	assert.True(t, check.IfNil(dnq))
+	assert.Equal(t, p2p.ErrNilHost, err)
+}
+
+func TestNewDhtNeighboursQuerier_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDhtNeighboursQuerierArgument()
+	arg.ProtocolID = ""
+	dnq, err := discovery.NewDhtNeighboursQuerier(arg)
+	assert.True(t, check.IfNil(dnq))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createDhtNeighboursQuerierArgument()
+	arg.Timeout = 0
+	dnq, err = discovery.NewDhtNeighboursQuerier(arg)
+	assert.True(t, check.IfNil(dnq))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestDhtNeighboursQuerier_QueryNeighboursUnsupportedProtocolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	netw := mocknet.New(context.Background())
+	h1, _ := netw.GenPeer()
+	h2, _ := netw.GenPeer()
+	_ = netw.LinkAll()
+
+	dnq, _ := discovery.NewDhtNeighboursQuerier(discovery.ArgDhtNeighboursQuerier{
+		Host:       h1,
+		ProtocolID: testProtocolID,
+		Timeout:    time.Second,
+	})
+
+	neighbours, err := dnq.QueryNeighbours(h2.ID(), 1)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, neighbours)
+}
+
+func TestDhtNeighboursQuerier_QueryNeighboursShouldReturnDistinctPeers(t *testing.T) {
+	t.Parallel()
+
+	netw := mocknet.New(context.Background())
+	h1, _ := netw.GenPeer()
+	h2, _ := netw.GenPeer()
+	h3, _ := netw.GenPeer()
+	_ = netw.LinkAll()
+
+	closerPeers := []peer.AddrInfo{
+		{ID: h1.ID(), Addrs: h1.Addrs()},
+		{ID: h2.ID(), Addrs: h2.Addrs()},
+		{ID: h3.ID(), Addrs: h3.Addrs()},
+	}
+	h2.SetStreamHandler(testProtocolID+"/kad/1.0.0", handleFindNode(t, closerPeers))
+
+	dnq, _ := discovery.NewDhtNeighboursQuerier(discovery.ArgDhtNeighboursQuerier{
+		Host:       h1,
+		ProtocolID: testProtocolID,
+		Timeout:    time.Second,
+	})
+
+	neighbours, err := dnq.QueryNeighbours(h2.ID(), 3)
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(neighbours))
+	assert.Equal(t, h3.ID(), neighbours[0].ID)
+	assert.Equal(t, h3.Addrs(), neighbours[0].Addrs)
+}
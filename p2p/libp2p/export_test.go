@@ -3,7 +3,9 @@ package libp2p
 import (
 	"context"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -18,6 +20,7 @@ var PubsubTimeCacheDuration = pubsubTimeCacheDuration
 var AcceptMessagesInAdvanceDuration = acceptMessagesInAdvanceDuration
 
 const CurrentTopicMessageVersion = currentTopicMessageVersion
+const CompressedTopicMessageVersion = compressedTopicMessageVersion
 
 func (netMes *networkMessenger) SetHost(newHost ConnectableHost) {
 	netMes.p2pHost = newHost
@@ -31,7 +34,7 @@ func (netMes *networkMessenger) SetPeerDiscoverer(discoverer p2p.PeerDiscoverer)
 	netMes.peerDiscoverer = discoverer
 }
 
-func (netMes *networkMessenger) PubsubCallback(handler p2p.MessageProcessor, topic string) func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
+func (netMes *networkMessenger) PubsubCallback(handler p2p.MessageProcessor, topic string) func(ctx context.Context, pid peer.ID, message *pubsub.Message) pubsub.ValidationResult {
 	return netMes.pubsubCallback(handler, topic)
 }
 
@@ -62,3 +65,11 @@ func (ip *identityProvider) HandleStreams(s network.Stream) {
 func (ip *identityProvider) ProcessReceivedData(recvBuff []byte) error {
 	return ip.processReceivedData(recvBuff)
 }
+
+func (netMes *networkMessenger) AppSpecificScore(pid core.PeerID) float64 {
+	return netMes.appSpecificScore(peer.ID(pid))
+}
+
+func (netMes *networkMessenger) ResetCompressionStatistics() compression.Statistics {
+	return netMes.compressor.ResetStatistics()
+}
@@ -2,8 +2,11 @@ package libp2p
 
 import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/addressBook"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
 )
 
 // ConnectionMonitor defines the behavior of a connection monitor
@@ -27,3 +30,31 @@ type PeerDiscovererWithSharder interface {
 	p2p.PeerDiscoverer
 	SetSharder(sharder Sharder) error
 }
+
+// AddressBook defines the behavior of a persistent store of the known peers
+type AddressBook interface {
+	Upsert(record addressBook.PeerRecord)
+	BestPeers(maxNum int) []addressBook.PeerRecord
+	Save() error
+	IsInterfaceNil() bool
+}
+
+// DhtNeighboursQuerier defines the behavior of a component able to ask a remote peer about its kad-dht routing table
+type DhtNeighboursQuerier interface {
+	QueryNeighbours(pid peer.ID, numQueries int) ([]peer.AddrInfo, error)
+	IsInterfaceNil() bool
+}
+
+// PayloadDecompressor defines the behavior of a component able to restore the compressed topic message payloads
+type PayloadDecompressor interface {
+	Decompress(algorithm uint32, payload []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// PayloadCompressor defines the behavior of a component able to compress the outgoing topic message payloads
+type PayloadCompressor interface {
+	PayloadDecompressor
+	Compress(payload []byte) ([]byte, uint32)
+	ProtocolID() protocol.ID
+	ResetStatistics() compression.Statistics
+}
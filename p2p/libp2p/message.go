@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-pubsub"
@@ -14,11 +15,22 @@ import (
 
 const currentTopicMessageVersion = uint32(1)
 
+// compressedTopicMessageVersion is used for the messages with a compressed payload so the peers not knowing about
+// compression will reject them instead of processing the compressed bytes
+const compressedTopicMessageVersion = uint32(2)
+
 // NewMessage returns a new instance of a Message object
-func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Message, error) {
+func NewMessage(
+	msg *pubsub.Message,
+	marshalizer p2p.Marshalizer,
+	decompressor PayloadDecompressor,
+) (*message.Message, error) {
 	if check.IfNil(marshalizer) {
 		return nil, p2p.ErrNilMarshalizer
 	}
+	if check.IfNil(decompressor) {
+		return nil, p2p.ErrNilPayloadDecompressor
+	}
 	if msg == nil {
 		return nil, p2p.ErrNilMessage
 	}
@@ -41,18 +53,17 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 		return nil, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
 	}
 
-	//TODO change this area when new versions of the message will need to be implemented
-	if topicMessage.Version != currentTopicMessageVersion {
-		return nil, fmt.Errorf("%w, supported %d, got %d",
-			p2p.ErrUnsupportedMessageVersion, currentTopicMessageVersion, topicMessage.Version)
-	}
-
 	if len(topicMessage.SignatureOnPid)+len(topicMessage.Pk) > 0 {
 		return nil, fmt.Errorf("%w for topicMessage.SignatureOnPid and topicMessage.Pk",
 			p2p.ErrUnsupportedFields)
 	}
 
-	newMsg.DataField = topicMessage.Payload
+	payload, err := extractPayload(topicMessage, decompressor)
+	if err != nil {
+		return nil, err
+	}
+
+	newMsg.DataField = payload
 	newMsg.TimestampField = topicMessage.Timestamp
 
 	id, err := peer.IDFromBytes(newMsg.From())
@@ -63,3 +74,25 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 	newMsg.PeerField = core.PeerID(id)
 	return newMsg, nil
 }
+
+func extractPayload(topicMessage *data.TopicMessage, decompressor PayloadDecompressor) ([]byte, error) {
+	switch topicMessage.Version {
+	case currentTopicMessageVersion:
+		if topicMessage.Compression != compression.NoCompression {
+			return nil, fmt.Errorf("%w for topicMessage.Compression in version %d",
+				p2p.ErrUnsupportedFields, topicMessage.Version)
+		}
+
+		return topicMessage.Payload, nil
+	case compressedTopicMessageVersion:
+		if topicMessage.Compression == compression.NoCompression {
+			return nil, fmt.Errorf("%w, version %d requires a compressed payload",
+				p2p.ErrUnsupportedCompression, topicMessage.Version)
+		}
+
+		return decompressor.Decompress(topicMessage.Compression, topicMessage.Payload)
+	default:
+		return nil, fmt.Errorf("%w, supported %d and %d, got %d", p2p.ErrUnsupportedMessageVersion,
+			currentTopicMessageVersion, compressedTopicMessageVersion, topicMessage.Version)
+	}
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/snappy"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -30,11 +32,33 @@ func getRandomID() []byte {
 	return []byte(id)
 }
 
+func createPayloadCompressor() libp2p.PayloadCompressor {
+	pc, _ := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+		Algorithm:           compression.SnappyAlgorithm,
+		MinPayloadSize:      0,
+		MaxDecompressedSize: libp2p.MaxSendBuffSize,
+	})
+
+	return pc
+}
+
+func createPubsubMessage(marshalizer *testscommon.ProtoMarshalizerMock, topicMessage *data.TopicMessage) *pubsub.Message {
+	buff, _ := marshalizer.Marshal(topicMessage)
+	topic := "topic"
+	mes := &pubsubpb.Message{
+		From:  getRandomID(),
+		Data:  buff,
+		Topic: &topic,
+	}
+
+	return &pubsub.Message{Message: mes}
+}
+
 func TestMessage_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	pMes := &pubsub.Message{}
-	m, err := libp2p.NewMessage(pMes, nil)
+	m, err := libp2p.NewMessage(pMes, nil, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrNilMarshalizer))
@@ -59,7 +83,7 @@ func TestMessage_ShouldErrBecauseOfFromField(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.NotNil(t, err)
@@ -83,7 +107,7 @@ func TestMessage_ShouldWork(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	require.Nil(t, err)
 	assert.False(t, check.IfNil(m))
@@ -107,7 +131,7 @@ func TestMessage_From(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	require.Nil(t, err)
 	assert.Equal(t, m.From(), from)
@@ -132,7 +156,7 @@ func TestMessage_Peer(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	require.Nil(t, err)
 	assert.Equal(t, core.PeerID(id), m.Peer())
@@ -144,7 +168,7 @@ func TestMessage_WrongVersionShouldErr(t *testing.T) {
 	marshalizer := &testscommon.ProtoMarshalizerMock{}
 
 	topicMessage := &data.TopicMessage{
-		Version:   libp2p.CurrentTopicMessageVersion + 1,
+		Version:   libp2p.CompressedTopicMessageVersion + 1,
 		Timestamp: time.Now().Unix(),
 		Payload:   []byte("data"),
 	}
@@ -157,12 +181,86 @@ func TestMessage_WrongVersionShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedMessageVersion))
 }
 
+func TestMessage_NilPayloadDecompressorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pMes := &pubsub.Message{}
+	m, err := libp2p.NewMessage(pMes, &testscommon.ProtoMarshalizerMock{}, nil)
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrNilPayloadDecompressor))
+}
+
+func TestMessage_CompressionOnUncompressedVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     snappy.Encode(nil, []byte("data")),
+		Compression: compression.SnappyCompression,
+	})
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
+}
+
+func TestMessage_CompressedVersionWithoutCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+		Version:   libp2p.CompressedTopicMessageVersion,
+		Timestamp: time.Now().Unix(),
+		Payload:   []byte("data"),
+	})
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
+func TestMessage_UnknownCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+		Version:     libp2p.CompressedTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     []byte("data"),
+		Compression: 37,
+	})
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
+func TestMessage_CompressedPayloadShouldBeDecompressed(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	payload := []byte("data data data data data data data data")
+	pMes := createPubsubMessage(marshalizer, &data.TopicMessage{
+		Version:     libp2p.CompressedTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     snappy.Encode(nil, payload),
+		Compression: compression.SnappyCompression,
+	})
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
+
+	require.Nil(t, err)
+	assert.Equal(t, payload, m.Data())
+}
+
 func TestMessage_PopulatedPkFieldShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -183,7 +281,7 @@ func TestMessage_PopulatedPkFieldShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
@@ -209,7 +307,7 @@ func TestMessage_PopulatedSigFieldShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
@@ -233,7 +331,7 @@ func TestMessage_NilTopic(t *testing.T) {
 		Topic: nil,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.Equal(t, p2p.ErrNilTopic, err)
 	assert.True(t, check.IfNil(m))
@@ -244,7 +342,7 @@ func TestMessage_NilMessage(t *testing.T) {
 
 	marshalizer := &testscommon.ProtoMarshalizerMock{}
 
-	m, err := libp2p.NewMessage(nil, marshalizer)
+	m, err := libp2p.NewMessage(nil, marshalizer, createPayloadCompressor())
 
 	assert.Equal(t, p2p.ErrNilMessage, err)
 	assert.True(t, check.IfNil(m))
@@ -16,10 +16,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	p2pDebug "github.com/ElrondNetwork/elrond-go/debug/p2p"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/addressBook"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	connMonitorFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionMonitor/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/disabled"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
 	discoveryFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/networksharding/factory"
@@ -32,10 +36,12 @@ import (
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
 	secio "github.com/libp2p/go-libp2p-secio"
+	"github.com/multiformats/go-multiaddr"
 )
 
 // ListenAddrWithIp4AndTcp defines the listening address with ip v.4 and TCP
@@ -56,6 +62,9 @@ const acceptMessagesInAdvanceDuration = 5 * time.Second //we are accepting the m
 const broadcastGoRoutines = 1000
 const timeBetweenPeerPrints = time.Second * 20
 const timeBetweenExternalLoggersCheck = time.Second * 20
+const timeBetweenPreferredPeersReconnects = time.Second * 30
+const timeoutConnectToKnownPeer = time.Second * 10
+const timeoutDhtNeighboursQuery = time.Second * 10
 const minRangePortValue = 1025
 const noSignPolicy = pubsub.MessageSignaturePolicy(0) //should be used only in tests
 
@@ -100,6 +109,16 @@ type networkMessenger struct {
 	debugger            p2p.Debugger
 	marshalizer         p2p.Marshalizer
 	syncTimer           p2p.SyncTimer
+	preferredPeers      map[peer.ID]string
+	blockedPeers        map[peer.ID]struct{}
+	addressBook         AddressBook
+	peerStoreConfig     config.PeerStoreConfig
+	mutHonestyHandler   sync.RWMutex
+	honestyHandler      p2p.PeerHonestyScoreHandler
+	dhtQuerier          DhtNeighboursQuerier
+	peerScoringConfig   config.PeerScoringConfig
+	compressor          PayloadCompressor
+	compressBroadcasts  bool
 }
 
 // ArgsNetworkMessenger defines the options used to create a p2p wrapper
@@ -202,9 +221,18 @@ func createMessenger(
 		peerShardResolver: &unknownPeerShardResolver{},
 		marshalizer:       args.Marshalizer,
 		syncTimer:         args.SyncTimer,
+		preferredPeers:    make(map[peer.ID]string),
+		blockedPeers:      make(map[peer.ID]struct{}),
+		peerStoreConfig:   args.P2pConfig.PeerStore,
+		peerScoringConfig: args.P2pConfig.PeerScoring,
 	}
 	netMes.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pHost.ID()))
 
+	err = netMes.createCompressor(args.P2pConfig.Compression)
+	if err != nil {
+		return nil, err
+	}
+
 	err = netMes.createPubSub(withMessageSigning)
 	if err != nil {
 		return nil, err
@@ -220,6 +248,11 @@ func createMessenger(
 		return nil, err
 	}
 
+	err = netMes.createAddressBook(args.P2pConfig.PeerStore)
+	if err != nil {
+		return nil, err
+	}
+
 	err = netMes.createConnectionMonitor(args.P2pConfig)
 	if err != nil {
 		return nil, err
@@ -249,6 +282,15 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 		optsPS = append(optsPS, pubsub.WithMessageSignaturePolicy(noSignPolicy))
 	}
 
+	if netMes.peerScoringConfig.Enabled {
+		params, thresholds, err := createPeerScoreParams(netMes.peerScoringConfig, netMes.appSpecificScore)
+		if err != nil {
+			return err
+		}
+
+		optsPS = append(optsPS, pubsub.WithPeerScore(params, thresholds))
+	}
+
 	pubsub.TimeCacheDuration = pubsubTimeCacheDuration
 
 	var err error
@@ -290,7 +332,7 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 				continue
 			}
 
-			buffToSend := netMes.createMessageBytes(sendableData.Buff)
+			buffToSend := netMes.createMessageBytes(sendableData.Buff, netMes.compressBroadcasts)
 			if len(buffToSend) == 0 {
 				continue
 			}
@@ -305,13 +347,56 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 	return nil
 }
 
-func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
+func (netMes *networkMessenger) createCompressor(compressionConfig config.CompressionConfig) error {
+	algorithm := compression.NoneAlgorithm
+	if compressionConfig.Enabled {
+		algorithm = compressionConfig.Algorithm
+	}
+
+	var err error
+	netMes.compressor, err = compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+		Algorithm:           algorithm,
+		MinPayloadSize:      int(compressionConfig.MinPayloadSizeInBytes),
+		MaxDecompressedSize: maxSendBuffSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	netMes.compressBroadcasts = compressionConfig.Enabled && compressionConfig.CompressBroadcastedData
+
+	protocolID := netMes.compressor.ProtocolID()
+	if len(protocolID) == 0 {
+		return nil
+	}
+
+	//the handler is never used, it is set only for the identify protocol to advertise the supported compression
+	// to the connected peers
+	netMes.p2pHost.SetStreamHandler(protocolID, func(stream network.Stream) {
+		_ = stream.Reset()
+	})
+
+	return nil
+}
+
+// createMessageBytes wraps the provided buffer in a topic message. The payload is compressed only if the caller knows
+// the receivers are able to decompress it
+func (netMes *networkMessenger) createMessageBytes(buff []byte, withCompression bool) []byte {
 	message := &data.TopicMessage{
 		Version:   currentTopicMessageVersion,
 		Payload:   buff,
 		Timestamp: netMes.syncTimer.CurrentTime().Unix(),
 	}
 
+	if withCompression {
+		payload, algorithm := netMes.compressor.Compress(buff)
+		if algorithm != compression.NoCompression {
+			message.Version = compressedTopicMessageVersion
+			message.Payload = payload
+			message.Compression = algorithm
+		}
+	}
+
 	buffToSend, errMarshal := netMes.marshalizer.Marshal(message)
 	if errMarshal != nil {
 		log.Warn("error sending data", "error", errMarshal)
@@ -322,6 +407,28 @@ func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
 }
 
 func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error {
+	preferredPeers := make([]peer.ID, 0, len(p2pConfig.Sharding.PreferredPeers))
+	for _, address := range p2pConfig.Sharding.PreferredPeers {
+		pid, err := preferredPeerID(address)
+		if err != nil {
+			return err
+		}
+
+		preferredPeers = append(preferredPeers, pid)
+		netMes.preferredPeers[pid] = address
+	}
+
+	blockedPeers := make([]peer.ID, 0, len(p2pConfig.Sharding.BlockedPeers))
+	for _, pidString := range p2pConfig.Sharding.BlockedPeers {
+		pid, errDecode := peer.Decode(pidString)
+		if errDecode != nil {
+			return fmt.Errorf("%w for blocked peer %s: %s", p2p.ErrInvalidValue, pidString, errDecode.Error())
+		}
+
+		blockedPeers = append(blockedPeers, pid)
+		netMes.blockedPeers[pid] = struct{}{}
+	}
+
 	args := factory.ArgsSharderFactory{
 		PeerShardResolver:       &unknownPeerShardResolver{},
 		Pid:                     netMes.p2pHost.ID(),
@@ -331,6 +438,8 @@ func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error
 		MaxIntraShardObservers:  int(p2pConfig.Sharding.MaxIntraShardObservers),
 		MaxCrossShardObservers:  int(p2pConfig.Sharding.MaxCrossShardObservers),
 		Type:                    p2pConfig.Sharding.Type,
+		PreferredPeers:          preferredPeers,
+		BlockedPeers:            blockedPeers,
 	}
 
 	var err error
@@ -339,6 +448,35 @@ func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error
 	return err
 }
 
+func preferredPeerID(address string) (peer.ID, error) {
+	multiAddr, err := multiaddr.NewMultiaddr(address)
+	if err != nil {
+		return "", fmt.Errorf("%w for preferred peer %s: %s", p2p.ErrInvalidValue, address, err.Error())
+	}
+
+	pInfo, err := peer.AddrInfoFromP2pAddr(multiAddr)
+	if err != nil {
+		return "", fmt.Errorf("%w for preferred peer %s: %s", p2p.ErrInvalidValue, address, err.Error())
+	}
+
+	return pInfo.ID, nil
+}
+
+func (netMes *networkMessenger) createAddressBook(peerStoreConfig config.PeerStoreConfig) error {
+	if !peerStoreConfig.Enabled {
+		return nil
+	}
+
+	var err error
+	netMes.addressBook, err = addressBook.NewAddressBook(addressBook.ArgsAddressBook{
+		FilePath:    peerStoreConfig.FilePath,
+		MaxPeers:    peerStoreConfig.MaxPeers,
+		Marshalizer: &marshal.JsonMarshalizer{},
+	})
+
+	return err
+}
+
 func (netMes *networkMessenger) createDiscoverer(p2pConfig config.P2PConfig) error {
 	var err error
 	netMes.peerDiscoverer, err = discoveryFactory.NewPeerDiscoverer(
@@ -347,6 +485,19 @@ func (netMes *networkMessenger) createDiscoverer(p2pConfig config.P2PConfig) err
 		netMes.sharder,
 		p2pConfig,
 	)
+	if err != nil {
+		return err
+	}
+
+	if !p2pConfig.KadDhtPeerDiscovery.Enabled {
+		return nil
+	}
+
+	netMes.dhtQuerier, err = discovery.NewDhtNeighboursQuerier(discovery.ArgDhtNeighboursQuerier{
+		Host:       netMes.p2pHost,
+		ProtocolID: p2pConfig.KadDhtPeerDiscovery.ProtocolID,
+		Timeout:    timeoutDhtNeighboursQuery,
+	})
 
 	return err
 }
@@ -436,9 +587,30 @@ func (netMes *networkMessenger) printLogsStats() {
 			"connections/s", connsPerSec,
 			"disconnections/s", disconnsPerSec,
 		)
+
+		netMes.printCompressionStatistics()
 	}
 }
 
+func (netMes *networkMessenger) printCompressionStatistics() {
+	stats := netMes.compressor.ResetStatistics()
+	if stats.NumCompressed+stats.NumDecompressed == 0 {
+		return
+	}
+
+	log.Debug("network compression metrics",
+		"compressed", stats.NumCompressed,
+		"not compressed", stats.NumNotCompressed,
+		"size before compression", core.ConvertBytes(stats.BytesBeforeCompression),
+		"size after compression", core.ConvertBytes(stats.BytesAfterCompression),
+		"compression time", stats.CompressionDuration,
+		"decompressed", stats.NumDecompressed,
+		"size before decompression", core.ConvertBytes(stats.BytesBeforeDecompression),
+		"size after decompression", core.ConvertBytes(stats.BytesAfterDecompression),
+		"decompression time", stats.DecompressionDuration,
+	)
+}
+
 func (netMes *networkMessenger) mapHistogram(input map[uint32]int) string {
 	keys := make([]uint32, 0, len(input))
 	for shard := range input {
@@ -488,9 +660,22 @@ func (netMes *networkMessenger) ApplyOptions(opts ...Option) error {
 
 // Close closes the host, connections and streams
 func (netMes *networkMessenger) Close() error {
+	var err error
+	if !check.IfNil(netMes.addressBook) {
+		log.Debug("saving network messenger's address book...")
+
+		netMes.updateAddressBook()
+		errSave := netMes.addressBook.Save()
+		if errSave != nil {
+			err = errSave
+			log.Warn("networkMessenger.Close",
+				"component", "addressBook",
+				"error", err)
+		}
+	}
+
 	log.Debug("closing network messenger's host...")
 
-	var err error
 	errHost := netMes.p2pHost.Close()
 	if errHost != nil {
 		err = errHost
@@ -561,9 +746,142 @@ func (netMes *networkMessenger) ConnectToPeer(address string) error {
 	return netMes.p2pHost.ConnectToPeer(netMes.ctx, address)
 }
 
-// Bootstrap will start the peer discovery mechanism
+// Bootstrap will start the peer discovery mechanism. Besides the discovery, the node will also connect to the
+// preferred peers and to the best peers known from a previous run, if the address book is enabled
 func (netMes *networkMessenger) Bootstrap() error {
-	return netMes.peerDiscoverer.Bootstrap()
+	err := netMes.peerDiscoverer.Bootstrap()
+	if err != nil {
+		return err
+	}
+
+	go netMes.maintainKnownPeers()
+
+	return nil
+}
+
+func (netMes *networkMessenger) maintainKnownPeers() {
+	netMes.connectToPreferredPeers()
+	netMes.connectToAddressBookPeers()
+
+	reconnectTicker := time.NewTicker(timeBetweenPreferredPeersReconnects)
+	defer reconnectTicker.Stop()
+
+	var chSave <-chan time.Time
+	if !check.IfNil(netMes.addressBook) && netMes.peerStoreConfig.SaveIntervalInSec > 0 {
+		saveTicker := time.NewTicker(time.Duration(netMes.peerStoreConfig.SaveIntervalInSec) * time.Second)
+		defer saveTicker.Stop()
+		chSave = saveTicker.C
+	}
+
+	for {
+		select {
+		case <-reconnectTicker.C:
+			netMes.connectToPreferredPeers()
+		case <-chSave:
+			netMes.updateAddressBook()
+			err := netMes.addressBook.Save()
+			if err != nil {
+				log.Warn("networkMessenger: error saving the address book", "error", err)
+			}
+		case <-netMes.ctx.Done():
+			return
+		}
+	}
+}
+
+func (netMes *networkMessenger) connectToPreferredPeers() {
+	for pid, address := range netMes.preferredPeers {
+		if netMes.IsConnected(core.PeerID(pid)) {
+			continue
+		}
+
+		err := netMes.connectWithTimeout(address)
+		if err != nil {
+			log.Debug("networkMessenger: error connecting to preferred peer", "address", address, "error", err)
+		}
+	}
+}
+
+func (netMes *networkMessenger) connectToAddressBookPeers() {
+	if check.IfNil(netMes.addressBook) {
+		return
+	}
+
+	records := netMes.addressBook.BestPeers(netMes.peerStoreConfig.MaxPeersToConnectAtStartup)
+	for _, record := range records {
+		pid, err := peer.Decode(record.Pid)
+		if err != nil || pid == netMes.p2pHost.ID() || netMes.isBlocked(pid) || netMes.IsConnected(core.PeerID(pid)) {
+			continue
+		}
+
+		for _, address := range record.Addresses {
+			err = netMes.connectWithTimeout(address)
+			if err == nil {
+				break
+			}
+
+			log.Trace("networkMessenger: error connecting to known peer", "address", address, "error", err)
+		}
+	}
+}
+
+func (netMes *networkMessenger) connectWithTimeout(address string) error {
+	ctx, cancel := context.WithTimeout(netMes.ctx, timeoutConnectToKnownPeer)
+	defer cancel()
+
+	return netMes.p2pHost.ConnectToPeer(ctx, address)
+}
+
+func (netMes *networkMessenger) isBlocked(pid peer.ID) bool {
+	_, found := netMes.blockedPeers[pid]
+	return found
+}
+
+// updateAddressBook refreshes the records of all connected peers: their addresses, the time they were last seen,
+// the measured latency and their honesty score
+func (netMes *networkMessenger) updateAddressBook() {
+	if check.IfNil(netMes.addressBook) {
+		return
+	}
+
+	peerstore := netMes.p2pHost.Peerstore()
+	for _, pid := range netMes.p2pHost.Network().Peers() {
+		if netMes.isBlocked(pid) {
+			continue
+		}
+
+		addresses := make([]string, 0)
+		for _, address := range peerstore.Addrs(pid) {
+			addresses = append(addresses, address.String()+"/p2p/"+pid.Pretty())
+		}
+		if len(addresses) == 0 {
+			continue
+		}
+
+		netMes.addressBook.Upsert(addressBook.PeerRecord{
+			Pid:          pid.Pretty(),
+			Addresses:    addresses,
+			LastSeen:     time.Now().Unix(),
+			LatencyMs:    int64(peerstore.LatencyEWMA(pid) / time.Millisecond),
+			HonestyScore: netMes.honestyScore(pid),
+		})
+	}
+}
+
+func (netMes *networkMessenger) honestyScore(pid peer.ID) float64 {
+	netMes.mutHonestyHandler.RLock()
+	defer netMes.mutHonestyHandler.RUnlock()
+
+	if check.IfNil(netMes.honestyHandler) {
+		return 0
+	}
+
+	pk := netMes.peerShardResolver.GetPeerInfo(core.PeerID(pid)).PkBytes
+	if len(pk) == 0 {
+		return 0
+	}
+
+	return netMes.honestyHandler.GetScore(string(pk))
 }
 
 // IsConnected returns true if current node is connected to provided peer
@@ -633,6 +951,32 @@ func (netMes *networkMessenger) PeerAddresses(pid core.PeerID) []string {
 	return result
 }
 
+// QueryDhtNeighbours asks the provided peer about the peers it holds in its kad-dht routing table. The returned peers
+// are also added in the local peerstore, so they can be contacted afterwards
+func (netMes *networkMessenger) QueryDhtNeighbours(pid core.PeerID, numQueries int) (map[core.PeerID][]string, error) {
+	if check.IfNil(netMes.dhtQuerier) {
+		return nil, p2p.ErrKadDhtNotEnabled
+	}
+
+	infos, err := netMes.dhtQuerier.QueryNeighbours(peer.ID(pid), numQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbours := make(map[core.PeerID][]string, len(infos))
+	for _, info := range infos {
+		netMes.p2pHost.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.TempAddrTTL)
+
+		addresses := make([]string, 0, len(info.Addrs))
+		for _, addr := range info.Addrs {
+			addresses = append(addresses, addr.String())
+		}
+		neighbours[core.PeerID(info.ID)] = addresses
+	}
+
+	return neighbours, nil
+}
+
 // ConnectedPeersOnTopic returns the connected peers on a provided topic
 func (netMes *networkMessenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
 	return netMes.poc.ConnectedPeersOnChannel(topic)
@@ -652,6 +996,12 @@ func (netMes *networkMessenger) CreateTopic(name string, createChannelForTopic b
 		return fmt.Errorf("%w for topic %s", err, name)
 	}
 
+	err = netMes.applyTopicScoreParams(topic)
+	if err != nil {
+		_ = topic.Close()
+		return fmt.Errorf("%w while setting the peer scoring parameters for topic %s", err, name)
+	}
+
 	netMes.topics[name] = topic
 	subscrRequest, err := topic.Subscribe()
 	if err != nil {
@@ -774,13 +1124,16 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 	return nil
 }
 
-func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, topic string) func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
-	return func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
+// pubsubCallback returns the topic validator. Only the messages that can not be decoded or that got the sending peer
+// denied while being processed are rejected, which penalizes the peer score. The messages rejected for harmless reasons,
+// like an old round, a duplicate or an antiflood throttle, are ignored so an honest peer does not get graylisted
+func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, topic string) func(ctx context.Context, pid peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+	return func(ctx context.Context, pid peer.ID, message *pubsub.Message) pubsub.ValidationResult {
 		fromConnectedPeer := core.PeerID(pid)
 		msg, err := netMes.transformAndCheckMessage(message, fromConnectedPeer, topic)
 		if err != nil {
 			log.Trace("p2p validator - new message", "error", err.Error(), "topic", message.Topic)
-			return false
+			return netMes.validationResultOnError(fromConnectedPeer)
 		}
 
 		err = handler.ProcessReceivedMessage(msg, fromConnectedPeer)
@@ -793,16 +1146,26 @@ func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, top
 				"seq no", p2p.MessageOriginatorSeq(msg),
 			)
 			netMes.processDebugMessage(topic, fromConnectedPeer, uint64(len(message.Data)), true)
-			return false
+			return netMes.validationResultOnError(fromConnectedPeer)
 		}
 
 		netMes.processDebugMessage(topic, fromConnectedPeer, uint64(len(message.Data)), false)
-		return true
+		return pubsub.ValidationAccept
+	}
+}
+
+// validationResultOnError rejects the message only if the connected peer was denied, as the components processing the
+// messages deny the peers sending malicious messages
+func (netMes *networkMessenger) validationResultOnError(fromConnectedPeer core.PeerID) pubsub.ValidationResult {
+	if netMes.connMonitorWrapper.PeerDenialEvaluator().IsDenied(fromConnectedPeer) {
+		return pubsub.ValidationReject
 	}
+
+	return pubsub.ValidationIgnore
 }
 
 func (netMes *networkMessenger) transformAndCheckMessage(pbMsg *pubsub.Message, pid core.PeerID, topic string) (p2p.MessageP2P, error) {
-	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer)
+	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer, netMes.compressor)
 	if errUnmarshal != nil {
 		//this error is so severe that will need to blacklist both the originator and the connected peer as there is
 		// no way this node can communicate with them
@@ -954,21 +1317,37 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 		return err
 	}
 
-	buffToSend := netMes.createMessageBytes(buff)
-	if len(buffToSend) == 0 {
-		return nil
-	}
-
 	if peerID == netMes.ID() {
+		buffToSend := netMes.createMessageBytes(buff, false)
+		if len(buffToSend) == 0 {
+			return nil
+		}
+
 		return netMes.sendDirectToSelf(topic, buffToSend)
 	}
 
+	buffToSend := netMes.createMessageBytes(buff, netMes.peerSupportsCompression(peerID))
+	if len(buffToSend) == 0 {
+		return nil
+	}
+
 	err = netMes.ds.Send(topic, buffToSend, peerID)
 	netMes.debugger.AddOutgoingMessage(topic, uint64(len(buffToSend)), err != nil)
 
 	return err
 }
 
+func (netMes *networkMessenger) peerSupportsCompression(pid core.PeerID) bool {
+	protocolID := netMes.compressor.ProtocolID()
+	if len(protocolID) == 0 {
+		return false
+	}
+
+	supported, err := netMes.p2pHost.Peerstore().SupportsProtocols(peer.ID(pid), string(protocolID))
+
+	return err == nil && len(supported) > 0
+}
+
 func (netMes *networkMessenger) sendDirectToSelf(topic string, buff []byte) error {
 	msg := &pubsub.Message{
 		Message: &pubsub_pb.Message{
@@ -1069,6 +1448,19 @@ func (netMes *networkMessenger) SetPeerDenialEvaluator(handler p2p.PeerDenialEva
 	return netMes.connMonitorWrapper.SetPeerDenialEvaluator(handler)
 }
 
+// SetPeerHonestyScoreHandler sets the component used to fetch the honesty score of the peers saved in the address book
+func (netMes *networkMessenger) SetPeerHonestyScoreHandler(handler p2p.PeerHonestyScoreHandler) error {
+	if check.IfNil(handler) {
+		return p2p.ErrNilPeerHonestyScoreHandler
+	}
+
+	netMes.mutHonestyHandler.Lock()
+	netMes.honestyHandler = handler
+	netMes.mutHonestyHandler.Unlock()
+
+	return nil
+}
+
 // GetConnectedPeersInfo gets the current connected peers information
 func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	peers := netMes.p2pHost.Network().Peers()
@@ -5,6 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -14,9 +17,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/addressBook"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -1098,6 +1104,84 @@ func TestNetworkMessenger_BootstrapPeerDiscoveryShouldCallPeerBootstrapper(t *te
 	_ = mes.Close()
 }
 
+func TestNewNetworkMessenger_InvalidPreferredPeerShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.Sharding.PreferredPeers = []string{"/ip4/127.0.0.1/tcp/10000"}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewNetworkMessenger_InvalidBlockedPeerShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.Sharding.BlockedPeers = []string{"not a peer ID"}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNetworkMessenger_SetPeerHonestyScoreHandlerNilHandlerShouldErr(t *testing.T) {
+	mes := createMockMessenger()
+	defer func() {
+		_ = mes.Close()
+	}()
+
+	err := mes.SetPeerHonestyScoreHandler(nil)
+
+	assert.Equal(t, p2p.ErrNilPeerHonestyScoreHandler, err)
+}
+
+func TestNetworkMessenger_AddressBookShouldBeSavedOnCloseAndUsedOnBootstrap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerstore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	netw := mocknet.New(context.Background())
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.PeerStore = config.PeerStoreConfig{
+		Enabled:                    true,
+		FilePath:                   filepath.Join(dir, "peerstore.json"),
+		MaxPeers:                   10,
+		MaxPeersToConnectAtStartup: 10,
+	}
+	mes1, _ := libp2p.NewMockMessenger(arg, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+	defer func() {
+		_ = mes2.Close()
+	}()
+
+	err = mes1.ConnectToPeer(getConnectableAddress(mes2))
+	require.Nil(t, err)
+	_ = mes1.Close()
+
+	book, _ := addressBook.NewAddressBook(addressBook.ArgsAddressBook{
+		FilePath:    arg.P2pConfig.PeerStore.FilePath,
+		MaxPeers:    10,
+		Marshalizer: &marshal.JsonMarshalizer{},
+	})
+	record, found := book.Get(mes2.ID().Pretty())
+	require.True(t, found)
+	assert.True(t, record.LastSeen > 0)
+	require.True(t, len(record.Addresses) > 0)
+
+	mes3, _ := libp2p.NewMockMessenger(arg, netw)
+	_ = netw.LinkAll()
+	defer func() {
+		_ = mes3.Close()
+	}()
+	err = mes3.Bootstrap()
+	require.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return mes3.IsConnected(mes2.ID())
+	}, time.Second*2, time.Millisecond*10)
+}
+
 //------- SetThresholdMinConnectedPeers
 
 func TestNetworkMessenger_SetThresholdMinConnectedPeersInvalidValueShouldErr(t *testing.T) {
@@ -1242,8 +1326,8 @@ func TestNetworkMessenger_PreventReprocessingShouldWork(t *testing.T) {
 		ValidatorData: nil,
 	}
 
-	assert.False(t, callBackFunc(ctx, pid, msg)) //this will not call
-	assert.False(t, callBackFunc(ctx, pid, msg)) //this will not call
+	assert.Equal(t, pubsub.ValidationIgnore, callBackFunc(ctx, pid, msg)) //this will not call
+	assert.Equal(t, pubsub.ValidationIgnore, callBackFunc(ctx, pid, msg)) //this will not call
 	assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalled))
 
 	_ = mes.Close()
@@ -1269,14 +1353,17 @@ func TestNetworkMessenger_PubsubCallbackNotMessageNotValidShouldNotCallHandler(t
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
 	numUpserts := int32(0)
+	deniedPids := sync.Map{}
 	_ = mes.SetPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{
 		UpsertPeerIDCalled: func(pid core.PeerID, duration time.Duration) error {
 			atomic.AddInt32(&numUpserts, 1)
+			deniedPids.Store(pid, struct{}{})
 			//any error thrown here should not impact the execution
 			return fmt.Errorf("expected error")
 		},
 		IsDeniedCalled: func(pid core.PeerID) bool {
-			return false
+			_, found := deniedPids.Load(pid)
+			return found
 		},
 	})
 
@@ -1312,14 +1399,75 @@ func TestNetworkMessenger_PubsubCallbackNotMessageNotValidShouldNotCallHandler(t
 		ValidatorData: nil,
 	}
 
-	assert.False(t, callBackFunc(ctx, pid, msg))
+	assert.Equal(t, pubsub.ValidationReject, callBackFunc(ctx, pid, msg))
 	assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalled))
 	assert.Equal(t, int32(2), atomic.LoadInt32(&numUpserts))
 
 	_ = mes.Close()
 }
 
-func TestNetworkMessenger_PubsubCallbackReturnsFalseIfHandlerErrors(t *testing.T) {
+func TestNetworkMessenger_PubsubCallbackShouldIgnoreIfHandlerErrors(t *testing.T) {
+	args := libp2p.ArgsNetworkMessenger{
+		Marshalizer:   &testscommon.ProtoMarshalizerMock{},
+		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		P2pConfig: config.P2PConfig{
+			Node: config.NodeConfig{
+				Port: "0",
+			},
+			KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+				Enabled: false,
+			},
+			Sharding: config.ShardingConfig{
+				Type: p2p.NilListSharder,
+			},
+		},
+		SyncTimer: &libp2p.LocalSyncTimer{},
+	}
+
+	mes, _ := libp2p.NewNetworkMessenger(args)
+
+	numCalled := uint32(0)
+	expectedErr := errors.New("expected error")
+	handler := &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			atomic.AddUint32(&numCalled, 1)
+			return expectedErr
+		},
+	}
+
+	callBackFunc := mes.PubsubCallback(handler, "")
+	ctx := context.Background()
+	pid := peer.ID(mes.ID())
+	innerMessage := &data.TopicMessage{
+		Payload:   []byte("data"),
+		Timestamp: time.Now().Unix(),
+		Version:   libp2p.CurrentTopicMessageVersion,
+	}
+	buff, _ := args.Marshalizer.Marshal(innerMessage)
+	topic := "topic"
+	msg := &pubsub.Message{
+		Message: &pubsub_pb.Message{
+			From:                 []byte(mes.ID()),
+			Data:                 buff,
+			Seqno:                []byte{0, 0, 0, 1},
+			Topic:                &topic,
+			Signature:            nil,
+			Key:                  nil,
+			XXX_NoUnkeyedLiteral: struct{}{},
+			XXX_unrecognized:     nil,
+			XXX_sizecache:        0,
+		},
+		ReceivedFrom:  "",
+		ValidatorData: nil,
+	}
+
+	assert.Equal(t, pubsub.ValidationIgnore, callBackFunc(ctx, pid, msg))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalled))
+
+	_ = mes.Close()
+}
+
+func TestNetworkMessenger_PubsubCallbackShouldRejectIfHandlerErrorsAndDeniesThePeer(t *testing.T) {
 	args := libp2p.ArgsNetworkMessenger{
 		Marshalizer:   &testscommon.ProtoMarshalizerMock{},
 		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
@@ -1338,12 +1486,21 @@ func TestNetworkMessenger_PubsubCallbackReturnsFalseIfHandlerErrors(t *testing.T
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
+	isDenied := atomic.Value{}
+	isDenied.Store(false)
+	_ = mes.SetPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{
+		IsDeniedCalled: func(pid core.PeerID) bool {
+			return isDenied.Load().(bool)
+		},
+	})
 
 	numCalled := uint32(0)
 	expectedErr := errors.New("expected error")
 	handler := &mock.MessageProcessorStub{
 		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 			atomic.AddUint32(&numCalled, 1)
+			// the interceptors deny the peers sending malicious messages
+			isDenied.Store(true)
 			return expectedErr
 		},
 	}
@@ -1374,7 +1531,7 @@ func TestNetworkMessenger_PubsubCallbackReturnsFalseIfHandlerErrors(t *testing.T
 		ValidatorData: nil,
 	}
 
-	assert.False(t, callBackFunc(ctx, pid, msg))
+	assert.Equal(t, pubsub.ValidationReject, callBackFunc(ctx, pid, msg))
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalled))
 
 	_ = mes.Close()
@@ -1552,3 +1709,215 @@ func TestNetworkMessenger_GetConnectedPeersInfo(t *testing.T) {
 	assert.Equal(t, selfShardID, cpi.SelfShardID)
 	assert.Equal(t, 1, len(cpi.UnknownPeers))
 }
+
+func TestNetworkMessenger_QueryDhtNeighboursWithKadDhtDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	netw := mocknet.New(context.Background())
+	mes, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	defer func() {
+		_ = mes.Close()
+	}()
+
+	neighbours, err := mes.QueryDhtNeighbours("pid", 1)
+
+	assert.Nil(t, neighbours)
+	assert.Equal(t, p2p.ErrKadDhtNotEnabled, err)
+}
+
+func createMockNetworkArgsWithPeerScoring() libp2p.ArgsNetworkMessenger {
+	args := createMockNetworkArgs()
+	args.P2pConfig.PeerScoring = config.PeerScoringConfig{
+		Enabled:            true,
+		DecayIntervalInSec: 1,
+		DecayToZero:        0.01,
+		AppSpecificWeight:  10,
+		DeniedPeerScore:    -1000,
+		GossipThreshold:    -500,
+		PublishThreshold:   -1000,
+		GraylistThreshold:  -2500,
+		Topics: []config.TopicScoringConfig{
+			{
+				TopicPrefix:                        "test",
+				TopicWeight:                        1,
+				TimeInMeshQuantumInMs:              1000,
+				InvalidMessageDeliveriesWeight:     -100,
+				InvalidMessageDeliveriesDecayInSec: 60,
+			},
+		},
+	}
+
+	return args
+}
+
+func TestNewNetworkMessenger_InvalidPeerScoringConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockNetworkArgsWithPeerScoring()
+	args.P2pConfig.PeerScoring.GraylistThreshold = 1
+
+	netw := mocknet.New(context.Background())
+	mes, err := libp2p.NewMockMessenger(args, netw)
+
+	assert.True(t, check.IfNil(mes))
+	assert.NotNil(t, err)
+}
+
+func TestNetworkMessenger_PeerScoringShouldIgnoreDishonestPeers(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	receiver, _ := libp2p.NewMockMessenger(createMockNetworkArgsWithPeerScoring(), netw)
+	honestSender, _ := libp2p.NewMockMessenger(createMockNetworkArgsWithPeerScoring(), netw)
+	dishonestSender, _ := libp2p.NewMockMessenger(createMockNetworkArgsWithPeerScoring(), netw)
+	_ = netw.LinkAll()
+	defer func() {
+		_ = receiver.Close()
+		_ = honestSender.Close()
+		_ = dishonestSender.Close()
+	}()
+
+	_ = receiver.SetPeerShardResolver(&mock.PeerShardResolverStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return core.P2PPeerInfo{PkBytes: pid.Bytes()}
+		},
+	})
+	_ = receiver.SetPeerHonestyScoreHandler(&mock.PeerHonestyScoreHandlerStub{
+		GetScoreCalled: func(pk string) float64 {
+			if pk == string(dishonestSender.ID()) {
+				return -1000
+			}
+
+			return 0
+		},
+	})
+
+	_ = honestSender.ConnectToPeer(getConnectableAddress(receiver))
+	_ = dishonestSender.ConnectToPeer(getConnectableAddress(receiver))
+
+	receivedFrom := make(map[core.PeerID]int)
+	mutReceived := sync.Mutex{}
+	_ = receiver.CreateTopic("test", false)
+	_ = receiver.RegisterMessageProcessor("test", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+			mutReceived.Lock()
+			receivedFrom[message.Peer()]++
+			mutReceived.Unlock()
+
+			return nil
+		},
+	})
+	_ = honestSender.CreateTopic("test", false)
+	_ = dishonestSender.CreateTopic("test", false)
+
+	time.Sleep(time.Second * 2)
+
+	honestSender.Broadcast("test", []byte("honest message"))
+	dishonestSender.Broadcast("test", []byte("dishonest message"))
+
+	time.Sleep(time.Second * 2)
+
+	mutReceived.Lock()
+	defer mutReceived.Unlock()
+
+	assert.Equal(t, 1, receivedFrom[honestSender.ID()])
+	assert.Equal(t, 0, receivedFrom[dishonestSender.ID()])
+}
+
+func TestNetworkMessenger_AppSpecificScore(t *testing.T) {
+	t.Parallel()
+
+	netw := mocknet.New(context.Background())
+	mes, _ := libp2p.NewMockMessenger(createMockNetworkArgsWithPeerScoring(), netw)
+	defer func() {
+		_ = mes.Close()
+	}()
+
+	assert.Equal(t, float64(0), mes.AppSpecificScore("pid"))
+
+	_ = mes.SetPeerShardResolver(&mock.PeerShardResolverStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return core.P2PPeerInfo{PkBytes: pid.Bytes()}
+		},
+	})
+	_ = mes.SetPeerHonestyScoreHandler(&mock.PeerHonestyScoreHandlerStub{
+		GetScoreCalled: func(pk string) float64 {
+			return 42
+		},
+	})
+	assert.Equal(t, float64(42), mes.AppSpecificScore("pid"))
+
+	_ = mes.SetPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{
+		IsDeniedCalled: func(pid core.PeerID) bool {
+			return pid == "denied"
+		},
+	})
+	assert.Equal(t, float64(-1000), mes.AppSpecificScore("denied"))
+	assert.Equal(t, float64(42), mes.AppSpecificScore("pid"))
+}
+
+func createMockNetworkArgsWithCompression(algorithm string) libp2p.ArgsNetworkMessenger {
+	args := createMockNetworkArgs()
+	args.P2pConfig.Compression = config.CompressionConfig{
+		Enabled:               true,
+		Algorithm:             algorithm,
+		MinPayloadSizeInBytes: 1024,
+	}
+
+	return args
+}
+
+func TestNewNetworkMessenger_InvalidCompressionAlgorithmShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mes, err := libp2p.NewMockMessenger(createMockNetworkArgsWithCompression("zip"), mocknet.New(context.Background()))
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
+func TestLibp2pMessenger_SendDirectShouldCompressOnlyForPeersSupportingCompression(t *testing.T) {
+	largeMsg := bytes.Repeat([]byte("trie node "), 10000)
+
+	netw := mocknet.New(context.Background())
+	sender, _ := libp2p.NewMockMessenger(createMockNetworkArgsWithCompression(compression.SnappyAlgorithm), netw)
+	compressingReceiver, _ := libp2p.NewMockMessenger(createMockNetworkArgsWithCompression(compression.SnappyAlgorithm), netw)
+	legacyReceiver, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	defer func() {
+		_ = sender.Close()
+		_ = compressingReceiver.Close()
+		_ = legacyReceiver.Close()
+	}()
+	_ = netw.LinkAll()
+
+	_ = sender.ConnectToPeer(compressingReceiver.Addresses()[0])
+	_ = sender.ConnectToPeer(legacyReceiver.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+	prepareMessengerForMatchDataReceive(compressingReceiver, largeMsg, wg)
+	prepareMessengerForMatchDataReceive(legacyReceiver, largeMsg, wg)
+
+	fmt.Println("Delaying as to allow peers to identify each other...")
+	time.Sleep(time.Second)
+
+	err := sender.SendToConnectedPeer("test", largeMsg, compressingReceiver.ID())
+	assert.Nil(t, err)
+	err = sender.SendToConnectedPeer("test", largeMsg, legacyReceiver.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	senderStats := sender.ResetCompressionStatistics()
+	assert.Equal(t, uint64(1), senderStats.NumCompressed)
+	assert.Equal(t, uint64(len(largeMsg)), senderStats.BytesBeforeCompression)
+	assert.True(t, senderStats.BytesAfterCompression < senderStats.BytesBeforeCompression)
+
+	receiverStats := compressingReceiver.ResetCompressionStatistics()
+	assert.Equal(t, uint64(1), receiverStats.NumDecompressed)
+	assert.Equal(t, senderStats.BytesAfterCompression, receiverStats.BytesBeforeDecompression)
+	assert.Equal(t, uint64(0), legacyReceiver.ResetCompressionStatistics().NumDecompressed)
+}
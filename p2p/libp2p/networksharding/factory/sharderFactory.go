@@ -20,6 +20,8 @@ type ArgsSharderFactory struct {
 	MaxCrossShardValidators int
 	MaxIntraShardObservers  int
 	MaxCrossShardObservers  int
+	PreferredPeers          []peer.ID
+	BlockedPeers            []peer.ID
 	Type                    string
 }
 
@@ -33,16 +35,20 @@ func NewSharder(arg ArgsSharderFactory) (p2p.CommonSharder, error) {
 			"MaxCrossShardValidators", arg.MaxCrossShardValidators,
 			"MaxIntraShardObservers", arg.MaxIntraShardObservers,
 			"MaxCrossShardObservers", arg.MaxCrossShardObservers,
+			"num preferred peers", len(arg.PreferredPeers),
+			"num blocked peers", len(arg.BlockedPeers),
 		)
-		return networksharding.NewListsSharder(
-			arg.PeerShardResolver,
-			arg.Pid,
-			arg.MaxConnectionCount,
-			arg.MaxIntraShardValidators,
-			arg.MaxCrossShardValidators,
-			arg.MaxIntraShardObservers,
-			arg.MaxCrossShardObservers,
-		)
+		return networksharding.NewListsSharder(networksharding.ArgListsSharder{
+			PeerResolver:            arg.PeerShardResolver,
+			SelfPeerId:              arg.Pid,
+			MaxPeerCount:            arg.MaxConnectionCount,
+			MaxIntraShardValidators: arg.MaxIntraShardValidators,
+			MaxCrossShardValidators: arg.MaxCrossShardValidators,
+			MaxIntraShardObservers:  arg.MaxIntraShardObservers,
+			MaxCrossShardObservers:  arg.MaxCrossShardObservers,
+			PreferredPeers:          arg.PreferredPeers,
+			BlockedPeers:            arg.BlockedPeers,
+		})
 	case p2p.OneListSharder:
 		log.Debug("using one list sharder",
 			"MaxConnectionCount", arg.MaxConnectionCount,
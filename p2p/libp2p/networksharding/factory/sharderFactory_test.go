@@ -35,15 +35,15 @@ func TestNewSharder_CreateListsSharderShouldWork(t *testing.T) {
 	maxValidators := 1
 	maxObservers := 1
 
-	expectedSharder, _ := networksharding.NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		maxPeerCount,
-		maxValidators,
-		maxValidators,
-		maxObservers,
-		maxObservers,
-	)
+	expectedSharder, _ := networksharding.NewListsSharder(networksharding.ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            maxPeerCount,
+		MaxIntraShardValidators: maxValidators,
+		MaxCrossShardValidators: maxValidators,
+		MaxIntraShardObservers:  maxObservers,
+		MaxCrossShardObservers:  maxObservers,
+	})
 	assert.Nil(t, err)
 	assert.IsType(t, reflect.TypeOf(expectedSharder), reflect.TypeOf(sharder))
 }
@@ -69,10 +69,25 @@ var leadingZerosCount = []int{
 // this will fail if we have less than 256 values in the slice
 var _ = leadingZerosCount[255]
 
+// ArgListsSharder represents the argument structure used in the creation of a listsSharder instance
+type ArgListsSharder struct {
+	PeerResolver            p2p.PeerShardResolver
+	SelfPeerId              peer.ID
+	MaxPeerCount            int
+	MaxIntraShardValidators int
+	MaxCrossShardValidators int
+	MaxIntraShardObservers  int
+	MaxCrossShardObservers  int
+	PreferredPeers          []peer.ID
+	BlockedPeers            []peer.ID
+}
+
 // listsSharder is the struct able to compute an eviction list of connected peers id according to the
 // provided parameters. It basically splits all connected peers into 3 lists: intra shard peers, cross shard peers
 // and unknown peers by the following rule: both intra shard and cross shard lists are upper bounded to provided
 // maximum levels, unknown list is able to fill the gap until maximum peer count value is fulfilled.
+// The preferred peers are never evicted and do not count towards the maximum levels while the blocked peers are
+// always evicted.
 type listsSharder struct {
 	mutResolver             sync.RWMutex
 	peerShardResolver       p2p.PeerShardResolver
@@ -83,65 +98,92 @@ type listsSharder struct {
 	maxIntraShardObservers  int
 	maxCrossShardObservers  int
 	maxUnknown              int
+	preferredPeers          map[peer.ID]struct{}
+	blockedPeers            map[peer.ID]struct{}
 	computeDistance         func(src peer.ID, dest peer.ID) *big.Int
 }
 
 // NewListsSharder creates a new kad list based kad sharder instance
-func NewListsSharder(
-	resolver p2p.PeerShardResolver,
-	selfPeerId peer.ID,
-	maxPeerCount int,
-	maxIntraShardValidators int,
-	maxCrossShardValidators int,
-	maxIntraShardObservers int,
-	maxCrossShardObservers int,
-) (*listsSharder, error) {
-
-	if check.IfNil(resolver) {
+func NewListsSharder(arg ArgListsSharder) (*listsSharder, error) {
+	if check.IfNil(arg.PeerResolver) {
 		return nil, p2p.ErrNilPeerShardResolver
 	}
-	if maxPeerCount < minAllowedConnectedPeersListSharder {
+	if arg.MaxPeerCount < minAllowedConnectedPeersListSharder {
 		return nil, fmt.Errorf("%w, maxPeerCount should be at least %d", p2p.ErrInvalidValue, minAllowedConnectedPeersListSharder)
 	}
-	if maxIntraShardValidators < minAllowedValidators {
+	if arg.MaxIntraShardValidators < minAllowedValidators {
 		return nil, fmt.Errorf("%w, maxIntraShardValidators should be at least %d", p2p.ErrInvalidValue, minAllowedValidators)
 	}
-	if maxCrossShardValidators < minAllowedValidators {
+	if arg.MaxCrossShardValidators < minAllowedValidators {
 		return nil, fmt.Errorf("%w, maxCrossShardValidators should be at least %d", p2p.ErrInvalidValue, minAllowedValidators)
 	}
-	if maxIntraShardObservers < minAllowedObservers {
+	if arg.MaxIntraShardObservers < minAllowedObservers {
 		return nil, fmt.Errorf("%w, maxIntraShardObservers should be at least %d", p2p.ErrInvalidValue, minAllowedObservers)
 	}
-	if maxCrossShardObservers < minAllowedObservers {
+	if arg.MaxCrossShardObservers < minAllowedObservers {
 		return nil, fmt.Errorf("%w, maxCrossShardObservers should be at least %d", p2p.ErrInvalidValue, minAllowedObservers)
 	}
-	if maxCrossShardObservers+maxIntraShardObservers == 0 {
+	if arg.MaxCrossShardObservers+arg.MaxIntraShardObservers == 0 {
 		log.Warn("no connections to observers are possible")
 	}
 
-	providedPeers := maxIntraShardValidators + maxCrossShardValidators + maxIntraShardObservers + maxCrossShardObservers
-	if providedPeers+minUnknownPeers > maxPeerCount {
-		return nil, fmt.Errorf("%w, maxValidators + maxObservers should be less than %d", p2p.ErrInvalidValue, maxPeerCount)
+	providedPeers := arg.MaxIntraShardValidators + arg.MaxCrossShardValidators + arg.MaxIntraShardObservers + arg.MaxCrossShardObservers
+	if providedPeers+minUnknownPeers > arg.MaxPeerCount {
+		return nil, fmt.Errorf("%w, maxValidators + maxObservers should be less than %d", p2p.ErrInvalidValue, arg.MaxPeerCount)
 	}
 
 	ls := &listsSharder{
-		peerShardResolver:       resolver,
-		selfPeerId:              selfPeerId,
-		maxPeerCount:            maxPeerCount,
+		peerShardResolver:       arg.PeerResolver,
+		selfPeerId:              arg.SelfPeerId,
+		maxPeerCount:            arg.MaxPeerCount,
 		computeDistance:         computeDistanceByCountingBits,
-		maxIntraShardValidators: maxIntraShardValidators,
-		maxCrossShardValidators: maxCrossShardValidators,
-		maxIntraShardObservers:  maxIntraShardObservers,
-		maxCrossShardObservers:  maxCrossShardObservers,
+		maxIntraShardValidators: arg.MaxIntraShardValidators,
+		maxCrossShardValidators: arg.MaxCrossShardValidators,
+		maxIntraShardObservers:  arg.MaxIntraShardObservers,
+		maxCrossShardObservers:  arg.MaxCrossShardObservers,
+		preferredPeers:          createPeersSet(arg.PreferredPeers),
+		blockedPeers:            createPeersSet(arg.BlockedPeers),
 	}
 
-	ls.maxUnknown = maxPeerCount - providedPeers
+	ls.maxUnknown = arg.MaxPeerCount - providedPeers
 
 	return ls, nil
 }
 
+func createPeersSet(peers []peer.ID) map[peer.ID]struct{} {
+	set := make(map[peer.ID]struct{}, len(peers))
+	for _, pid := range peers {
+		set[pid] = struct{}{}
+	}
+
+	return set
+}
+
 // ComputeEvictionList returns the eviction list
 func (ls *listsSharder) ComputeEvictionList(pidList []peer.ID) []peer.ID {
+	blockedPeers := make([]peer.ID, 0)
+	peersToSplit := make([]peer.ID, 0, len(pidList))
+	for _, pid := range pidList {
+		_, isBlocked := ls.blockedPeers[pid]
+		if isBlocked {
+			blockedPeers = append(blockedPeers, pid)
+			continue
+		}
+
+		_, isPreferred := ls.preferredPeers[pid]
+		if isPreferred {
+			continue
+		}
+
+		peersToSplit = append(peersToSplit, pid)
+	}
+
+	evictionProposed := ls.computeEvictionListFromSplitPeers(peersToSplit)
+
+	return append(evictionProposed, blockedPeers...)
+}
+
+func (ls *listsSharder) computeEvictionListFromSplitPeers(pidList []peer.ID) []peer.ID {
 	peerDistances := ls.splitPeerIds(pidList)
 
 	existingNumIntraShardValidators := len(peerDistances[intraShardValidators])
@@ -65,15 +65,15 @@ func countPeers(peers []peer.ID, shardID uint32, marker string) int {
 func TestNewListsSharder_NilPeerShardResolverShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		nil,
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            nil,
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	assert.True(t, check.IfNil(ls))
 	assert.True(t, errors.Is(err, p2p.ErrNilPeerShardResolver))
@@ -82,15 +82,15 @@ func TestNewListsSharder_NilPeerShardResolverShouldErr(t *testing.T) {
 func TestNewListsSharder_InvalidIntraShardValidatorsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators-1,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators - 1,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	assert.True(t, check.IfNil(ls))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
@@ -99,15 +99,15 @@ func TestNewListsSharder_InvalidIntraShardValidatorsShouldErr(t *testing.T) {
 func TestNewListsSharder_InvalidCrossShardValidatorsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators-1,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators - 1,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	assert.True(t, check.IfNil(ls))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
@@ -116,15 +116,15 @@ func TestNewListsSharder_InvalidCrossShardValidatorsShouldErr(t *testing.T) {
 func TestNewListsSharder_InvalidIntraShardObserversShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers-1,
-		minAllowedObservers,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers - 1,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	assert.True(t, check.IfNil(ls))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
@@ -133,15 +133,15 @@ func TestNewListsSharder_InvalidIntraShardObserversShouldErr(t *testing.T) {
 func TestNewListsSharder_InvalidCrossShardObserversShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers-1,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers - 1,
+	})
 
 	assert.True(t, check.IfNil(ls))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
@@ -150,15 +150,15 @@ func TestNewListsSharder_InvalidCrossShardObserversShouldErr(t *testing.T) {
 func TestNewListsSharder_NoRoomForUnknownShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers+1,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers + 1,
+	})
 
 	assert.True(t, check.IfNil(ls))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
@@ -167,15 +167,15 @@ func TestNewListsSharder_NoRoomForUnknownShouldErr(t *testing.T) {
 func TestNewListsSharder_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ls, err := NewListsSharder(
-		&mock.PeerShardResolverStub{},
-		"",
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, err := NewListsSharder(ArgListsSharder{
+		PeerResolver:            &mock.PeerShardResolverStub{},
+		SelfPeerId:              "",
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	assert.False(t, check.IfNil(ls))
 	assert.Nil(t, err)
@@ -186,15 +186,15 @@ func TestNewListsSharder_ShouldWork(t *testing.T) {
 func TestListsSharder_ComputeEvictionListNotReachedValidatorsShouldRetEmpty(t *testing.T) {
 	t.Parallel()
 
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 	pidCrtShard := peer.ID(fmt.Sprintf("%d %s", crtShardId, validatorMarker))
 	pidCrossShard := peer.ID(fmt.Sprintf("%d %s", crossShardId, validatorMarker))
 	pids := []peer.ID{pidCrtShard, pidCrossShard}
@@ -207,15 +207,15 @@ func TestListsSharder_ComputeEvictionListNotReachedValidatorsShouldRetEmpty(t *t
 func TestListsSharder_ComputeEvictionListNotReachedObserversShouldRetEmpty(t *testing.T) {
 	t.Parallel()
 
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 	pidCrtShard := peer.ID(fmt.Sprintf("%d %s", crtShardId, observerMarker))
 	pidCrossShard := peer.ID(fmt.Sprintf("%d %s", crossShardId, observerMarker))
 	pids := []peer.ID{pidCrtShard, pidCrossShard}
@@ -228,15 +228,15 @@ func TestListsSharder_ComputeEvictionListNotReachedObserversShouldRetEmpty(t *te
 func TestListsSharder_ComputeEvictionListNotReachedUnknownShouldRetEmpty(t *testing.T) {
 	t.Parallel()
 
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 	pidUnknown := peer.ID(fmt.Sprintf("0 %s", unknownMarker))
 	pids := []peer.ID{pidUnknown}
 
@@ -245,18 +245,63 @@ func TestListsSharder_ComputeEvictionListNotReachedUnknownShouldRetEmpty(t *test
 	assert.Equal(t, 0, len(evictList))
 }
 
+func TestListsSharder_ComputeEvictionListShouldNotEvictPreferredPeers(t *testing.T) {
+	t.Parallel()
+
+	pidCrtShard1 := peer.ID(fmt.Sprintf("%d - 1 - %s", crtShardId, validatorMarker))
+	pidCrtShard2 := peer.ID(fmt.Sprintf("%d - 2 - %s", crtShardId, validatorMarker))
+	pidCrtShard3 := peer.ID(fmt.Sprintf("%d - 3 - %s", crtShardId, validatorMarker))
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+		PreferredPeers:          []peer.ID{pidCrtShard1, pidCrtShard2},
+	})
+	pids := []peer.ID{pidCrtShard1, pidCrtShard2, pidCrtShard3}
+
+	evictList := ls.ComputeEvictionList(pids)
+
+	assert.Equal(t, 0, len(evictList))
+}
+
+func TestListsSharder_ComputeEvictionListShouldEvictBlockedPeers(t *testing.T) {
+	t.Parallel()
+
+	pidCrtShard := peer.ID(fmt.Sprintf("%d %s", crtShardId, validatorMarker))
+	pidCrossShard := peer.ID(fmt.Sprintf("%d %s", crossShardId, validatorMarker))
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+		BlockedPeers:            []peer.ID{pidCrossShard},
+	})
+	pids := []peer.ID{pidCrtShard, pidCrossShard}
+
+	evictList := ls.ComputeEvictionList(pids)
+
+	assert.Equal(t, []peer.ID{pidCrossShard}, evictList)
+}
+
 func TestListsSharder_ComputeEvictionListReachedIntraShardShouldSortAndEvict(t *testing.T) {
 	t.Parallel()
 
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 	pidCrtShard1 := peer.ID(fmt.Sprintf("%d - 1 - %s", crtShardId, validatorMarker))
 	pidCrtShard2 := peer.ID(fmt.Sprintf("%d - 2 - %s", crtShardId, validatorMarker))
 	pids := []peer.ID{pidCrtShard2, pidCrtShard1}
@@ -271,15 +316,15 @@ func TestListsSharder_ComputeEvictionListUnknownPeersShouldFillTheGap(t *testing
 	t.Parallel()
 
 	maxPeerCount := 5
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		maxPeerCount,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            maxPeerCount,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	unknownPids := make([]peer.ID, maxPeerCount)
 	for i := 0; i < maxPeerCount; i++ {
@@ -297,15 +342,15 @@ func TestListsSharder_ComputeEvictionListUnknownPeersShouldFillTheGap(t *testing
 func TestListsSharder_ComputeEvictionListCrossShouldFillTheGap(t *testing.T) {
 	t.Parallel()
 
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		5,
-		1,
-		1,
-		1,
-		1,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            5,
+		MaxIntraShardValidators: 1,
+		MaxCrossShardValidators: 1,
+		MaxIntraShardObservers:  1,
+		MaxCrossShardObservers:  1,
+	})
 
 	pids := []peer.ID{
 		peer.ID(fmt.Sprintf("%d %s", crossShardId, validatorMarker)),
@@ -323,15 +368,15 @@ func TestListsSharder_ComputeEvictionListCrossShouldFillTheGap(t *testing.T) {
 func TestListsSharder_ComputeEvictionListEvictFromAllShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ls, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		5,
-		1,
-		1,
-		1,
-		1,
-	)
+	ls, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            5,
+		MaxIntraShardValidators: 1,
+		MaxCrossShardValidators: 1,
+		MaxIntraShardObservers:  1,
+		MaxCrossShardObservers:  1,
+	})
 
 	pids := []peer.ID{
 		peer.ID(fmt.Sprintf("%d %s", crtShardId, validatorMarker)),
@@ -426,15 +471,15 @@ func TestComputeDistanceLog2Based(t *testing.T) {
 func TestListsSharder_SetPeerShardResolverNilShouldErr(t *testing.T) {
 	t.Parallel()
 
-	lks, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	lks, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 
 	err := lks.SetPeerShardResolver(nil)
 
@@ -444,15 +489,15 @@ func TestListsSharder_SetPeerShardResolverNilShouldErr(t *testing.T) {
 func TestListsSharder_SetPeerShardResolverShouldWork(t *testing.T) {
 	t.Parallel()
 
-	lks, _ := NewListsSharder(
-		createStringPeersShardResolver(),
-		crtPid,
-		minAllowedConnectedPeersListSharder,
-		minAllowedValidators,
-		minAllowedValidators,
-		minAllowedObservers,
-		minAllowedObservers,
-	)
+	lks, _ := NewListsSharder(ArgListsSharder{
+		PeerResolver:            createStringPeersShardResolver(),
+		SelfPeerId:              crtPid,
+		MaxPeerCount:            minAllowedConnectedPeersListSharder,
+		MaxIntraShardValidators: minAllowedValidators,
+		MaxCrossShardValidators: minAllowedValidators,
+		MaxIntraShardObservers:  minAllowedObservers,
+		MaxCrossShardObservers:  minAllowedObservers,
+	})
 	newPeerShardResolver := &mock.PeerShardResolverStub{}
 	err := lks.SetPeerShardResolver(newPeerShardResolver)
 
@@ -0,0 +1,206 @@
+package libp2p
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// createPeerScoreParams builds the gossipsub peer scoring parameters. The per-topic parameters are not set here as
+// the topics are joined later on, see topicScoreParams
+func createPeerScoreParams(
+	cfg config.PeerScoringConfig,
+	appSpecificScore func(pid peer.ID) float64,
+) (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds, error) {
+	err := checkPeerScoringConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decayInterval := time.Duration(cfg.DecayIntervalInSec) * time.Second
+	params := &pubsub.PeerScoreParams{
+		Topics:                      make(map[string]*pubsub.TopicScoreParams),
+		TopicScoreCap:               cfg.TopicScoreCap,
+		AppSpecificScore:            appSpecificScore,
+		AppSpecificWeight:           cfg.AppSpecificWeight,
+		IPColocationFactorWeight:    cfg.IPColocationFactorWeight,
+		IPColocationFactorThreshold: cfg.IPColocationFactorThreshold,
+		BehaviourPenaltyWeight:      cfg.BehaviourPenaltyWeight,
+		DecayInterval:               decayInterval,
+		DecayToZero:                 cfg.DecayToZero,
+		RetainScore:                 time.Duration(cfg.RetainScoreInSec) * time.Second,
+	}
+	if cfg.BehaviourPenaltyWeight != 0 {
+		params.BehaviourPenaltyDecay = scoreParameterDecay(cfg.BehaviourPenaltyDecayInSec, cfg)
+	}
+
+	thresholds := &pubsub.PeerScoreThresholds{
+		GossipThreshold:             cfg.GossipThreshold,
+		PublishThreshold:            cfg.PublishThreshold,
+		GraylistThreshold:           cfg.GraylistThreshold,
+		AcceptPXThreshold:           cfg.AcceptPXThreshold,
+		OpportunisticGraftThreshold: cfg.OpportunisticGraftThreshold,
+	}
+
+	return params, thresholds, nil
+}
+
+// checkPeerScoringConfig validates the values pubsub would only check when the topics are joined, along with the
+// values pubsub does not check at all. The rest of the parameters are validated by pubsub at creation time
+func checkPeerScoringConfig(cfg config.PeerScoringConfig) error {
+	if cfg.DecayIntervalInSec == 0 {
+		return fmt.Errorf("%w for DecayIntervalInSec, should be at least 1", p2p.ErrInvalidValue)
+	}
+	if cfg.AppSpecificWeight < 0 {
+		return fmt.Errorf("%w for AppSpecificWeight, should be positive or 0", p2p.ErrInvalidValue)
+	}
+	if cfg.DeniedPeerScore > 0 {
+		return fmt.Errorf("%w for DeniedPeerScore, should be negative or 0", p2p.ErrInvalidValue)
+	}
+	if cfg.BehaviourPenaltyWeight != 0 && cfg.BehaviourPenaltyDecayInSec < cfg.DecayIntervalInSec {
+		return fmt.Errorf("%w for BehaviourPenaltyDecayInSec, should be at least DecayIntervalInSec", p2p.ErrInvalidValue)
+	}
+
+	prefixes := make(map[string]struct{})
+	for _, topicCfg := range cfg.Topics {
+		_, found := prefixes[topicCfg.TopicPrefix]
+		if found {
+			return fmt.Errorf("%w, duplicated topic prefix %s", p2p.ErrInvalidValue, topicCfg.TopicPrefix)
+		}
+		prefixes[topicCfg.TopicPrefix] = struct{}{}
+
+		err := checkTopicScoringConfig(topicCfg, cfg.DecayIntervalInSec)
+		if err != nil {
+			return fmt.Errorf("%w for topic prefix %s", err, topicCfg.TopicPrefix)
+		}
+	}
+
+	return nil
+}
+
+func checkTopicScoringConfig(topicCfg config.TopicScoringConfig, decayIntervalInSec uint32) error {
+	if len(topicCfg.TopicPrefix) == 0 {
+		return fmt.Errorf("%w, empty TopicPrefix", p2p.ErrInvalidValue)
+	}
+	if topicCfg.TopicWeight < 0 {
+		return fmt.Errorf("%w for TopicWeight, should be positive or 0", p2p.ErrInvalidValue)
+	}
+	if topicCfg.TimeInMeshQuantumInMs == 0 {
+		return fmt.Errorf("%w for TimeInMeshQuantumInMs, should be at least 1", p2p.ErrInvalidValue)
+	}
+	if topicCfg.TimeInMeshWeight < 0 {
+		return fmt.Errorf("%w for TimeInMeshWeight, should be positive or 0", p2p.ErrInvalidValue)
+	}
+	if topicCfg.TimeInMeshWeight != 0 && topicCfg.TimeInMeshCap <= 0 {
+		return fmt.Errorf("%w for TimeInMeshCap, should be positive", p2p.ErrInvalidValue)
+	}
+	if topicCfg.FirstMessageDeliveriesWeight < 0 {
+		return fmt.Errorf("%w for FirstMessageDeliveriesWeight, should be positive or 0", p2p.ErrInvalidValue)
+	}
+	if topicCfg.FirstMessageDeliveriesWeight != 0 {
+		if topicCfg.FirstMessageDeliveriesDecayInSec < decayIntervalInSec {
+			return fmt.Errorf("%w for FirstMessageDeliveriesDecayInSec, should be at least DecayIntervalInSec",
+				p2p.ErrInvalidValue)
+		}
+		if topicCfg.FirstMessageDeliveriesCap <= 0 {
+			return fmt.Errorf("%w for FirstMessageDeliveriesCap, should be positive", p2p.ErrInvalidValue)
+		}
+	}
+	if topicCfg.InvalidMessageDeliveriesWeight > 0 {
+		return fmt.Errorf("%w for InvalidMessageDeliveriesWeight, should be negative or 0", p2p.ErrInvalidValue)
+	}
+	if topicCfg.InvalidMessageDeliveriesDecayInSec < decayIntervalInSec {
+		return fmt.Errorf("%w for InvalidMessageDeliveriesDecayInSec, should be at least DecayIntervalInSec",
+			p2p.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// topicScoreParams returns the scoring parameters of the configured topic prefix that best matches the provided
+// topic (the longest one). Returns false if no configured prefix matches the topic
+func topicScoreParams(topic string, cfg config.PeerScoringConfig) (*pubsub.TopicScoreParams, bool) {
+	var bestMatch *config.TopicScoringConfig
+	for i := range cfg.Topics {
+		topicCfg := &cfg.Topics[i]
+		if !strings.HasPrefix(topic, topicCfg.TopicPrefix) {
+			continue
+		}
+		if bestMatch == nil || len(topicCfg.TopicPrefix) > len(bestMatch.TopicPrefix) {
+			bestMatch = topicCfg
+		}
+	}
+	if bestMatch == nil {
+		return nil, false
+	}
+
+	params := &pubsub.TopicScoreParams{
+		TopicWeight:                    bestMatch.TopicWeight,
+		TimeInMeshWeight:               bestMatch.TimeInMeshWeight,
+		TimeInMeshQuantum:              time.Duration(bestMatch.TimeInMeshQuantumInMs) * time.Millisecond,
+		TimeInMeshCap:                  bestMatch.TimeInMeshCap,
+		FirstMessageDeliveriesWeight:   bestMatch.FirstMessageDeliveriesWeight,
+		FirstMessageDeliveriesCap:      bestMatch.FirstMessageDeliveriesCap,
+		InvalidMessageDeliveriesWeight: bestMatch.InvalidMessageDeliveriesWeight,
+		InvalidMessageDeliveriesDecay:  scoreParameterDecay(bestMatch.InvalidMessageDeliveriesDecayInSec, cfg),
+	}
+	if bestMatch.FirstMessageDeliveriesWeight != 0 {
+		params.FirstMessageDeliveriesDecay = scoreParameterDecay(bestMatch.FirstMessageDeliveriesDecayInSec, cfg)
+	}
+
+	return params, true
+}
+
+// scoreParameterDecay computes the per decay interval factor that makes a counter reach the DecayToZero value after
+// the provided number of seconds
+func scoreParameterDecay(decayInSec uint32, cfg config.PeerScoringConfig) float64 {
+	return pubsub.ScoreParameterDecayWithBase(
+		time.Duration(decayInSec)*time.Second,
+		time.Duration(cfg.DecayIntervalInSec)*time.Second,
+		cfg.DecayToZero,
+	)
+}
+
+// appSpecificScore feeds our own signals in the gossipsub peer score: a peer denied by the antiflood/blacklist
+// components receives the configured penalty, otherwise the peer honesty score of its public key is used
+func (netMes *networkMessenger) appSpecificScore(pid peer.ID) float64 {
+	if netMes.isPeerDenied(pid) {
+		return netMes.peerScoringConfig.DeniedPeerScore
+	}
+
+	return netMes.honestyScore(pid)
+}
+
+func (netMes *networkMessenger) isPeerDenied(pid peer.ID) bool {
+	if check.IfNil(netMes.connMonitorWrapper) {
+		return false
+	}
+
+	peerDenialEvaluator := netMes.connMonitorWrapper.PeerDenialEvaluator()
+	if check.IfNil(peerDenialEvaluator) {
+		return false
+	}
+
+	return peerDenialEvaluator.IsDenied(core.PeerID(pid))
+}
+
+func (netMes *networkMessenger) applyTopicScoreParams(topic *pubsub.Topic) error {
+	if !netMes.peerScoringConfig.Enabled {
+		return nil
+	}
+
+	params, found := topicScoreParams(topic.String(), netMes.peerScoringConfig)
+	if !found {
+		log.Debug("no peer scoring parameters for topic", "topic", topic.String())
+		return nil
+	}
+
+	return topic.SetScoreParams(params)
+}
@@ -0,0 +1,123 @@
+package libp2p
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTopicScoringConfig(prefix string) config.TopicScoringConfig {
+	return config.TopicScoringConfig{
+		TopicPrefix:                        prefix,
+		TopicWeight:                        1,
+		TimeInMeshWeight:                   0.01,
+		TimeInMeshQuantumInMs:              1000,
+		TimeInMeshCap:                      3600,
+		FirstMessageDeliveriesWeight:       1,
+		FirstMessageDeliveriesDecayInSec:   600,
+		FirstMessageDeliveriesCap:          100,
+		InvalidMessageDeliveriesWeight:     -100,
+		InvalidMessageDeliveriesDecayInSec: 3600,
+	}
+}
+
+func createPeerScoringConfig() config.PeerScoringConfig {
+	return config.PeerScoringConfig{
+		Enabled:                     true,
+		DecayIntervalInSec:          1,
+		DecayToZero:                 0.01,
+		RetainScoreInSec:            3600,
+		TopicScoreCap:               100,
+		AppSpecificWeight:           10,
+		DeniedPeerScore:             -1000,
+		BehaviourPenaltyWeight:      -10,
+		BehaviourPenaltyDecayInSec:  600,
+		GossipThreshold:             -500,
+		PublishThreshold:            -1000,
+		GraylistThreshold:           -2500,
+		AcceptPXThreshold:           100,
+		OpportunisticGraftThreshold: 5,
+		Topics: []config.TopicScoringConfig{
+			createTopicScoringConfig("heartbeat"),
+			createTopicScoringConfig("transactions"),
+			createTopicScoringConfig("transactions_0"),
+		},
+	}
+}
+
+func TestCreatePeerScoreParams_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	appScore := func(pid peer.ID) float64 { return 0 }
+	invalidConfigs := map[string]func(cfg *config.PeerScoringConfig){
+		"zero decay interval":         func(cfg *config.PeerScoringConfig) { cfg.DecayIntervalInSec = 0 },
+		"negative app weight":         func(cfg *config.PeerScoringConfig) { cfg.AppSpecificWeight = -1 },
+		"positive denied peer score":  func(cfg *config.PeerScoringConfig) { cfg.DeniedPeerScore = 1 },
+		"behaviour decay too small":   func(cfg *config.PeerScoringConfig) { cfg.BehaviourPenaltyDecayInSec = 0 },
+		"duplicated prefix":           func(cfg *config.PeerScoringConfig) { cfg.Topics[1].TopicPrefix = "heartbeat" },
+		"empty prefix":                func(cfg *config.PeerScoringConfig) { cfg.Topics[0].TopicPrefix = "" },
+		"negative topic weight":       func(cfg *config.PeerScoringConfig) { cfg.Topics[0].TopicWeight = -1 },
+		"zero time in mesh quantum":   func(cfg *config.PeerScoringConfig) { cfg.Topics[0].TimeInMeshQuantumInMs = 0 },
+		"zero time in mesh cap":       func(cfg *config.PeerScoringConfig) { cfg.Topics[0].TimeInMeshCap = 0 },
+		"zero first deliveries cap":   func(cfg *config.PeerScoringConfig) { cfg.Topics[0].FirstMessageDeliveriesCap = 0 },
+		"first deliveries decay":      func(cfg *config.PeerScoringConfig) { cfg.Topics[0].FirstMessageDeliveriesDecayInSec = 0 },
+		"positive invalid deliveries": func(cfg *config.PeerScoringConfig) { cfg.Topics[0].InvalidMessageDeliveriesWeight = 1 },
+		"invalid deliveries decay":    func(cfg *config.PeerScoringConfig) { cfg.Topics[0].InvalidMessageDeliveriesDecayInSec = 0 },
+	}
+
+	for name, modify := range invalidConfigs {
+		cfg := createPeerScoringConfig()
+		modify(&cfg)
+
+		params, thresholds, err := createPeerScoreParams(cfg, appScore)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue), name)
+		assert.Nil(t, params, name)
+		assert.Nil(t, thresholds, name)
+	}
+}
+
+func TestCreatePeerScoreParams_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cfg := createPeerScoringConfig()
+	params, thresholds, err := createPeerScoreParams(cfg, func(pid peer.ID) float64 { return 5 })
+
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(params.Topics))
+	assert.Equal(t, float64(5), params.AppSpecificScore("pid"))
+	assert.Equal(t, cfg.AppSpecificWeight, params.AppSpecificWeight)
+	assert.Equal(t, time.Second, params.DecayInterval)
+	assert.Equal(t, time.Hour, params.RetainScore)
+	assert.True(t, params.BehaviourPenaltyDecay > 0 && params.BehaviourPenaltyDecay < 1)
+	assert.Equal(t, cfg.GraylistThreshold, thresholds.GraylistThreshold)
+	assert.Equal(t, cfg.OpportunisticGraftThreshold, thresholds.OpportunisticGraftThreshold)
+}
+
+func TestTopicScoreParams_ShouldUseTheLongestMatchingPrefix(t *testing.T) {
+	t.Parallel()
+
+	cfg := createPeerScoringConfig()
+	cfg.Topics[1].TopicWeight = 0.5
+	cfg.Topics[2].TopicWeight = 0.7
+
+	params, found := topicScoreParams("consensus_0", cfg)
+	assert.False(t, found)
+	assert.Nil(t, params)
+
+	params, found = topicScoreParams("transactions_1", cfg)
+	require.True(t, found)
+	assert.Equal(t, 0.5, params.TopicWeight)
+
+	params, found = topicScoreParams("transactions_0_META", cfg)
+	require.True(t, found)
+	assert.Equal(t, 0.7, params.TopicWeight)
+	assert.Equal(t, time.Second, params.TimeInMeshQuantum)
+	assert.True(t, params.FirstMessageDeliveriesDecay > 0 && params.FirstMessageDeliveriesDecay < 1)
+	assert.True(t, params.InvalidMessageDeliveriesDecay > params.FirstMessageDeliveriesDecay)
+}
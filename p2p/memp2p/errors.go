@@ -10,3 +10,6 @@ var ErrNotConnectedToNetwork = errors.New("not connected to network")
 
 // ErrReceivingPeerNotConnected signals that the receiving peer of a sending operation is not connected to the network
 var ErrReceivingPeerNotConnected = errors.New("receiving peer not connected to network")
+
+// ErrInvalidLinkConditions signals that invalid link conditions were provided
+var ErrInvalidLinkConditions = errors.New("invalid link conditions")
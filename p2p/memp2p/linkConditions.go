@@ -0,0 +1,47 @@
+package memp2p
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// LinkConditions defines how the messages sent from a peer to another peer are delivered. The zero value
+// describes an ideal link: no latency, no losses and unlimited bandwidth
+type LinkConditions struct {
+	Latency time.Duration
+	// Jitter is the maximum random delay added over the latency of each message
+	Jitter time.Duration
+	// DropRate is the probability, between 0 and 1, for a message to be lost
+	DropRate float64
+	// BandwidthBytesPerSecond caps the link throughput, 0 meaning unlimited
+	BandwidthBytesPerSecond uint64
+}
+
+func (lc LinkConditions) check() error {
+	if lc.Latency < 0 {
+		return fmt.Errorf("%w, negative latency %v", ErrInvalidLinkConditions, lc.Latency)
+	}
+	if lc.Jitter < 0 {
+		return fmt.Errorf("%w, negative jitter %v", ErrInvalidLinkConditions, lc.Jitter)
+	}
+	if lc.DropRate < 0 || lc.DropRate > 1 {
+		return fmt.Errorf("%w, drop rate %v not in [0, 1]", ErrInvalidLinkConditions, lc.DropRate)
+	}
+
+	return nil
+}
+
+// NetworkEvent is a scripted change of the network topology
+type NetworkEvent struct {
+	// Delay is the time to wait, after the previous event was applied, before applying this event
+	Delay time.Duration
+	// Partition holds the groups of peers to be isolated from each other. An empty partition heals the network
+	Partition [][]core.PeerID
+}
+
+type linkID struct {
+	from core.PeerID
+	to   core.PeerID
+}
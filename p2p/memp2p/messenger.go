@@ -17,6 +17,8 @@ const maxQueueSize = 1000
 
 var log = logger.GetOrCreate("p2p/memp2p")
 
+var _ p2p.Messenger = (*Messenger)(nil)
+
 // Messenger is an implementation of the p2p.Messenger interface that
 // uses no real networking code, but instead connects to a network simulated in
 // memory (the Network struct). The Messenger is intended for use
@@ -38,7 +40,7 @@ type Messenger struct {
 	topicValidators map[string]p2p.MessageProcessor
 	topicsMutex     *sync.RWMutex
 	seqNo           uint64
-	processQueue    chan p2p.MessageP2P
+	processQueue    chan *message
 	numReceived     uint64
 }
 
@@ -61,7 +63,7 @@ func NewMessenger(network *Network) (*Messenger, error) {
 		topics:          make(map[string]struct{}),
 		topicValidators: make(map[string]p2p.MessageProcessor),
 		topicsMutex:     &sync.RWMutex{},
-		processQueue:    make(chan p2p.MessageP2P, maxQueueSize),
+		processQueue:    make(chan *message, maxQueueSize),
 	}
 	network.RegisterPeer(messenger)
 	go messenger.processFromQueue()
@@ -115,32 +117,42 @@ func (messenger *Messenger) IsConnectedToNetwork() bool {
 }
 
 // IsConnected returns true if this Messenger is connected to the peer with the
-// specified ID. It always returns true if the Messenger is connected to the
-// network and false otherwise, regardless of the provided peer ID.
-func (messenger *Messenger) IsConnected(_ core.PeerID) bool {
-	return messenger.IsConnectedToNetwork()
+// specified ID. Both peers need to be connected to the network and not be
+// separated by a network partition.
+func (messenger *Messenger) IsConnected(peerID core.PeerID) bool {
+	return messenger.network.CanReach(messenger.ID(), peerID)
 }
 
 // ConnectedPeers returns a slice of IDs belonging to the peers to which this
 // Messenger is connected. If the Messenger is connected to the in₋memory
 // network, then the function returns a slice containing the IDs of all the
-// other peers connected to the network. Returns false if the Messenger is
-// not connected.
+// other peers connected to the network, excepting the ones separated by a
+// network partition. Returns an empty slice if the Messenger is not connected.
 func (messenger *Messenger) ConnectedPeers() []core.PeerID {
+	connectedPeers := make([]core.PeerID, 0)
 	if !messenger.IsConnectedToNetwork() {
-		return []core.PeerID{}
+		return connectedPeers
+	}
+
+	for _, peerID := range messenger.network.PeerIDsExceptOne(messenger.ID()) {
+		if messenger.network.CanReach(messenger.ID(), peerID) {
+			connectedPeers = append(connectedPeers, peerID)
+		}
 	}
-	return messenger.network.PeerIDsExceptOne(messenger.ID())
+
+	return connectedPeers
 }
 
 // ConnectedAddresses returns a slice of peer addresses to which this Messenger
 // is connected. If this Messenger is connected to the network, then the
-// addresses of all the other peers in the network are returned.
+// addresses of all the other reachable peers in the network are returned.
 func (messenger *Messenger) ConnectedAddresses() []string {
-	if !messenger.IsConnectedToNetwork() {
-		return []string{}
+	addresses := make([]string, 0)
+	for _, peerID := range messenger.ConnectedPeers() {
+		addresses = append(addresses, messenger.PeerAddresses(peerID)...)
 	}
-	return messenger.network.ListAddressesExceptOne(messenger.ID())
+
+	return addresses
 }
 
 // PeerAddresses creates the address string from a given peer ID.
@@ -159,7 +171,7 @@ func (messenger *Messenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
 
 	allPeersExceptThis := messenger.network.PeersExceptOne(messenger.ID())
 	for _, peer := range allPeersExceptThis {
-		if peer.HasTopic(topic) {
+		if peer.HasTopic(topic) && messenger.network.CanReach(messenger.ID(), peer.ID()) {
 			filteredPeers = append(filteredPeers, peer.ID())
 		}
 	}
@@ -211,7 +223,7 @@ func (messenger *Messenger) HasTopicValidator(name string) bool {
 	validator := messenger.topicValidators[name]
 	messenger.topicsMutex.RUnlock()
 
-	return check.IfNil(validator)
+	return !check.IfNil(validator)
 }
 
 // RegisterMessageProcessor sets the provided message processor to be the
@@ -238,6 +250,24 @@ func (messenger *Messenger) RegisterMessageProcessor(topic string, handler p2p.M
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors of all the topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics of interest for this Messenger
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	messenger.topics = make(map[string]struct{})
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
 // UnregisterMessageProcessor unsets the message processor for the given topic
 // (sets it to nil).
 func (messenger *Messenger) UnregisterMessageProcessor(topic string) error {
@@ -302,7 +332,7 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
-		peer.receiveMessage(messageObject)
+		messenger.network.deliver(messenger.ID(), peer, messageObject)
 	}
 
 	return nil
@@ -336,7 +366,7 @@ func (messenger *Messenger) processFromQueue() {
 		}
 		messenger.topicsMutex.Unlock()
 
-		_ = validator.ProcessReceivedMessage(messageObject, messenger.p2pID)
+		_ = validator.ProcessReceivedMessage(messageObject, messageObject.Peer())
 	}
 }
 
@@ -347,11 +377,11 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 		messageObject := newMessage(topic, buff, messenger.ID(), seqNo)
 
 		receivingPeer, peerFound := messenger.network.Peers()[peerID]
-		if !peerFound {
+		if !peerFound || !messenger.network.CanReach(messenger.ID(), peerID) {
 			return ErrReceivingPeerNotConnected
 		}
 
-		receivingPeer.receiveMessage(messageObject)
+		messenger.network.deliver(messenger.ID(), receivingPeer, messageObject)
 
 		return nil
 	}
@@ -364,8 +394,8 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 // previously registered a message processor for that topic. The Network will
 // log the message only if the Network.LogMessages flag is set and only if the
 // Messenger has the requested topic and MessageProcessor.
-func (messenger *Messenger) receiveMessage(message p2p.MessageP2P) {
-	messenger.processQueue <- message
+func (messenger *Messenger) receiveMessage(msg *message) {
+	messenger.processQueue <- msg
 }
 
 // IsConnectedToTheNetwork returns true as this implementation is always connected to its network
@@ -398,6 +428,11 @@ func (messenger *Messenger) SetPeerDenialEvaluator(_ p2p.PeerDenialEvaluator) er
 	return nil
 }
 
+// SetPeerHonestyScoreHandler does nothing
+func (messenger *Messenger) SetPeerHonestyScoreHandler(_ p2p.PeerHonestyScoreHandler) error {
+	return nil
+}
+
 // GetConnectedPeersInfo returns a nil object. Not implemented.
 func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
@@ -47,9 +48,11 @@ func TestRegisteringTopics(t *testing.T) {
 	// The newly created topic has no MessageProcessor attached to it, so we
 	// attach one now.
 	assert.Nil(t, messenger.TopicValidator("rocket"))
+	assert.False(t, messenger.HasTopicValidator("rocket"))
 	err = messenger.RegisterMessageProcessor("rocket", processor)
 	assert.Nil(t, err)
 	assert.Equal(t, processor, messenger.TopicValidator("rocket"))
+	assert.True(t, messenger.HasTopicValidator("rocket"))
 
 	// Cannot unregister a MessageProcessor from a topic that doesn't exist.
 	err = messenger.UnregisterMessageProcessor("albatross")
@@ -184,3 +187,121 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func createPeersOnTopic(network *memp2p.Network, numPeers int, topic string) []*memp2p.Messenger {
+	peers := make([]*memp2p.Messenger, numPeers)
+	for i := 0; i < numPeers; i++ {
+		peer, _ := memp2p.NewMessenger(network)
+		_ = peer.CreateTopic(topic, false)
+		peers[i] = peer
+	}
+
+	return peers
+}
+
+func TestSettingInvalidLinkConditions(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	err := network.SetDefaultLinkConditions(memp2p.LinkConditions{Latency: -time.Second})
+	assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+
+	err = network.SetLinkConditions("a", "b", memp2p.LinkConditions{DropRate: 1.5})
+	assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+
+	err = network.SetLinkConditions("a", "b", memp2p.LinkConditions{Jitter: time.Second, DropRate: 0.5})
+	assert.Nil(t, err)
+}
+
+func TestPartitioningAndHealingTheNetwork(t *testing.T) {
+	network := memp2p.NewNetwork()
+	peers := createPeersOnTopic(network, 4, "rocket")
+
+	network.Partition(
+		[]core.PeerID{peers[0].ID(), peers[1].ID()},
+		[]core.PeerID{peers[2].ID(), peers[3].ID()},
+	)
+	assert.Equal(t, []core.PeerID{peers[1].ID()}, peers[0].ConnectedPeers())
+	assert.Equal(t, 1, len(peers[0].ConnectedPeersOnTopic("rocket")))
+	assert.False(t, peers[0].IsConnected(peers[2].ID()))
+	err := peers[0].SendToConnectedPeer("rocket", []byte("try to launch this rocket"), peers[2].ID())
+	assert.Equal(t, memp2p.ErrReceivingPeerNotConnected, err)
+
+	peers[0].Broadcast("rocket", []byte("launch the rocket"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1, 2: 0, 3: 0})
+
+	network.Heal()
+	assert.True(t, peers[0].IsConnected(peers[2].ID()))
+	assert.Equal(t, 3, len(peers[0].ConnectedPeers()))
+
+	peers[0].Broadcast("rocket", []byte("launch the rocket again"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 2, 1: 2, 2: 1, 3: 1})
+}
+
+func TestScriptedPartitionsShouldApplyInOrder(t *testing.T) {
+	network := memp2p.NewNetwork()
+	peers := createPeersOnTopic(network, 2, "rocket")
+
+	chDone := network.RunScript([]memp2p.NetworkEvent{
+		{Delay: 0, Partition: [][]core.PeerID{{peers[0].ID()}}},
+		{Delay: time.Millisecond * 300},
+	})
+
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, network.CanReach(peers[0].ID(), peers[1].ID()))
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "script should have finished")
+	}
+	assert.True(t, network.CanReach(peers[0].ID(), peers[1].ID()))
+}
+
+func TestLinkLatencyShouldDelayTheMessages(t *testing.T) {
+	network := memp2p.NewNetwork()
+	peers := createPeersOnTopic(network, 2, "rocket")
+	_ = network.SetLinkConditions(peers[0].ID(), peers[1].ID(), memp2p.LinkConditions{Latency: time.Millisecond * 500})
+
+	peers[0].Broadcast("rocket", []byte("launch the rocket"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0})
+
+	time.Sleep(time.Millisecond * 700)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1})
+
+	// the link in the opposite direction is not affected
+	peers[1].Broadcast("rocket", []byte("launch the rocket back"))
+	time.Sleep(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 2, 1: 2})
+}
+
+func TestLinkBandwidthShouldQueueTheMessages(t *testing.T) {
+	network := memp2p.NewNetwork()
+	peers := createPeersOnTopic(network, 2, "rocket")
+	_ = network.SetDefaultLinkConditions(memp2p.LinkConditions{BandwidthBytesPerSecond: 1000})
+
+	payload := make([]byte, 400)
+	_ = peers[0].SendToConnectedPeer("rocket", payload, peers[1].ID())
+	_ = peers[0].SendToConnectedPeer("rocket", payload, peers[1].ID())
+
+	time.Sleep(time.Millisecond * 600)
+	assert.Equal(t, uint64(1), peers[1].NumMessagesReceived())
+
+	time.Sleep(time.Millisecond * 500)
+	assert.Equal(t, uint64(2), peers[1].NumMessagesReceived())
+}
+
+func TestLinkDropRateShouldLoseTheMessages(t *testing.T) {
+	network := memp2p.NewNetwork()
+	peers := createPeersOnTopic(network, 2, "rocket")
+	_ = network.SetDefaultLinkConditions(memp2p.LinkConditions{DropRate: 1})
+
+	for i := 0; i < 10; i++ {
+		peers[0].Broadcast("rocket", []byte("launch the rocket"))
+	}
+	time.Sleep(time.Millisecond * 100)
+
+	testReceivedMessages(t, peers, map[int]uint64{0: 10, 1: 0})
+}
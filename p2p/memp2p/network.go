@@ -2,7 +2,9 @@ package memp2p
 
 import (
 	"fmt"
+	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 )
@@ -11,17 +13,33 @@ import (
 // struct. It simulates a network where each peer is connected to all the other
 // peers. The peers are connected to the network if they are in the internal
 // `peers` map; otherwise, they are disconnected.
+//
+// By default, the messages are delivered instantly and reliably. The network can
+// be configured to simulate, for each link between two peers, the latency, the
+// jitter, the loss of messages and a limited bandwidth. The peers can also be
+// split in partitions that can not reach each other until the network is healed.
 type Network struct {
 	mutex sync.RWMutex
 	peers map[core.PeerID]*Messenger
+
+	mutConditions     sync.Mutex
+	defaultConditions LinkConditions
+	linksConditions   map[linkID]LinkConditions
+	linksBusyUntil    map[linkID]time.Time
+	partitionOfPeer   map[core.PeerID]int
+	randomizer        *rand.Rand
 }
 
 // NewNetwork constructs a new Network instance with an empty
 // internal map of peers.
 func NewNetwork() *Network {
 	network := Network{
-		mutex: sync.RWMutex{},
-		peers: make(map[core.PeerID]*Messenger),
+		mutex:           sync.RWMutex{},
+		peers:           make(map[core.PeerID]*Messenger),
+		linksConditions: make(map[linkID]LinkConditions),
+		linksBusyUntil:  make(map[linkID]time.Time),
+		partitionOfPeer: make(map[core.PeerID]int),
+		randomizer:      rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 
 	return &network
@@ -129,3 +147,137 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// SetDefaultLinkConditions sets the conditions of all the links that do not have specific conditions set
+func (network *Network) SetDefaultLinkConditions(conditions LinkConditions) error {
+	err := conditions.check()
+	if err != nil {
+		return err
+	}
+
+	network.mutConditions.Lock()
+	network.defaultConditions = conditions
+	network.mutConditions.Unlock()
+
+	return nil
+}
+
+// SetLinkConditions sets the conditions of the link used by the messages sent from a peer to another peer.
+// The link in the opposite direction is not affected
+func (network *Network) SetLinkConditions(from core.PeerID, to core.PeerID, conditions LinkConditions) error {
+	err := conditions.check()
+	if err != nil {
+		return err
+	}
+
+	network.mutConditions.Lock()
+	network.linksConditions[linkID{from: from, to: to}] = conditions
+	network.mutConditions.Unlock()
+
+	return nil
+}
+
+// Partition splits the network so that the peers from different groups can not reach each other. The peers
+// that are not part of any group form an additional group
+func (network *Network) Partition(groups ...[]core.PeerID) {
+	network.mutConditions.Lock()
+	defer network.mutConditions.Unlock()
+
+	network.partitionOfPeer = make(map[core.PeerID]int)
+	for idx, group := range groups {
+		for _, pid := range group {
+			network.partitionOfPeer[pid] = idx + 1
+		}
+	}
+}
+
+// Heal removes any partition, so all the peers can reach each other again
+func (network *Network) Heal() {
+	network.Partition()
+}
+
+// RunScript applies, on a separate go routine, the provided events one after the other. The returned channel
+// is closed after the last event was applied
+func (network *Network) RunScript(events []NetworkEvent) <-chan struct{} {
+	chDone := make(chan struct{})
+	go func() {
+		for _, event := range events {
+			time.Sleep(event.Delay)
+			network.Partition(event.Partition...)
+		}
+		close(chDone)
+	}()
+
+	return chDone
+}
+
+// CanReach returns true if the messages sent from a peer can reach the other peer, meaning both are connected
+// to the network and are in the same partition
+func (network *Network) CanReach(from core.PeerID, to core.PeerID) bool {
+	if !network.IsPeerConnected(from) || !network.IsPeerConnected(to) {
+		return false
+	}
+
+	network.mutConditions.Lock()
+	defer network.mutConditions.Unlock()
+
+	return network.partitionOfPeer[from] == network.partitionOfPeer[to]
+}
+
+// deliver hands the message to the receiving peer, applying the conditions of the link between the peers
+func (network *Network) deliver(from core.PeerID, receiver *Messenger, msg *message) {
+	if from == receiver.ID() {
+		receiver.receiveMessage(msg)
+		return
+	}
+	if !network.CanReach(from, receiver.ID()) {
+		return
+	}
+
+	delay, isDropped := network.computeDelivery(linkID{from: from, to: receiver.ID()}, len(msg.Data()))
+	if isDropped {
+		return
+	}
+	if delay == 0 {
+		receiver.receiveMessage(msg)
+		return
+	}
+
+	time.AfterFunc(delay, func() {
+		receiver.receiveMessage(msg)
+	})
+}
+
+// computeDelivery returns the delay after which a message of the provided size will be delivered on the
+// given link or if the message is lost. With a bandwidth cap, the messages are transmitted one after the other,
+// so a message waits for the previous ones, sent on the same link, to be transmitted
+func (network *Network) computeDelivery(link linkID, size int) (time.Duration, bool) {
+	network.mutConditions.Lock()
+	defer network.mutConditions.Unlock()
+
+	conditions, found := network.linksConditions[link]
+	if !found {
+		conditions = network.defaultConditions
+	}
+
+	if conditions.DropRate > 0 && network.randomizer.Float64() < conditions.DropRate {
+		return 0, true
+	}
+
+	delay := conditions.Latency
+	if conditions.Jitter > 0 {
+		delay += time.Duration(network.randomizer.Int63n(int64(conditions.Jitter) + 1))
+	}
+	if conditions.BandwidthBytesPerSecond > 0 {
+		now := time.Now()
+		transmissionStart := network.linksBusyUntil[link]
+		if transmissionStart.Before(now) {
+			transmissionStart = now
+		}
+		transmissionTime := time.Duration(uint64(size) * uint64(time.Second) / conditions.BandwidthBytesPerSecond)
+		network.linksBusyUntil[link] = transmissionStart.Add(transmissionTime)
+		delay += network.linksBusyUntil[link].Sub(now)
+	}
+
+	return delay, false
+}
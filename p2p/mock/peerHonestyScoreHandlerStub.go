@@ -0,0 +1,20 @@
+package mock
+
+// PeerHonestyScoreHandlerStub -
+type PeerHonestyScoreHandlerStub struct {
+	GetScoreCalled func(pk string) float64
+}
+
+// GetScore -
+func (stub *PeerHonestyScoreHandlerStub) GetScore(pk string) float64 {
+	if stub.GetScoreCalled != nil {
+		return stub.GetScoreCalled(pk)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *PeerHonestyScoreHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -140,6 +140,7 @@ type Messenger interface {
 	SetThresholdMinConnectedPeers(minConnectedPeers int) error
 	SetPeerShardResolver(peerShardResolver PeerShardResolver) error
 	SetPeerDenialEvaluator(handler PeerDenialEvaluator) error
+	SetPeerHonestyScoreHandler(handler PeerHonestyScoreHandler) error
 	GetConnectedPeersInfo() *ConnectedPeersInfo
 	UnjoinAllTopics() error
 
@@ -274,6 +275,12 @@ type PeerDenialEvaluator interface {
 	IsInterfaceNil() bool
 }
 
+// PeerHonestyScoreHandler is able to tell the honesty score of a peer, identified by its public key
+type PeerHonestyScoreHandler interface {
+	GetScore(pk string) float64
+	IsInterfaceNil() bool
+}
+
 // ConnectionMonitorWrapper uses a connection monitor but checks if the peer is blacklisted or not
 //TODO this should be removed after merging of the PeerShardResolver and BlacklistHandler
 type ConnectionMonitorWrapper interface {
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -24,7 +25,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var log = logger.GetOrCreate("process/block")
@@ -1016,6 +1019,56 @@ func (bp *baseProcessor) saveBody(body *block.Body, header data.HeaderHandler) {
 	}
 }
 
+// saveLogsBloom stores the bloom filter of the logs generated by the transactions executed in the committed block,
+// so that the logs searches can skip the blocks which do not contain the requested addresses or identifiers. The
+// transactions sent from this shard are also checked, as the cross shard built-in function calls are executed, and
+// generate their logs, in the sender shard as well
+func (bp *baseProcessor) saveLogsBloom(headerHash []byte, body *block.Body) {
+	logsStorer := bp.store.GetStorer(dataRetriever.TxLogsUnit)
+	if check.IfNil(logsStorer) {
+		return
+	}
+
+	startTime := time.Now()
+
+	txHashes := make([][]byte, 0)
+	for _, miniBlock := range body.MiniBlocks {
+		isExecutedInSelfShard := miniBlock.ReceiverShardID == bp.shardCoordinator.SelfId() ||
+			miniBlock.SenderShardID == bp.shardCoordinator.SelfId()
+		canGenerateLogs := miniBlock.Type == block.TxBlock || miniBlock.Type == block.SmartContractResultBlock
+		if !isExecutedInSelfShard || !canGenerateLogs {
+			continue
+		}
+
+		txHashes = append(txHashes, miniBlock.TxHashes...)
+	}
+
+	logsBloom, errNotCritical := transactionLog.CreateLogsBloom(txHashes, logsStorer, bp.marshalizer)
+	if errNotCritical != nil {
+		log.Warn("saveLogsBloom.CreateLogsBloom", "error", errNotCritical.Error())
+		return
+	}
+	if logsBloom == nil {
+		return
+	}
+
+	marshalizedLogsBloom, errNotCritical := bp.marshalizer.Marshal(logsBloom)
+	if errNotCritical != nil {
+		log.Warn("saveLogsBloom.Marshal", "error", errNotCritical.Error())
+		return
+	}
+
+	errNotCritical = bp.store.Put(dataRetriever.LogsBloomUnit, headerHash, marshalizedLogsBloom)
+	if errNotCritical != nil {
+		log.Warn("saveLogsBloom.Put -> LogsBloomUnit", "error", errNotCritical.Error())
+	}
+
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.PutInStorerMaxTime {
+		log.Warn("saveLogsBloom", "elapsed time", elapsedTime)
+	}
+}
+
 func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
 	startTime := time.Now()
 
@@ -1099,8 +1152,38 @@ func (bp *baseProcessor) updateStateStorage(
 	accounts.PruneTrie(prevRootHash, data.OldRoot)
 }
 
+// setEpochForLogsPutOperation redirects only the transactions logs, which are saved while the block is processed, to
+// the storage of the block's epoch. The other storers switch the epoch when the block is committed
+func (bp *baseProcessor) setEpochForLogsPutOperation(epoch uint32) {
+	logsStorer, ok := bp.store.GetStorer(dataRetriever.TxLogsUnit).(storage.StorerWithPutInEpoch)
+	if !ok {
+		return
+	}
+
+	logsStorer.SetEpochForPutOperation(epoch)
+}
+
+// setBlockLogCorrelation attaches the block being processed to the log lines. The header hash is final only after the
+// aggregated signature was added (the header was received through sync), otherwise only the nonce is attached until
+// the block is committed
+func (bp *baseProcessor) setBlockLogCorrelation(headerHandler data.HeaderHandler) {
+	if len(headerHandler.GetSignature()) == 0 {
+		logging.SetCorrelationBlock(headerHandler.GetNonce(), nil)
+		return
+	}
+
+	headerHash, err := core.CalculateHash(bp.marshalizer, bp.hasher, headerHandler)
+	if err != nil {
+		log.Debug("setBlockLogCorrelation.CalculateHash", "error", err.Error())
+	}
+
+	logging.SetCorrelationBlock(headerHandler.GetNonce(), headerHash)
+}
+
 // RevertAccountState reverts the account state for cleanup failed process
 func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
+	logging.ClearCorrelationBlock()
+
 	for key := range bp.accountsDB {
 		err := bp.accountsDB[key].RevertToSnapshot(0)
 		if err != nil {
@@ -2,6 +2,7 @@ package block_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"reflect"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
@@ -28,7 +30,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func haveTime() time.Duration {
@@ -1205,3 +1209,107 @@ func TestAddHeaderIntoTrackerPool_ShouldWork(t *testing.T) {
 	sp.AddHeaderIntoTrackerPool(nonce, shardID)
 	assert.True(t, wasCalled)
 }
+
+func TestBaseProcessor_SaveLogsBloomShouldOnlyUseTxsExecutedInSelfShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	logBuff, _ := marshalizer.Marshal(&transaction.Log{
+		Address: []byte("contract"),
+		Events:  []*transaction.Event{{Address: []byte("contract"), Identifier: []byte("transfer")}},
+	})
+	logsStorer := mock.NewStorerMock()
+	_ = logsStorer.Put([]byte("self tx"), logBuff)
+	_ = logsStorer.Put([]byte("sent tx"), logBuff)
+	_ = logsStorer.Put([]byte("other shards tx"), logBuff)
+	_ = logsStorer.Put([]byte("reward tx"), logBuff)
+	logsBloomStorer := mock.NewStorerMock()
+
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TxLogsUnit, logsStorer)
+	store.AddStorer(dataRetriever.LogsBloomUnit, logsBloomStorer)
+
+	arguments := CreateMockArguments()
+	arguments.Store = store
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{
+		{TxHashes: [][]byte{[]byte("self tx")}, SenderShardID: 1, ReceiverShardID: 0, Type: block.TxBlock},
+		// cross shard built-in function calls are executed in the sender shard as well
+		{TxHashes: [][]byte{[]byte("sent tx")}, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock},
+		{TxHashes: [][]byte{[]byte("other shards tx")}, SenderShardID: 1, ReceiverShardID: 2, Type: block.SmartContractResultBlock},
+		{TxHashes: [][]byte{[]byte("reward tx")}, SenderShardID: core.MetachainShardId, ReceiverShardID: 0, Type: block.RewardsBlock},
+	}}
+	sp.SaveLogsBloom([]byte("header hash"), body)
+
+	logsBloomBuff, err := logsBloomStorer.Get([]byte("header hash"))
+	require.Nil(t, err)
+	logsBloom := &transaction.LogsBloom{}
+	_ = marshalizer.Unmarshal(logsBloom, logsBloomBuff)
+	assert.Equal(t, [][]byte{[]byte("self tx"), []byte("sent tx")}, logsBloom.TxHashes)
+}
+
+func TestBaseProcessor_SetEpochForLogsPutOperationShouldOnlySwitchTheLogsStorer(t *testing.T) {
+	t.Parallel()
+
+	logsStorer := genericMocks.NewStorerMock("Logs", 1)
+	txStorer := genericMocks.NewStorerMock("Transactions", 1)
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TxLogsUnit, logsStorer)
+	store.AddStorer(dataRetriever.TransactionUnit, txStorer)
+
+	arguments := CreateMockArguments()
+	arguments.Store = store
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.SetEpochForLogsPutOperation(2)
+
+	assert.Equal(t, uint32(2), logsStorer.GetCurrentEpoch())
+	assert.Equal(t, uint32(1), txStorer.GetCurrentEpoch())
+}
+
+func TestBaseProcessor_SaveLogsBloomWithoutLogsShouldNotStore(t *testing.T) {
+	t.Parallel()
+
+	logsBloomStorer := mock.NewStorerMock()
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TxLogsUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.LogsBloomUnit, logsBloomStorer)
+
+	arguments := CreateMockArguments()
+	arguments.Store = store
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{
+		{TxHashes: [][]byte{[]byte("tx")}, ReceiverShardID: 0, Type: block.TxBlock},
+	}}
+	sp.SaveLogsBloom([]byte("header hash"), body)
+
+	_, err := logsBloomStorer.Get([]byte("header hash"))
+	assert.NotNil(t, err)
+}
+
+func TestBaseProcessor_SetBlockLogCorrelationShouldAttachTheHashOnlyForFinalHeaders(t *testing.T) {
+	// not a parallel test as the block log correlation elements are global
+	defer logging.ClearCorrelationBlock()
+
+	arguments := CreateMockArguments()
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.SetBlockLogCorrelation(&block.Header{Nonce: 5})
+	nonce, hash, isSet := logging.GetCorrelationBlock()
+	assert.True(t, isSet)
+	assert.Equal(t, uint64(5), nonce)
+	assert.Equal(t, "", hash)
+
+	header := &block.Header{Nonce: 6, Signature: []byte("aggregated signature")}
+	expectedHash, _ := sp.ComputeHeaderHash(header)
+	sp.SetBlockLogCorrelation(header)
+	nonce, hash, _ = logging.GetCorrelationBlock()
+	assert.Equal(t, uint64(6), nonce)
+	assert.Equal(t, hex.EncodeToString(expectedHash), hash)
+
+	sp.RevertAccountState(header)
+	_, _, isSet = logging.GetCorrelationBlock()
+	assert.False(t, isSet)
+}
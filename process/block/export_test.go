@@ -21,6 +21,10 @@ func (bp *baseProcessor) ComputeHeaderHash(hdr data.HeaderHandler) ([]byte, erro
 	return core.CalculateHash(bp.marshalizer, bp.hasher, hdr)
 }
 
+func (bp *baseProcessor) SetEpochForLogsPutOperation(epoch uint32) {
+	bp.setEpochForLogsPutOperation(epoch)
+}
+
 func (bp *baseProcessor) VerifyStateRoot(rootHash []byte) bool {
 	return bp.verifyStateRoot(rootHash)
 }
@@ -369,3 +373,15 @@ func (mp *metaProcessor) RequestShardHeadersIfNeeded(hdrsAddedForShard map[uint3
 func (bp *baseProcessor) AddHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	bp.addHeaderIntoTrackerPool(nonce, shardID)
 }
+
+func (bp *baseProcessor) SaveLogsBloom(headerHash []byte, body *block.Body) {
+	bp.saveLogsBloom(headerHash, body)
+}
+
+func (bp *baseProcessor) SetBlockLogCorrelation(headerHandler data.HeaderHandler) {
+	bp.setBlockLogCorrelation(headerHandler)
+}
+
+func (mp *metaProcessor) VerifyScheduledActivations(header *block.MetaBlock) error {
+	return mp.verifyScheduledActivations(header)
+}
@@ -12,6 +12,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -173,7 +174,9 @@ func (mp *metaProcessor) ProcessBlock(
 	}
 
 	mp.epochNotifier.CheckEpoch(headerHandler.GetEpoch())
+	mp.setEpochForLogsPutOperation(headerHandler.GetEpoch())
 	mp.requestHandler.SetEpoch(headerHandler.GetEpoch())
+	mp.setBlockLogCorrelation(headerHandler)
 
 	log.Debug("started processing block",
 		"epoch", headerHandler.GetEpoch(),
@@ -353,6 +356,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.verifyScheduledActivations(header)
+	if err != nil {
+		return err
+	}
+
 	currentRootHash, err := mp.validatorStatisticsProcessor.RootHash()
 	if err != nil {
 		return err
@@ -681,10 +689,12 @@ func (mp *metaProcessor) CreateBlock(
 		return nil, nil, process.ErrWrongTypeAssertion
 	}
 
+	logging.SetCorrelationBlock(metaHdr.GetNonce(), nil)
 	mp.epochStartTrigger.Update(initialHdr.GetRound(), initialHdr.GetNonce())
 	metaHdr.SetEpoch(mp.epochStartTrigger.Epoch())
 	metaHdr.SoftwareVersion = []byte(mp.headerIntegrityVerifier.GetVersion(metaHdr.Epoch))
 	mp.epochNotifier.CheckEpoch(metaHdr.GetEpoch())
+	mp.setEpochForLogsPutOperation(metaHdr.GetEpoch())
 	mp.blockChainHook.SetCurrentHeader(initialHdr)
 
 	var body data.BodyHandler
@@ -753,6 +763,21 @@ func (mp *metaProcessor) processIfFirstBlockAfterEpochStart() error {
 	return nil
 }
 
+// verifyScheduledActivations checks that the epoch start meta block carries, in its reserved field, the activations
+// scheduled by the governance contract
+func (mp *metaProcessor) verifyScheduledActivations(header *block.MetaBlock) error {
+	scheduledActivations, err := mp.epochSystemSCProcessor.ScheduledActivationsData()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(scheduledActivations, header.Reserved) {
+		return process.ErrScheduledActivationsMismatch
+	}
+
+	return nil
+}
+
 func (mp *metaProcessor) updateEpochStartHeader(metaHdr *block.MetaBlock) error {
 	sw := core.NewStopWatch()
 	sw.Start("createEpochStartForMetablock")
@@ -768,6 +793,11 @@ func (mp *metaProcessor) updateEpochStartHeader(metaHdr *block.MetaBlock) error
 
 	metaHdr.EpochStart = *epochStart
 
+	metaHdr.Reserved, err = mp.epochSystemSCProcessor.ScheduledActivationsData()
+	if err != nil {
+		return err
+	}
+
 	totalAccumulatedFeesInEpoch := big.NewInt(0)
 	totalDevFeesInEpoch := big.NewInt(0)
 	currentHeader := mp.blockChain.GetCurrentBlockHeader()
@@ -1090,6 +1120,7 @@ func (mp *metaProcessor) CommitBlock(
 		if err != nil {
 			mp.RevertAccountState(headerHandler)
 		}
+		logging.ClearCorrelationBlock()
 	}()
 
 	err = checkForNils(headerHandler, bodyHandler)
@@ -1132,8 +1163,10 @@ func (mp *metaProcessor) CommitBlock(
 
 	mp.commitEpochStart(header, body)
 	headerHash := mp.hasher.Compute(string(marshalizedHeader))
+	logging.SetCorrelationBlock(header.GetNonce(), headerHash)
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body, header)
+	mp.saveLogsBloom(headerHash, body)
 
 	err = mp.commitAll()
 	if err != nil {
@@ -2682,3 +2682,22 @@ func TestMetaProcessor_CreateAndProcessBlockCallsProcessAfterFirstEpoch(t *testi
 	assert.Nil(t, err)
 	assert.True(t, toggleCalled, calledSaveNodesCoordinator)
 }
+
+func TestMetaProcessor_VerifyScheduledActivations(t *testing.T) {
+	t.Parallel()
+
+	scheduledActivations := []byte("scheduled activations")
+	arguments := createMockMetaArguments()
+	arguments.EpochSystemSCProcessor = &mock.EpochStartSystemSCStub{
+		ScheduledActivationsDataCalled: func() ([]byte, error) {
+			return scheduledActivations, nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	err := mp.VerifyScheduledActivations(&block.MetaBlock{Reserved: []byte("other activations")})
+	assert.Equal(t, process.ErrScheduledActivationsMismatch, err)
+
+	err = mp.VerifyScheduledActivations(&block.MetaBlock{Reserved: scheduledActivations})
+	assert.Nil(t, err)
+}
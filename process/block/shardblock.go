@@ -10,6 +10,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -129,7 +130,9 @@ func (sp *shardProcessor) ProcessBlock(
 	}
 
 	sp.epochNotifier.CheckEpoch(headerHandler.GetEpoch())
+	sp.setEpochForLogsPutOperation(headerHandler.GetEpoch())
 	sp.requestHandler.SetEpoch(headerHandler.GetEpoch())
+	sp.setBlockLogCorrelation(headerHandler)
 
 	log.Debug("started processing block",
 		"epoch", headerHandler.GetEpoch(),
@@ -690,6 +693,7 @@ func (sp *shardProcessor) CreateBlock(
 	}
 
 	sp.createBlockStarted()
+	logging.SetCorrelationBlock(shardHdr.GetNonce(), nil)
 
 	if sp.epochStartTrigger.IsEpochStart() {
 		log.Debug("CreateBlock", "IsEpochStart", sp.epochStartTrigger.IsEpochStart(),
@@ -699,6 +703,7 @@ func (sp *shardProcessor) CreateBlock(
 
 	shardHdr.SetEpoch(sp.epochStartTrigger.MetaEpoch())
 	sp.epochNotifier.CheckEpoch(shardHdr.GetEpoch())
+	sp.setEpochForLogsPutOperation(shardHdr.GetEpoch())
 	sp.blockChainHook.SetCurrentHeader(shardHdr)
 	shardHdr.SoftwareVersion = []byte(sp.headerIntegrityVerifier.GetVersion(shardHdr.Epoch))
 	body, err := sp.createBlockBody(shardHdr, haveTime)
@@ -753,6 +758,7 @@ func (sp *shardProcessor) CommitBlock(
 		if err != nil {
 			sp.RevertAccountState(headerHandler)
 		}
+		logging.ClearCorrelationBlock()
 	}()
 
 	err = checkForNils(headerHandler, bodyHandler)
@@ -794,6 +800,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	headerHash := sp.hasher.Compute(string(marshalizedHeader))
+	logging.SetCorrelationBlock(header.GetNonce(), headerHash)
 
 	sp.saveShardHeader(header, headerHash, marshalizedHeader)
 
@@ -804,6 +811,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.saveBody(body, header)
+	sp.saveLogsBloom(headerHash, body)
 
 	processedMetaHdrs, err := sp.getOrderedProcessedMetaBlocksFromHeader(header)
 	if err != nil {
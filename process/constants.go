@@ -67,6 +67,10 @@ const MaxSyncWithErrorsAllowed = 10
 // if they are missing
 const MaxHeadersToRequestInAdvance = 20
 
+// MaxSyncPipelineWindowSize defines the maximum number of upcoming nonces for which the headers and the bodies can be
+// requested and verified ahead of execution, when the pipelined sync is enabled
+const MaxSyncPipelineWindowSize = 500
+
 // RoundModulusTrigger defines a round modulus on which a trigger for an action will be released
 const RoundModulusTrigger = 5
 
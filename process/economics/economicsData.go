@@ -39,6 +39,7 @@ type economicsData struct {
 	minInflation                     float64
 	yearSettings                     map[uint32]*config.YearSetting
 	mutYearSettings                  sync.RWMutex
+	epochNotifier                    process.EpochNotifier
 	flagPenalizedTooMuchGas          atomic.Flag
 	flagGasPriceModifier             atomic.Flag
 	penalizedTooMuchGasEnableEpoch   uint32
@@ -92,6 +93,7 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		developerPercentage:              args.Economics.RewardsSettings.DeveloperPercentage,
 		minInflation:                     args.Economics.GlobalSettings.MinimumInflation,
 		genesisTotalSupply:               convertedData.genesisTotalSupply,
+		epochNotifier:                    args.EpochNotifier,
 		penalizedTooMuchGasEnableEpoch:   args.PenalizedTooMuchGasEnableEpoch,
 		gasPriceModifierEnableEpoch:      args.GasPriceModifierEnableEpoch,
 		gasPriceModifier:                 args.Economics.FeeSettings.GasPriceModifier,
@@ -441,10 +443,10 @@ func (ed *economicsData) ComputeTxFeeBasedOnGasUsed(tx process.TransactionWithFe
 
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (ed *economicsData) EpochConfirmed(epoch uint32) {
-	ed.flagPenalizedTooMuchGas.Toggle(epoch >= ed.penalizedTooMuchGasEnableEpoch)
+	ed.flagPenalizedTooMuchGas.Toggle(epoch >= ed.epochNotifier.ActivationEpoch(core.PenalizedTooMuchGasFeature, ed.penalizedTooMuchGasEnableEpoch))
 	log.Debug("economics: penalized too much gas", "enabled", ed.flagPenalizedTooMuchGas.IsSet())
 
-	ed.flagGasPriceModifier.Toggle(epoch >= ed.gasPriceModifierEnableEpoch)
+	ed.flagGasPriceModifier.Toggle(epoch >= ed.epochNotifier.ActivationEpoch(core.GasPriceModifierFeature, ed.gasPriceModifierEnableEpoch))
 	log.Debug("economics: gas price modifier", "enabled", ed.flagGasPriceModifier.IsSet())
 	ed.statusHandler.SetStringValue(core.MetricGasPriceModifier, fmt.Sprintf("%g", ed.GasPriceModifier()))
 }
@@ -632,3 +632,25 @@ func TestEconomicsData_ComputeGasUsedAndFeeBasedOnRefundValueCheck(t *testing.T)
 	require.Equal(t, expectedGasUsed, gasUsed)
 	require.Equal(t, expectedFee, fee)
 }
+
+func TestEconomicsData_EpochConfirmedShouldUseTheScheduledActivationEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(0.5)
+	args.GasPriceModifierEnableEpoch = 10
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		ActivationEpochCalled: func(featureName string, defaultEpoch uint32) uint32 {
+			if featureName == core.GasPriceModifierFeature {
+				return 5
+			}
+			return defaultEpoch
+		},
+	}
+	economicsData, _ := economics.NewEconomicsData(args)
+
+	economicsData.EpochConfirmed(4)
+	assert.Equal(t, 1.0, economicsData.GasPriceModifier())
+
+	economicsData.EpochConfirmed(5)
+	assert.Equal(t, 0.5, economicsData.GasPriceModifier())
+}
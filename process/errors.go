@@ -946,3 +946,19 @@ var ErrMaxAccumulatedFeesExceeded = errors.New("max accumulated fees has been ex
 
 // ErrMaxDeveloperFeesExceeded signals that max developer fees has been exceeded
 var ErrMaxDeveloperFeesExceeded = errors.New("max developer fees has been exceeded")
+
+// ErrInvalidSyncPipelineWindowSize signals that an invalid sync pipeline window size has been provided
+var ErrInvalidSyncPipelineWindowSize = errors.New("invalid sync pipeline window size")
+
+// ErrFloodPreventerNotFound signals that no flood preventer with the provided name was found
+var ErrFloodPreventerNotFound = errors.New("flood preventer not found")
+
+// ErrBlackListNotEditable signals that the peers black list does not support removing peers
+var ErrBlackListNotEditable = errors.New("peers black list does not support removing peers")
+
+// ErrAntifloodDisabled signals that the antiflood component is disabled
+var ErrAntifloodDisabled = errors.New("antiflood is disabled")
+
+// ErrScheduledActivationsMismatch signals that the scheduled activations from the epoch start meta block are not the
+// same as the ones kept by the governance contract
+var ErrScheduledActivationsMismatch = errors.New("scheduled activations mismatch")
@@ -19,6 +19,8 @@ const (
 	AccountTrieNodesTopic = "accountTrieNodes"
 	// ValidatorTrieNodesTopic is used for sharding validator state trie nodes
 	ValidatorTrieNodesTopic = "validatorTrieNodes"
+	// AccountTrieProofsTopic is used for sharing Merkle proofs of accounts
+	AccountTrieProofsTopic = "accountTrieProofs"
 )
 
 // SystemVirtualMachine is a byte array identifier for the smart contract address created for system VM
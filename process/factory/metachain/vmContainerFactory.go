@@ -37,6 +37,7 @@ type vmContainerFactory struct {
 	systemSCConfig         *config.SystemSmartContractsConfig
 	epochNotifier          process.EpochNotifier
 	addressPubKeyConverter core.PubkeyConverter
+	chainID                []byte
 }
 
 // ArgsNewVMContainerFactory defines the arguments needed to create a new VM container factory
@@ -52,6 +53,7 @@ type ArgsNewVMContainerFactory struct {
 	ValidatorAccountsDB state.AccountsAdapter
 	ChanceComputer      sharding.ChanceComputer
 	EpochNotifier       process.EpochNotifier
+	ChainID             []byte
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -86,6 +88,9 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 	if check.IfNil(args.ArgBlockChainHook.PubkeyConv) {
 		return nil, vm.ErrNilAddressPubKeyConverter
 	}
+	if len(args.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
 
 	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(args.ArgBlockChainHook)
 	if err != nil {
@@ -107,6 +112,7 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 		chanceComputer:         args.ChanceComputer,
 		epochNotifier:          args.EpochNotifier,
 		addressPubKeyConverter: args.ArgBlockChainHook.PubkeyConv,
+		chainID:                args.ChainID,
 	}, nil
 }
 
@@ -171,6 +177,7 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 		Economics:              vmf.economics,
 		EpochNotifier:          vmf.epochNotifier,
 		AddressPubKeyConverter: vmf.addressPubKeyConverter,
+		ChainID:                vmf.chainID,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
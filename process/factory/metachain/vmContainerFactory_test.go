@@ -52,6 +52,7 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Hasher:              &mock.HasherMock{},
 		Marshalizer:         &mock.MarshalizerMock{},
+		ChainID:             []byte("chain ID"),
 		SystemSCConfig: &config.SystemSmartContractsConfig{
 			ESDTSystemSCConfig: config.ESDTSystemSCConfig{
 				BaseIssuingCost: "100000000",
@@ -134,6 +135,7 @@ func TestVmContainerFactory_Create(t *testing.T) {
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Hasher:              &mock.HasherMock{},
 		Marshalizer:         &mock.MarshalizerMock{},
+		ChainID:             []byte("chain ID"),
 		SystemSCConfig: &config.SystemSmartContractsConfig{
 			ESDTSystemSCConfig: config.ESDTSystemSCConfig{
 				BaseIssuingCost: "100000000",
@@ -234,6 +236,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["ChangeRewardAddress"] = value
 	gasMap["ChangeValidatorKeys"] = value
 	gasMap["UnJail"] = value
+	gasMap["Slash"] = value
 	gasMap["ESDTIssue"] = value
 	gasMap["ESDTOperations"] = value
 	gasMap["Proposal"] = value
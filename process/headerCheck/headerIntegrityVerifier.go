@@ -145,7 +145,7 @@ func (hdrIntVer *headerIntegrityVerifier) setInCache(epoch uint32, version strin
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
+	if len(hdr.GetReserved()) > 0 && !isEpochStartMetaBlock(hdr) {
 		return process.ErrReservedFieldNotSupportedYet
 	}
 
@@ -157,6 +157,12 @@ func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
 	return hdrIntVer.checkChainID(hdr)
 }
 
+// isEpochStartMetaBlock returns true for the epoch start meta blocks, which carry the scheduled activations in their
+// reserved field
+func isEpochStartMetaBlock(hdr data.HeaderHandler) bool {
+	return hdr.GetShardID() == core.MetachainShardId && hdr.IsStartOfEpochBlock()
+}
+
 func (hdrIntVer *headerIntegrityVerifier) checkVersionLength(version []byte) error {
 	if len(version) == 0 || len(version) > core.MaxSoftwareVersionLengthInBytes {
 		return fmt.Errorf("%w when checking lenghts", ErrInvalidSoftwareVersion)
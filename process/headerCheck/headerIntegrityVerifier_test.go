@@ -174,6 +174,32 @@ func TestHeaderIntegrityVerifier_PopulatedReservedShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
 }
 
+func TestHeaderIntegrityVerifier_PopulatedReservedInEpochStartMetaBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.MetaBlock{
+		ChainID:         []byte("chainID"),
+		SoftwareVersion: []byte("v1"),
+		Reserved:        []byte("scheduled activations"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{}},
+		},
+	}
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		[]byte("chainID"),
+		[]config.VersionByEpochs{
+			{
+				StartEpoch: 0,
+				Version:    "*",
+			},
+		},
+		defaultVersion,
+		&testscommon.CacherStub{},
+	)
+	err := hdrIntVer.Verify(hdr)
+	assert.Nil(t, err)
+}
+
 func TestHeaderIntegrityVerifier_VerifySoftwareVersionEmptyVersionInHeaderShouldErr(t *testing.T) {
 	t.Parallel()
 
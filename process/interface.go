@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -658,6 +659,15 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerBlackListReasonCacher is a peer black list cacher that also remembers why each peer was blacklisted and
+// allows a peer to be removed from the black list before its ban expires
+type PeerBlackListReasonCacher interface {
+	PeerBlackListCacher
+	UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error
+	Remove(pid core.PeerID)
+	GetBlacklistedPeers() []*api.BlacklistedPeer
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -771,6 +781,9 @@ type FloodPreventer interface {
 	IncreaseLoad(pid core.PeerID, size uint64) error
 	ApplyConsensusSize(size int)
 	Reset()
+	Name() string
+	GetStatus() *api.FloodPreventerStatus
+	SetMaxLimits(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
 	IsInterfaceNil() bool
 }
 
@@ -781,6 +794,7 @@ type TopicFloodPreventer interface {
 	ResetForTopic(topic string)
 	ResetForNotRegisteredTopics()
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	GetTopicsStatus() []*api.TopicFloodPreventerStatus
 	IsInterfaceNil() bool
 }
 
@@ -852,6 +866,7 @@ type EpochStartSystemSCProcessor interface {
 		rewardTxs epochStart.TransactionCacher,
 	) error
 	ToggleUnStakeUnBond(value bool) error
+	ScheduledActivationsData() ([]byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -999,11 +1014,12 @@ type NodesCoordinator interface {
 	IsInterfaceNil() bool
 }
 
-// EpochNotifier can notify upon an epoch change and provide the current epoch
+// EpochNotifier can notify upon an epoch change, provide the current epoch and the activation epochs of the protocol features
 type EpochNotifier interface {
 	RegisterNotifyHandler(handler core.EpochSubscriberHandler)
 	CurrentEpoch() uint32
 	CheckEpoch(epoch uint32)
+	ActivationEpoch(featureName string, defaultEpoch uint32) uint32
 	IsInterfaceNil() bool
 }
 
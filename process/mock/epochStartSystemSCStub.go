@@ -11,6 +11,7 @@ type EpochStartSystemSCStub struct {
 	ProcessSystemSmartContractCalled func(validatorInfos map[uint32][]*state.ValidatorInfo, nonce uint64, epoch uint32) error
 	ProcessDelegationRewardsCalled   func(miniBlocks block.MiniBlockSlice, txCache epochStart.TransactionCacher) error
 	ToggleUnStakeUnBondCalled        func(value bool) error
+	ScheduledActivationsDataCalled   func() ([]byte, error)
 }
 
 // ToggleUnStakeUnBond -
@@ -40,6 +41,14 @@ func (e *EpochStartSystemSCStub) ProcessDelegationRewards(
 	return nil
 }
 
+// ScheduledActivationsData -
+func (e *EpochStartSystemSCStub) ScheduledActivationsData() ([]byte, error) {
+	if e.ScheduledActivationsDataCalled != nil {
+		return e.ScheduledActivationsDataCalled()
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (e *EpochStartSystemSCStub) IsInterfaceNil() bool {
 	return e == nil
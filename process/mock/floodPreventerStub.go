@@ -1,12 +1,18 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core"
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
 
 // FloodPreventerStub -
 type FloodPreventerStub struct {
 	IncreaseLoadCalled       func(pid core.PeerID, size uint64) error
 	ApplyConsensusSizeCalled func(size int)
 	ResetCalled              func()
+	NameCalled               func() string
+	GetStatusCalled          func() *api.FloodPreventerStatus
+	SetMaxLimitsCalled       func(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error
 }
 
 // IncreaseLoad -
@@ -26,6 +32,33 @@ func (fps *FloodPreventerStub) Reset() {
 	fps.ResetCalled()
 }
 
+// Name -
+func (fps *FloodPreventerStub) Name() string {
+	if fps.NameCalled != nil {
+		return fps.NameCalled()
+	}
+
+	return ""
+}
+
+// GetStatus -
+func (fps *FloodPreventerStub) GetStatus() *api.FloodPreventerStatus {
+	if fps.GetStatusCalled != nil {
+		return fps.GetStatusCalled()
+	}
+
+	return &api.FloodPreventerStatus{}
+}
+
+// SetMaxLimits -
+func (fps *FloodPreventerStub) SetMaxLimits(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if fps.SetMaxLimitsCalled != nil {
+		return fps.SetMaxLimitsCalled(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (fps *FloodPreventerStub) IsInterfaceNil() bool {
 	return fps == nil
@@ -1,12 +1,16 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core"
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
 	IncreaseLoadCalled           func(pid core.PeerID, topic string, numMessages uint32) error
 	ResetForTopicCalled          func(topic string)
 	SetMaxMessagesForTopicCalled func(topic string, num uint32)
+	GetTopicsStatusCalled        func() []*api.TopicFloodPreventerStatus
 }
 
 // IncreaseLoad -
@@ -36,6 +40,15 @@ func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(topic string, num uint32) {
 	}
 }
 
+// GetTopicsStatus -
+func (t *TopicAntiFloodStub) GetTopicsStatus() []*api.TopicFloodPreventerStatus {
+	if t.GetTopicsStatusCalled != nil {
+		return t.GetTopicsStatusCalled()
+	}
+
+	return make([]*api.TopicFloodPreventerStatus, 0)
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
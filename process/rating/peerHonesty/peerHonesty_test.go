@@ -326,6 +326,23 @@ func TestP2pPeerHonesty_CheckBlacklistMinScoreReached(t *testing.T) {
 	assert.True(t, upsertCalled)
 }
 
+func TestP2pPeerHonesty_GetScoreShouldSumTheTopicsScores(t *testing.T) {
+	t.Parallel()
+
+	pph, _ := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&mock.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+	)
+
+	pk := "pk"
+	pph.ChangeScore(pk, "topic1", 3)
+	pph.ChangeScore(pk, "topic2", -1)
+
+	assert.Equal(t, float64(2), pph.GetScore(pk))
+	assert.Equal(t, float64(0), pph.GetScore("unknown pk"))
+}
+
 func TestP2pPeerHonesty_CheckBlacklistHasShouldNotCallUpsert(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +47,7 @@ type scProcessor struct {
 	vmContainer                    process.VirtualMachinesContainer
 	argsParser                     process.ArgumentsParser
 	builtInFunctions               process.BuiltInFunctionContainer
+	epochNotifier                  process.EpochNotifier
 	deployEnableEpoch              uint32
 	builtinEnableEpoch             uint32
 	penalizedTooMuchGasEnableEpoch uint32
@@ -181,6 +182,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		builtInFunctions:               args.BuiltInFunctions,
 		txLogsProcessor:                args.TxLogsProcessor,
 		badTxForwarder:                 args.BadTxForwarder,
+		epochNotifier:                  args.EpochNotifier,
 		deployEnableEpoch:              args.DeployEnableEpoch,
 		builtinEnableEpoch:             args.BuiltinEnableEpoch,
 		repairCallBackEnableEpoch:      args.RepairCallbackEnableEpoch,
@@ -2250,10 +2252,10 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32) {
 	sc.flagDeploy.Toggle(epoch >= sc.deployEnableEpoch)
 	log.Debug("scProcessor: deployment of SC", "enabled", sc.flagDeploy.IsSet())
 
-	sc.flagBuiltin.Toggle(epoch >= sc.builtinEnableEpoch)
+	sc.flagBuiltin.Toggle(epoch >= sc.epochNotifier.ActivationEpoch(core.BuiltInFunctionsFeature, sc.builtinEnableEpoch))
 	log.Debug("scProcessor: built in functions", "enabled", sc.flagBuiltin.IsSet())
 
-	sc.flagPenalizedTooMuchGas.Toggle(epoch >= sc.penalizedTooMuchGasEnableEpoch)
+	sc.flagPenalizedTooMuchGas.Toggle(epoch >= sc.epochNotifier.ActivationEpoch(core.PenalizedTooMuchGasFeature, sc.penalizedTooMuchGasEnableEpoch))
 	log.Debug("scProcessor: penalized too much gas", "enabled", sc.flagPenalizedTooMuchGas.IsSet())
 
 	sc.flagRepairCallBackData.Toggle(epoch >= sc.repairCallBackEnableEpoch)
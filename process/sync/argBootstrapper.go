@@ -37,6 +37,8 @@ type ArgBaseBootstrapper struct {
 	MiniblocksProvider  process.MiniBlockProvider
 	Uint64Converter     typeConverters.Uint64ByteSliceConverter
 	Indexer             process.Indexer
+	// PipelineWindowSize enables the pipelined sync when greater than 0
+	PipelineWindowSize uint64
 }
 
 // ArgShardBootstrapper holds all dependencies required by the bootstrap data factory in order to create
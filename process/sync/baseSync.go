@@ -10,6 +10,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -112,6 +113,13 @@ type baseBootstrap struct {
 	poolsHolder        dataRetriever.PoolsHolder
 	mutRequestHeaders  sync.Mutex
 	cancelFunc         func()
+
+	pipelineWindowSize        uint64
+	mutVerifiedChain          sync.Mutex
+	verifiedChain             []*verifiedHeader
+	isPrefetchingInPipeline   atomic.Flag
+	syncThroughputStartTime   time.Time
+	numBlocksSyncedInInterval uint64
 }
 
 // setRequestedHeaderNonce method sets the header nonce requested by the sync mechanism
@@ -447,12 +455,15 @@ func checkBootstrapNilParameters(arguments ArgBaseBootstrapper) error {
 	if check.IfNil(arguments.Indexer) {
 		return process.ErrNilIndexer
 	}
+	if arguments.PipelineWindowSize > process.MaxSyncPipelineWindowSize {
+		return process.ErrInvalidSyncPipelineWindowSize
+	}
 
 	return nil
 }
 
 func (boot *baseBootstrap) requestHeadersFromNonceIfMissing(fromNonce uint64) {
-	toNonce := core.MinUint64(fromNonce+process.MaxHeadersToRequestInAdvance-1, boot.forkDetector.ProbableHighestNonce())
+	toNonce := core.MinUint64(fromNonce+boot.numHeadersToRequestInAdvance()-1, boot.forkDetector.ProbableHighestNonce())
 
 	if fromNonce > toNonce {
 		return
@@ -493,6 +504,7 @@ func (boot *baseBootstrap) syncBlocks(ctx context.Context) {
 func (boot *baseBootstrap) doJobOnSyncBlockFail(bodyHandler data.BodyHandler, headerHandler data.HeaderHandler, err error) {
 	processBlockStarted := !check.IfNil(bodyHandler) && !check.IfNil(headerHandler)
 	isProcessWithError := processBlockStarted && err != process.ErrTimeIsOut
+	boot.resetVerifiedChain()
 
 	numSyncedWithErrors := boot.incrementSyncedWithErrorsForNonce(boot.getNonceForNextBlock())
 	allowedSyncWithErrorsLimitReached := numSyncedWithErrors >= process.MaxSyncWithErrorsAllowed
@@ -537,6 +549,7 @@ func (boot *baseBootstrap) syncBlock() error {
 	boot.computeNodeState()
 	nodeState := boot.GetNodeState()
 	if nodeState != core.NsNotSynchronized {
+		boot.resetSyncThroughput()
 		return nil
 	}
 
@@ -581,12 +594,16 @@ func (boot *baseBootstrap) syncBlock() error {
 		}
 	}()
 
-	header, err = boot.getNextHeaderRequestingIfMissing()
+	header, err = boot.getNextHeader()
 	if err != nil {
 		return err
 	}
 
-	go boot.requestHeadersFromNonceIfMissing(header.GetNonce() + 1)
+	if boot.isPipelinedSyncEnabled() {
+		go boot.prefetchBlocksInPipeline(header)
+	} else {
+		go boot.requestHeadersFromNonceIfMissing(header.GetNonce() + 1)
+	}
 
 	body, err = boot.blockBootstrapper.getBlockBodyRequestingIfMissing(header)
 	if err != nil {
@@ -627,6 +644,8 @@ func (boot *baseBootstrap) syncBlock() error {
 		"nonce", header.GetNonce(),
 	)
 
+	boot.updateSyncThroughput()
+
 	boot.cleanNoncesSyncedWithErrorsBehindFinal()
 
 	return nil
@@ -976,6 +995,7 @@ func (boot *baseBootstrap) init() {
 	boot.syncStateListeners = make([]func(bool), 0)
 	boot.requestedHashes = process.RequiredDataPool{}
 	boot.mapNonceSyncedWithErrors = make(map[uint64]uint32)
+	boot.syncThroughputStartTime = time.Now()
 }
 
 func (boot *baseBootstrap) requestHeaders(fromNonce uint64, toNonce uint64) {
@@ -227,3 +227,12 @@ func (boot *baseBootstrap) GetMapNonceSyncedWithErrorsLen() int {
 func (boot *baseBootstrap) CleanNoncesSyncedWithErrorsBehindFinal() {
 	boot.cleanNoncesSyncedWithErrorsBehindFinal()
 }
+
+func (boot *ShardBootstrap) VerifyHeadersChainAhead(header data.HeaderHandler, headerHash []byte) (int, uint64) {
+	newVerifiedHeaders, numVerifiedHeaders := boot.verifyHeadersChainAhead(header, headerHash)
+	return len(newVerifiedHeaders), numVerifiedHeaders
+}
+
+func (boot *ShardBootstrap) GetNextHeader() (data.HeaderHandler, error) {
+	return boot.getNextHeader()
+}
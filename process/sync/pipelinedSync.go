@@ -0,0 +1,199 @@
+package sync
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// syncThroughputInterval defines the time interval over which the sync throughput is measured
+const syncThroughputInterval = 10 * time.Second
+
+func (boot *baseBootstrap) isPipelinedSyncEnabled() bool {
+	return boot.pipelineWindowSize > 0
+}
+
+func (boot *baseBootstrap) numHeadersToRequestInAdvance() uint64 {
+	if boot.pipelineWindowSize > process.MaxHeadersToRequestInAdvance {
+		return boot.pipelineWindowSize
+	}
+
+	return process.MaxHeadersToRequestInAdvance
+}
+
+// getNextHeader returns the next header to be executed, taking it from the verified chain when the pipelined sync is
+// enabled and the chain already holds it
+func (boot *baseBootstrap) getNextHeader() (data.HeaderHandler, error) {
+	if boot.isPipelinedSyncEnabled() {
+		header, ok := boot.getNextVerifiedHeader()
+		if ok {
+			return header, nil
+		}
+	}
+
+	return boot.getNextHeaderRequestingIfMissing()
+}
+
+type verifiedHeader struct {
+	header data.HeaderHandler
+	hash   []byte
+}
+
+// prefetchBlocksInPipeline requests the missing headers for the window of nonces following the provided header and
+// extends, ahead of execution, the verified chain with the headers already received. The bodies are requested only for
+// the headers newly added to the chain, so that they will be in the pools when their turn to be executed comes. Only
+// one prefetch runs at a time, the calls made while another one is in progress are dropped
+func (boot *baseBootstrap) prefetchBlocksInPipeline(header data.HeaderHandler) {
+	isPrefetching := boot.isPrefetchingInPipeline.Set()
+	if isPrefetching {
+		return
+	}
+	defer boot.isPrefetchingInPipeline.Unset()
+
+	boot.requestHeadersFromNonceIfMissing(header.GetNonce() + 1)
+
+	headerHash, err := core.CalculateHash(boot.marshalizer, boot.hasher, header)
+	if err != nil {
+		log.Debug("prefetchBlocksInPipeline.CalculateHash", "error", err.Error())
+		return
+	}
+
+	newVerifiedHeaders, numVerifiedHeaders := boot.verifyHeadersChainAhead(header, headerHash)
+	for _, newVerifiedHeader := range newVerifiedHeaders {
+		boot.requestMiniBlocks(newVerifiedHeader.header)
+	}
+
+	boot.statusHandler.SetUInt64Value(core.MetricSyncPipelineVerifiedHeaders, numVerifiedHeaders)
+}
+
+// verifyHeadersChainAhead extends the verified chain which follows the provided header with the consecutive headers
+// from the pool that are linked through their previous hash. The chain is rebuilt if it does not follow the provided
+// header anymore and the walk stops at the first nonce for which no linked header exists in the pool. The headers
+// reaching the pools were already checked by the interceptors, so only the link between them remains to be verified.
+// It returns the newly verified headers and the length of the chain
+func (boot *baseBootstrap) verifyHeadersChainAhead(header data.HeaderHandler, headerHash []byte) ([]*verifiedHeader, uint64) {
+	boot.mutVerifiedChain.Lock()
+	defer boot.mutVerifiedChain.Unlock()
+
+	boot.removeVerifiedHeadersUpToNonce(header.GetNonce())
+	if len(boot.verifiedChain) > 0 && !bytes.Equal(boot.verifiedChain[0].header.GetPrevHash(), headerHash) {
+		boot.verifiedChain = nil
+	}
+
+	prevHeader, prevHash := header, headerHash
+	if len(boot.verifiedChain) > 0 {
+		lastVerifiedHeader := boot.verifiedChain[len(boot.verifiedChain)-1]
+		prevHeader, prevHash = lastVerifiedHeader.header, lastVerifiedHeader.hash
+	}
+
+	lastNonce := core.MinUint64(header.GetNonce()+boot.pipelineWindowSize, boot.forkDetector.ProbableHighestNonce())
+	newVerifiedHeaders := make([]*verifiedHeader, 0)
+	for nonce := prevHeader.GetNonce() + 1; nonce <= lastNonce; nonce++ {
+		nextHeader, nextHash, found := boot.getHeaderLinkedTo(prevHeader, prevHash)
+		if !found {
+			log.Trace("verifyHeadersChainAhead: chain is not complete", "missing nonce", nonce)
+			break
+		}
+
+		newVerifiedHeaders = append(newVerifiedHeaders, &verifiedHeader{header: nextHeader, hash: nextHash})
+		prevHeader, prevHash = nextHeader, nextHash
+	}
+	boot.verifiedChain = append(boot.verifiedChain, newVerifiedHeaders...)
+
+	return newVerifiedHeaders, uint64(len(boot.verifiedChain))
+}
+
+// getNextVerifiedHeader returns the header which follows the current block in the verified chain. The chain is dropped
+// if it does not follow the current block or if it diverges from the notarized header or from a detected fork
+func (boot *baseBootstrap) getNextVerifiedHeader() (data.HeaderHandler, bool) {
+	boot.mutVerifiedChain.Lock()
+	defer boot.mutVerifiedChain.Unlock()
+
+	if boot.forkInfo.IsDetected {
+		boot.verifiedChain = nil
+		return nil, false
+	}
+
+	nonce := boot.getNonceForNextBlock()
+	boot.removeVerifiedHeadersUpToNonce(nonce - 1)
+	if len(boot.verifiedChain) == 0 {
+		return nil, false
+	}
+
+	nextVerifiedHeader := boot.verifiedChain[0]
+	currentHash := boot.chainHandler.GetCurrentBlockHeaderHash()
+	if check.IfNil(boot.chainHandler.GetCurrentBlockHeader()) {
+		currentHash = boot.chainHandler.GetGenesisHeaderHash()
+	}
+	notarizedHash := boot.forkDetector.GetNotarizedHeaderHash(nonce)
+
+	isNextHeader := nextVerifiedHeader.header.GetNonce() == nonce &&
+		bytes.Equal(nextVerifiedHeader.header.GetPrevHash(), currentHash)
+	isNotarizedHeader := notarizedHash == nil || bytes.Equal(notarizedHash, nextVerifiedHeader.hash)
+	if !isNextHeader || !isNotarizedHeader {
+		boot.verifiedChain = nil
+		return nil, false
+	}
+
+	return nextVerifiedHeader.header, true
+}
+
+func (boot *baseBootstrap) resetVerifiedChain() {
+	boot.mutVerifiedChain.Lock()
+	boot.verifiedChain = nil
+	boot.mutVerifiedChain.Unlock()
+}
+
+func (boot *baseBootstrap) removeVerifiedHeadersUpToNonce(nonce uint64) {
+	numToRemove := 0
+	for numToRemove < len(boot.verifiedChain) && boot.verifiedChain[numToRemove].header.GetNonce() <= nonce {
+		numToRemove++
+	}
+
+	boot.verifiedChain = boot.verifiedChain[numToRemove:]
+}
+
+func (boot *baseBootstrap) getHeaderLinkedTo(prevHeader data.HeaderHandler, prevHash []byte) (data.HeaderHandler, []byte, bool) {
+	headers, hashes, err := boot.headers.GetHeadersByNonceAndShardId(prevHeader.GetNonce()+1, boot.shardCoordinator.SelfId())
+	if err != nil {
+		return nil, nil, false
+	}
+
+	for i, header := range headers {
+		isLinked := bytes.Equal(header.GetPrevHash(), prevHash) && header.GetRound() > prevHeader.GetRound()
+		if isLinked {
+			return header, hashes[i], true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// updateSyncThroughput is called after each synced block and publishes, once per measurement interval, the number
+// of blocks synced per minute
+func (boot *baseBootstrap) updateSyncThroughput() {
+	boot.numBlocksSyncedInInterval++
+
+	elapsedTime := time.Since(boot.syncThroughputStartTime)
+	if elapsedTime < syncThroughputInterval {
+		return
+	}
+
+	blocksPerMinute := uint64(float64(boot.numBlocksSyncedInInterval) * float64(time.Minute) / float64(elapsedTime))
+	boot.statusHandler.SetUInt64Value(core.MetricSyncBlocksPerMinute, blocksPerMinute)
+	log.Debug("sync throughput",
+		"blocks per minute", blocksPerMinute,
+		"pipelined", boot.isPipelinedSyncEnabled(),
+	)
+
+	boot.resetSyncThroughput()
+}
+
+func (boot *baseBootstrap) resetSyncThroughput() {
+	boot.syncThroughputStartTime = time.Now()
+	boot.numBlocksSyncedInInterval = 0
+}
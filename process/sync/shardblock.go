@@ -57,6 +57,7 @@ func NewShardBootstrap(arguments ArgShardBootstrapper) (*ShardBootstrap, error)
 		uint64Converter:     arguments.Uint64Converter,
 		poolsHolder:         arguments.PoolsHolder,
 		indexer:             arguments.Indexer,
+		pipelineWindowSize:  arguments.PipelineWindowSize,
 	}
 
 	boot := ShardBootstrap{
@@ -246,7 +247,7 @@ func (boot *ShardBootstrap) getShardHeaderFromPool(headerHash []byte) (data.Head
 
 func (boot *ShardBootstrap) requestMiniBlocksFromHeaderWithNonceIfMissing(headerHandler data.HeaderHandler) {
 	nextBlockNonce := boot.getNonceForNextBlock()
-	maxNonce := core.MinUint64(nextBlockNonce+process.MaxHeadersToRequestInAdvance-1, boot.forkDetector.ProbableHighestNonce())
+	maxNonce := core.MinUint64(nextBlockNonce+boot.numHeadersToRequestInAdvance()-1, boot.forkDetector.ProbableHighestNonce())
 	if headerHandler.GetNonce() < nextBlockNonce || headerHandler.GetNonce() > maxNonce {
 		return
 	}
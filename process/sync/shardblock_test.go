@@ -376,6 +376,18 @@ func TestNewShardBootstrap_NilBlackListHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBlackListCacher, err)
 }
 
+func TestNewShardBootstrap_InvalidPipelineWindowSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := CreateShardBootstrapMockArguments()
+	args.PipelineWindowSize = process.MaxSyncPipelineWindowSize + 1
+
+	bs, err := sync.NewShardBootstrap(args)
+
+	assert.Nil(t, bs)
+	assert.True(t, errors.Is(err, process.ErrInvalidSyncPipelineWindowSize))
+}
+
 func TestNewShardBootstrap_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1965,3 +1977,142 @@ func TestShardBootstrap_CleanNoncesSyncedWithErrorsBehindFinalShouldWork(t *test
 	assert.Equal(t, 1, bs.GetMapNonceSyncedWithErrorsLen())
 	assert.Equal(t, uint32(9), bs.GetNumSyncedWithErrorsForNonce(3))
 }
+
+func createLinkedHeadersForPipeline() ([]*block.Header, [][]byte) {
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	hdr1 := &block.Header{Nonce: 1, Round: 1}
+	hdr1Hash, _ := core.CalculateHash(marshalizer, hasher, hdr1)
+	hdr2 := &block.Header{Nonce: 2, Round: 2, PrevHash: hdr1Hash}
+	hdr2Hash, _ := core.CalculateHash(marshalizer, hasher, hdr2)
+	hdr3 := &block.Header{Nonce: 3, Round: 3, PrevHash: hdr2Hash}
+	hdr3Hash, _ := core.CalculateHash(marshalizer, hasher, hdr3)
+	hdr4 := &block.Header{Nonce: 4, Round: 4, PrevHash: []byte("not linked")}
+
+	return []*block.Header{hdr1, hdr2, hdr3, hdr4}, [][]byte{hdr1Hash, hdr2Hash, hdr3Hash, []byte("hdr4 hash")}
+}
+
+func createPipelinedShardBootstrapMockArguments(headers []*block.Header, hashes [][]byte) sync.ArgShardBootstrapper {
+	args := CreateShardBootstrapMockArguments()
+	args.PipelineWindowSize = 10
+	args.Hasher = &mock.HasherMock{}
+	args.Marshalizer = &mock.MarshalizerMock{}
+	args.ForkDetector = &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 100
+		},
+		GetNotarizedHeaderHashCalled: func(nonce uint64) []byte {
+			return nil
+		},
+	}
+	pools := createMockPools()
+	pools.HeadersCalled = func() dataRetriever.HeadersPool {
+		return &mock.HeadersCacherStub{
+			GetHeaderByNonceAndShardIdCalled: func(hdrNonce uint64, shardId uint32) ([]data.HeaderHandler, [][]byte, error) {
+				for i, hdr := range headers {
+					if hdr.Nonce == hdrNonce {
+						return []data.HeaderHandler{hdr}, [][]byte{hashes[i]}, nil
+					}
+				}
+
+				return nil, nil, errors.New("missing header")
+			},
+		}
+	}
+	args.PoolsHolder = pools
+
+	return args
+}
+
+func TestShardBootstrap_VerifyHeadersChainAheadShouldStopAtFirstUnlinkedHeader(t *testing.T) {
+	t.Parallel()
+
+	headers, hashes := createLinkedHeadersForPipeline()
+	args := createPipelinedShardBootstrapMockArguments(headers[1:], hashes[1:])
+
+	bs, _ := sync.NewShardBootstrap(args)
+	numNewVerifiedHeaders, numVerifiedHeaders := bs.VerifyHeadersChainAhead(headers[0], hashes[0])
+
+	assert.Equal(t, 2, numNewVerifiedHeaders)
+	assert.Equal(t, uint64(2), numVerifiedHeaders)
+}
+
+func TestShardBootstrap_VerifyHeadersChainAheadShouldOnlyReturnTheNewlyVerifiedHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers, hashes := createLinkedHeadersForPipeline()
+	args := createPipelinedShardBootstrapMockArguments(headers[1:], hashes[1:])
+
+	bs, _ := sync.NewShardBootstrap(args)
+	_, _ = bs.VerifyHeadersChainAhead(headers[0], hashes[0])
+	numNewVerifiedHeaders, numVerifiedHeaders := bs.VerifyHeadersChainAhead(headers[0], hashes[0])
+	assert.Equal(t, 0, numNewVerifiedHeaders)
+	assert.Equal(t, uint64(2), numVerifiedHeaders)
+
+	numNewVerifiedHeaders, numVerifiedHeaders = bs.VerifyHeadersChainAhead(headers[1], hashes[1])
+	assert.Equal(t, 0, numNewVerifiedHeaders)
+	assert.Equal(t, uint64(1), numVerifiedHeaders)
+}
+
+func TestShardBootstrap_GetNextHeaderShouldReturnTheHeaderFromTheVerifiedChain(t *testing.T) {
+	t.Parallel()
+
+	headers, hashes := createLinkedHeadersForPipeline()
+	args := createPipelinedShardBootstrapMockArguments(headers[1:], hashes[1:])
+	args.ChainHandler = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return headers[0]
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return hashes[0]
+		},
+	}
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestShardHeaderByNonceCalled: func(shardID uint32, nonce uint64) {
+			assert.Fail(t, "should not have requested the header")
+		},
+	}
+
+	bs, _ := sync.NewShardBootstrap(args)
+	_, _ = bs.VerifyHeadersChainAhead(headers[0], hashes[0])
+	header, err := bs.GetNextHeader()
+
+	assert.Nil(t, err)
+	assert.True(t, header == headers[1])
+}
+
+func TestShardBootstrap_GetNextHeaderShouldNotUseAVerifiedChainNotFollowingTheCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	headers, hashes := createLinkedHeadersForPipeline()
+	otherHdr2 := &block.Header{Nonce: 2, Round: 2, PrevHash: []byte("other hdr1 hash")}
+	args := createPipelinedShardBootstrapMockArguments(headers[1:], hashes[1:])
+	args.ChainHandler = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 1, Round: 1}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("other hdr1 hash")
+		},
+	}
+	pools := createMockPools()
+	pools.HeadersCalled = func() dataRetriever.HeadersPool {
+		return &mock.HeadersCacherStub{
+			GetHeaderByNonceAndShardIdCalled: func(hdrNonce uint64, shardId uint32) ([]data.HeaderHandler, [][]byte, error) {
+				if hdrNonce == 2 {
+					return []data.HeaderHandler{headers[1], otherHdr2}, [][]byte{hashes[1], []byte("other hdr2 hash")}, nil
+				}
+
+				return nil, nil, errors.New("missing header")
+			},
+		}
+	}
+	args.PoolsHolder = pools
+
+	bs, _ := sync.NewShardBootstrap(args)
+	_, _ = bs.VerifyHeadersChainAhead(headers[0], hashes[0])
+	header, err := bs.GetNextHeader()
+
+	assert.Nil(t, err)
+	assert.True(t, header == otherHdr2)
+}
@@ -92,7 +92,7 @@ func (pbp *p2pBlackListProcessor) ResetStatistics() {
 				"peer ID", pid.Pretty(),
 				"ban period", pbp.banDuration,
 			)
-			err := pbp.peerBlacklistCacher.Upsert(pid, pbp.banDuration)
+			err := pbp.upsertInBlacklist(pid)
 			if err != nil {
 				log.Warn("error adding peer id in peer ids cache", ""+
 					"pid", p2p.PeerIdToShortString(pid),
@@ -103,6 +103,17 @@ func (pbp *p2pBlackListProcessor) ResetStatistics() {
 	}
 }
 
+func (pbp *p2pBlackListProcessor) upsertInBlacklist(pid core.PeerID) error {
+	reasonCacher, ok := pbp.peerBlacklistCacher.(process.PeerBlackListReasonCacher)
+	if !ok {
+		return pbp.peerBlacklistCacher.Upsert(pid, pbp.banDuration)
+	}
+
+	reason := fmt.Sprintf("flooding detected by the %s flood preventer", pbp.name)
+
+	return reasonCacher.UpsertWithReason(pid, pbp.banDuration, reason)
+}
+
 func (pbp *p2pBlackListProcessor) getFloodingValue(key []byte) (uint32, bool) {
 	obj, ok := pbp.cacher.Peek(key)
 	if !ok {
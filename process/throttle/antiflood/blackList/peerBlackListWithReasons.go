@@ -0,0 +1,127 @@
+package blackList
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const unspecifiedReason = "unspecified"
+
+var _ process.PeerBlackListReasonCacher = (*peerBlackListWithReasons)(nil)
+
+type blacklistEntry struct {
+	timestamp time.Time
+	span      time.Duration
+	reason    string
+}
+
+type peerBlackListWithReasons struct {
+	mut     sync.RWMutex
+	entries map[core.PeerID]*blacklistEntry
+}
+
+// NewPeerBlackListWithReasons creates a peer black list that, besides the ban duration, also keeps the reason each
+// peer was blacklisted for. It behaves like the peer time cache: upserting an existing peer resets its timestamp and
+// keeps the larger of the two spans
+func NewPeerBlackListWithReasons() *peerBlackListWithReasons {
+	return &peerBlackListWithReasons{
+		entries: make(map[core.PeerID]*blacklistEntry),
+	}
+}
+
+// Upsert adds or refreshes a peer in the black list without specifying a reason
+func (pbl *peerBlackListWithReasons) Upsert(pid core.PeerID, span time.Duration) error {
+	return pbl.UpsertWithReason(pid, span, unspecifiedReason)
+}
+
+// UpsertWithReason adds or refreshes a peer in the black list. The reason is replaced with the provided one
+func (pbl *peerBlackListWithReasons) UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+
+	pbl.mut.Lock()
+	defer pbl.mut.Unlock()
+
+	existing, found := pbl.entries[pid]
+	if found {
+		if existing.span < span {
+			existing.span = span
+		}
+		existing.timestamp = time.Now()
+		existing.reason = reason
+
+		return nil
+	}
+
+	pbl.entries[pid] = &blacklistEntry{
+		timestamp: time.Now(),
+		span:      span,
+		reason:    reason,
+	}
+
+	return nil
+}
+
+// Has returns true if the peer is blacklisted
+func (pbl *peerBlackListWithReasons) Has(pid core.PeerID) bool {
+	pbl.mut.RLock()
+	defer pbl.mut.RUnlock()
+
+	_, found := pbl.entries[pid]
+
+	return found
+}
+
+// Remove unbans the provided peer
+func (pbl *peerBlackListWithReasons) Remove(pid core.PeerID) {
+	pbl.mut.Lock()
+	delete(pbl.entries, pid)
+	pbl.mut.Unlock()
+}
+
+// Sweep removes the peers whose ban expired
+func (pbl *peerBlackListWithReasons) Sweep() {
+	pbl.mut.Lock()
+	defer pbl.mut.Unlock()
+
+	for pid, entry := range pbl.entries {
+		if time.Since(entry.timestamp) > entry.span {
+			delete(pbl.entries, pid)
+		}
+	}
+}
+
+// GetBlacklistedPeers returns the blacklisted peers, along with the reason and the ban expiry, sorted by the expiry
+func (pbl *peerBlackListWithReasons) GetBlacklistedPeers() []*api.BlacklistedPeer {
+	pbl.mut.RLock()
+	peers := make([]*api.BlacklistedPeer, 0, len(pbl.entries))
+	for pid, entry := range pbl.entries {
+		peers = append(peers, &api.BlacklistedPeer{
+			Pid:       pid.Pretty(),
+			Reason:    entry.reason,
+			ExpiresAt: entry.timestamp.Add(entry.span).Unix(),
+		})
+	}
+	pbl.mut.RUnlock()
+
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].ExpiresAt == peers[j].ExpiresAt {
+			return peers[i].Pid < peers[j].Pid
+		}
+
+		return peers[i].ExpiresAt < peers[j].ExpiresAt
+	})
+
+	return peers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pbl *peerBlackListWithReasons) IsInterfaceNil() bool {
+	return pbl == nil
+}
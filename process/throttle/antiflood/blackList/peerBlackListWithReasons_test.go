@@ -0,0 +1,89 @@
+package blackList_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerBlackListWithReasons_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+
+	assert.False(t, check.IfNil(pbl))
+	assert.Equal(t, 0, len(pbl.GetBlacklistedPeers()))
+}
+
+func TestPeerBlackListWithReasons_UpsertWithReasonEmptyPidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+
+	err := pbl.UpsertWithReason("", time.Second, "reason")
+
+	assert.Equal(t, process.ErrEmptyPeerID, err)
+}
+
+func TestPeerBlackListWithReasons_UpsertShouldKeepTheLargerSpanAndTheLastReason(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+	pid := core.PeerID("pid")
+
+	_ = pbl.UpsertWithReason(pid, time.Hour, "first")
+	_ = pbl.UpsertWithReason(pid, time.Second, "second")
+
+	assert.True(t, pbl.Has(pid))
+	peers := pbl.GetBlacklistedPeers()
+	assert.Equal(t, 1, len(peers))
+	assert.Equal(t, pid.Pretty(), peers[0].Pid)
+	assert.Equal(t, "second", peers[0].Reason)
+	assert.True(t, peers[0].ExpiresAt >= time.Now().Add(time.Hour-time.Minute).Unix())
+}
+
+func TestPeerBlackListWithReasons_UpsertWithoutReasonShouldMarkUnspecified(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+	_ = pbl.Upsert("pid", time.Second)
+
+	peers := pbl.GetBlacklistedPeers()
+	assert.Equal(t, "unspecified", peers[0].Reason)
+}
+
+func TestPeerBlackListWithReasons_RemoveAndSweep(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+	_ = pbl.Upsert("removed", time.Hour)
+	_ = pbl.Upsert("expired", time.Nanosecond)
+	_ = pbl.Upsert("kept", time.Hour)
+
+	pbl.Remove("removed")
+	time.Sleep(time.Millisecond)
+	pbl.Sweep()
+
+	assert.False(t, pbl.Has("removed"))
+	assert.False(t, pbl.Has("expired"))
+	assert.True(t, pbl.Has("kept"))
+}
+
+func TestPeerBlackListWithReasons_GetBlacklistedPeersShouldSortByExpiry(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+	_ = pbl.UpsertWithReason("late", 2*time.Hour, "r1")
+	_ = pbl.UpsertWithReason("early", time.Hour, "r2")
+
+	peers := pbl.GetBlacklistedPeers()
+
+	assert.Equal(t, 2, len(peers))
+	assert.Equal(t, core.PeerID("early").Pretty(), peers[0].Pid)
+	assert.Equal(t, core.PeerID("late").Pretty(), peers[1].Pid)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -60,6 +61,25 @@ func (af *AntiFlood) SetDebugger(_ process.AntifloodDebugger) error {
 func (af *AntiFlood) BlacklistPeer(_ core.PeerID, _ string, _ time.Duration) {
 }
 
+// GetAntifloodStatus returns an empty status
+func (af *AntiFlood) GetAntifloodStatus() *api.AntifloodStatus {
+	return &api.AntifloodStatus{
+		FloodPreventers:  make([]*api.FloodPreventerStatus, 0),
+		Topics:           make([]*api.TopicFloodPreventerStatus, 0),
+		BlacklistedPeers: make([]*api.BlacklistedPeer, 0),
+	}
+}
+
+// SetFloodPreventerLimits returns ErrAntifloodDisabled
+func (af *AntiFlood) SetFloodPreventerLimits(_ string, _ uint32, _ uint64) error {
+	return process.ErrAntifloodDisabled
+}
+
+// UnblacklistPeer returns ErrAntifloodDisabled
+func (af *AntiFlood) UnblacklistPeer(_ core.PeerID) error {
+	return process.ErrAntifloodDisabled
+}
+
 // IsInterfaceNil return true if there is no value under the interface
 func (af *AntiFlood) IsInterfaceNil() bool {
 	return af == nil
@@ -2,6 +2,7 @@ package disabled
 
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -34,6 +35,11 @@ func (ntfp *nilTopicFloodPreventer) ResetForNotRegisteredTopics() {
 func (ntfp *nilTopicFloodPreventer) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// GetTopicsStatus returns an empty slice
+func (ntfp *nilTopicFloodPreventer) GetTopicsStatus() []*api.TopicFloodPreventerStatus {
+	return make([]*api.TopicFloodPreventerStatus, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ntfp *nilTopicFloodPreventer) IsInterfaceNil() bool {
 	return ntfp == nil
@@ -50,12 +50,7 @@ func initP2PAntiFloodAndBlackList(
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
 ) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
-	cache := timecache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
+	p2pPeerBlackList := blackList.NewPeerBlackListWithReasons()
 	publicKeysCache := timecache.NewTimeCache(defaultSpan)
 
 	fastReactingFloodPreventer, err := createFloodPreventer(
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -266,6 +267,84 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// Name returns the name of the flood preventer
+func (qfp *quotaFloodPreventer) Name() string {
+	return qfp.name
+}
+
+// GetStatus returns the current limits and the counters gathered for each peer since the last reset
+func (qfp *quotaFloodPreventer) GetStatus() *api.FloodPreventerStatus {
+	qfp.mutOperation.RLock()
+	defer qfp.mutOperation.RUnlock()
+
+	status := &api.FloodPreventerStatus{
+		Name:                          qfp.name,
+		BaseMaxNumMessagesPerPeer:     qfp.baseMaxNumMessagesPerPeer,
+		ComputedMaxNumMessagesPerPeer: qfp.computedMaxNumMessagesPerPeer,
+		MaxTotalSizePerPeer:           qfp.maxTotalSizePerPeer,
+		PercentReserved:               qfp.percentReserved,
+		Peers:                         make([]*api.FloodPreventerPeerQuota, 0),
+	}
+
+	for _, key := range qfp.cacher.Keys() {
+		val, ok := qfp.cacher.Peek(key)
+		if !ok {
+			continue
+		}
+
+		q, isQuota := val.(*quota)
+		if !isQuota {
+			continue
+		}
+
+		status.Peers = append(status.Peers, &api.FloodPreventerPeerQuota{
+			Pid:           core.PeerID(key).Pretty(),
+			NumReceived:   q.numReceivedMessages,
+			SizeReceived:  q.sizeReceivedMessages,
+			NumProcessed:  q.numProcessedMessages,
+			SizeProcessed: q.sizeProcessedMessages,
+		})
+	}
+
+	return status
+}
+
+// SetMaxLimits changes, at runtime, the maximum number of messages and the maximum total size that can be received
+// from a peer. The increase already applied because of the consensus size is kept on top of the new base value
+func (qfp *quotaFloodPreventer) SetMaxLimits(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	if baseMaxNumMessagesPerPeer < minMessages {
+		return fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			baseMaxNumMessagesPerPeer,
+			minMessages,
+		)
+	}
+	if maxTotalSizePerPeer < minTotalSize {
+		return fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxTotalSizePerPeer,
+			minTotalSize,
+		)
+	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	consensusIncrease := qfp.computedMaxNumMessagesPerPeer - qfp.baseMaxNumMessagesPerPeer
+	qfp.baseMaxNumMessagesPerPeer = baseMaxNumMessagesPerPeer
+	qfp.computedMaxNumMessagesPerPeer = baseMaxNumMessagesPerPeer + consensusIncrease
+	qfp.maxTotalSizePerPeer = maxTotalSizePerPeer
+
+	log.Debug("quotaFloodPreventer.SetMaxLimits",
+		"name", qfp.name,
+		"base", qfp.baseMaxNumMessagesPerPeer,
+		"computed", qfp.computedMaxNumMessagesPerPeer,
+		"max total size", qfp.maxTotalSizePerPeer,
+	)
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
@@ -491,3 +491,56 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+func TestQuotaFloodPreventer_GetStatusShouldReturnPeersCounters(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	arg.MaxTotalSizePerPeer = 1000
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	identifier := core.PeerID("identifier")
+	_ = qfp.IncreaseLoad(identifier, 30)
+	_ = qfp.IncreaseLoad(identifier, 40)
+
+	status := qfp.GetStatus()
+
+	assert.Equal(t, "test", status.Name)
+	assert.Equal(t, uint32(10), status.BaseMaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(1000), status.MaxTotalSizePerPeer)
+	assert.Equal(t, 1, len(status.Peers))
+	assert.Equal(t, identifier.Pretty(), status.Peers[0].Pid)
+	assert.Equal(t, uint32(2), status.Peers[0].NumReceived)
+	assert.Equal(t, uint64(70), status.Peers[0].SizeReceived)
+}
+
+func TestQuotaFloodPreventer_SetMaxLimitsInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qfp, _ := NewQuotaFloodPreventer(createDefaultArgument())
+
+	err := qfp.SetMaxLimits(minMessages-1, minTotalSize)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = qfp.SetMaxLimits(minMessages, minTotalSize-1)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestQuotaFloodPreventer_SetMaxLimitsShouldKeepTheConsensusIncrease(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	arg.IncreaseThreshold = 1000
+	arg.IncreaseFactor = 0.25
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.ApplyConsensusSize(2000)
+
+	err := qfp.SetMaxLimits(3000, 5000)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3000), qfp.baseMaxNumMessagesPerPeer)
+	assert.Equal(t, uint32(3250), qfp.computedMaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(5000), qfp.maxTotalSizePerPeer)
+}
@@ -2,11 +2,13 @@ package floodPreventers
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -158,6 +160,48 @@ func (tfp *topicFloodPreventer) maxMessagesForTopicWildcard(topic string) uint32
 	return tfp.defaultMaxMessagesPerPeer
 }
 
+// GetTopicsStatus returns, for each topic that either has a limit set or received messages since the last reset, the
+// maximum number of messages accepted from a peer and the number of messages received from each peer
+func (tfp *topicFloodPreventer) GetTopicsStatus() []*api.TopicFloodPreventerStatus {
+	tfp.mutTopicMaxMessages.RLock()
+	defer tfp.mutTopicMaxMessages.RUnlock()
+
+	topics := make(map[string]struct{})
+	for topic := range tfp.registeredTopics {
+		topics[topic] = struct{}{}
+	}
+	for topic, counters := range tfp.counterMap {
+		if len(counters) > 0 {
+			topics[topic] = struct{}{}
+		}
+	}
+
+	statuses := make([]*api.TopicFloodPreventerStatus, 0, len(topics))
+	for topic := range topics {
+		maxMessages, ok := tfp.topicMaxMessages[topic]
+		if !ok {
+			maxMessages = tfp.maxMessagesForTopicWildcard(topic)
+		}
+
+		status := &api.TopicFloodPreventerStatus{
+			Topic:              topic,
+			MaxMessagesPerPeer: maxMessages,
+			NumMessagesPerPeer: make(map[string]uint32),
+		}
+		for pid, numMessages := range tfp.counterMap[topic] {
+			status.NumMessagesPerPeer[pid.Pretty()] = numMessages
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Topic < statuses[j].Topic
+	})
+
+	return statuses
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tfp *topicFloodPreventer) IsInterfaceNil() bool {
 	return tfp == nil
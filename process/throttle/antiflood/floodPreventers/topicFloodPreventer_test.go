@@ -312,3 +312,29 @@ func TestTopicFloodPreventer_ResetForNotRegisteredTopicsWithWildcardShuldWork(t
 	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
 	assert.Nil(t, err)
 }
+
+func TestTopicFloodPreventer_GetTopicsStatusShouldReturnLimitsAndCounters(t *testing.T) {
+	t.Parallel()
+
+	defaultMaxMessages := uint32(5)
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(defaultMaxMessages)
+	tfp.SetMaxMessagesForTopic("registered", 10)
+	tfp.SetMaxMessagesForTopic("wildcard*", 20)
+	pid := core.PeerID("pid")
+	_ = tfp.IncreaseLoad(pid, "wildcard_1", 2)
+	_ = tfp.IncreaseLoad(pid, "unregistered", 3)
+
+	statuses := tfp.GetTopicsStatus()
+
+	assert.Equal(t, 4, len(statuses))
+	assert.Equal(t, "registered", statuses[0].Topic)
+	assert.Equal(t, uint32(10), statuses[0].MaxMessagesPerPeer)
+	assert.Equal(t, 0, len(statuses[0].NumMessagesPerPeer))
+	assert.Equal(t, "unregistered", statuses[1].Topic)
+	assert.Equal(t, defaultMaxMessages, statuses[1].MaxMessagesPerPeer)
+	assert.Equal(t, uint32(3), statuses[1].NumMessagesPerPeer[pid.Pretty()])
+	assert.Equal(t, "wildcard*", statuses[2].Topic)
+	assert.Equal(t, "wildcard_1", statuses[3].Topic)
+	assert.Equal(t, uint32(20), statuses[3].MaxMessagesPerPeer)
+	assert.Equal(t, uint32(2), statuses[3].NumMessagesPerPeer[pid.Pretty()])
+}
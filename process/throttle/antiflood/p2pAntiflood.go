@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
@@ -234,7 +235,7 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
 
-	err := af.blacklistHandler.Upsert(peer, duration)
+	err := af.upsertInBlacklist(peer, reason, duration)
 	if err != nil {
 		log.Warn("error adding in blacklist",
 			"pid", peer.Pretty(),
@@ -254,6 +255,62 @@ func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration
 	}
 }
 
+func (af *p2pAntiflood) upsertInBlacklist(peer core.PeerID, reason string, duration time.Duration) error {
+	reasonCacher, ok := af.blacklistHandler.(process.PeerBlackListReasonCacher)
+	if !ok {
+		return af.blacklistHandler.Upsert(peer, duration)
+	}
+
+	return reasonCacher.UpsertWithReason(peer, duration, reason)
+}
+
+// GetAntifloodStatus returns the limits and the counters of all flood preventers, the per-topic counters and the
+// currently blacklisted peers
+func (af *p2pAntiflood) GetAntifloodStatus() *api.AntifloodStatus {
+	status := &api.AntifloodStatus{
+		FloodPreventers:  make([]*api.FloodPreventerStatus, 0, len(af.floodPreventers)),
+		Topics:           af.topicPreventer.GetTopicsStatus(),
+		BlacklistedPeers: make([]*api.BlacklistedPeer, 0),
+	}
+
+	for _, fp := range af.floodPreventers {
+		status.FloodPreventers = append(status.FloodPreventers, fp.GetStatus())
+	}
+
+	reasonCacher, ok := af.blacklistHandler.(process.PeerBlackListReasonCacher)
+	if ok {
+		status.BlacklistedPeers = reasonCacher.GetBlacklistedPeers()
+	}
+
+	return status
+}
+
+// SetFloodPreventerLimits changes, at runtime, the limits of the flood preventer with the provided name
+func (af *p2pAntiflood) SetFloodPreventerLimits(name string, baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64) error {
+	for _, fp := range af.floodPreventers {
+		if fp.Name() != name {
+			continue
+		}
+
+		return fp.SetMaxLimits(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer)
+	}
+
+	return fmt.Errorf("%w, name %s", process.ErrFloodPreventerNotFound, name)
+}
+
+// UnblacklistPeer removes the provided peer from the black list before its ban expires
+func (af *p2pAntiflood) UnblacklistPeer(peer core.PeerID) error {
+	reasonCacher, ok := af.blacklistHandler.(process.PeerBlackListReasonCacher)
+	if !ok {
+		return process.ErrBlackListNotEditable
+	}
+
+	reasonCacher.Remove(peer)
+	log.Debug("removed peer from black list", "pid", peer.Pretty())
+
+	return nil
+}
+
 // Close will call the close function on all sub components
 // TODO call this after the large components managers will be implemented
 func (af *p2pAntiflood) Close() error {
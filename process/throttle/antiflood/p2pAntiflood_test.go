@@ -8,10 +8,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/stretchr/testify/assert"
 )
@@ -463,3 +465,102 @@ func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	err = afm.IsOriginatorEligibleForTopic(core.PeerID(validatorPID), "topic")
 	assert.Nil(t, err)
 }
+
+func TestP2pAntiflood_GetAntifloodStatusShouldGatherFromAllComponents(t *testing.T) {
+	t.Parallel()
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+	_ = pbl.UpsertWithReason("pid", time.Hour, "reason")
+	afm, _ := antiflood.NewP2PAntiflood(
+		pbl,
+		&mock.TopicAntiFloodStub{
+			GetTopicsStatusCalled: func() []*api.TopicFloodPreventerStatus {
+				return []*api.TopicFloodPreventerStatus{{Topic: "topic"}}
+			},
+		},
+		&mock.FloodPreventerStub{
+			GetStatusCalled: func() *api.FloodPreventerStatus {
+				return &api.FloodPreventerStatus{Name: "fp1"}
+			},
+		},
+		&mock.FloodPreventerStub{
+			GetStatusCalled: func() *api.FloodPreventerStatus {
+				return &api.FloodPreventerStatus{Name: "fp2"}
+			},
+		},
+	)
+
+	status := afm.GetAntifloodStatus()
+
+	assert.Equal(t, 2, len(status.FloodPreventers))
+	assert.Equal(t, "fp1", status.FloodPreventers[0].Name)
+	assert.Equal(t, "fp2", status.FloodPreventers[1].Name)
+	assert.Equal(t, 1, len(status.Topics))
+	assert.Equal(t, 1, len(status.BlacklistedPeers))
+	assert.Equal(t, "reason", status.BlacklistedPeers[0].Reason)
+}
+
+func TestP2pAntiflood_SetFloodPreventerLimitsNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{
+			NameCalled: func() string {
+				return "fp"
+			},
+		},
+	)
+
+	err := afm.SetFloodPreventerLimits("missing", 10, 100)
+
+	assert.True(t, errors.Is(err, process.ErrFloodPreventerNotFound))
+}
+
+func TestP2pAntiflood_SetFloodPreventerLimitsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	setCalled := false
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{
+			NameCalled: func() string {
+				return "fp"
+			},
+			SetMaxLimitsCalled: func(base uint32, maxSize uint64) error {
+				setCalled = base == 10 && maxSize == 100
+				return nil
+			},
+		},
+	)
+
+	err := afm.SetFloodPreventerLimits("fp", 10, 100)
+
+	assert.Nil(t, err)
+	assert.True(t, setCalled)
+}
+
+func TestP2pAntiflood_UnblacklistPeer(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+	err := afm.UnblacklistPeer("pid")
+	assert.Equal(t, process.ErrBlackListNotEditable, err)
+
+	pbl := blackList.NewPeerBlackListWithReasons()
+	_ = pbl.Upsert("pid", time.Hour)
+	afm, _ = antiflood.NewP2PAntiflood(
+		pbl,
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+	err = afm.UnblacklistPeer("pid")
+	assert.Nil(t, err)
+	assert.False(t, pbl.Has("pid"))
+}
@@ -39,6 +39,7 @@ type txProcessor struct {
 	argsParser                     process.ArgumentsParser
 	scrForwarder                   process.IntermediateTransactionHandler
 	signMarshalizer                marshal.Marshalizer
+	epochNotifier                  process.EpochNotifier
 	flagRelayedTx                  atomic.Flag
 	flagMetaProtection             atomic.Flag
 	relayedTxEnableEpoch           uint32
@@ -135,6 +136,7 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		argsParser:                     args.ArgsParser,
 		scrForwarder:                   args.ScrForwarder,
 		signMarshalizer:                args.SignMarshalizer,
+		epochNotifier:                  args.EpochNotifier,
 		relayedTxEnableEpoch:           args.RelayedTxEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		metaProtectionEnableEpoch:      args.MetaProtectionEnableEpoch,
@@ -858,13 +860,13 @@ func (txProc *txProcessor) executeFailedRelayedUserTx(
 
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (txProc *txProcessor) EpochConfirmed(epoch uint32) {
-	txProc.flagRelayedTx.Toggle(epoch >= txProc.relayedTxEnableEpoch)
+	txProc.flagRelayedTx.Toggle(epoch >= txProc.epochNotifier.ActivationEpoch(core.RelayedTransactionsFeature, txProc.relayedTxEnableEpoch))
 	log.Debug("txProcessor: relayed transactions", "enabled", txProc.flagRelayedTx.IsSet())
 
-	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= txProc.penalizedTooMuchGasEnableEpoch)
+	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= txProc.epochNotifier.ActivationEpoch(core.PenalizedTooMuchGasFeature, txProc.penalizedTooMuchGasEnableEpoch))
 	log.Debug("txProcessor: penalized too much gas", "enabled", txProc.flagPenalizedTooMuchGas.IsSet())
 
-	txProc.flagMetaProtection.Toggle(epoch >= txProc.metaProtectionEnableEpoch)
+	txProc.flagMetaProtection.Toggle(epoch >= txProc.epochNotifier.ActivationEpoch(core.MetaProtectionFeature, txProc.metaProtectionEnableEpoch))
 	log.Debug("txProcessor: meta protection", "enabled", txProc.flagMetaProtection.IsSet())
 }
 
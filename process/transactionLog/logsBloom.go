@@ -0,0 +1,85 @@
+package transactionLog
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+)
+
+// CreateLogsBloom creates the logs bloom of a block from the logs generated by the provided transactions. The bloom
+// holds the log addresses, the event addresses and the event identifiers. It returns nil if none of the
+// transactions generated logs
+func CreateLogsBloom(
+	txHashes [][]byte,
+	logsStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
+) (*transaction.LogsBloom, error) {
+	if check.IfNil(logsStorer) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	filter := bloom.NewDefaultFilter()
+	txHashesWithLogs := make([][]byte, 0)
+	for _, txHash := range txHashes {
+		txLogBuff, err := logsStorer.Get(txHash)
+		if err != nil {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err = marshalizer.Unmarshal(txLog, txLogBuff)
+		if err != nil {
+			return nil, err
+		}
+
+		addLogToBloom(filter, txLog)
+		txHashesWithLogs = append(txHashesWithLogs, txHash)
+	}
+
+	if len(txHashesWithLogs) == 0 {
+		return nil, nil
+	}
+
+	return &transaction.LogsBloom{
+		Bloom:    filter.Bytes(),
+		TxHashes: txHashesWithLogs,
+	}, nil
+}
+
+func addLogToBloom(filter *bloom.Bloom, txLog *transaction.Log) {
+	filter.Add(txLog.Address)
+	for _, event := range txLog.Events {
+		filter.Add(event.Address)
+		filter.Add(event.Identifier)
+	}
+}
+
+// LogsBloomMayContain returns true if all the provided non-empty values might have been added in the logs bloom
+// and false if at least one of them was definitely not added
+func LogsBloomMayContain(logsBloom *transaction.LogsBloom, values ...[]byte) (bool, error) {
+	if logsBloom == nil {
+		return false, nil
+	}
+
+	filter, err := bloom.NewDefaultFilterFromBytes(logsBloom.Bloom)
+	if err != nil {
+		return false, err
+	}
+
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		if !filter.MayContain(value) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,63 @@
+package transactionLog_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLogsBloom_NilStorerShouldErr(t *testing.T) {
+	logsBloom, err := transactionLog.CreateLogsBloom([][]byte{[]byte("txhash")}, nil, &mock.MarshalizerMock{})
+
+	require.Nil(t, logsBloom)
+	require.Equal(t, process.ErrNilStore, err)
+}
+
+func TestCreateLogsBloom_NoLogsShouldReturnNil(t *testing.T) {
+	logsBloom, err := transactionLog.CreateLogsBloom([][]byte{[]byte("txhash")}, mock.NewStorerMock(), &mock.MarshalizerMock{})
+
+	require.Nil(t, logsBloom)
+	require.Nil(t, err)
+}
+
+func TestCreateLogsBloom_ShouldContainAddressesAndIdentifiers(t *testing.T) {
+	storer := mock.NewStorerMock()
+	marshalizer := &mock.MarshalizerMock{}
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      storer,
+		Marshalizer: marshalizer,
+	})
+
+	logs := []*vmcommon.LogEntry{
+		{Address: []byte("event address"), Identifier: []byte("transfer")},
+	}
+	tx := &transaction.Transaction{RcvAddr: []byte("contract")}
+	require.Nil(t, txLogProcessor.SaveLog([]byte("txhash with logs"), tx, logs))
+
+	txHashes := [][]byte{[]byte("txhash without logs"), []byte("txhash with logs")}
+	logsBloom, err := transactionLog.CreateLogsBloom(txHashes, storer, marshalizer)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("txhash with logs")}, logsBloom.TxHashes)
+
+	mayContain, err := transactionLog.LogsBloomMayContain(logsBloom, []byte("contract"), []byte("transfer"))
+	require.Nil(t, err)
+	require.True(t, mayContain)
+
+	mayContain, _ = transactionLog.LogsBloomMayContain(logsBloom, []byte("event address"), nil)
+	require.True(t, mayContain)
+
+	mayContain, _ = transactionLog.LogsBloomMayContain(logsBloom, []byte("contract"), []byte("another identifier"))
+	require.False(t, mayContain)
+}
+
+func TestLogsBloomMayContain_InvalidBloomShouldErr(t *testing.T) {
+	mayContain, err := transactionLog.LogsBloomMayContain(&transaction.LogsBloom{Bloom: []byte("invalid")}, []byte("contract"))
+
+	require.False(t, mayContain)
+	require.NotNil(t, err)
+}
@@ -143,6 +143,25 @@ func TestTxLogProcessor_GetLogErrNotFound(t *testing.T) {
 	require.Equal(t, process.ErrLogNotFound, err)
 }
 
+func TestTxLogProcessor_GetLogShouldWork(t *testing.T) {
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      mock.NewStorerMock(),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+
+	logs := []*vmcommon.LogEntry{
+		{Address: []byte("event address"), Identifier: []byte("transfer")},
+	}
+	tx := &transaction.Transaction{RcvAddr: []byte("receiver")}
+	require.Nil(t, txLogProcessor.SaveLog([]byte("txhash"), tx, logs))
+
+	txLog, err := txLogProcessor.GetLog([]byte("txhash"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("receiver"), txLog.GetAddress())
+	require.Equal(t, 1, len(txLog.GetLogEvents()))
+	require.Equal(t, []byte("transfer"), txLog.GetLogEvents()[0].GetIdentifier())
+}
+
 func TestTxLogProcessor_GetLogUnmarshalErr(t *testing.T) {
 	retErr := errors.New("marshal error")
 	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
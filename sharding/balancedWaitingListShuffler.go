@@ -0,0 +1,49 @@
+package sharding
+
+// NewBalancedWaitingListShuffler creates a validator shuffler that selects the shuffled out validators the same way
+// the hash based shuffler does, but distributes the new and the shuffled out validators so that the waiting lists
+// of all shards (metachain included) end up with sizes as equal as possible
+func NewBalancedWaitingListShuffler(args *NodesShufflerArgs) (*randHashShuffler, error) {
+	rxs, err := NewHashValidatorsShuffler(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rxs.balanceWaitingLists = true
+
+	return rxs, nil
+}
+
+// distributeValidatorsBalanced distributes the new nodes and the shuffled out nodes, always adding the next
+// validator in the waiting list with the smallest size. Ties are broken by choosing the smallest shard ID
+func distributeValidatorsBalanced(
+	destLists map[uint32][]Validator,
+	newNodes []Validator,
+	shuffledOut map[uint32][]Validator,
+	randomness []byte,
+) error {
+	if len(destLists) == 0 {
+		return ErrNilOrEmptyDestinationForDistribute
+	}
+
+	validators := make([]Validator, 0, len(newNodes))
+	validators = append(validators, newNodes...)
+	for _, shardId := range sortKeys(shuffledOut) {
+		validators = append(validators, shuffledOut[shardId]...)
+	}
+
+	shuffledValidators := shuffleList(validators, randomness)
+	sortedShardIds := sortKeys(destLists)
+	for _, v := range shuffledValidators {
+		selectedShardId := sortedShardIds[0]
+		for _, shardId := range sortedShardIds[1:] {
+			if len(destLists[shardId]) < len(destLists[selectedShardId]) {
+				selectedShardId = shardId
+			}
+		}
+
+		destLists[selectedShardId] = append(destLists[selectedShardId], v)
+	}
+
+	return nil
+}
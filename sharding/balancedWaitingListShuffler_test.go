@@ -0,0 +1,81 @@
+package sharding
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBalancedWaitingListShuffler_NilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shuffler, err := NewBalancedWaitingListShuffler(nil)
+
+	assert.Nil(t, shuffler)
+	assert.Equal(t, ErrNilNodeShufflerArguments, err)
+}
+
+func TestBalancedWaitingListShuffler_UpdateNodeListsShouldBalanceWaitingLists(t *testing.T) {
+	t.Parallel()
+
+	shuffler, _ := NewBalancedWaitingListShuffler(&NodesShufflerArgs{
+		NodesShard:           eligiblePerShard,
+		NodesMeta:            eligiblePerShard,
+		Hysteresis:           hysteresis,
+		ShuffleBetweenShards: true,
+	})
+	nbShards := uint32(3)
+	args := createShufflerArgs(eligiblePerShard, waitingPerShard, nbShards)
+	args.Waiting[0] = args.Waiting[0][:5]
+	args.NewNodes = generateValidatorList(17)
+
+	res, err := shuffler.UpdateNodeLists(args)
+	require.Nil(t, err)
+
+	minWaiting, maxWaiting := len(res.Waiting[core.MetachainShardId]), len(res.Waiting[core.MetachainShardId])
+	numWaiting := 0
+	for shardId := uint32(0); shardId < nbShards; shardId++ {
+		assert.Equal(t, eligiblePerShard, len(res.Eligible[shardId]))
+		numWaiting += len(res.Waiting[shardId])
+		if len(res.Waiting[shardId]) < minWaiting {
+			minWaiting = len(res.Waiting[shardId])
+		}
+		if len(res.Waiting[shardId]) > maxWaiting {
+			maxWaiting = len(res.Waiting[shardId])
+		}
+	}
+	numWaiting += len(res.Waiting[core.MetachainShardId])
+
+	assert.Equal(t, 5+3*waitingPerShard+17, numWaiting)
+	assert.True(t, maxWaiting-minWaiting <= 1)
+}
+
+func TestDistributeValidatorsBalanced_EmptyDestinationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := distributeValidatorsBalanced(nil, generateValidatorList(3), nil, []byte("rand"))
+
+	assert.Equal(t, ErrNilOrEmptyDestinationForDistribute, err)
+}
+
+func TestDistributeValidatorsBalanced_ShouldFillSmallestListsFirst(t *testing.T) {
+	t.Parallel()
+
+	dest := map[uint32][]Validator{
+		0:                     generateValidatorList(5),
+		1:                     generateValidatorList(1),
+		core.MetachainShardId: generateValidatorList(3),
+	}
+	shuffledOut := map[uint32][]Validator{
+		0: generateValidatorList(2),
+	}
+
+	err := distributeValidatorsBalanced(dest, generateValidatorList(2), shuffledOut, []byte("rand"))
+	require.Nil(t, err)
+
+	assert.Equal(t, 5, len(dest[0]))
+	assert.Equal(t, 4, len(dest[1]))
+	assert.Equal(t, 4, len(dest[core.MetachainShardId]))
+}
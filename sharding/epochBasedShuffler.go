@@ -0,0 +1,111 @@
+package sharding
+
+import (
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var _ NodesShuffler = (*epochBasedShuffler)(nil)
+
+// ArgsEpochBasedShuffler holds the arguments needed to create an epoch based nodes shuffler
+type ArgsEpochBasedShuffler struct {
+	ShufflerArgs *NodesShufflerArgs
+	Registry     ShufflerRegistry
+	Strategies   []config.ShufflerStrategyConfig
+}
+
+type shufflerStrategy struct {
+	epochEnable  uint32
+	shufflerType string
+	shuffler     NodesShuffler
+}
+
+// epochBasedShuffler delegates the shuffling to the shuffler strategy active in the epoch for which the nodes lists
+// are computed
+type epochBasedShuffler struct {
+	strategies []*shufflerStrategy
+}
+
+// NewEpochBasedShuffler creates a nodes shuffler that selects the shuffling strategy by epoch. If no strategy is
+// configured, the hash based shuffler will be used starting with the genesis epoch
+func NewEpochBasedShuffler(args ArgsEpochBasedShuffler) (*epochBasedShuffler, error) {
+	if args.ShufflerArgs == nil {
+		return nil, ErrNilNodeShufflerArguments
+	}
+	if check.IfNil(args.Registry) {
+		return nil, ErrNilShufflerRegistry
+	}
+
+	strategiesConfig := args.Strategies
+	if len(strategiesConfig) == 0 {
+		strategiesConfig = []config.ShufflerStrategyConfig{{EpochEnable: 0, Type: HashShufflerType}}
+	}
+
+	strategies := make([]*shufflerStrategy, 0, len(strategiesConfig))
+	for _, strategyConfig := range strategiesConfig {
+		shuffler, err := args.Registry.Create(strategyConfig.Type, args.ShufflerArgs)
+		if err != nil {
+			return nil, err
+		}
+
+		strategies = append(strategies, &shufflerStrategy{
+			epochEnable:  strategyConfig.EpochEnable,
+			shufflerType: strategyConfig.Type,
+			shuffler:     shuffler,
+		})
+	}
+
+	sort.SliceStable(strategies, func(i, j int) bool {
+		return strategies[i].epochEnable < strategies[j].epochEnable
+	})
+	if strategies[0].epochEnable != 0 {
+		return nil, ErrMissingGenesisShufflerStrategy
+	}
+	for i := 1; i < len(strategies); i++ {
+		if strategies[i].epochEnable == strategies[i-1].epochEnable {
+			return nil, ErrDuplicatedShufflerStrategyEpoch
+		}
+	}
+
+	return &epochBasedShuffler{
+		strategies: strategies,
+	}, nil
+}
+
+// UpdateParams updates the parameters of all the contained shufflers
+func (ebs *epochBasedShuffler) UpdateParams(numNodesShard uint32, numNodesMeta uint32, hysteresis float32, adaptivity bool) {
+	for _, strategy := range ebs.strategies {
+		strategy.shuffler.UpdateParams(numNodesShard, numNodesMeta, hysteresis, adaptivity)
+	}
+}
+
+// UpdateNodeLists shuffles the nodes using the strategy active in the provided epoch
+func (ebs *epochBasedShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNodes, error) {
+	strategy := ebs.strategyForEpoch(args.Epoch)
+	log.Debug("epochBasedShuffler.UpdateNodeLists", "epoch", args.Epoch, "shuffler type", strategy.shufflerType)
+
+	return strategy.shuffler.UpdateNodeLists(args)
+}
+
+// ShufflerType returns the type of the shuffler strategy active in the provided epoch
+func (ebs *epochBasedShuffler) ShufflerType(epoch uint32) string {
+	return ebs.strategyForEpoch(epoch).shufflerType
+}
+
+func (ebs *epochBasedShuffler) strategyForEpoch(epoch uint32) *shufflerStrategy {
+	active := ebs.strategies[0]
+	for _, strategy := range ebs.strategies {
+		if epoch >= strategy.epochEnable {
+			active = strategy
+		}
+	}
+
+	return active
+}
+
+// IsInterfaceNil verifies if the underlying object is nil
+func (ebs *epochBasedShuffler) IsInterfaceNil() bool {
+	return ebs == nil
+}
@@ -0,0 +1,188 @@
+package sharding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nodesShufflerStub struct {
+	updateParamsCalled    func(numNodesShard uint32, numNodesMeta uint32, hysteresis float32, adaptivity bool)
+	updateNodeListsCalled func(args ArgsUpdateNodes) (*ResUpdateNodes, error)
+}
+
+func (nss *nodesShufflerStub) UpdateParams(numNodesShard uint32, numNodesMeta uint32, hysteresis float32, adaptivity bool) {
+	if nss.updateParamsCalled != nil {
+		nss.updateParamsCalled(numNodesShard, numNodesMeta, hysteresis, adaptivity)
+	}
+}
+
+func (nss *nodesShufflerStub) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNodes, error) {
+	if nss.updateNodeListsCalled != nil {
+		return nss.updateNodeListsCalled(args)
+	}
+
+	return &ResUpdateNodes{}, nil
+}
+
+func (nss *nodesShufflerStub) IsInterfaceNil() bool {
+	return nss == nil
+}
+
+func createMockArgsEpochBasedShuffler() ArgsEpochBasedShuffler {
+	return ArgsEpochBasedShuffler{
+		ShufflerArgs: &NodesShufflerArgs{
+			NodesShard:           eligiblePerShard,
+			NodesMeta:            eligiblePerShard,
+			Hysteresis:           hysteresis,
+			Adaptivity:           adaptivity,
+			ShuffleBetweenShards: true,
+		},
+		Registry: NewShufflerRegistry(),
+		Strategies: []config.ShufflerStrategyConfig{
+			{EpochEnable: 5, Type: NoShufflerType},
+			{EpochEnable: 0, Type: HashShufflerType},
+			{EpochEnable: 10, Type: BalancedWaitingListShufflerType},
+		},
+	}
+}
+
+func TestNewEpochBasedShuffler_NilShufflerArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEpochBasedShuffler()
+	args.ShufflerArgs = nil
+	ebs, err := NewEpochBasedShuffler(args)
+
+	assert.True(t, check.IfNil(ebs))
+	assert.Equal(t, ErrNilNodeShufflerArguments, err)
+}
+
+func TestNewEpochBasedShuffler_NilRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEpochBasedShuffler()
+	args.Registry = nil
+	ebs, err := NewEpochBasedShuffler(args)
+
+	assert.True(t, check.IfNil(ebs))
+	assert.Equal(t, ErrNilShufflerRegistry, err)
+}
+
+func TestNewEpochBasedShuffler_UnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEpochBasedShuffler()
+	args.Strategies[1].Type = "unknown"
+	ebs, err := NewEpochBasedShuffler(args)
+
+	assert.True(t, check.IfNil(ebs))
+	assert.True(t, errors.Is(err, ErrUnknownShufflerType))
+}
+
+func TestNewEpochBasedShuffler_MissingGenesisStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEpochBasedShuffler()
+	args.Strategies = args.Strategies[:1]
+	ebs, err := NewEpochBasedShuffler(args)
+
+	assert.True(t, check.IfNil(ebs))
+	assert.Equal(t, ErrMissingGenesisShufflerStrategy, err)
+}
+
+func TestNewEpochBasedShuffler_DuplicatedEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEpochBasedShuffler()
+	args.Strategies[2].EpochEnable = 5
+	ebs, err := NewEpochBasedShuffler(args)
+
+	assert.True(t, check.IfNil(ebs))
+	assert.Equal(t, ErrDuplicatedShufflerStrategyEpoch, err)
+}
+
+func TestNewEpochBasedShuffler_NoStrategiesShouldUseHashShuffler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEpochBasedShuffler()
+	args.Strategies = nil
+	ebs, err := NewEpochBasedShuffler(args)
+
+	require.Nil(t, err)
+	assert.Equal(t, HashShufflerType, ebs.ShufflerType(0))
+	assert.Equal(t, HashShufflerType, ebs.ShufflerType(100))
+}
+
+func TestEpochBasedShuffler_ShufflerTypeShouldSelectByEpoch(t *testing.T) {
+	t.Parallel()
+
+	ebs, err := NewEpochBasedShuffler(createMockArgsEpochBasedShuffler())
+	require.Nil(t, err)
+
+	assert.Equal(t, HashShufflerType, ebs.ShufflerType(0))
+	assert.Equal(t, HashShufflerType, ebs.ShufflerType(4))
+	assert.Equal(t, NoShufflerType, ebs.ShufflerType(5))
+	assert.Equal(t, NoShufflerType, ebs.ShufflerType(9))
+	assert.Equal(t, BalancedWaitingListShufflerType, ebs.ShufflerType(10))
+	assert.Equal(t, BalancedWaitingListShufflerType, ebs.ShufflerType(1000))
+}
+
+func TestEpochBasedShuffler_UpdateNodeListsShouldDelegateByEpoch(t *testing.T) {
+	t.Parallel()
+
+	calledEpochs := make(map[string][]uint32)
+	createStub := func(name string) ShufflerCreator {
+		return func(args *NodesShufflerArgs) (NodesShuffler, error) {
+			return &nodesShufflerStub{
+				updateNodeListsCalled: func(args ArgsUpdateNodes) (*ResUpdateNodes, error) {
+					calledEpochs[name] = append(calledEpochs[name], args.Epoch)
+					return &ResUpdateNodes{}, nil
+				},
+			}, nil
+		}
+	}
+
+	args := createMockArgsEpochBasedShuffler()
+	_ = args.Registry.Register("first", createStub("first"))
+	_ = args.Registry.Register("second", createStub("second"))
+	args.Strategies = []config.ShufflerStrategyConfig{
+		{EpochEnable: 0, Type: "first"},
+		{EpochEnable: 2, Type: "second"},
+	}
+	ebs, _ := NewEpochBasedShuffler(args)
+
+	for epoch := uint32(0); epoch < 4; epoch++ {
+		_, err := ebs.UpdateNodeLists(ArgsUpdateNodes{Epoch: epoch})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, []uint32{0, 1}, calledEpochs["first"])
+	assert.Equal(t, []uint32{2, 3}, calledEpochs["second"])
+}
+
+func TestEpochBasedShuffler_UpdateParamsShouldUpdateAllShufflers(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	args := createMockArgsEpochBasedShuffler()
+	_ = args.Registry.Register("stub", func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return &nodesShufflerStub{
+			updateParamsCalled: func(numNodesShard uint32, numNodesMeta uint32, hysteresis float32, adaptivity bool) {
+				numCalls++
+			},
+		}, nil
+	})
+	args.Strategies = []config.ShufflerStrategyConfig{
+		{EpochEnable: 0, Type: "stub"},
+		{EpochEnable: 2, Type: "stub"},
+	}
+	ebs, _ := NewEpochBasedShuffler(args)
+
+	ebs.UpdateParams(10, 10, 0.2, false)
+	assert.Equal(t, 2, numCalls)
+}
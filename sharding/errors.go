@@ -129,3 +129,24 @@ var ErrNilOrEmptyDestinationForDistribute = errors.New("nil or empty destination
 
 // ErrNilNodeShufflerArguments signals that a nil argument pointer was provided for creating the nodes shuffler instance
 var ErrNilNodeShufflerArguments = errors.New("nil arguments for the creation of a node shuffler")
+
+// ErrEmptyShufflerType signals that an empty shuffler type was provided
+var ErrEmptyShufflerType = errors.New("empty shuffler type")
+
+// ErrNilShufflerCreator signals that a nil shuffler creator was provided
+var ErrNilShufflerCreator = errors.New("nil shuffler creator")
+
+// ErrShufflerTypeAlreadyRegistered signals that the shuffler type was already registered
+var ErrShufflerTypeAlreadyRegistered = errors.New("shuffler type already registered")
+
+// ErrUnknownShufflerType signals that the requested shuffler type is not registered
+var ErrUnknownShufflerType = errors.New("unknown shuffler type")
+
+// ErrNilShufflerRegistry signals that a nil shuffler registry was provided
+var ErrNilShufflerRegistry = errors.New("nil shuffler registry")
+
+// ErrMissingGenesisShufflerStrategy signals that no shuffler strategy is enabled starting with epoch 0
+var ErrMissingGenesisShufflerStrategy = errors.New("missing shuffler strategy for epoch 0")
+
+// ErrDuplicatedShufflerStrategyEpoch signals that more than one shuffler strategy is enabled in the same epoch
+var ErrDuplicatedShufflerStrategyEpoch = errors.New("duplicated shuffler strategy enable epoch")
@@ -35,6 +35,7 @@ type shuffleNodesArg struct {
 	nodesPerShard          uint32
 	nbShards               uint32
 	maxNodesToSwapPerShard uint32
+	balanceWaitingLists    bool
 }
 
 // TODO: Decide if transaction load statistics will be used for limiting the number of shards
@@ -52,6 +53,7 @@ type randHashShuffler struct {
 	availableNodesConfigs []config.MaxNodesChangeConfig
 	mutShufflerParams     sync.RWMutex
 	validatorDistributor  ValidatorsDistributor
+	balanceWaitingLists   bool
 }
 
 // NewHashValidatorsShuffler creates a validator shuffler that uses a hash between validator key and a given
@@ -166,6 +168,7 @@ func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNo
 		nbShards:               args.NbShards,
 		distributor:            rhs.validatorDistributor,
 		maxNodesToSwapPerShard: rhs.activeNodesConfig.NodesToShufflePerShard,
+		balanceWaitingLists:    rhs.balanceWaitingLists,
 	})
 }
 
@@ -250,14 +253,21 @@ func shuffleNodes(arg shuffleNodesArg) (*ResUpdateNodes, error) {
 	if err != nil {
 		log.Warn("moveNodesToMap failed", "error", err)
 	}
-	err = distributeValidators(newWaiting, arg.newNodes, arg.randomness)
-	if err != nil {
-		log.Warn("distributeValidators newNodes failed", "error", err)
-	}
+	if arg.balanceWaitingLists {
+		err = distributeValidatorsBalanced(newWaiting, arg.newNodes, shuffledOutMap, arg.randomness)
+		if err != nil {
+			log.Warn("distributeValidatorsBalanced failed", "error", err)
+		}
+	} else {
+		err = distributeValidators(newWaiting, arg.newNodes, arg.randomness)
+		if err != nil {
+			log.Warn("distributeValidators newNodes failed", "error", err)
+		}
 
-	err = arg.distributor.DistributeValidators(newWaiting, shuffledOutMap, arg.randomness)
-	if err != nil {
-		log.Warn("distributeValidators shuffledOut failed", "error", err)
+		err = arg.distributor.DistributeValidators(newWaiting, shuffledOutMap, arg.randomness)
+		if err != nil {
+			log.Warn("distributeValidators shuffledOut failed", "error", err)
+		}
 	}
 
 	actualLeaving, _ := removeValidatorsFromList(allLeaving, stillRemainingInLeaving, len(stillRemainingInLeaving))
@@ -91,6 +91,14 @@ type NodesShuffler interface {
 	IsInterfaceNil() bool
 }
 
+// ShufflerRegistry is able to create nodes shufflers by their type
+type ShufflerRegistry interface {
+	Register(shufflerType string, creator ShufflerCreator) error
+	Create(shufflerType string, args *NodesShufflerArgs) (NodesShuffler, error)
+	Types() []string
+	IsInterfaceNil() bool
+}
+
 // NodesCoordinatorHelper provides polymorphism functionality for nodesCoordinator
 type NodesCoordinatorHelper interface {
 	ValidatorsWeights(validators []Validator) ([]uint32, error)
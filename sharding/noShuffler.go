@@ -0,0 +1,94 @@
+package sharding
+
+import (
+	"sync"
+)
+
+var _ NodesShuffler = (*noShuffler)(nil)
+
+// noShuffler is a NodesShuffler that never moves validators between shards and never rotates eligible validators
+// into the waiting lists. It is meant to be used on private chains where the validators set is fixed
+type noShuffler struct {
+	mutParams  sync.RWMutex
+	nodesShard uint32
+	nodesMeta  uint32
+}
+
+// NewNoShuffler creates a nodes shuffler that does not shuffle the validators
+func NewNoShuffler(args *NodesShufflerArgs) (*noShuffler, error) {
+	if args == nil {
+		return nil, ErrNilNodeShufflerArguments
+	}
+
+	ns := &noShuffler{}
+	ns.UpdateParams(args.NodesShard, args.NodesMeta, args.Hysteresis, args.Adaptivity)
+
+	return ns, nil
+}
+
+// UpdateParams updates the minimum number of nodes per shard and metachain. Hysteresis and adaptivity are ignored
+// as this shuffler never splits or merges shards
+func (ns *noShuffler) UpdateParams(nodesShard uint32, nodesMeta uint32, _ float32, _ bool) {
+	ns.mutParams.Lock()
+	ns.nodesShard = nodesShard
+	ns.nodesMeta = nodesMeta
+	ns.mutParams.Unlock()
+}
+
+// UpdateNodeLists keeps the current eligible validators in place. The leaving validators are removed (as long as the
+// shards can still sustain the removal), the waiting validators are promoted only to fill the missing eligible
+// positions and the new validators are equally distributed among the waiting lists
+func (ns *noShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNodes, error) {
+	ns.mutParams.RLock()
+	nodesShard := ns.nodesShard
+	nodesMeta := ns.nodesMeta
+	ns.mutParams.RUnlock()
+
+	eligibleCopy := copyValidatorMap(args.Eligible)
+	waitingCopy := copyValidatorMap(args.Waiting)
+	createListsForAllShards(waitingCopy, args.NbShards)
+
+	additionalLeaving := removeDupplicates(args.UnStakeLeaving, args.AdditionalLeaving)
+	allLeaving := append(append(make([]Validator, 0), args.UnStakeLeaving...), additionalLeaving...)
+
+	numToRemove, err := computeNumToRemove(shuffleNodesArg{
+		eligible:      eligibleCopy,
+		waiting:       waitingCopy,
+		nodesMeta:     nodesMeta,
+		nodesPerShard: nodesShard,
+		nbShards:      args.NbShards,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remainingUnstakeLeaving, _ := removeLeavingNodesNotExistingInEligibleOrWaiting(args.UnStakeLeaving, waitingCopy, eligibleCopy)
+	remainingAdditionalLeaving, _ := removeLeavingNodesNotExistingInEligibleOrWaiting(additionalLeaving, waitingCopy, eligibleCopy)
+
+	newEligible, newWaiting, stillRemainingUnstakeLeaving := removeLeavingNodesFromValidatorMaps(eligibleCopy, waitingCopy, numToRemove, remainingUnstakeLeaving)
+	newEligible, newWaiting, stillRemainingAdditionalLeaving := removeLeavingNodesFromValidatorMaps(newEligible, newWaiting, numToRemove, remainingAdditionalLeaving)
+	stillRemainingInLeaving := append(stillRemainingUnstakeLeaving, stillRemainingAdditionalLeaving...)
+
+	err = moveMaxNumNodesToMap(newEligible, newWaiting, nodesMeta, nodesShard)
+	if err != nil {
+		log.Warn("moveNodesToMap failed", "error", err)
+	}
+	err = distributeValidators(newWaiting, args.NewNodes, args.Rand)
+	if err != nil {
+		log.Warn("distributeValidators newNodes failed", "error", err)
+	}
+
+	actualLeaving, _ := removeValidatorsFromList(allLeaving, stillRemainingInLeaving, len(stillRemainingInLeaving))
+
+	return &ResUpdateNodes{
+		Eligible:       newEligible,
+		Waiting:        newWaiting,
+		Leaving:        actualLeaving,
+		StillRemaining: stillRemainingInLeaving,
+	}, nil
+}
+
+// IsInterfaceNil verifies if the underlying object is nil
+func (ns *noShuffler) IsInterfaceNil() bool {
+	return ns == nil
+}
@@ -0,0 +1,68 @@
+package sharding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNoShuffler_NilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ns, err := NewNoShuffler(nil)
+
+	assert.True(t, check.IfNil(ns))
+	assert.Equal(t, ErrNilNodeShufflerArguments, err)
+}
+
+func TestNoShuffler_UpdateNodeListsShouldKeepEligible(t *testing.T) {
+	t.Parallel()
+
+	ns, _ := NewNoShuffler(&NodesShufflerArgs{NodesShard: eligiblePerShard, NodesMeta: eligiblePerShard})
+	args := createShufflerArgs(eligiblePerShard, waitingPerShard, 2)
+	args.NewNodes = generateValidatorList(6)
+
+	res, err := ns.UpdateNodeLists(args)
+	require.Nil(t, err)
+
+	for shardId, eligible := range args.Eligible {
+		assert.Equal(t, eligible, res.Eligible[shardId])
+		assert.Equal(t, waitingPerShard+2, len(res.Waiting[shardId]))
+	}
+	assert.Equal(t, 0, len(res.Leaving))
+}
+
+func TestNoShuffler_UpdateNodeListsLeavingShouldBeReplacedFromWaiting(t *testing.T) {
+	t.Parallel()
+
+	ns, _ := NewNoShuffler(&NodesShufflerArgs{NodesShard: eligiblePerShard, NodesMeta: eligiblePerShard})
+	args := createShufflerArgs(eligiblePerShard, waitingPerShard, 1)
+	leavingEligible := args.Eligible[0][0]
+	args.UnStakeLeaving = []Validator{leavingEligible}
+
+	res, err := ns.UpdateNodeLists(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, []Validator{leavingEligible}, res.Leaving)
+	assert.Equal(t, 0, len(res.StillRemaining))
+	assert.Equal(t, eligiblePerShard, len(res.Eligible[0]))
+	assert.Equal(t, waitingPerShard-1, len(res.Waiting[0]))
+	assert.False(t, contains(res.Eligible[0], []Validator{leavingEligible}))
+	assert.Equal(t, args.Eligible[core.MetachainShardId], res.Eligible[core.MetachainShardId])
+}
+
+func TestNoShuffler_UpdateNodeListsNotEnoughValidatorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ns, _ := NewNoShuffler(&NodesShufflerArgs{NodesShard: eligiblePerShard + waitingPerShard + 1, NodesMeta: eligiblePerShard})
+	args := createShufflerArgs(eligiblePerShard, waitingPerShard, 1)
+
+	res, err := ns.UpdateNodeLists(args)
+
+	assert.Nil(t, res)
+	assert.True(t, errors.Is(err, ErrSmallShardEligibleListSize))
+}
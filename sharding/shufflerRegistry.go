@@ -0,0 +1,105 @@
+package sharding
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const (
+	// HashShufflerType is the name of the default, hash based, nodes shuffler
+	HashShufflerType = "hash"
+	// BalancedWaitingListShufflerType is the name of the hash based nodes shuffler that keeps the waiting lists balanced
+	BalancedWaitingListShufflerType = "balancedWaiting"
+	// NoShufflerType is the name of the nodes shuffler that does not shuffle validators
+	NoShufflerType = "none"
+)
+
+// ShufflerCreator defines a function able to create a nodes shuffler
+type ShufflerCreator func(args *NodesShufflerArgs) (NodesShuffler, error)
+
+type shufflerRegistry struct {
+	mutCreators sync.RWMutex
+	creators    map[string]ShufflerCreator
+}
+
+// NewShufflerRegistry creates a new shuffler registry that already contains the built-in shuffler strategies
+func NewShufflerRegistry() *shufflerRegistry {
+	sr := &shufflerRegistry{
+		creators: make(map[string]ShufflerCreator),
+	}
+
+	sr.creators[HashShufflerType] = func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return NewHashValidatorsShuffler(args)
+	}
+	sr.creators[BalancedWaitingListShufflerType] = func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return NewBalancedWaitingListShuffler(args)
+	}
+	sr.creators[NoShufflerType] = func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return NewNoShuffler(args)
+	}
+
+	return sr
+}
+
+// Register adds a new shuffler strategy. Existing strategies can not be overwritten
+func (sr *shufflerRegistry) Register(shufflerType string, creator ShufflerCreator) error {
+	if len(shufflerType) == 0 {
+		return ErrEmptyShufflerType
+	}
+	if creator == nil {
+		return ErrNilShufflerCreator
+	}
+
+	sr.mutCreators.Lock()
+	defer sr.mutCreators.Unlock()
+
+	_, exists := sr.creators[shufflerType]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrShufflerTypeAlreadyRegistered, shufflerType)
+	}
+	sr.creators[shufflerType] = creator
+
+	return nil
+}
+
+// Create will create a new shuffler instance of the provided type
+func (sr *shufflerRegistry) Create(shufflerType string, args *NodesShufflerArgs) (NodesShuffler, error) {
+	sr.mutCreators.RLock()
+	creator, exists := sr.creators[shufflerType]
+	sr.mutCreators.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownShufflerType, shufflerType)
+	}
+
+	shuffler, err := creator(args)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(shuffler) {
+		return nil, fmt.Errorf("%w for type %s", ErrNilShuffler, shufflerType)
+	}
+
+	return shuffler, nil
+}
+
+// Types returns the sorted list of registered shuffler types
+func (sr *shufflerRegistry) Types() []string {
+	sr.mutCreators.RLock()
+	types := make([]string, 0, len(sr.creators))
+	for shufflerType := range sr.creators {
+		types = append(types, shufflerType)
+	}
+	sr.mutCreators.RUnlock()
+
+	sort.Strings(types)
+
+	return types
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *shufflerRegistry) IsInterfaceNil() bool {
+	return sr == nil
+}
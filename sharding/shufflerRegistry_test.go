@@ -0,0 +1,99 @@
+package sharding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShufflerRegistry_ShouldContainBuiltInTypes(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+
+	assert.False(t, check.IfNil(sr))
+	assert.Equal(t, []string{BalancedWaitingListShufflerType, HashShufflerType, NoShufflerType}, sr.Types())
+}
+
+func TestShufflerRegistry_RegisterEmptyTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	err := sr.Register("", func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return NewNoShuffler(args)
+	})
+
+	assert.Equal(t, ErrEmptyShufflerType, err)
+}
+
+func TestShufflerRegistry_RegisterNilCreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	err := sr.Register("custom", nil)
+
+	assert.Equal(t, ErrNilShufflerCreator, err)
+}
+
+func TestShufflerRegistry_RegisterExistingTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	err := sr.Register(HashShufflerType, func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return NewNoShuffler(args)
+	})
+
+	assert.True(t, errors.Is(err, ErrShufflerTypeAlreadyRegistered))
+}
+
+func TestShufflerRegistry_RegisterAndCreateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	err := sr.Register("custom", func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return NewNoShuffler(args)
+	})
+	assert.Nil(t, err)
+
+	shuffler, err := sr.Create("custom", &NodesShufflerArgs{NodesShard: 10, NodesMeta: 10})
+	assert.Nil(t, err)
+	_, ok := shuffler.(*noShuffler)
+	assert.True(t, ok)
+}
+
+func TestShufflerRegistry_CreateUnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	shuffler, err := sr.Create("unknown", &NodesShufflerArgs{})
+
+	assert.True(t, check.IfNil(shuffler))
+	assert.True(t, errors.Is(err, ErrUnknownShufflerType))
+}
+
+func TestShufflerRegistry_CreateNilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	for _, shufflerType := range sr.Types() {
+		shuffler, err := sr.Create(shufflerType, nil)
+
+		assert.True(t, check.IfNil(shuffler))
+		assert.Equal(t, ErrNilNodeShufflerArguments, err)
+	}
+}
+
+func TestShufflerRegistry_CreateCreatorReturnsNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := NewShufflerRegistry()
+	_ = sr.Register("nil", func(args *NodesShufflerArgs) (NodesShuffler, error) {
+		return nil, nil
+	})
+	shuffler, err := sr.Create("nil", &NodesShufflerArgs{})
+
+	assert.True(t, check.IfNil(shuffler))
+	assert.True(t, errors.Is(err, ErrNilShuffler))
+}
@@ -31,3 +31,6 @@ var ErrNilStorage = errors.New("nil storage")
 
 // ErrNilTermUIStartChannel signals that a nil TermUI start channel has been provided
 var ErrNilTermUIStartChannel = errors.New("nil TermUI start channel")
+
+// ErrNoNodesToDisplay signals that an empty list of nodes was provided to a multi node view
+var ErrNoNodesToDisplay = errors.New("no nodes to display")
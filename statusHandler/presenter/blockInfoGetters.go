@@ -1,6 +1,8 @@
 package presenter
 
 import (
+	"strings"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 )
 
@@ -29,6 +31,16 @@ func (psh *PresenterStatusHandler) GetConsensusRoundState() string {
 	return psh.getFromCacheAsString(core.MetricConsensusRoundState)
 }
 
+// GetConsensusRoundsTimeline will return the summary of the last consensus rounds, one line per round
+func (psh *PresenterStatusHandler) GetConsensusRoundsTimeline() []string {
+	timeline := psh.getFromCacheAsString(core.MetricConsensusRoundsTimeline)
+	if timeline == metricNotAvailable || len(timeline) == 0 {
+		return make([]string, 0)
+	}
+
+	return strings.Split(timeline, "\n")
+}
+
 // GetCurrentBlockHash will return current block hash
 func (psh *PresenterStatusHandler) GetCurrentBlockHash() string {
 	return psh.getFromCacheAsString(core.MetricCurrentBlockHash)
@@ -103,6 +103,20 @@ func TestPresenterStatusHandler_GetConsensusRoundStateState(t *testing.T) {
 	assert.Equal(t, consensusRoundState, result)
 }
 
+func TestPresenterStatusHandler_GetConsensusRoundsTimeline(t *testing.T) {
+	t.Parallel()
+
+	presenterStatusHandler := NewPresenterStatusHandler()
+	assert.Equal(t, 0, len(presenterStatusHandler.GetConsensusRoundsTimeline()))
+
+	timeline := "round 11: committed, signatures 3/3, leader aa\nround 10: extended in subround (BLOCK), signatures 0/3, leader bb"
+	presenterStatusHandler.SetStringValue(core.MetricConsensusRoundsTimeline, timeline)
+	result := presenterStatusHandler.GetConsensusRoundsTimeline()
+
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "round 11: committed, signatures 3/3, leader aa", result[0])
+}
+
 func TestPresenterStatusHandler_GetCurrentBlockHash(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,88 @@
+package presenter
+
+import (
+	"time"
+)
+
+// maxHistoryLength is the number of samples kept for each of the charted metrics
+var maxHistoryLength = 300
+
+type metricsSnapshot struct {
+	timestamp      time.Time
+	numTxProcessed uint64
+	nonce          uint64
+}
+
+// RecordHistory samples the metrics that are charted over time. It should be called at a regular interval, the
+// rates being computed against the previous sample
+func (psh *PresenterStatusHandler) RecordHistory(timestamp time.Time) {
+	current := &metricsSnapshot{
+		timestamp:      timestamp,
+		numTxProcessed: psh.GetNumTxProcessed(),
+		nonce:          psh.GetNonce(),
+	}
+
+	psh.mutHistory.Lock()
+	defer psh.mutHistory.Unlock()
+
+	previous := psh.lastSnapshot
+	psh.lastSnapshot = current
+	if previous == nil {
+		return
+	}
+
+	tps := float64(0)
+	elapsedSeconds := current.timestamp.Sub(previous.timestamp).Seconds()
+	if elapsedSeconds > 0 && current.numTxProcessed >= previous.numTxProcessed {
+		tps = float64(current.numTxProcessed-previous.numTxProcessed) / elapsedSeconds
+	}
+
+	nonceProgress := float64(0)
+	if current.nonce >= previous.nonce {
+		nonceProgress = float64(current.nonce - previous.nonce)
+	}
+
+	psh.tpsHistory = appendToHistory(psh.tpsHistory, tps)
+	psh.memUsedHistory = appendToHistory(psh.memUsedHistory, float64(psh.GetMemUsedByNode()))
+	psh.nonceProgressHistory = appendToHistory(psh.nonceProgressHistory, nonceProgress)
+}
+
+func appendToHistory(history []float64, value float64) []float64 {
+	history = append(history, value)
+	if len(history) > maxHistoryLength {
+		history = history[len(history)-maxHistoryLength:]
+	}
+
+	return history
+}
+
+// GetTpsHistory returns the processed transactions per second, oldest sample first
+func (psh *PresenterStatusHandler) GetTpsHistory() []float64 {
+	psh.mutHistory.RLock()
+	defer psh.mutHistory.RUnlock()
+
+	return copyHistory(psh.tpsHistory)
+}
+
+// GetMemUsedHistory returns the memory used by the node in bytes, oldest sample first
+func (psh *PresenterStatusHandler) GetMemUsedHistory() []float64 {
+	psh.mutHistory.RLock()
+	defer psh.mutHistory.RUnlock()
+
+	return copyHistory(psh.memUsedHistory)
+}
+
+// GetNonceProgressHistory returns the number of blocks the node advanced between samples, oldest sample first
+func (psh *PresenterStatusHandler) GetNonceProgressHistory() []float64 {
+	psh.mutHistory.RLock()
+	defer psh.mutHistory.RUnlock()
+
+	return copyHistory(psh.nonceProgressHistory)
+}
+
+func copyHistory(history []float64) []float64 {
+	result := make([]float64, len(history))
+	copy(result, history)
+
+	return result
+}
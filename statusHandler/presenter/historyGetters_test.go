@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenterStatusHandler_RecordHistoryFirstSampleShouldNotAddValues(t *testing.T) {
+	t.Parallel()
+
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetUInt64Value(core.MetricNumProcessedTxs, 100)
+	presenterStatusHandler.RecordHistory(time.Now())
+
+	assert.Equal(t, 0, len(presenterStatusHandler.GetTpsHistory()))
+	assert.Equal(t, 0, len(presenterStatusHandler.GetMemUsedHistory()))
+	assert.Equal(t, 0, len(presenterStatusHandler.GetNonceProgressHistory()))
+}
+
+func TestPresenterStatusHandler_RecordHistoryShouldComputeRates(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetUInt64Value(core.MetricNumProcessedTxs, 100)
+	presenterStatusHandler.SetUInt64Value(core.MetricNonce, 10)
+	presenterStatusHandler.RecordHistory(start)
+
+	presenterStatusHandler.SetUInt64Value(core.MetricNumProcessedTxs, 500)
+	presenterStatusHandler.SetUInt64Value(core.MetricNonce, 12)
+	presenterStatusHandler.SetUInt64Value(core.MetricMemUsedGolang, 2048)
+	presenterStatusHandler.RecordHistory(start.Add(2 * time.Second))
+
+	//counters reset after a node restart should not produce huge values
+	presenterStatusHandler.SetUInt64Value(core.MetricNumProcessedTxs, 0)
+	presenterStatusHandler.SetUInt64Value(core.MetricNonce, 0)
+	presenterStatusHandler.RecordHistory(start.Add(4 * time.Second))
+
+	assert.Equal(t, []float64{200, 0}, presenterStatusHandler.GetTpsHistory())
+	assert.Equal(t, []float64{2, 0}, presenterStatusHandler.GetNonceProgressHistory())
+	assert.Equal(t, []float64{2048, 2048}, presenterStatusHandler.GetMemUsedHistory())
+}
+
+func TestPresenterStatusHandler_RecordHistoryShouldKeepTheLatestSamples(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	presenterStatusHandler := NewPresenterStatusHandler()
+	for i := 0; i <= maxHistoryLength+5; i++ {
+		presenterStatusHandler.SetUInt64Value(core.MetricNonce, uint64(i*i))
+		presenterStatusHandler.RecordHistory(start.Add(time.Duration(i) * time.Second))
+	}
+
+	history := presenterStatusHandler.GetNonceProgressHistory()
+	assert.Equal(t, maxHistoryLength, len(history))
+	lastIndex := maxHistoryLength + 5
+	assert.Equal(t, float64(lastIndex*lastIndex-(lastIndex-1)*(lastIndex-1)), history[len(history)-1])
+}
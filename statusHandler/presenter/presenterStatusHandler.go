@@ -17,6 +17,11 @@ type PresenterStatusHandler struct {
 	oldRound                    uint64
 	synchronizationSpeedHistory []uint64
 	totalRewardsOld             *big.Float
+	mutHistory                  sync.RWMutex
+	lastSnapshot                *metricsSnapshot
+	tpsHistory                  []float64
+	memUsedHistory              []float64
+	nonceProgressHistory        []float64
 }
 
 // NewPresenterStatusHandler will return an instance of the struct
@@ -25,6 +30,9 @@ func NewPresenterStatusHandler() *PresenterStatusHandler {
 		presenterMetrics:            &sync.Map{},
 		synchronizationSpeedHistory: make([]uint64, 0),
 		totalRewardsOld:             big.NewFloat(0),
+		tpsHistory:                  make([]float64, 0),
+		memUsedHistory:              make([]float64, 0),
+		nonceProgressHistory:        make([]float64, 0),
 	}
 	return psh
 }
@@ -1,5 +1,7 @@
 package view
 
+import "time"
+
 // Presenter defines the methods that return information about node
 type Presenter interface {
 	GetAppVersion() string
@@ -27,6 +29,7 @@ type Presenter interface {
 	GetCrossCheckBlockHeight() string
 	GetConsensusState() string
 	GetConsensusRoundState() string
+	GetConsensusRoundsTimeline() []string
 	GetCpuLoadPercent() uint64
 	GetMemLoadPercent() uint64
 	GetTotalMem() uint64
@@ -58,6 +61,11 @@ type Presenter interface {
 	CalculateRewardsPerHour() string
 	GetZeros() string
 
+	RecordHistory(timestamp time.Time)
+	GetTpsHistory() []float64
+	GetMemUsedHistory() []float64
+	GetNonceProgressHistory() []float64
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
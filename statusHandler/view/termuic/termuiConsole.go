@@ -24,8 +24,10 @@ var log = logger.GetOrCreate("statushandler/view/termuic")
 
 // TermuiConsole data where is store data from handler
 type TermuiConsole struct {
-	presenter                 view.Presenter
+	nodes                     []termuiRenders.NodeInfo
 	consoleRender             TermuiRender
+	summaryRender             *termuiRenders.NodesSummaryRender
+	showSummary               bool
 	grid                      *termuiRenders.DrawableContainer
 	mutRefresh                *sync.RWMutex
 	refreshTimeInMilliseconds int
@@ -36,12 +38,27 @@ func NewTermuiConsole(presenter view.Presenter, refreshTimeInMilliseconds int) (
 	if check.IfNil(presenter) {
 		return nil, statusHandler.ErrNilPresenterInterface
 	}
+
+	return NewMultiNodeTermuiConsole([]termuiRenders.NodeInfo{{Presenter: presenter}}, refreshTimeInMilliseconds)
+}
+
+// NewMultiNodeTermuiConsole returns a TermuiConsole able to display several nodes. When more than one node is
+// provided, the console starts with a summary table of all nodes from which each node can be displayed in detail
+func NewMultiNodeTermuiConsole(nodes []termuiRenders.NodeInfo, refreshTimeInMilliseconds int) (*TermuiConsole, error) {
+	if len(nodes) == 0 {
+		return nil, statusHandler.ErrNoNodesToDisplay
+	}
+	for _, node := range nodes {
+		if check.IfNil(node.Presenter) {
+			return nil, statusHandler.ErrNilPresenterInterface
+		}
+	}
 	if refreshTimeInMilliseconds < 1 {
 		return nil, statusHandler.ErrInvalidRefreshTimeInMilliseconds
 	}
 
 	tc := TermuiConsole{
-		presenter:                 presenter,
+		nodes:                     nodes,
 		mutRefresh:                &sync.RWMutex{},
 		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
 	}
@@ -72,21 +89,27 @@ func (tc *TermuiConsole) eventLoop() {
 	}
 
 	var err error
-	tc.consoleRender, err = termuiRenders.NewWidgetsRender(tc.presenter, tc.grid)
+	if tc.isMultiNode() {
+		tc.summaryRender, err = termuiRenders.NewNodesSummaryRender(tc.nodes)
+		tc.showSummary = true
+	} else {
+		tc.consoleRender, err = termuiRenders.NewWidgetsRender(tc.nodes[0].Presenter, tc.grid)
+	}
 	if err != nil {
 		log.Debug("nil console render", "error", err.Error())
 		return
 	}
 
 	termWidth, termHeight := ui.TerminalDimensions()
-	tc.grid.SetRectangle(0, 0, termWidth, termHeight)
+	tc.setRectangle(termWidth, termHeight)
 
 	uiEvents := ui.PollEvents()
 	// handles kill signal sent to gotop
 	sigTerm := make(chan os.Signal, 2)
 	signal.Notify(sigTerm, os.Interrupt, syscall.SIGTERM)
 
-	tc.consoleRender.RefreshData(tc.refreshTimeInMilliseconds)
+	tc.recordHistory()
+	tc.refreshActiveRender(tc.refreshTimeInMilliseconds)
 	ticksCounter := uint32(0)
 
 	for {
@@ -110,10 +133,73 @@ func (tc *TermuiConsole) processUiEvents(e ui.Event, numMillisecondsRefreshTime
 		ui.Close()
 		stopApplication()
 		return
+	case "<Down>", "j":
+		tc.changeSelection(true, numMillisecondsRefreshTime)
+	case "<Up>", "k":
+		tc.changeSelection(false, numMillisecondsRefreshTime)
+	case "<Enter>":
+		tc.showSelectedNode(numMillisecondsRefreshTime)
+	case "<Escape>", "<Backspace>":
+		tc.showNodesSummary(numMillisecondsRefreshTime)
+	}
+}
+
+func (tc *TermuiConsole) isMultiNode() bool {
+	return len(tc.nodes) > 1
+}
+
+func (tc *TermuiConsole) changeSelection(next bool, numMillisecondsRefreshTime int) {
+	if !tc.showSummary {
+		return
+	}
+
+	if next {
+		tc.summaryRender.SelectNext()
+	} else {
+		tc.summaryRender.SelectPrevious()
+	}
+	tc.refreshWindow(numMillisecondsRefreshTime)
+}
+
+func (tc *TermuiConsole) showSelectedNode(numMillisecondsRefreshTime int) {
+	if !tc.showSummary {
+		return
+	}
+
+	consoleRender, err := termuiRenders.NewWidgetsRender(tc.summaryRender.Selected().Presenter, tc.grid)
+	if err != nil {
+		log.Debug("nil console render", "error", err.Error())
+		return
+	}
+
+	tc.consoleRender = consoleRender
+	tc.showSummary = false
+	width, height := ui.TerminalDimensions()
+	tc.doResize(width, height, numMillisecondsRefreshTime)
+}
+
+func (tc *TermuiConsole) showNodesSummary(numMillisecondsRefreshTime int) {
+	if !tc.isMultiNode() || tc.showSummary {
+		return
+	}
+
+	tc.showSummary = true
+	width, height := ui.TerminalDimensions()
+	tc.doResize(width, height, numMillisecondsRefreshTime)
+}
+
+// recordHistory samples the metrics of all nodes, not only of the displayed one, so the charts are complete when
+// switching between nodes
+func (tc *TermuiConsole) recordHistory() {
+	now := time.Now()
+	for _, node := range tc.nodes {
+		node.Presenter.RecordHistory(now)
 	}
 }
 
 func (tc *TermuiConsole) doChanges(counter *uint32, numMillisecondsRefreshTime int) {
+	tc.recordHistory()
+
 	atomic.AddUint32(counter, 1)
 	if atomic.LoadUint32(counter) > numOfTicksBeforeRedrawing {
 		width, height := ui.TerminalDimensions()
@@ -130,15 +216,36 @@ func (tc *TermuiConsole) doResizeEvent(e ui.Event, numMillisecondsRefreshTime in
 }
 
 func (tc *TermuiConsole) doResize(width int, height int, numMillisecondsRefreshTime int) {
-	tc.grid.SetRectangle(0, 0, width, height)
+	tc.setRectangle(width, height)
 	tc.refreshWindow(numMillisecondsRefreshTime)
 }
 
+func (tc *TermuiConsole) setRectangle(width int, height int) {
+	tc.grid.SetRectangle(0, 0, width, height)
+	if tc.summaryRender != nil {
+		tc.summaryRender.SetRectangle(0, 0, width, height)
+	}
+}
+
 func (tc *TermuiConsole) refreshWindow(numMillisecondsRefreshTime int) {
 	tc.mutRefresh.Lock()
 	defer tc.mutRefresh.Unlock()
 
-	tc.consoleRender.RefreshData(numMillisecondsRefreshTime)
+	tc.refreshActiveRender(numMillisecondsRefreshTime)
 	ui.Clear()
+	if tc.showSummary {
+		ui.Render(tc.summaryRender.Drawable())
+		return
+	}
+
 	ui.Render(tc.grid.TopLeft(), tc.grid.TopRight(), tc.grid.Bottom())
 }
+
+func (tc *TermuiConsole) refreshActiveRender(numMillisecondsRefreshTime int) {
+	if tc.showSummary {
+		tc.summaryRender.RefreshData(numMillisecondsRefreshTime)
+		return
+	}
+
+	tc.consoleRender.RefreshData(numMillisecondsRefreshTime)
+}
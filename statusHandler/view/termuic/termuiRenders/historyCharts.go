@@ -0,0 +1,93 @@
+package termuiRenders
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+// historyCharts holds the sparklines drawn from the metrics the presenter sampled over time
+type historyCharts struct {
+	tps           *widgets.SparklineGroup
+	memUsed       *widgets.SparklineGroup
+	nonceProgress *widgets.SparklineGroup
+}
+
+func newHistoryCharts() *historyCharts {
+	return &historyCharts{
+		tps:           newSparklineGroup(ui.ColorGreen),
+		memUsed:       newSparklineGroup(ui.ColorMagenta),
+		nonceProgress: newSparklineGroup(ui.ColorCyan),
+	}
+}
+
+func newSparklineGroup(color ui.Color) *widgets.SparklineGroup {
+	sparkline := widgets.NewSparkline()
+	sparkline.LineColor = color
+
+	return widgets.NewSparklineGroup(sparkline)
+}
+
+func (hc *historyCharts) refresh(presenter view.Presenter) {
+	tpsHistory := presenter.GetTpsHistory()
+	hc.tps.Title = fmt.Sprintf("TPS - current: %.1f, max: %.1f", lastValue(tpsHistory), maxValue(tpsHistory))
+	setSparklineData(hc.tps, tpsHistory)
+
+	memUsedHistory := presenter.GetMemUsedHistory()
+	hc.memUsed.Title = fmt.Sprintf("Memory used - current: %s, max: %s",
+		core.ConvertBytes(uint64(lastValue(memUsedHistory))), core.ConvertBytes(uint64(maxValue(memUsedHistory))))
+	setSparklineData(hc.memUsed, memUsedHistory)
+
+	nonceProgressHistory := presenter.GetNonceProgressHistory()
+	hc.nonceProgress.Title = fmt.Sprintf("Nonce progress - blocks in the last %d samples: %.0f",
+		len(nonceProgressHistory), sumValues(nonceProgressHistory))
+	setSparklineData(hc.nonceProgress, nonceProgressHistory)
+}
+
+// setSparklineData keeps only the latest values that fit in the widget, as the sparkline draws from the left side
+func setSparklineData(group *widgets.SparklineGroup, history []float64) {
+	sparkline := group.Sparklines[0]
+
+	width := group.Inner.Dx()
+	if width > 0 && len(history) > width {
+		history = history[len(history)-width:]
+	}
+
+	sparkline.Data = history
+	//the sparkline can not scale a flat line of zeros
+	sparkline.MaxVal = 0
+	if maxValue(history) == 0 {
+		sparkline.MaxVal = 1
+	}
+}
+
+func lastValue(history []float64) float64 {
+	if len(history) == 0 {
+		return 0
+	}
+
+	return history[len(history)-1]
+}
+
+func maxValue(history []float64) float64 {
+	max := float64(0)
+	for _, value := range history {
+		if value > max {
+			max = value
+		}
+	}
+
+	return max
+}
+
+func sumValues(history []float64) float64 {
+	sum := float64(0)
+	for _, value := range history {
+		sum += value
+	}
+
+	return sum
+}
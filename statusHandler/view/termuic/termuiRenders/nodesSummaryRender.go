@@ -0,0 +1,163 @@
+package termuiRenders
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+const statusNoData = "no data"
+const metricNotAvailable = "N/A"
+
+var summaryHeader = []string{"#", "Node", "Address", "Shard", "Type", "Nonce", "Status", "Peers", "Signed / accepted", "Proposed / accepted"}
+
+// NodeInfo associates a node address with the presenter holding the metrics fetched from that node
+type NodeInfo struct {
+	Address   string
+	Presenter view.Presenter
+}
+
+// NodesSummaryRender displays a table with the main metrics of several nodes along with the charts of the selected node
+type NodesSummaryRender struct {
+	grid       *ui.Grid
+	nodesTable *widgets.Table
+	charts     *historyCharts
+	nodes      []NodeInfo
+	selected   int
+}
+
+// NewNodesSummaryRender creates a new NodesSummaryRender for the provided nodes
+func NewNodesSummaryRender(nodes []NodeInfo) (*NodesSummaryRender, error) {
+	if len(nodes) == 0 {
+		return nil, statusHandler.ErrNoNodesToDisplay
+	}
+	for _, node := range nodes {
+		if check.IfNil(node.Presenter) {
+			return nil, fmt.Errorf("%w for node %s", statusHandler.ErrNilPresenterInterface, node.Address)
+		}
+	}
+
+	nsr := &NodesSummaryRender{
+		nodesTable: widgets.NewTable(),
+		charts:     newHistoryCharts(),
+		nodes:      nodes,
+	}
+	nsr.nodesTable.Rows = [][]string{summaryHeader}
+	nsr.nodesTable.RowSeparator = false
+	nsr.nodesTable.FillRow = true
+
+	nsr.grid = ui.NewGrid()
+	nsr.grid.Set(
+		ui.NewRow(2.0/3, nsr.nodesTable),
+		ui.NewRow(1.0/3,
+			ui.NewCol(1.0/3, nsr.charts.tps),
+			ui.NewCol(1.0/3, nsr.charts.memUsed),
+			ui.NewCol(1.0/3, nsr.charts.nonceProgress),
+		),
+	)
+
+	return nsr, nil
+}
+
+// SelectNext moves the selection to the next node, wrapping around at the end of the list
+func (nsr *NodesSummaryRender) SelectNext() {
+	nsr.selected = (nsr.selected + 1) % len(nsr.nodes)
+}
+
+// SelectPrevious moves the selection to the previous node, wrapping around at the beginning of the list
+func (nsr *NodesSummaryRender) SelectPrevious() {
+	nsr.selected = (nsr.selected + len(nsr.nodes) - 1) % len(nsr.nodes)
+}
+
+// Selected returns the currently selected node
+func (nsr *NodesSummaryRender) Selected() NodeInfo {
+	return nsr.nodes[nsr.selected]
+}
+
+// SetRectangle sets the area on which the summary is drawn
+func (nsr *NodesSummaryRender) SetRectangle(startWidth, startHeight, termWidth, termHeight int) {
+	nsr.grid.SetRect(startWidth, startHeight, termWidth, termHeight)
+}
+
+// Drawable returns the drawable object holding all the summary widgets
+func (nsr *NodesSummaryRender) Drawable() ui.Drawable {
+	return nsr.grid
+}
+
+// RefreshData prepares the nodes table and the charts of the selected node
+func (nsr *NodesSummaryRender) RefreshData(_ int) {
+	rows := make([][]string, 0, len(nsr.nodes)+1)
+	rows = append(rows, summaryHeader)
+	rowStyles := map[int]ui.Style{
+		0: ui.NewStyle(ui.ColorYellow, ui.ColorClear, ui.ModifierBold),
+	}
+
+	for i, node := range nsr.nodes {
+		status := nodeStatus(node.Presenter)
+		rows = append(rows, nodeSummaryRow(i, node, status))
+
+		switch {
+		case i == nsr.selected:
+			rowStyles[i+1] = ui.NewStyle(ui.ColorBlack, ui.ColorWhite)
+		case status == statusNoData:
+			rowStyles[i+1] = ui.NewStyle(ui.ColorRed)
+		case status == statusSyncing:
+			rowStyles[i+1] = ui.NewStyle(ui.ColorYellow)
+		}
+	}
+
+	nsr.nodesTable.Title = fmt.Sprintf("Nodes (%d) - up/down: select, enter: details, esc: back to this view",
+		len(nsr.nodes))
+	nsr.nodesTable.Rows = rows
+	nsr.nodesTable.RowStyles = rowStyles
+
+	selected := nsr.Selected()
+	nsr.charts.refresh(selected.Presenter)
+	nsr.charts.tps.Title = fmt.Sprintf("%s [%s]", nsr.charts.tps.Title, selected.Address)
+}
+
+func nodeSummaryRow(index int, node NodeInfo, status string) []string {
+	presenter := node.Presenter
+
+	return []string{
+		fmt.Sprintf("%d", index+1),
+		presenter.GetNodeName(),
+		node.Address,
+		shardIdToString(presenter.GetShardId()),
+		presenter.GetNodeType(),
+		fmt.Sprintf("%d / %d", presenter.GetNonce(), presenter.GetProbableHighestNonce()),
+		status,
+		fmt.Sprintf("%d", presenter.GetNumConnectedPeers()),
+		fmt.Sprintf("%d / %d", presenter.GetCountConsensus(), presenter.GetCountConsensusAcceptedBlocks()),
+		fmt.Sprintf("%d / %d", presenter.GetCountLeader(), presenter.GetCountAcceptedBlocks()),
+	}
+}
+
+func nodeStatus(presenter view.Presenter) string {
+	if presenter.GetAppVersion() == metricNotAvailable {
+		return statusNoData
+	}
+	if presenter.GetSynchronizedRound() < presenter.GetCurrentRound() {
+		return statusSyncing
+	}
+
+	return statusSynchronized
+}
+
+func shardIdToString(shardId uint64) string {
+	if shardId == uint64(core.MetachainShardId) {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardId)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsr *NodesSummaryRender) IsInterfaceNil() bool {
+	return nsr == nil
+}
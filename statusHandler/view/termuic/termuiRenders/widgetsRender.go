@@ -17,11 +17,12 @@ const invalidKey = "invalid key"
 
 //WidgetsRender will define termui widgets that need to display a termui console
 type WidgetsRender struct {
-	container    *DrawableContainer
-	lLog         *widgets.List
-	instanceInfo *widgets.Table
-	chainInfo    *widgets.Table
-	blockInfo    *widgets.Table
+	container       *DrawableContainer
+	lLog            *widgets.List
+	consensusRounds *widgets.List
+	instanceInfo    *widgets.Table
+	chainInfo       *widgets.Table
+	blockInfo       *widgets.Table
 
 	epochLoad   *widgets.Gauge
 	cpuLoad     *widgets.Gauge
@@ -31,6 +32,8 @@ type WidgetsRender struct {
 
 	networkBytesInEpoch *widgets.Gauge
 
+	charts *historyCharts
+
 	presenter view.Presenter
 }
 
@@ -71,6 +74,9 @@ func (wr *WidgetsRender) initWidgets() {
 	wr.networkBytesInEpoch = widgets.NewGauge()
 
 	wr.lLog = widgets.NewList()
+	wr.consensusRounds = widgets.NewList()
+
+	wr.charts = newHistoryCharts()
 }
 
 func (wr *WidgetsRender) setGrid() {
@@ -96,7 +102,17 @@ func (wr *WidgetsRender) setGrid() {
 	)
 
 	gridBottom := ui.NewGrid()
-	gridBottom.Set(ui.NewRow(1.0, wr.lLog))
+	gridBottom.Set(
+		ui.NewRow(1.0/3,
+			ui.NewCol(1.0/3, wr.charts.tps),
+			ui.NewCol(1.0/3, wr.charts.memUsed),
+			ui.NewCol(1.0/3, wr.charts.nonceProgress),
+		),
+		ui.NewRow(2.0/3,
+			ui.NewCol(2.0/3, wr.lLog),
+			ui.NewCol(1.0/3, wr.consensusRounds),
+		),
+	)
 
 	wr.container.SetTopLeft(gridLeft)
 	wr.container.SetTopRight(gridRight)
@@ -109,7 +125,9 @@ func (wr *WidgetsRender) RefreshData(numMillisecondsRefreshTime int) {
 	wr.prepareChainInfo(numMillisecondsRefreshTime)
 	wr.prepareBlockInfo()
 	wr.prepareListWithLogsForDisplay()
+	wr.prepareConsensusRoundsForDisplay()
 	wr.prepareLoads()
+	wr.charts.refresh(wr.presenter)
 }
 
 func (wr *WidgetsRender) prepareInstanceInfo() {
@@ -324,6 +342,15 @@ func (wr *WidgetsRender) prepareListWithLogsForDisplay() {
 	wr.lLog.WrapText = true
 }
 
+func (wr *WidgetsRender) prepareConsensusRoundsForDisplay() {
+	wr.consensusRounds.Title = "Consensus rounds"
+	wr.consensusRounds.TextStyle = ui.NewStyle(ui.ColorWhite)
+
+	rounds := wr.presenter.GetConsensusRoundsTimeline()
+	wr.consensusRounds.Rows = wr.prepareLogLines(rounds, wr.consensusRounds.Size().Y)
+	wr.consensusRounds.WrapText = false
+}
+
 func (wr *WidgetsRender) prepareLogLines(logData []string, size int) []string {
 	logDataLen := len(logData)
 	maxSize := size - 2 // decrease 2 units as the total size of the log list includes also the header and the footer
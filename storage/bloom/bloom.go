@@ -27,7 +27,8 @@ import (
 var _ storage.BloomFilter = (*Bloom)(nil)
 
 const (
-	bitsInByte = 8
+	bitsInByte        = 8
+	defaultFilterSize = 2048
 )
 
 // Bloom represents a bloom filter. It holds the filter itself, the hashing functions that must be
@@ -61,11 +62,31 @@ func NewFilter(size uint, h []hashing.Hasher) (*Bloom, error) {
 // and implementations of blake2b, sha3-keccak and fnv128a hashing functions
 func NewDefaultFilter() *Bloom {
 	return &Bloom{
-		filter:   make([]byte, 2048),
-		hashFunc: []hashing.Hasher{keccak.Keccak{}, &blake2b.Blake2b{}, fnv.Fnv{}},
+		filter:   make([]byte, defaultFilterSize),
+		hashFunc: defaultHashFunctions(),
 	}
 }
 
+// NewDefaultFilterFromBytes returns a Bloom object, using the default hashing functions, whose bits are
+// restored from the provided bytes. It returns an error if the provided bytes do not have the default filter size
+func NewDefaultFilterFromBytes(filter []byte) (*Bloom, error) {
+	if len(filter) != defaultFilterSize {
+		return nil, errors.New("invalid filter size")
+	}
+
+	filterCopy := make([]byte, defaultFilterSize)
+	copy(filterCopy, filter)
+
+	return &Bloom{
+		filter:   filterCopy,
+		hashFunc: defaultHashFunctions(),
+	}, nil
+}
+
+func defaultHashFunctions() []hashing.Hasher {
+	return []hashing.Hasher{keccak.Keccak{}, &blake2b.Blake2b{}, fnv.Fnv{}}
+}
+
 // Add sets the bits that correspond to the hashes of the data
 func (b *Bloom) Add(data []byte) {
 	res := getBitsIndexes(b, data)
@@ -95,6 +116,17 @@ func (b *Bloom) MayContain(data []byte) bool {
 	return true
 }
 
+// Bytes returns a copy of the bits of the bloom filter, so the filter can be persisted
+func (b *Bloom) Bytes() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	filterCopy := make([]byte, len(b.filter))
+	copy(filterCopy, b.filter)
+
+	return filterCopy
+}
+
 // Clear resets the bits of the bloom filter
 func (b *Bloom) Clear() {
 	for i := 0; i < len(b.filter); i++ {
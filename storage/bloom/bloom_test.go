@@ -86,3 +86,22 @@ func TestConcurrency(t *testing.T) {
 		assert.True(t, b.MayContain([]byte("j"+strconv.Itoa(i))), "j"+strconv.Itoa(i))
 	}
 }
+
+func TestNewDefaultFilterFromBytesWithWrongSize(t *testing.T) {
+	_, err := bloom.NewDefaultFilterFromBytes(make([]byte, 10))
+
+	assert.NotNil(t, err, "Expected error")
+}
+
+func TestNewDefaultFilterFromBytes(t *testing.T) {
+	b := bloom.NewDefaultFilter()
+	b.Add([]byte("12345"))
+	b.Add([]byte("BloomFilter"))
+
+	restored, err := bloom.NewDefaultFilterFromBytes(b.Bytes())
+
+	assert.Nil(t, err)
+	assert.True(t, restored.MayContain([]byte("12345")))
+	assert.True(t, restored.MayContain([]byte("BloomFilter")))
+	assert.False(t, restored.MayContain([]byte("test")))
+}
@@ -77,6 +77,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	var rewardTxUnit *pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var logsBloomUnit *pruning.PruningStorer
 	var receiptsUnit *pruning.PruningStorer
 	var err error
 
@@ -207,6 +208,13 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	logsBloomUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.LogsBloomStorage)
+	logsBloomUnit, err = pruning.NewPruningStorer(logsBloomUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, logsBloomUnit)
+
 	receiptsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage)
 	receiptsUnit, err = pruning.NewPruningStorer(receiptsUnitArgs)
 	if err != nil {
@@ -229,6 +237,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	store.AddStorer(dataRetriever.LogsBloomUnit, logsBloomUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 
 	err = psf.setupDbLookupExtensions(store, &successfullyCreatedStorers)
@@ -249,6 +258,7 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	var rewardTxUnit *pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var logsBloomUnit *pruning.PruningStorer
 	var receiptsUnit *pruning.PruningStorer
 	var err error
 
@@ -335,6 +345,19 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	// the economics breakdown is static as it is queried for any past epoch
+	economicsBreakdownDbConfig := GetDBFromConfig(psf.generalConfig.EconomicsBreakdownStorage.DB)
+	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.EconomicsBreakdownStorage.DB.FilePath)
+	economicsBreakdownDbConfig.FilePath = dbPath
+	economicsBreakdownStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.EconomicsBreakdownStorage.Cache),
+		economicsBreakdownDbConfig,
+		GetBloomFromConfig(psf.generalConfig.EconomicsBreakdownStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, economicsBreakdownStorageUnit)
+
 	txUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxStorage)
 	txUnit, err = pruning.NewPruningStorer(txUnitArgs)
 	if err != nil {
@@ -377,6 +400,13 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	logsBloomUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.LogsBloomStorage)
+	logsBloomUnit, err = pruning.NewPruningStorer(logsBloomUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, logsBloomUnit)
+
 	receiptsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ReceiptsStorage)
 	receiptsUnit, err = pruning.NewPruningStorer(receiptsUnitArgs)
 	if err != nil {
@@ -400,7 +430,9 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	store.AddStorer(dataRetriever.LogsBloomUnit, logsBloomUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
+	store.AddStorer(dataRetriever.EconomicsBreakdownUnit, economicsBreakdownStorageUnit)
 
 	err = psf.setupDbLookupExtensions(store, &successfullyCreatedStorers)
 	if err != nil {
@@ -11,6 +11,7 @@ type ChainStorerMock struct {
 	Rewards      *StorerMock
 	Unsigned     *StorerMock
 	HdrNonce     *StorerMock
+	Logs         *StorerMock
 }
 
 // NewChainStorerMock -
@@ -20,6 +21,7 @@ func NewChainStorerMock(epoch uint32) *ChainStorerMock {
 		Rewards:      NewStorerMock("Rewards", epoch),
 		Unsigned:     NewStorerMock("Unsigned", epoch),
 		HdrNonce:     NewStorerMock("HeaderNonce", epoch),
+		Logs:         NewStorerMock("Logs", epoch),
 	}
 }
 
@@ -44,6 +46,9 @@ func (sm *ChainStorerMock) GetStorer(unitType dataRetriever.UnitType) storage.St
 	if unitType == dataRetriever.UnsignedTransactionUnit {
 		return sm.Unsigned
 	}
+	if unitType == dataRetriever.TxLogsUnit {
+		return sm.Logs
+	}
 
 	return sm.HdrNonce
 }
@@ -35,6 +35,16 @@ func (sm *StorerMock) SetCurrentEpoch(epoch uint32) {
 	sm.currentEpoch.Set(epoch)
 }
 
+// SetEpochForPutOperation -
+func (sm *StorerMock) SetEpochForPutOperation(epoch uint32) {
+	sm.SetCurrentEpoch(epoch)
+}
+
+// GetCurrentEpoch -
+func (sm *StorerMock) GetCurrentEpoch() uint32 {
+	return sm.currentEpoch.Get()
+}
+
 // GetCurrentEpochData -
 func (sm *StorerMock) GetCurrentEpochData() *container.MutexMap {
 	return sm.GetEpochData(sm.currentEpoch.Get())
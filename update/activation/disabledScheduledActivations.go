@@ -0,0 +1,22 @@
+package activation
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+type disabledScheduledActivationsHandler struct {
+}
+
+// NewDisabledScheduledActivationsHandler returns a handler that never reports scheduled activations. It is used by the
+// components that do not follow the epoch start meta blocks, such as the test web servers.
+func NewDisabledScheduledActivationsHandler() *disabledScheduledActivationsHandler {
+	return &disabledScheduledActivationsHandler{}
+}
+
+// GetScheduledActivations returns an empty slice
+func (dsah *disabledScheduledActivationsHandler) GetScheduledActivations() []*api.ScheduledActivation {
+	return make([]*api.ScheduledActivation, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsah *disabledScheduledActivationsHandler) IsInterfaceNil() bool {
+	return dsah == nil
+}
@@ -0,0 +1,257 @@
+package activation
+
+import (
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+var log = logger.GetOrCreate("update/activation")
+
+// supportedFeatures holds the protocol features whose activation epoch is read at runtime from the epoch notifier,
+// so they can be activated by governance without restarting the node
+var supportedFeatures = map[string]struct{}{
+	core.BuiltInFunctionsFeature:    {},
+	core.RelayedTransactionsFeature: {},
+	core.PenalizedTooMuchGasFeature: {},
+	core.MetaProtectionFeature:      {},
+	core.GasPriceModifierFeature:    {},
+}
+
+// ArgsScheduledActivationsHandler defines the arguments needed to create a scheduled activations handler
+type ArgsScheduledActivationsHandler struct {
+	EpochStartNotifier epochStart.RegistrationHandler
+	EpochNotifier      update.ActivationEpochsHandler
+	HardforkTrigger    update.HardforkTrigger
+	MetaBlockStorer    storage.Storer
+	Marshalizer        marshal.Marshalizer
+	StartEpoch         uint32
+	AppVersion         string
+}
+
+type scheduledActivationsHandler struct {
+	epochNotifier   update.ActivationEpochsHandler
+	hardforkTrigger update.HardforkTrigger
+	marshalizer     marshal.Marshalizer
+	appVersion      string
+
+	mutUpdate          sync.Mutex
+	triggeredHardForks map[uint32]struct{}
+
+	mutActivations sync.RWMutex
+	activations    []*api.ScheduledActivation
+}
+
+// NewScheduledActivationsHandler creates a handler that reads the activations scheduled by the accepted governance
+// proposals from the epoch start meta blocks, which are seen by all the shards. Feature activations are handed to the
+// epoch notifier, so they are applied at runtime, while hardforks are automatically triggered
+func NewScheduledActivationsHandler(args ArgsScheduledActivationsHandler) (*scheduledActivationsHandler, error) {
+	if check.IfNil(args.EpochStartNotifier) {
+		return nil, update.ErrNilEpochStartNotifier
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, update.ErrNilEpochNotifier
+	}
+	if check.IfNil(args.HardforkTrigger) {
+		return nil, update.ErrNilHardforkTrigger
+	}
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, update.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if len(args.AppVersion) == 0 {
+		return nil, update.ErrEmptyVersionString
+	}
+
+	sah := &scheduledActivationsHandler{
+		epochNotifier:      args.EpochNotifier,
+		hardforkTrigger:    args.HardforkTrigger,
+		marshalizer:        args.Marshalizer,
+		appVersion:         args.AppVersion,
+		triggeredHardForks: make(map[uint32]struct{}),
+		activations:        make([]*api.ScheduledActivation, 0),
+	}
+
+	sah.loadActivationsFromStorage(args.MetaBlockStorer, args.StartEpoch)
+	args.EpochStartNotifier.RegisterHandler(sah)
+
+	return sah, nil
+}
+
+// loadActivationsFromStorage applies the activations from the epoch start meta block of the epoch the node starts in,
+// so a restarted node knows about them without waiting for the next epoch start
+func (sah *scheduledActivationsHandler) loadActivationsFromStorage(metaBlockStorer storage.Storer, epoch uint32) {
+	buff, err := metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		log.Debug("scheduledActivationsHandler: epoch start meta block not found", "epoch", epoch, "error", err)
+		return
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = sah.marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		log.Warn("scheduledActivationsHandler: cannot unmarshal epoch start meta block", "epoch", epoch, "error", err)
+		return
+	}
+
+	err = sah.applyActivations(metaBlock)
+	if err != nil {
+		log.Warn("scheduledActivationsHandler.applyActivations", "epoch", epoch, "error", err)
+	}
+}
+
+// EpochStartPrepare applies the activations carried by the epoch start meta block
+func (sah *scheduledActivationsHandler) EpochStartPrepare(metaHdr data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(metaHdr) {
+		return
+	}
+
+	err := sah.applyActivations(metaHdr)
+	if err != nil {
+		log.Warn("scheduledActivationsHandler.applyActivations", "epoch", metaHdr.GetEpoch(), "error", err)
+	}
+}
+
+// EpochStartAction does nothing as the activations are applied when the epoch start meta block is prepared
+func (sah *scheduledActivationsHandler) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (sah *scheduledActivationsHandler) NotifyOrder() uint32 {
+	return core.ScheduledActivationsOrder
+}
+
+func (sah *scheduledActivationsHandler) applyActivations(metaHdr data.HeaderHandler) error {
+	sah.mutUpdate.Lock()
+	defer sah.mutUpdate.Unlock()
+
+	activations, err := sah.parseActivations(metaHdr.GetReserved())
+	if err != nil {
+		return err
+	}
+
+	activationEpochs := make(map[string]uint32)
+	for _, activation := range activations {
+		if activation.IsHardFork || !activation.Supported {
+			continue
+		}
+
+		activationEpochs[activation.Name] = activation.Epoch
+	}
+	sah.epochNotifier.SetActivationEpochs(activationEpochs)
+
+	sah.mutActivations.Lock()
+	sah.activations = activations
+	sah.mutActivations.Unlock()
+
+	for _, activation := range activations {
+		if activation.Epoch <= metaHdr.GetEpoch() {
+			continue
+		}
+
+		if !activation.Supported {
+			log.Warn("scheduled activation is not supported by this node's binary, please upgrade",
+				"name", activation.Name,
+				"epoch", activation.Epoch,
+				"software version", activation.SoftwareVersion,
+				"github commit", activation.GitHubCommit,
+				"app version", sah.appVersion,
+			)
+		}
+
+		if activation.IsHardFork {
+			sah.triggerHardFork(activation.Epoch)
+		}
+	}
+
+	return nil
+}
+
+func (sah *scheduledActivationsHandler) parseActivations(reserved []byte) ([]*api.ScheduledActivation, error) {
+	activations := make([]*api.ScheduledActivation, 0)
+	if len(reserved) == 0 {
+		return activations, nil
+	}
+
+	scheduledActivations := &systemSmartContracts.ScheduledActivations{}
+	err := sah.marshalizer.Unmarshal(scheduledActivations, reserved)
+	if err != nil {
+		return nil, update.ErrInvalidScheduledActivationsData
+	}
+
+	for _, scheduledActivation := range scheduledActivations.Activations {
+		if scheduledActivation == nil {
+			return nil, update.ErrInvalidScheduledActivationsData
+		}
+
+		activations = append(activations, sah.createActivation(scheduledActivation))
+	}
+
+	return activations, nil
+}
+
+func (sah *scheduledActivationsHandler) createActivation(scheduledActivation *systemSmartContracts.ScheduledActivation) *api.ScheduledActivation {
+	activation := &api.ScheduledActivation{
+		Name:            string(scheduledActivation.Name),
+		Epoch:           scheduledActivation.Epoch,
+		SoftwareVersion: string(scheduledActivation.SoftwareVersion),
+		GitHubCommit:    string(scheduledActivation.GitHubCommit),
+		IsHardFork:      string(scheduledActivation.Name) == systemSmartContracts.HardForkActivationName,
+	}
+
+	if activation.IsHardFork {
+		activation.Supported = len(activation.SoftwareVersion) > 0 && strings.HasPrefix(sah.appVersion, activation.SoftwareVersion)
+	} else {
+		_, activation.Supported = supportedFeatures[activation.Name]
+	}
+
+	return activation
+}
+
+func (sah *scheduledActivationsHandler) triggerHardFork(epoch uint32) {
+	_, alreadyTriggered := sah.triggeredHardForks[epoch]
+	if alreadyTriggered {
+		return
+	}
+
+	log.Info("triggering hardfork scheduled by governance", "epoch", epoch)
+	err := sah.hardforkTrigger.Trigger(epoch, false)
+	if err != nil {
+		log.Error("scheduledActivationsHandler.triggerHardFork", "epoch", epoch, "error", err)
+		return
+	}
+
+	sah.triggeredHardForks[epoch] = struct{}{}
+}
+
+// GetScheduledActivations returns the activations read from the last epoch start meta block
+func (sah *scheduledActivationsHandler) GetScheduledActivations() []*api.ScheduledActivation {
+	sah.mutActivations.RLock()
+	defer sah.mutActivations.RUnlock()
+
+	activations := make([]*api.ScheduledActivation, 0, len(sah.activations))
+	for _, activation := range sah.activations {
+		activationCopy := *activation
+		activations = append(activations, &activationCopy)
+	}
+
+	return activations
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sah *scheduledActivationsHandler) IsInterfaceNil() bool {
+	return sah == nil
+}
@@ -0,0 +1,215 @@
+package activation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsScheduledActivationsHandler() ArgsScheduledActivationsHandler {
+	return ArgsScheduledActivationsHandler{
+		EpochStartNotifier: &mock.EpochStartNotifierStub{},
+		EpochNotifier:      &mock.EpochNotifierStub{},
+		HardforkTrigger:    &mock.HardforkTriggerStub{},
+		MetaBlockStorer: &mock.StorerStub{
+			SearchFirstCalled: func(key []byte) ([]byte, error) {
+				return nil, errors.New("not found")
+			},
+		},
+		Marshalizer: &mock.MarshalizerMock{},
+		StartEpoch:  0,
+		AppVersion:  "v1.1.0-0-gabcdef",
+	}
+}
+
+func createEpochStartMetaBlock(t *testing.T, epoch uint32, activations ...*systemSmartContracts.ScheduledActivation) *block.MetaBlock {
+	metaBlock := &block.MetaBlock{Epoch: epoch}
+	if len(activations) == 0 {
+		return metaBlock
+	}
+
+	var err error
+	marshalizer := &mock.MarshalizerMock{}
+	metaBlock.Reserved, err = marshalizer.Marshal(&systemSmartContracts.ScheduledActivations{Activations: activations})
+	require.Nil(t, err)
+
+	return metaBlock
+}
+
+func TestNewScheduledActivationsHandler_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledActivationsHandler()
+	args.EpochStartNotifier = nil
+	sah, err := NewScheduledActivationsHandler(args)
+	assert.True(t, check.IfNil(sah))
+	assert.Equal(t, update.ErrNilEpochStartNotifier, err)
+
+	args = createMockArgsScheduledActivationsHandler()
+	args.EpochNotifier = nil
+	sah, err = NewScheduledActivationsHandler(args)
+	assert.True(t, check.IfNil(sah))
+	assert.Equal(t, update.ErrNilEpochNotifier, err)
+
+	args = createMockArgsScheduledActivationsHandler()
+	args.HardforkTrigger = nil
+	sah, err = NewScheduledActivationsHandler(args)
+	assert.True(t, check.IfNil(sah))
+	assert.Equal(t, update.ErrNilHardforkTrigger, err)
+
+	args = createMockArgsScheduledActivationsHandler()
+	args.MetaBlockStorer = nil
+	sah, err = NewScheduledActivationsHandler(args)
+	assert.True(t, check.IfNil(sah))
+	assert.Equal(t, update.ErrNilStorage, err)
+
+	args = createMockArgsScheduledActivationsHandler()
+	args.Marshalizer = nil
+	sah, err = NewScheduledActivationsHandler(args)
+	assert.True(t, check.IfNil(sah))
+	assert.Equal(t, update.ErrNilMarshalizer, err)
+
+	args = createMockArgsScheduledActivationsHandler()
+	args.AppVersion = ""
+	sah, err = NewScheduledActivationsHandler(args)
+	assert.True(t, check.IfNil(sah))
+	assert.Equal(t, update.ErrEmptyVersionString, err)
+}
+
+func TestNewScheduledActivationsHandler_ShouldRegisterToEpochStartNotifier(t *testing.T) {
+	t.Parallel()
+
+	registered := false
+	args := createMockArgsScheduledActivationsHandler()
+	args.EpochStartNotifier = &mock.EpochStartNotifierStub{
+		RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+			registered = true
+		},
+	}
+	sah, err := NewScheduledActivationsHandler(args)
+
+	assert.False(t, check.IfNil(sah))
+	assert.Nil(t, err)
+	assert.True(t, registered)
+	assert.Equal(t, uint32(core.ScheduledActivationsOrder), sah.NotifyOrder())
+}
+
+func TestNewScheduledActivationsHandler_ShouldLoadActivationsFromTheStartEpochMetaBlock(t *testing.T) {
+	t.Parallel()
+
+	var activationEpochs map[string]uint32
+	args := createMockArgsScheduledActivationsHandler()
+	args.StartEpoch = 7
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		SetActivationEpochsCalled: func(epochs map[string]uint32) {
+			activationEpochs = epochs
+		},
+	}
+	args.MetaBlockStorer = &mock.StorerStub{
+		SearchFirstCalled: func(key []byte) ([]byte, error) {
+			assert.Equal(t, []byte(core.EpochStartIdentifier(7)), key)
+			metaBlock := createEpochStartMetaBlock(t, 7, &systemSmartContracts.ScheduledActivation{
+				Name:  []byte(core.RelayedTransactionsFeature),
+				Epoch: 9,
+			})
+
+			return args.Marshalizer.Marshal(metaBlock)
+		},
+	}
+	sah, _ := NewScheduledActivationsHandler(args)
+
+	assert.Equal(t, map[string]uint32{core.RelayedTransactionsFeature: 9}, activationEpochs)
+	assert.Equal(t, 1, len(sah.GetScheduledActivations()))
+}
+
+func TestScheduledActivationsHandler_EpochStartPrepareInvalidDataShouldKeepPreviousActivations(t *testing.T) {
+	t.Parallel()
+
+	numSetCalls := 0
+	args := createMockArgsScheduledActivationsHandler()
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		SetActivationEpochsCalled: func(_ map[string]uint32) {
+			numSetCalls++
+		},
+	}
+	sah, _ := NewScheduledActivationsHandler(args)
+
+	sah.EpochStartPrepare(createEpochStartMetaBlock(t, 1, &systemSmartContracts.ScheduledActivation{
+		Name:  []byte(core.GasPriceModifierFeature),
+		Epoch: 10,
+	}), nil)
+	require.Equal(t, 1, len(sah.GetScheduledActivations()))
+
+	sah.EpochStartPrepare(&block.MetaBlock{Epoch: 2, Reserved: []byte("invalid data")}, nil)
+	assert.Equal(t, 1, len(sah.GetScheduledActivations()))
+	assert.Equal(t, 1, numSetCalls)
+}
+
+func TestScheduledActivationsHandler_EpochStartPrepareShouldSetSupportedFeatureActivations(t *testing.T) {
+	t.Parallel()
+
+	var activationEpochs map[string]uint32
+	args := createMockArgsScheduledActivationsHandler()
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		SetActivationEpochsCalled: func(epochs map[string]uint32) {
+			activationEpochs = epochs
+		},
+	}
+	sah, _ := NewScheduledActivationsHandler(args)
+
+	sah.EpochStartPrepare(createEpochStartMetaBlock(t, 10,
+		&systemSmartContracts.ScheduledActivation{Name: []byte(core.MetaProtectionFeature), Epoch: 20, GitHubCommit: []byte("commit1")},
+		&systemSmartContracts.ScheduledActivation{Name: []byte("UnknownFeature"), Epoch: 30, GitHubCommit: []byte("commit2")},
+	), nil)
+
+	assert.Equal(t, map[string]uint32{core.MetaProtectionFeature: 20}, activationEpochs)
+
+	activations := sah.GetScheduledActivations()
+	require.Equal(t, 2, len(activations))
+	assert.True(t, activations[0].Supported)
+	assert.Equal(t, "commit1", activations[0].GitHubCommit)
+	assert.False(t, activations[1].Supported)
+
+	sah.EpochStartPrepare(createEpochStartMetaBlock(t, 11), nil)
+	assert.Equal(t, 0, len(activationEpochs))
+	assert.Equal(t, 0, len(sah.GetScheduledActivations()))
+}
+
+func TestScheduledActivationsHandler_EpochStartPrepareShouldTriggerHardForkOnce(t *testing.T) {
+	t.Parallel()
+
+	triggeredEpochs := make([]uint32, 0)
+	args := createMockArgsScheduledActivationsHandler()
+	args.HardforkTrigger = &mock.HardforkTriggerStub{
+		TriggerCalled: func(epoch uint32, withEarlyEndOfEpoch bool) error {
+			triggeredEpochs = append(triggeredEpochs, epoch)
+			return nil
+		},
+	}
+	sah, _ := NewScheduledActivationsHandler(args)
+
+	activations := []*systemSmartContracts.ScheduledActivation{
+		{Name: []byte(systemSmartContracts.HardForkActivationName), Epoch: 5, SoftwareVersion: []byte("v1.2.0")},
+		{Name: []byte(systemSmartContracts.HardForkActivationName), Epoch: 300, SoftwareVersion: []byte("v1.1.0")},
+	}
+	sah.EpochStartPrepare(createEpochStartMetaBlock(t, 3, activations...), nil)
+	sah.EpochStartPrepare(createEpochStartMetaBlock(t, 4, activations...), nil)
+
+	assert.Equal(t, []uint32{5, 300}, triggeredEpochs)
+
+	scheduledActivations := sah.GetScheduledActivations()
+	require.Equal(t, 2, len(scheduledActivations))
+	assert.True(t, scheduledActivations[0].IsHardFork)
+	assert.False(t, scheduledActivations[0].Supported)
+	assert.True(t, scheduledActivations[1].IsHardFork)
+	assert.True(t, scheduledActivations[1].Supported)
+}
@@ -259,3 +259,15 @@ type RoundHandler interface {
 	TimeStamp() time.Time
 	IsInterfaceNil() bool
 }
+
+// ActivationEpochsHandler is able to apply the activation epochs scheduled on chain for the protocol features
+type ActivationEpochsHandler interface {
+	SetActivationEpochs(activationEpochs map[string]uint32)
+	IsInterfaceNil() bool
+}
+
+// HardforkTrigger defines the functionality needed to trigger a hardfork at a given epoch
+type HardforkTrigger interface {
+	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
+	IsInterfaceNil() bool
+}
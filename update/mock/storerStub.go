@@ -14,10 +14,15 @@ type StorerStub struct {
 	RangeKeysCalled        func(handler func(key []byte, val []byte) bool)
 	CloseCalled            func() error
 	GetBulkFromEpochCalled func(keys [][]byte, epoch uint32) (map[string][]byte, error)
+	SearchFirstCalled      func(key []byte) ([]byte, error)
 }
 
 // SearchFirst -
-func (ss *StorerStub) SearchFirst(_ []byte) ([]byte, error) {
+func (ss *StorerStub) SearchFirst(key []byte) ([]byte, error) {
+	if ss.SearchFirstCalled != nil {
+		return ss.SearchFirstCalled(key)
+	}
+
 	return nil, nil
 }
 
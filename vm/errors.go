@@ -229,3 +229,15 @@ var ErrOwnerCannotUnDelegate = errors.New("owner cannot undelegate, contract sti
 
 // ErrNotEnoughInitialOwnerFunds signals that not enough initial owner funds has been provided
 var ErrNotEnoughInitialOwnerFunds = errors.New("not enough initial owner funds")
+
+// ErrNilEquivocationProofVerifier signals that a nil equivocation proof verifier has been provided
+var ErrNilEquivocationProofVerifier = errors.New("nil equivocation proof verifier")
+
+// ErrInvalidSlashingPercentage signals that an invalid slashing percentage has been provided
+var ErrInvalidSlashingPercentage = errors.New("invalid slashing percentage")
+
+// ErrValidatorDataCannotBeMoved signals that the validator data cannot be moved to a delegation contract
+var ErrValidatorDataCannotBeMoved = errors.New("validator data cannot be moved to a delegation contract")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
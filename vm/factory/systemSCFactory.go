@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -25,6 +26,7 @@ type systemSCFactory struct {
 	epochNotifier          vm.EpochNotifier
 	systemSCsContainer     vm.SystemSCContainer
 	addressPubKeyConverter core.PubkeyConverter
+	chainID                []byte
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -39,6 +41,7 @@ type ArgsNewSystemSCFactory struct {
 	SystemSCConfig         *config.SystemSmartContractsConfig
 	EpochNotifier          vm.EpochNotifier
 	AddressPubKeyConverter core.PubkeyConverter
+	ChainID                []byte
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -70,6 +73,9 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	if check.IfNil(args.AddressPubKeyConverter) {
 		return nil, vm.ErrNilAddressPubKeyConverter
 	}
+	if len(args.ChainID) == 0 {
+		return nil, vm.ErrInvalidChainID
+	}
 
 	scf := &systemSCFactory{
 		systemEI:               args.SystemEI,
@@ -81,6 +87,7 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		economics:              args.Economics,
 		epochNotifier:          args.EpochNotifier,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
+		chainID:                args.ChainID,
 	}
 
 	err := scf.createGasConfig(args.GasSchedule.LatestGasSchedule())
@@ -167,18 +174,32 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 }
 
 func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, error) {
+	proofVerifier, err := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       scf.marshalizer,
+		Hasher:            scf.hasher,
+		SignatureVerifier: scf.sigVerifier,
+		ChainID:           scf.chainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	args := systemSmartContracts.ArgsValidatorSmartContract{
-		Eei:                scf.systemEI,
-		SigVerifier:        scf.sigVerifier,
-		StakingSCConfig:    scf.systemSCConfig.StakingSystemSCConfig,
-		StakingSCAddress:   vm.StakingSCAddress,
-		EndOfEpochAddress:  vm.EndOfEpochAddress,
-		ValidatorSCAddress: vm.ValidatorSCAddress,
-		GasCost:            scf.gasCost,
-		Marshalizer:        scf.marshalizer,
-		GenesisTotalSupply: scf.economics.GenesisTotalSupply(),
-		EpochNotifier:      scf.epochNotifier,
-		MinDeposit:         scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+		Eei:                    scf.systemEI,
+		SigVerifier:            scf.sigVerifier,
+		StakingSCConfig:        scf.systemSCConfig.StakingSystemSCConfig,
+		StakingSCAddress:       vm.StakingSCAddress,
+		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		GasCost:                scf.gasCost,
+		Marshalizer:            scf.marshalizer,
+		GenesisTotalSupply:     scf.economics.GenesisTotalSupply(),
+		EpochNotifier:          scf.epochNotifier,
+		MinDeposit:             scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+		ProofVerifier:          proofVerifier,
+
+		ValidatorToDelegationEnableEpoch: scf.systemSCConfig.DelegationManagerSystemSCConfig.ValidatorToDelegationEnableEpoch,
 	}
 	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(args)
 	return validatorSC, err
@@ -194,6 +215,8 @@ func (scf *systemSCFactory) createESDTContract() (vm.SystemSmartContract, error)
 		ESDTSCConfig:           scf.systemSCConfig.ESDTSystemSCConfig,
 		EpochNotifier:          scf.epochNotifier,
 		AddressPubKeyConverter: scf.addressPubKeyConverter,
+
+		LiquidStakingEnableEpoch: scf.systemSCConfig.DelegationSystemSCConfig.LiquidStakingEnableEpoch,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	return esdt, err
@@ -229,6 +252,7 @@ func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract,
 		Marshalizer:            scf.marshalizer,
 		EpochNotifier:          scf.epochNotifier,
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		ESDTSCAddress:          vm.ESDTSCAddress,
 	}
 	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
 	return delegation, err
@@ -26,6 +26,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Marshalizer:         &mock.MarshalizerMock{},
 		Hasher:              &mock.HasherMock{},
+		ChainID:             []byte("chain ID"),
 		SystemSCConfig: &config.SystemSmartContractsConfig{
 			ESDTSystemSCConfig: config.ESDTSystemSCConfig{
 				BaseIssuingCost: "100000000",
@@ -169,6 +170,17 @@ func TestNewSystemSCFactory_NilPubKeyConverter(t *testing.T) {
 	assert.Equal(t, vm.ErrNilAddressPubKeyConverter, err)
 }
 
+func TestNewSystemSCFactory_EmptyChainID(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockNewSystemScFactoryArgs()
+	arguments.ChainID = nil
+	scFactory, err := NewSystemSCFactory(arguments)
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrInvalidChainID, err)
+}
+
 func TestNewSystemSCFactory_Ok(t *testing.T) {
 	t.Parallel()
 
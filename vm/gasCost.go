@@ -21,6 +21,7 @@ type MetaChainSystemSCsCost struct {
 	ChangeRewardAddress uint64
 	ChangeValidatorKeys uint64
 	UnJail              uint64
+	Slash               uint64
 	ESDTIssue           uint64
 	ESDTOperations      uint64
 	Proposal            uint64
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+)
+
+// EquivocationProofVerifierStub -
+type EquivocationProofVerifierStub struct {
+	VerifyMarshalizedCalled func(buff []byte) (*slashing.EquivocationProof, error)
+}
+
+// VerifyMarshalized -
+func (epvs *EquivocationProofVerifierStub) VerifyMarshalized(buff []byte) (*slashing.EquivocationProof, error) {
+	if epvs.VerifyMarshalizedCalled != nil {
+		return epvs.VerifyMarshalizedCalled(buff)
+	}
+	return &slashing.EquivocationProof{}, nil
+}
+
+// IsInterfaceNil -
+func (epvs *EquivocationProofVerifierStub) IsInterfaceNil() bool {
+	return epvs == nil
+}
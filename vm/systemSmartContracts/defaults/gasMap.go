@@ -48,6 +48,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["ChangeRewardAddress"] = value
 	gasMap["ChangeValidatorKeys"] = value
 	gasMap["UnJail"] = value
+	gasMap["Slash"] = value
 	gasMap["ESDTIssue"] = value
 	gasMap["ESDTOperations"] = value
 	gasMap["Proposal"] = value
@@ -26,6 +26,11 @@ const serviceFeeKey = "serviceFee"
 const totalActiveKey = "totalActive"
 const rewardKeyPrefix = "reward"
 const fundKeyPrefix = "fund"
+const initFromValidatorData = "initFromValidatorData"
+const liquidStakingKey = "liquidStaking"
+const numSlashingEventsKey = "numSlashingEvents"
+const slashingEventPrefix = "slashingEvent"
+const slashingCheckpointPrefix = "slashingCheckpoint"
 
 const (
 	active   = uint32(0)
@@ -52,6 +57,9 @@ type delegation struct {
 	mutExecution           sync.RWMutex
 	stakingV2EnableEpoch   uint32
 	stakingV2Enabled       atomic.Flag
+	esdtSCAddr             []byte
+	liquidStakingEpoch     uint32
+	flagLiquidStaking      atomic.Flag
 }
 
 // ArgsNewDelegation defines the arguments to create the delegation smart contract
@@ -64,6 +72,7 @@ type ArgsNewDelegation struct {
 	StakingSCAddress       []byte
 	ValidatorSCAddress     []byte
 	EndOfEpochAddress      []byte
+	ESDTSCAddress          []byte
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
 	EpochNotifier          vm.EpochNotifier
@@ -83,6 +92,9 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 	if len(args.DelegationMgrSCAddress) < 1 {
 		return nil, fmt.Errorf("%w for delegation sc address", vm.ErrInvalidAddress)
 	}
+	if len(args.ESDTSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for esdt sc address", vm.ErrInvalidAddress)
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -115,6 +127,8 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		endOfEpochAddr:         args.EndOfEpochAddress,
 		stakingV2EnableEpoch:   args.StakingSCConfig.StakingV2Epoch,
 		stakingV2Enabled:       atomic.Flag{},
+		esdtSCAddr:             args.ESDTSCAddress,
+		liquidStakingEpoch:     args.DelegationSCConfig.LiquidStakingEnableEpoch,
 	}
 
 	var okValue bool
@@ -159,6 +173,10 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 	switch args.Function {
 	case core.SCDeployInitFunctionName:
 		return d.init(args)
+	case initFromValidatorData:
+		return d.initFromValidatorData(args)
+	case "mergeValidatorDataToContract":
+		return d.mergeValidatorDataToContract(args)
 	case "addNodes":
 		return d.addNodes(args)
 	case "removeNodes":
@@ -217,6 +235,8 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.getContractConfig(args)
 	case "unStakeAtEndOfEpoch":
 		return d.unStakeAtEndOfEpoch(args)
+	case "slashActive":
+		return d.slashActive(args)
 	case "reDelegateRewards":
 		return d.reDelegateRewards(args)
 	case "reStakeUnStakedNodes":
@@ -231,6 +251,12 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.setMetaData(args)
 	case "getMetaData":
 		return d.getMetaData(args)
+	case "enableLiquidStaking":
+		return d.enableLiquidStaking(args)
+	case "getLiquidStakingData":
+		return d.getLiquidStakingInfo(args)
+	case core.BuiltInFunctionESDTTransfer:
+		return d.unDelegateReceipts(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + " is an unknown function")
@@ -247,16 +273,6 @@ func (d *delegation) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 		d.eei.AddReturnMessage("invalid number of arguments to init delegation contract")
 		return vmcommon.UserError
 	}
-	serviceFee := big.NewInt(0).SetBytes(args.Arguments[1]).Uint64()
-	if serviceFee < d.minServiceFee || serviceFee > d.maxServiceFee {
-		d.eei.AddReturnMessage("service fee out of bounds")
-		return vmcommon.UserError
-	}
-	maxDelegationCap := big.NewInt(0).SetBytes(args.Arguments[0])
-	if maxDelegationCap.Cmp(zero) < 0 {
-		d.eei.AddReturnMessage("invalid max delegation cap")
-		return vmcommon.UserError
-	}
 	if args.CallValue.Cmp(zero) < 0 {
 		d.eei.AddReturnMessage("invalid call value")
 		return vmcommon.UserError
@@ -264,6 +280,31 @@ func (d *delegation) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 
 	initialOwnerFunds := big.NewInt(0).Set(args.CallValue)
 	ownerAddress = args.CallerAddr
+	dStatus, returnCode := d.initDelegationStructures(ownerAddress, args.Arguments[0], args.Arguments[1], initialOwnerFunds)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	return d.delegateUser(initialOwnerFunds, ownerAddress, args.RecipientAddr, dStatus)
+}
+
+func (d *delegation) initDelegationStructures(
+	ownerAddress []byte,
+	maxDelegationCapBytes []byte,
+	serviceFeeBytes []byte,
+	initialOwnerFunds *big.Int,
+) (*DelegationContractStatus, vmcommon.ReturnCode) {
+	serviceFee := big.NewInt(0).SetBytes(serviceFeeBytes).Uint64()
+	if serviceFee < d.minServiceFee || serviceFee > d.maxServiceFee {
+		d.eei.AddReturnMessage("service fee out of bounds")
+		return nil, vmcommon.UserError
+	}
+	maxDelegationCap := big.NewInt(0).SetBytes(maxDelegationCapBytes)
+	if maxDelegationCap.Cmp(zero) < 0 {
+		d.eei.AddReturnMessage("invalid max delegation cap")
+		return nil, vmcommon.UserError
+	}
+
 	d.eei.SetStorage([]byte(core.DelegationSystemSCKey), []byte(core.DelegationSystemSCKey))
 	d.eei.SetStorage([]byte(ownerKey), ownerAddress)
 	d.eei.SetStorage([]byte(serviceFeeKey), big.NewInt(0).SetUint64(serviceFee).Bytes())
@@ -280,7 +321,7 @@ func (d *delegation) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	err := d.saveDelegationContractConfig(dConfig)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
-		return vmcommon.UserError
+		return nil, vmcommon.UserError
 	}
 
 	dStatus := &DelegationContractStatus{
@@ -299,10 +340,212 @@ func (d *delegation) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	err = d.saveGlobalFundData(globalFund)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	return dStatus, vmcommon.Ok
+}
+
+// initFromValidatorData initializes a contract deployed by the delegation manager for an existing validator. The
+// validator's keys and stake are taken over as they are, the owner's initial funds being the validator's total stake
+func (d *delegation) initFromValidatorData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, d.delegationMgrSCAddress) {
+		d.eei.AddReturnMessage("only delegation manager can call this function")
+		return vmcommon.UserError
+	}
+	ownerAddress := d.eei.GetStorage([]byte(ownerKey))
+	if len(ownerAddress) != 0 {
+		d.eei.AddReturnMessage("smart contract was already initialized")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		d.eei.AddReturnMessage("invalid number of arguments to init delegation contract from validator data")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
 		return vmcommon.UserError
 	}
 
-	return d.delegateUser(initialOwnerFunds, ownerAddress, args.RecipientAddr, dStatus)
+	validatorAddress := args.Arguments[0]
+	validatorData, err := d.getValidatorData(validatorAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	delegationManagement, err := d.getDelegationManagement()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if validatorData.TotalStakeValue.Cmp(delegationManagement.MinDeposit) < 0 {
+		d.eei.AddReturnMessage(fmt.Sprintf("%s, validator total stake must be at least %s",
+			vm.ErrNotEnoughInitialOwnerFunds.Error(), delegationManagement.MinDeposit.String()))
+		return vmcommon.UserError
+	}
+
+	dStatus, returnCode := d.initDelegationStructures(validatorAddress, args.Arguments[1], args.Arguments[2], validatorData.TotalStakeValue)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	return d.addValidatorDataToContract(validatorAddress, validatorData, dStatus)
+}
+
+// mergeValidatorDataToContract takes over the keys and the stake of a validator owned by the owner of this contract
+func (d *delegation) mergeValidatorDataToContract(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, d.delegationMgrSCAddress) {
+		d.eei.AddReturnMessage("only delegation manager can call this function")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+
+	validatorAddress := args.Arguments[0]
+	if !d.isOwner(validatorAddress) {
+		d.eei.AddReturnMessage("only the owner of the contract can merge its validator data")
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	validatorData, err := d.getValidatorData(validatorAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	listToVerify := append(dStatus.StakedKeys, dStatus.NotStakedKeys...)
+	listToVerify = append(listToVerify, dStatus.UnStakedKeys...)
+	if verifyIfBLSPubKeysExist(listToVerify, validatorData.BlsPubKeys) {
+		d.eei.AddReturnMessage(vm.ErrBLSPublicKeyMismatch.Error())
+		return vmcommon.UserError
+	}
+
+	return d.addValidatorDataToContract(validatorAddress, validatorData, dStatus)
+}
+
+// addValidatorDataToContract adds the validator's keys as staked keys and its total stake as owner's active funds.
+// The tokens are already held by the validator SC, the validator data itself being moved by the delegation manager
+func (d *delegation) addValidatorDataToContract(
+	ownerAddress []byte,
+	validatorData *ValidatorDataV2,
+	dStatus *DelegationContractStatus,
+) vmcommon.ReturnCode {
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	isNew, delegator, err := d.getOrCreateDelegatorData(ownerAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+		delegator.UnClaimedRewards = big.NewInt(0)
+	} else {
+		err = d.computeAndUpdateRewards(ownerAddress, delegator)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	for _, blsKey := range validatorData.BlsPubKeys {
+		dStatus.StakedKeys = append(dStatus.StakedKeys, &NodesData{BLSKey: blsKey})
+	}
+
+	stakeValue := validatorData.TotalStakeValue
+	globalFund.TotalActive.Add(globalFund.TotalActive, stakeValue)
+	withDelegationCap := dConfig.MaxDelegationCap.Cmp(zero) != 0
+	if withDelegationCap && globalFund.TotalActive.Cmp(dConfig.MaxDelegationCap) > 0 {
+		d.eei.AddReturnMessage("total delegation cap reached")
+		return vmcommon.UserError
+	}
+
+	if len(delegator.ActiveFund) == 0 {
+		var fundKey []byte
+		fundKey, err = d.createAndSaveNextKeyFund(ownerAddress, stakeValue, active)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+
+		delegator.ActiveFund = fundKey
+		d.addNewFundToGlobalData(globalFund, fundKey, active)
+		if isNew {
+			dStatus.NumUsers++
+		}
+	} else {
+		err = d.addValueToFund(delegator.ActiveFund, stakeValue)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	err = d.saveDelegationStatus(dStatus)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.saveDelegatorData(ownerAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) getValidatorData(address []byte) (*ValidatorDataV2, error) {
+	marshaledData := d.eei.GetStorageFromAddress(d.validatorSCAddr, address)
+	if len(marshaledData) == 0 {
+		return nil, fmt.Errorf("%w validator data", vm.ErrDataNotFoundUnderKey)
+	}
+
+	validatorData := &ValidatorDataV2{}
+	err := d.marshalizer.Unmarshal(validatorData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if validatorData.TotalStakeValue == nil {
+		validatorData.TotalStakeValue = big.NewInt(0)
+	}
+
+	return validatorData, nil
 }
 
 func (d *delegation) delegateUser(
@@ -1114,6 +1357,15 @@ func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return vmcommon.OutOfGas
 	}
 
+	liquidStakingData, err := d.getLiquidStakingData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidStakingData != nil && !d.isOwner(args.CallerAddr) {
+		return d.delegateForReceipts(args, liquidStakingData)
+	}
+
 	dStatus, err := d.getDelegationStatus()
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
@@ -1284,15 +1536,7 @@ func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.Retur
 	delegator.UnStakedFunds = append(delegator.UnStakedFunds, unStakedFundKey)
 
 	if activeFund.Value.Cmp(zero) == 0 {
-		for i, fundKey := range globalFund.ActiveFunds {
-			if bytes.Equal(delegator.ActiveFund, fundKey) {
-				copy(globalFund.ActiveFunds[i:], globalFund.ActiveFunds[i+1:])
-				lenKeys := len(globalFund.ActiveFunds)
-				globalFund.ActiveFunds[lenKeys-1] = nil
-				globalFund.ActiveFunds = globalFund.ActiveFunds[:lenKeys-1]
-				break
-			}
-		}
+		removeActiveFundFromGlobalData(globalFund, delegator.ActiveFund)
 		delegator.ActiveFund = nil
 	}
 
@@ -1311,6 +1555,18 @@ func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.Retur
 	return vmcommon.Ok
 }
 
+func removeActiveFundFromGlobalData(globalFund *GlobalFundData, activeFundKey []byte) {
+	for i, fundKey := range globalFund.ActiveFunds {
+		if bytes.Equal(activeFundKey, fundKey) {
+			copy(globalFund.ActiveFunds[i:], globalFund.ActiveFunds[i+1:])
+			lenKeys := len(globalFund.ActiveFunds)
+			globalFund.ActiveFunds[lenKeys-1] = nil
+			globalFund.ActiveFunds = globalFund.ActiveFunds[:lenKeys-1]
+			break
+		}
+	}
+}
+
 func (d *delegation) updateRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !bytes.Equal(args.CallerAddr, d.endOfEpochAddr) {
 		d.eei.AddReturnMessage("only end of epoch address can call this function")
@@ -1730,6 +1986,126 @@ func (d *delegation) unStakeAtEndOfEpoch(args *vmcommon.ContractCallInput) vmcom
 	return vmcommon.Ok
 }
 
+// slashActive is called by the validator system SC when one of the contract's nodes was slashed. The active stake is
+// lowered by the slashed value and every active fund will be lowered proportionally the next time it is loaded.
+// The optional arguments are the keys which were unstaked because they were no longer covered by the remaining stake.
+func (d *delegation) slashActive(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, d.validatorSCAddr) {
+		d.eei.AddReturnMessage("can be called by validator sc address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 1 {
+		d.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	slashedValue := big.NewInt(0).SetBytes(args.Arguments[0])
+	if slashedValue.Cmp(globalFund.TotalActive) > 0 {
+		slashedValue.Set(globalFund.TotalActive)
+	}
+	if slashedValue.Cmp(zero) > 0 {
+		d.saveSlashingEvent(globalFund.TotalActive, slashedValue)
+		globalFund.TotalActive.Sub(globalFund.TotalActive, slashedValue)
+		err = d.saveGlobalFundData(globalFund)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	if len(args.Arguments) == 1 {
+		return vmcommon.Ok
+	}
+
+	status, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, unStakedKey := range args.Arguments[1:] {
+		status.StakedKeys, status.UnStakedKeys = moveNodeFromList(status.StakedKeys, status.UnStakedKeys, unStakedKey)
+	}
+
+	err = d.saveDelegationStatus(status)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func slashingEventKeys(index uint64) ([]byte, []byte) {
+	indexInBytes := big.NewInt(0).SetUint64(index).Bytes()
+	eventKey := append([]byte(slashingEventPrefix), indexInBytes...)
+	totalActiveKeyForEvent := append(append([]byte{}, eventKey...), []byte(totalActiveKey)...)
+
+	return totalActiveKeyForEvent, eventKey
+}
+
+func (d *delegation) getNumSlashingEvents() uint64 {
+	return big.NewInt(0).SetBytes(d.eei.GetStorage([]byte(numSlashingEventsKey))).Uint64()
+}
+
+func (d *delegation) saveSlashingEvent(totalActiveBefore *big.Int, slashedValue *big.Int) {
+	numEvents := d.getNumSlashingEvents()
+	totalActiveKeyForEvent, slashedValueKey := slashingEventKeys(numEvents)
+	d.eei.SetStorage(totalActiveKeyForEvent, totalActiveBefore.Bytes())
+	d.eei.SetStorage(slashedValueKey, slashedValue.Bytes())
+	d.eei.SetStorage([]byte(numSlashingEventsKey), big.NewInt(0).SetUint64(numEvents+1).Bytes())
+}
+
+// applyPendingSlashing lowers an active fund by all the slashing events which happened since the fund was last saved
+func (d *delegation) applyPendingSlashing(key []byte, dFund *Fund) {
+	if dFund.Type != active {
+		return
+	}
+
+	numEvents := d.getNumSlashingEvents()
+	checkpointKey := append([]byte(slashingCheckpointPrefix), key...)
+	checkpoint := big.NewInt(0).SetBytes(d.eei.GetStorage(checkpointKey)).Uint64()
+	for i := checkpoint; i < numEvents; i++ {
+		totalActiveKeyForEvent, slashedValueKey := slashingEventKeys(i)
+		totalActiveBefore := big.NewInt(0).SetBytes(d.eei.GetStorage(totalActiveKeyForEvent))
+		if totalActiveBefore.Cmp(zero) == 0 {
+			continue
+		}
+
+		remainingActive := big.NewInt(0).Sub(totalActiveBefore, big.NewInt(0).SetBytes(d.eei.GetStorage(slashedValueKey)))
+		dFund.Value.Mul(dFund.Value, remainingActive)
+		dFund.Value.Div(dFund.Value, totalActiveBefore)
+	}
+}
+
+func (d *delegation) saveSlashingCheckpoint(key []byte, dFund *Fund) {
+	if dFund.Type != active {
+		return
+	}
+
+	checkpointKey := append([]byte(slashingCheckpointPrefix), key...)
+	numEvents := d.getNumSlashingEvents()
+	if numEvents == 0 && len(d.eei.GetStorage(checkpointKey)) == 0 {
+		return
+	}
+	if dFund.Value.Cmp(zero) == 0 {
+		d.eei.SetStorage(checkpointKey, nil)
+		return
+	}
+
+	d.eei.SetStorage(checkpointKey, big.NewInt(0).SetUint64(numEvents).Bytes())
+}
+
 func (d *delegation) checkArgumentsForGeneralViewFunc(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
@@ -2317,6 +2693,7 @@ func (d *delegation) getFund(key []byte) (*Fund, error) {
 	if err != nil {
 		return nil, err
 	}
+	d.applyPendingSlashing(key, dFund)
 
 	return dFund, nil
 }
@@ -2333,6 +2710,7 @@ func (d *delegation) createAndSaveNextKeyFund(address []byte, value *big.Int, fu
 }
 
 func (d *delegation) saveFund(key []byte, dFund *Fund) error {
+	d.saveSlashingCheckpoint(key, dFund)
 	if dFund.Value.Cmp(zero) == 0 {
 		d.eei.SetStorage(key, nil)
 		return nil
@@ -2460,6 +2838,9 @@ func (d *delegation) EpochConfirmed(epoch uint32) {
 
 	d.stakingV2Enabled.Toggle(epoch > d.stakingV2EnableEpoch)
 	log.Debug("stakingV2", "enabled", d.stakingV2Enabled.IsSet())
+
+	d.flagLiquidStaking.Toggle(epoch >= d.liquidStakingEpoch)
+	log.Debug("delegation liquid staking", "enabled", d.flagLiquidStaking.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
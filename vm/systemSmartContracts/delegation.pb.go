@@ -650,6 +650,53 @@ func (m *RewardComputationData) GetServiceFee() uint64 {
 	return 0
 }
 
+type LiquidStakingData struct {
+	TokenIdentifier []byte        `protobuf:"bytes,1,opt,name=TokenIdentifier,proto3" json:"TokenIdentifier"`
+	TotalReceipts   *math_big.Int `protobuf:"bytes,2,opt,name=TotalReceipts,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalReceipts"`
+}
+
+func (m *LiquidStakingData) Reset()      { *m = LiquidStakingData{} }
+func (*LiquidStakingData) ProtoMessage() {}
+func (*LiquidStakingData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{10}
+}
+func (m *LiquidStakingData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidStakingData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LiquidStakingData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidStakingData.Merge(m, src)
+}
+func (m *LiquidStakingData) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidStakingData) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidStakingData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidStakingData proto.InternalMessageInfo
+
+func (m *LiquidStakingData) GetTokenIdentifier() []byte {
+	if m != nil {
+		return m.TokenIdentifier
+	}
+	return nil
+}
+
+func (m *LiquidStakingData) GetTotalReceipts() *math_big.Int {
+	if m != nil {
+		return m.TotalReceipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DelegationManagement)(nil), "proto.DelegationManagement")
 	proto.RegisterType((*DelegationContractList)(nil), "proto.DelegationContractList")
@@ -661,84 +708,88 @@ func init() {
 	proto.RegisterType((*GlobalFundData)(nil), "proto.GlobalFundData")
 	proto.RegisterType((*NodesData)(nil), "proto.NodesData")
 	proto.RegisterType((*RewardComputationData)(nil), "proto.RewardComputationData")
+	proto.RegisterType((*LiquidStakingData)(nil), "proto.LiquidStakingData")
 }
 
 func init() { proto.RegisterFile("delegation.proto", fileDescriptor_b823c7d67e95582e) }
 
 var fileDescriptor_b823c7d67e95582e = []byte{
-	// 1147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0x8e, 0xd3, 0x74, 0xb7, 0xfb, 0x9a, 0xf4, 0xd7, 0xce, 0xb6, 0x3f, 0x22, 0x40, 0x76, 0x65,
-	0x09, 0xa9, 0x12, 0xda, 0x54, 0x0b, 0x48, 0x48, 0x70, 0xa1, 0x4e, 0xb7, 0x28, 0xda, 0x36, 0x45,
-	0x93, 0x76, 0x11, 0xab, 0x15, 0xd2, 0x24, 0x9e, 0xba, 0xa3, 0xc6, 0x9e, 0xc8, 0x1e, 0xb7, 0x5b,
-	0x89, 0x03, 0x17, 0x24, 0x38, 0x80, 0x38, 0x70, 0xe1, 0x3f, 0x40, 0xfc, 0x25, 0x1c, 0x2b, 0x4e,
-	0x3d, 0x20, 0x43, 0xd3, 0x0b, 0xf2, 0x69, 0xc5, 0x1d, 0x09, 0x79, 0x6c, 0x27, 0xe3, 0x24, 0xbb,
-	0x07, 0x14, 0x71, 0x89, 0xdf, 0xfb, 0x9e, 0xe7, 0xf3, 0xb3, 0xbf, 0xf7, 0xde, 0x4c, 0x60, 0xd5,
-	0xa6, 0x7d, 0xea, 0x10, 0xc1, 0xb8, 0xd7, 0x18, 0xf8, 0x5c, 0x70, 0xb4, 0x28, 0x2f, 0xaf, 0x3f,
-	0x70, 0x98, 0x38, 0x0d, 0xbb, 0x8d, 0x1e, 0x77, 0xb7, 0x1d, 0xee, 0xf0, 0x6d, 0x09, 0x77, 0xc3,
-	0x13, 0xe9, 0x49, 0x47, 0x5a, 0xe9, 0x2a, 0xf3, 0xef, 0x05, 0x58, 0xdf, 0x1d, 0x51, 0x1d, 0x10,
-	0x8f, 0x38, 0xd4, 0xa5, 0x9e, 0x40, 0x1f, 0xc0, 0x4a, 0x3b, 0x74, 0x0f, 0x4f, 0x9a, 0xdc, 0x13,
-	0x3e, 0xe9, 0x89, 0xa0, 0xae, 0x6d, 0x6a, 0x5b, 0x35, 0x0b, 0xc5, 0x91, 0x31, 0x11, 0xc1, 0x13,
-	0x3e, 0x7a, 0x08, 0xcb, 0xfb, 0x24, 0x10, 0x3b, 0xb6, 0xed, 0xd3, 0x20, 0xa8, 0x97, 0x37, 0xb5,
-	0xad, 0xaa, 0xf5, 0xbf, 0x38, 0x32, 0x54, 0x18, 0xab, 0x0e, 0x7a, 0x1f, 0x6a, 0x07, 0xcc, 0xeb,
-	0x50, 0xff, 0x9c, 0xf5, 0xe8, 0x1e, 0xa5, 0xf5, 0x85, 0x4d, 0x6d, 0xab, 0x62, 0xad, 0xc5, 0x91,
-	0x51, 0x0c, 0xe0, 0xa2, 0x2b, 0x17, 0x92, 0xe7, 0xca, 0xc2, 0x8a, 0xb2, 0x50, 0x0d, 0xe0, 0xa2,
-	0x8b, 0x02, 0x80, 0x03, 0xe6, 0xed, 0xd2, 0x01, 0x0f, 0x98, 0xa8, 0x2f, 0xca, 0x1c, 0x3b, 0x71,
-	0x64, 0x28, 0xe8, 0xcf, 0xbf, 0x1b, 0x3b, 0x2e, 0x11, 0xa7, 0xdb, 0x5d, 0xe6, 0x34, 0x5a, 0x9e,
-	0xf8, 0x50, 0xf9, 0xb6, 0x8f, 0xfa, 0x3e, 0xf7, 0xec, 0x36, 0x15, 0x17, 0xdc, 0x3f, 0xdb, 0xa6,
-	0xd2, 0x7b, 0xe0, 0xf0, 0x6d, 0x9b, 0x08, 0xd2, 0xb0, 0x98, 0xd3, 0xf2, 0x44, 0x93, 0x04, 0x82,
-	0xfa, 0x58, 0x21, 0x44, 0xdf, 0x69, 0x70, 0x5f, 0xba, 0xf9, 0x17, 0xdf, 0x71, 0x79, 0xe8, 0x89,
-	0xfa, 0x1d, 0xf9, 0xf8, 0x67, 0x71, 0x64, 0xcc, 0x0a, 0xcf, 0x27, 0x8f, 0x59, 0xcc, 0xe6, 0x23,
-	0xf8, 0xff, 0x18, 0xcb, 0x15, 0xdc, 0x67, 0x81, 0x40, 0x6f, 0xc3, 0xbd, 0x4c, 0x1c, 0x9a, 0x68,
-	0xbf, 0xb0, 0x55, 0xb5, 0x6a, 0x71, 0x64, 0x8c, 0x41, 0x3c, 0x36, 0xcd, 0x6f, 0x17, 0x61, 0xb5,
-	0xc0, 0x73, 0xc2, 0x1c, 0xf4, 0x95, 0x06, 0xab, 0x07, 0xe4, 0xb9, 0x82, 0x93, 0x81, 0xac, 0xa2,
-	0xaa, 0xf5, 0x59, 0x1c, 0x19, 0x53, 0xb1, 0xf9, 0xbc, 0xe6, 0x14, 0x2d, 0xfa, 0x5a, 0x83, 0xb5,
-	0x96, 0xc7, 0x04, 0x23, 0xfd, 0xc3, 0x0b, 0x8f, 0xfa, 0x7b, 0xa1, 0x67, 0xe7, 0x55, 0xf9, 0x34,
-	0x8e, 0x8c, 0xe9, 0xe0, 0x7c, 0x32, 0x99, 0xe6, 0x45, 0x2d, 0xb8, 0xbf, 0x13, 0x0a, 0xee, 0x12,
-	0xc1, 0x7a, 0x3b, 0x3d, 0xc1, 0xce, 0x65, 0x92, 0xb2, 0xd8, 0x97, 0xac, 0xd7, 0x12, 0xf9, 0x67,
-	0x84, 0xf1, 0x2c, 0x10, 0xed, 0xc3, 0x7a, 0xf3, 0x94, 0x78, 0x0e, 0x25, 0xdd, 0x3e, 0x9d, 0xa8,
-	0xff, 0x25, 0xab, 0x1e, 0x47, 0xc6, 0xcc, 0x38, 0x9e, 0x89, 0xa2, 0xf7, 0xa0, 0xda, 0xf4, 0x29,
-	0x11, 0xd4, 0x6e, 0x73, 0xaf, 0x47, 0x65, 0x3f, 0x54, 0xac, 0xd5, 0x38, 0x32, 0x0a, 0x38, 0x2e,
-	0x78, 0xc9, 0xaa, 0x63, 0xcf, 0xe2, 0x9e, 0xfd, 0x09, 0xf5, 0x19, 0xb7, 0x65, 0x19, 0x67, 0xab,
-	0x54, 0x1c, 0x17, 0x3c, 0x44, 0xe0, 0x8d, 0xe6, 0x29, 0xed, 0x9d, 0x35, 0xc9, 0xe0, 0xd0, 0xc3,
-	0x34, 0x13, 0x8b, 0x62, 0x7a, 0x41, 0x7c, 0x3b, 0xa8, 0xdf, 0x95, 0x2f, 0x60, 0xc4, 0x91, 0xf1,
-	0xaa, 0xdb, 0xf0, 0xab, 0x82, 0xe6, 0x37, 0x1a, 0x20, 0x65, 0xac, 0x51, 0x41, 0x76, 0x89, 0x20,
-	0xe8, 0x4d, 0xa8, 0xb4, 0x89, 0x4b, 0xb3, 0x22, 0x5c, 0x8a, 0x23, 0x43, 0xfa, 0x58, 0xfe, 0xa2,
-	0xb7, 0xe0, 0xee, 0xa7, 0xb4, 0x1b, 0x30, 0x41, 0xb3, 0xe2, 0x58, 0x8e, 0x23, 0x23, 0x87, 0x70,
-	0x6e, 0xa0, 0x06, 0x40, 0xcb, 0xa6, 0x9e, 0x60, 0x27, 0x8c, 0xfa, 0x52, 0xba, 0xaa, 0xb5, 0x92,
-	0x0c, 0x8e, 0x31, 0x8a, 0x15, 0xdb, 0xfc, 0xb1, 0x0c, 0xf5, 0xe9, 0x1e, 0xeb, 0x08, 0x22, 0xc2,
-	0x00, 0x7d, 0x04, 0xd0, 0x11, 0xe4, 0x8c, 0xda, 0x8f, 0xe9, 0x65, 0xda, 0x66, 0xcb, 0xef, 0xac,
-	0xa6, 0xb3, 0xb9, 0xd1, 0xe6, 0x36, 0x0d, 0x92, 0xbc, 0x53, 0xfa, 0xf1, 0x7d, 0x58, 0xb1, 0x51,
-	0x0b, 0x6a, 0x6d, 0x2e, 0x14, 0x92, 0xf2, 0x4b, 0x48, 0xe4, 0x48, 0x2c, 0xdc, 0x8a, 0x8b, 0x2e,
-	0xda, 0x4b, 0xe4, 0x54, 0x98, 0x16, 0x5e, 0xc2, 0x94, 0x09, 0xac, 0x10, 0x15, 0x3c, 0xb4, 0x05,
-	0x4b, 0xed, 0xd0, 0x3d, 0x0e, 0xa8, 0x1f, 0x64, 0xe3, 0xb8, 0x1a, 0x47, 0xc6, 0x08, 0xc3, 0x23,
-	0xcb, 0xfc, 0x55, 0x83, 0x4a, 0xd2, 0x19, 0xc8, 0x86, 0xc5, 0x27, 0xa4, 0x1f, 0xe6, 0xd2, 0xb4,
-	0xe3, 0xc8, 0x48, 0x81, 0xf9, 0xb4, 0x62, 0xca, 0x95, 0x28, 0x5c, 0xdc, 0x94, 0xa4, 0xc2, 0xf9,
-	0x86, 0x94, 0x1b, 0xc8, 0x80, 0xc5, 0xb4, 0x0b, 0xd2, 0x4d, 0xe8, 0x5e, 0x92, 0x4c, 0x5a, 0xfe,
-	0xe9, 0x25, 0xa9, 0xa3, 0xa3, 0xcb, 0x41, 0xda, 0x6b, 0xb5, 0xb4, 0x8e, 0x12, 0x1f, 0xcb, 0x5f,
-	0xf3, 0xb7, 0x05, 0xa8, 0x65, 0x82, 0x73, 0x5f, 0xd6, 0x5d, 0x03, 0x40, 0x76, 0x2e, 0x4d, 0xde,
-	0x35, 0x7b, 0x45, 0xa9, 0xe9, 0x18, 0xc5, 0x8a, 0x9d, 0x6c, 0x6a, 0xf9, 0x07, 0xcd, 0x87, 0x55,
-	0x32, 0x7f, 0xa5, 0x82, 0x85, 0x00, 0x2e, 0xba, 0xa8, 0x09, 0x6b, 0x59, 0x0b, 0xc8, 0xee, 0x18,
-	0x70, 0xe6, 0x09, 0xf9, 0x16, 0x35, 0x6b, 0x23, 0x99, 0x74, 0x53, 0x41, 0x3c, 0x0d, 0xc9, 0xb9,
-	0x7d, 0xec, 0x35, 0xfb, 0x84, 0xb9, 0xd4, 0xce, 0xbb, 0xb2, 0x32, 0x9e, 0xdb, 0x93, 0xb1, 0x39,
-	0xcd, 0xed, 0x49, 0x5a, 0xf4, 0x83, 0x06, 0x1b, 0x47, 0x5c, 0x90, 0x7e, 0x33, 0x74, 0xc3, 0x7e,
-	0x32, 0x75, 0xf2, 0x64, 0xd2, 0xdd, 0xfa, 0xf3, 0x38, 0x32, 0x66, 0xdf, 0x30, 0x9f, 0x8c, 0x66,
-	0x73, 0x9b, 0x7f, 0x95, 0x61, 0xe5, 0xe3, 0x3e, 0xef, 0x92, 0x7e, 0xf2, 0xcd, 0xa5, 0xbe, 0x0f,
-	0x61, 0x79, 0xac, 0x5e, 0xbe, 0x5b, 0xca, 0x03, 0x8f, 0x02, 0x63, 0xd5, 0xf9, 0xf7, 0x12, 0x9f,
-	0xc3, 0xb2, 0xcc, 0x2b, 0x25, 0xcb, 0xe6, 0xcf, 0x51, 0xf2, 0x2c, 0x05, 0x9e, 0xcf, 0x07, 0x50,
-	0x19, 0xd1, 0x17, 0x50, 0x93, 0x6e, 0x9e, 0x4d, 0x56, 0x11, 0x4f, 0x92, 0x84, 0x0b, 0x81, 0xf9,
-	0x3c, 0xbb, 0xc8, 0x69, 0x3e, 0x83, 0x7b, 0xa3, 0xf9, 0x83, 0x4c, 0xb8, 0x63, 0xed, 0x77, 0x1e,
-	0xd3, 0xcb, 0xac, 0x95, 0x20, 0x8e, 0x8c, 0x0c, 0xc1, 0xd9, 0x35, 0x39, 0xbe, 0x74, 0x98, 0xe3,
-	0x51, 0xfb, 0x20, 0x70, 0xb2, 0x66, 0x97, 0xc7, 0x97, 0x11, 0x88, 0xc7, 0xa6, 0x79, 0x55, 0x86,
-	0x8d, 0x54, 0xde, 0x26, 0x77, 0x07, 0xa1, 0x90, 0x93, 0x5a, 0x3e, 0x2a, 0x39, 0xb0, 0x65, 0xc2,
-	0x1f, 0xf1, 0x5d, 0x16, 0x08, 0x9f, 0x75, 0x43, 0x91, 0x8f, 0x29, 0x79, 0x60, 0x9b, 0x11, 0x9e,
-	0xd3, 0x81, 0x6d, 0x06, 0xf3, 0xa4, 0xfc, 0xe5, 0xff, 0x4a, 0xfe, 0x06, 0xc0, 0xd4, 0xe9, 0x3c,
-	0xdd, 0x96, 0xc6, 0x47, 0x0b, 0xc5, 0xb6, 0xda, 0x57, 0x37, 0x7a, 0xe9, 0xfa, 0x46, 0x2f, 0xbd,
-	0xb8, 0xd1, 0xb5, 0x2f, 0x87, 0xba, 0xf6, 0xd3, 0x50, 0xd7, 0x7e, 0x19, 0xea, 0xda, 0xd5, 0x50,
-	0xd7, 0xae, 0x87, 0xba, 0xf6, 0xc7, 0x50, 0xd7, 0xfe, 0x1c, 0xea, 0xa5, 0x17, 0x43, 0x5d, 0xfb,
-	0xfe, 0x56, 0x2f, 0x5d, 0xdd, 0xea, 0xa5, 0xeb, 0x5b, 0xbd, 0xf4, 0x74, 0x3d, 0xb8, 0x0c, 0x04,
-	0x75, 0x3b, 0x2e, 0xf1, 0xc5, 0xe8, 0x3f, 0x45, 0xf7, 0x8e, 0xdc, 0x84, 0xde, 0xfd, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x2d, 0x67, 0x02, 0x77, 0xf9, 0x0c, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0xb4, 0xdb, 0x7d, 0x4d, 0x76, 0xdb, 0xd9, 0x5d, 0x88, 0x00, 0xd9, 0x95, 0x25,
+	0xa4, 0x4a, 0x68, 0x53, 0x2d, 0x20, 0x21, 0x81, 0x90, 0xa8, 0xd3, 0x2d, 0x8a, 0xb6, 0x4d, 0xd1,
+	0xa4, 0x5d, 0xc4, 0x6a, 0x85, 0x34, 0x89, 0xa7, 0xee, 0xa8, 0xf1, 0x4c, 0xb0, 0xc7, 0xed, 0x56,
+	0xe2, 0xc0, 0x05, 0x09, 0x0e, 0x20, 0x0e, 0x5c, 0xf8, 0x07, 0x88, 0x5f, 0xc2, 0xb1, 0xe2, 0xd4,
+	0x03, 0x32, 0x34, 0xbd, 0x20, 0x9f, 0x56, 0xdc, 0x91, 0x90, 0xc7, 0x76, 0x62, 0x27, 0xd9, 0x3d,
+	0xa0, 0x88, 0x4b, 0x3c, 0xef, 0x7b, 0x9e, 0xcf, 0x6f, 0xe6, 0x7b, 0xef, 0xcd, 0x04, 0x56, 0x6d,
+	0xda, 0xa7, 0x0e, 0x91, 0x4c, 0xf0, 0xc6, 0xc0, 0x13, 0x52, 0xa0, 0x45, 0xf5, 0x78, 0xed, 0xbe,
+	0xc3, 0xe4, 0x71, 0xd0, 0x6d, 0xf4, 0x84, 0xbb, 0xe9, 0x08, 0x47, 0x6c, 0x2a, 0xb8, 0x1b, 0x1c,
+	0x29, 0x4b, 0x19, 0x6a, 0x94, 0xcc, 0x32, 0xff, 0x59, 0x80, 0xbb, 0xdb, 0x23, 0xaa, 0x3d, 0xc2,
+	0x89, 0x43, 0x5d, 0xca, 0x25, 0x7a, 0x1f, 0x6e, 0xb5, 0x03, 0x77, 0xff, 0xa8, 0x29, 0xb8, 0xf4,
+	0x48, 0x4f, 0xfa, 0x75, 0x6d, 0x5d, 0xdb, 0xa8, 0x59, 0x28, 0x0a, 0x8d, 0x09, 0x0f, 0x9e, 0xb0,
+	0xd1, 0x03, 0x58, 0xd9, 0x25, 0xbe, 0xdc, 0xb2, 0x6d, 0x8f, 0xfa, 0x7e, 0xbd, 0xbc, 0xae, 0x6d,
+	0x54, 0xad, 0xdb, 0x51, 0x68, 0xe4, 0x61, 0x9c, 0x37, 0xd0, 0x7b, 0x50, 0xdb, 0x63, 0xbc, 0x43,
+	0xbd, 0x53, 0xd6, 0xa3, 0x3b, 0x94, 0xd6, 0x17, 0xd6, 0xb5, 0x8d, 0x8a, 0xb5, 0x16, 0x85, 0x46,
+	0xd1, 0x81, 0x8b, 0xa6, 0x9a, 0x48, 0x9e, 0xe5, 0x26, 0x56, 0x72, 0x13, 0xf3, 0x0e, 0x5c, 0x34,
+	0x91, 0x0f, 0xb0, 0xc7, 0xf8, 0x36, 0x1d, 0x08, 0x9f, 0xc9, 0xfa, 0xa2, 0x8a, 0xb1, 0x13, 0x85,
+	0x46, 0x0e, 0xfd, 0xe5, 0x0f, 0x63, 0xcb, 0x25, 0xf2, 0x78, 0xb3, 0xcb, 0x9c, 0x46, 0x8b, 0xcb,
+	0x0f, 0x72, 0x7b, 0xfb, 0xb0, 0xef, 0x09, 0x6e, 0xb7, 0xa9, 0x3c, 0x13, 0xde, 0xc9, 0x26, 0x55,
+	0xd6, 0x7d, 0x47, 0x6c, 0xda, 0x44, 0x92, 0x86, 0xc5, 0x9c, 0x16, 0x97, 0x4d, 0xe2, 0x4b, 0xea,
+	0xe1, 0x1c, 0x21, 0xfa, 0x5e, 0x83, 0x3b, 0xca, 0xcc, 0x76, 0x7c, 0xcb, 0x15, 0x01, 0x97, 0xf5,
+	0x25, 0xf5, 0xf9, 0xa7, 0x51, 0x68, 0xcc, 0x72, 0xcf, 0x27, 0x8e, 0x59, 0xcc, 0xe6, 0x43, 0x78,
+	0x65, 0x8c, 0x65, 0x0a, 0xee, 0x32, 0x5f, 0xa2, 0xb7, 0xe0, 0x66, 0x2a, 0x0e, 0x8d, 0xb5, 0x5f,
+	0xd8, 0xa8, 0x5a, 0xb5, 0x28, 0x34, 0xc6, 0x20, 0x1e, 0x0f, 0xcd, 0xef, 0x16, 0x61, 0xb5, 0xc0,
+	0x73, 0xc4, 0x1c, 0xf4, 0xb5, 0x06, 0xab, 0x7b, 0xe4, 0x59, 0x0e, 0x27, 0x03, 0x95, 0x45, 0x55,
+	0xeb, 0xb3, 0x28, 0x34, 0xa6, 0x7c, 0xf3, 0x59, 0xe6, 0x14, 0x2d, 0xfa, 0x46, 0x83, 0xb5, 0x16,
+	0x67, 0x92, 0x91, 0xfe, 0xfe, 0x19, 0xa7, 0xde, 0x4e, 0xc0, 0xed, 0x2c, 0x2b, 0x9f, 0x44, 0xa1,
+	0x31, 0xed, 0x9c, 0x4f, 0x24, 0xd3, 0xbc, 0xa8, 0x05, 0x77, 0xb6, 0x02, 0x29, 0x5c, 0x22, 0x59,
+	0x6f, 0xab, 0x27, 0xd9, 0xa9, 0x0a, 0x52, 0x25, 0xfb, 0xb2, 0xf5, 0x6a, 0x2c, 0xff, 0x0c, 0x37,
+	0x9e, 0x05, 0xa2, 0x5d, 0xb8, 0xdb, 0x3c, 0x26, 0xdc, 0xa1, 0xa4, 0xdb, 0xa7, 0x13, 0xf9, 0xbf,
+	0x6c, 0xd5, 0xa3, 0xd0, 0x98, 0xe9, 0xc7, 0x33, 0x51, 0xf4, 0x2e, 0x54, 0x9b, 0x1e, 0x25, 0x92,
+	0xda, 0x6d, 0xc1, 0x7b, 0x54, 0xd5, 0x43, 0xc5, 0x5a, 0x8d, 0x42, 0xa3, 0x80, 0xe3, 0x82, 0x15,
+	0xcf, 0x3a, 0xe4, 0x96, 0xe0, 0xf6, 0x27, 0xd4, 0x63, 0xc2, 0xae, 0x2f, 0x8d, 0x67, 0xe5, 0x71,
+	0x5c, 0xb0, 0x10, 0x81, 0xd7, 0x9b, 0xc7, 0xb4, 0x77, 0xd2, 0x24, 0x83, 0x7d, 0x8e, 0x69, 0x2a,
+	0x16, 0xc5, 0xf4, 0x8c, 0x78, 0xb6, 0x5f, 0xbf, 0xa1, 0x16, 0x60, 0x44, 0xa1, 0xf1, 0xb2, 0xd7,
+	0xf0, 0xcb, 0x9c, 0xe6, 0xb7, 0x1a, 0xa0, 0x5c, 0x5b, 0xa3, 0x92, 0x6c, 0x13, 0x49, 0xd0, 0x1b,
+	0x50, 0x69, 0x13, 0x97, 0xa6, 0x49, 0xb8, 0x1c, 0x85, 0x86, 0xb2, 0xb1, 0xfa, 0x45, 0x6f, 0xc2,
+	0x8d, 0x4f, 0x69, 0xd7, 0x67, 0x92, 0xa6, 0xc9, 0xb1, 0x12, 0x85, 0x46, 0x06, 0xe1, 0x6c, 0x80,
+	0x1a, 0x00, 0x2d, 0x9b, 0x72, 0xc9, 0x8e, 0x18, 0xf5, 0x94, 0x74, 0x55, 0xeb, 0x56, 0xdc, 0x38,
+	0xc6, 0x28, 0xce, 0x8d, 0xcd, 0x9f, 0xca, 0x50, 0x9f, 0xae, 0xb1, 0x8e, 0x24, 0x32, 0xf0, 0xd1,
+	0x47, 0x00, 0x1d, 0x49, 0x4e, 0xa8, 0xfd, 0x88, 0x9e, 0x27, 0x65, 0xb6, 0xf2, 0xf6, 0x6a, 0xd2,
+	0x9b, 0x1b, 0x6d, 0x61, 0x53, 0x3f, 0x8e, 0x3b, 0xa1, 0x1f, 0xbf, 0x87, 0x73, 0x63, 0xd4, 0x82,
+	0x5a, 0x5b, 0xc8, 0x1c, 0x49, 0xf9, 0x05, 0x24, 0xaa, 0x25, 0x16, 0x5e, 0xc5, 0x45, 0x13, 0xed,
+	0xc4, 0x72, 0xe6, 0x98, 0x16, 0x5e, 0xc0, 0x94, 0x0a, 0x9c, 0x23, 0x2a, 0x58, 0x68, 0x03, 0x96,
+	0xdb, 0x81, 0x7b, 0xe8, 0x53, 0xcf, 0x4f, 0xdb, 0x71, 0x35, 0x0a, 0x8d, 0x11, 0x86, 0x47, 0x23,
+	0xf3, 0x37, 0x0d, 0x2a, 0x71, 0x65, 0x20, 0x1b, 0x16, 0x1f, 0x93, 0x7e, 0x90, 0x49, 0xd3, 0x8e,
+	0x42, 0x23, 0x01, 0xe6, 0x53, 0x8a, 0x09, 0x57, 0xac, 0x70, 0xf1, 0x50, 0x52, 0x0a, 0xa7, 0x10,
+	0xce, 0x06, 0xc8, 0x80, 0xc5, 0xa4, 0x0a, 0x92, 0x43, 0xe8, 0x66, 0x1c, 0x4c, 0x92, 0xfe, 0xc9,
+	0x23, 0xce, 0xa3, 0x83, 0xf3, 0x41, 0x52, 0x6b, 0xb5, 0x24, 0x8f, 0x62, 0x1b, 0xab, 0x5f, 0xf3,
+	0xf7, 0x05, 0xa8, 0xa5, 0x82, 0x0b, 0x4f, 0xe5, 0x5d, 0x03, 0x40, 0x55, 0x2e, 0x8d, 0xd7, 0x9a,
+	0x2e, 0x51, 0x69, 0x3a, 0x46, 0x71, 0x6e, 0x1c, 0x1f, 0x6a, 0xd9, 0x86, 0x66, 0xcd, 0x2a, 0xee,
+	0xbf, 0x4a, 0xc1, 0x82, 0x03, 0x17, 0x4d, 0xd4, 0x84, 0xb5, 0xb4, 0x04, 0x54, 0x75, 0x0c, 0x04,
+	0xe3, 0x52, 0xad, 0xa2, 0x66, 0xdd, 0x8b, 0x3b, 0xdd, 0x94, 0x13, 0x4f, 0x43, 0xaa, 0x6f, 0x1f,
+	0xf2, 0x66, 0x9f, 0x30, 0x97, 0xda, 0x59, 0x55, 0x56, 0xc6, 0x7d, 0x7b, 0xd2, 0x37, 0xa7, 0xbe,
+	0x3d, 0x49, 0x8b, 0x7e, 0xd4, 0xe0, 0xde, 0x81, 0x90, 0xa4, 0xdf, 0x0c, 0xdc, 0xa0, 0x4f, 0xe4,
+	0xc8, 0x93, 0x9e, 0xd6, 0x9f, 0x47, 0xa1, 0x31, 0xfb, 0x85, 0xf9, 0x44, 0x34, 0x9b, 0xdb, 0xfc,
+	0xbb, 0x0c, 0xb7, 0x3e, 0xee, 0x8b, 0x2e, 0xe9, 0xc7, 0x7b, 0xae, 0xf4, 0x7d, 0x00, 0x2b, 0x63,
+	0xf5, 0xb2, 0xd3, 0x52, 0x5d, 0x78, 0x72, 0x30, 0xce, 0x1b, 0xff, 0x5d, 0xe2, 0x53, 0x58, 0x51,
+	0x71, 0x25, 0x64, 0x69, 0xff, 0x39, 0x88, 0xbf, 0x95, 0x83, 0xe7, 0xb3, 0x01, 0x79, 0x46, 0xf4,
+	0x25, 0xd4, 0x94, 0x99, 0x45, 0x93, 0x66, 0xc4, 0xe3, 0x38, 0xe0, 0x82, 0x63, 0x3e, 0xdf, 0x2e,
+	0x72, 0x9a, 0x4f, 0xe1, 0xe6, 0xa8, 0xff, 0x20, 0x13, 0x96, 0xac, 0xdd, 0xce, 0x23, 0x7a, 0x9e,
+	0x96, 0x12, 0x44, 0xa1, 0x91, 0x22, 0x38, 0x7d, 0xc6, 0xd7, 0x97, 0x0e, 0x73, 0x38, 0xb5, 0xf7,
+	0x7c, 0x27, 0x2d, 0x76, 0x75, 0x7d, 0x19, 0x81, 0x78, 0x3c, 0x34, 0x2f, 0xca, 0x70, 0x2f, 0x91,
+	0xb7, 0x29, 0xdc, 0x41, 0x20, 0x55, 0xa7, 0x56, 0x9f, 0x8a, 0x2f, 0x6c, 0xa9, 0xf0, 0x07, 0x62,
+	0x9b, 0xf9, 0xd2, 0x63, 0xdd, 0x40, 0x66, 0x6d, 0x4a, 0x5d, 0xd8, 0x66, 0xb8, 0xe7, 0x74, 0x61,
+	0x9b, 0xc1, 0x3c, 0x29, 0x7f, 0xf9, 0xff, 0x92, 0xbf, 0x01, 0x30, 0x75, 0x3b, 0x4f, 0x8e, 0xa5,
+	0x11, 0x8a, 0x73, 0x63, 0xf3, 0x42, 0x83, 0xb5, 0x5d, 0xf6, 0x45, 0xc0, 0xec, 0x58, 0x41, 0xc6,
+	0x1d, 0xb5, 0x9d, 0x1f, 0xc2, 0xed, 0x03, 0x71, 0x42, 0x79, 0xee, 0x00, 0x4d, 0x76, 0xf2, 0x4e,
+	0x14, 0x1a, 0x93, 0x2e, 0x3c, 0x09, 0x8c, 0x72, 0x10, 0xd3, 0x1e, 0x65, 0x03, 0x99, 0x75, 0xf1,
+	0x71, 0x0e, 0x66, 0x8e, 0x79, 0xe6, 0x60, 0xc6, 0x69, 0xb5, 0x2f, 0xae, 0xf4, 0xd2, 0xe5, 0x95,
+	0x5e, 0x7a, 0x7e, 0xa5, 0x6b, 0x5f, 0x0d, 0x75, 0xed, 0xe7, 0xa1, 0xae, 0xfd, 0x3a, 0xd4, 0xb5,
+	0x8b, 0xa1, 0xae, 0x5d, 0x0e, 0x75, 0xed, 0xcf, 0xa1, 0xae, 0xfd, 0x35, 0xd4, 0x4b, 0xcf, 0x87,
+	0xba, 0xf6, 0xc3, 0xb5, 0x5e, 0xba, 0xb8, 0xd6, 0x4b, 0x97, 0xd7, 0x7a, 0xe9, 0xc9, 0x5d, 0xff,
+	0xdc, 0x97, 0xd4, 0xed, 0xb8, 0xc4, 0x93, 0xa3, 0xbf, 0x49, 0xdd, 0x25, 0x75, 0xae, 0xbe, 0xf3,
+	0xef, 0x00, 0x9d, 0x5b, 0x86, 0x01, 0xcc, 0x0d, 0x00, 0x00,
 }
 
 func (this *DelegationManagement) Equal(that interface{}) bool {
@@ -1136,6 +1187,36 @@ func (this *RewardComputationData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LiquidStakingData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LiquidStakingData)
+	if !ok {
+		that2, ok := that.(LiquidStakingData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TokenIdentifier, that1.TokenIdentifier) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalReceipts, that1.TotalReceipts) {
+			return false
+		}
+	}
+	return true
+}
 func (this *DelegationManagement) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1271,6 +1352,17 @@ func (this *RewardComputationData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *LiquidStakingData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.LiquidStakingData{")
+	s = append(s, "TokenIdentifier: "+fmt.Sprintf("%#v", this.TokenIdentifier)+",\n")
+	s = append(s, "TotalReceipts: "+fmt.Sprintf("%#v", this.TotalReceipts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringDelegation(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1844,6 +1936,47 @@ func (m *RewardComputationData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LiquidStakingData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidStakingData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidStakingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalReceipts)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalReceipts, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenIdentifier) > 0 {
+		i -= len(m.TokenIdentifier)
+		copy(dAtA[i:], m.TokenIdentifier)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.TokenIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDelegation(v)
 	base := offset
@@ -2113,6 +2246,24 @@ func (m *RewardComputationData) Size() (n int) {
 	return n
 }
 
+func (m *LiquidStakingData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalReceipts)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
 func sovDelegation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2263,6 +2414,17 @@ func (this *RewardComputationData) String() string {
 	}, "")
 	return s
 }
+func (this *LiquidStakingData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LiquidStakingData{`,
+		`TokenIdentifier:` + fmt.Sprintf("%v", this.TokenIdentifier) + `,`,
+		`TotalReceipts:` + fmt.Sprintf("%v", this.TotalReceipts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringDelegation(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3971,6 +4133,131 @@ func (m *RewardComputationData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LiquidStakingData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidStakingData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidStakingData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifier = append(m.TokenIdentifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenIdentifier == nil {
+				m.TokenIdentifier = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReceipts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalReceipts = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDelegation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
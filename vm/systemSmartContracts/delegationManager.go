@@ -3,6 +3,7 @@ package systemSmartContracts
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sync"
@@ -22,20 +23,22 @@ const delegationContractsList = "delegationContracts"
 var nextAddressAdd = big.NewInt(1 << 24)
 
 type delegationManager struct {
-	eei                      vm.SystemEI
-	delegationMgrSCAddress   []byte
-	stakingSCAddr            []byte
-	validatorSCAddr          []byte
-	configChangeAddr         []byte
-	gasCost                  vm.GasCost
-	marshalizer              marshal.Marshalizer
-	delegationMgrEnabled     atomic.Flag
-	enableDelegationMgrEpoch uint32
-	minCreationDeposit       *big.Int
-	minDelegationAmount      *big.Int
-	minFee                   uint64
-	maxFee                   uint64
-	mutExecution             sync.RWMutex
+	eei                              vm.SystemEI
+	delegationMgrSCAddress           []byte
+	stakingSCAddr                    []byte
+	validatorSCAddr                  []byte
+	configChangeAddr                 []byte
+	gasCost                          vm.GasCost
+	marshalizer                      marshal.Marshalizer
+	delegationMgrEnabled             atomic.Flag
+	enableDelegationMgrEpoch         uint32
+	flagValidatorToDelegation        atomic.Flag
+	validatorToDelegationEnableEpoch uint32
+	minCreationDeposit               *big.Int
+	minDelegationAmount              *big.Int
+	minFee                           uint64
+	maxFee                           uint64
+	mutExecution                     sync.RWMutex
 }
 
 // ArgsNewDelegationManager defines the arguments to create the delegation manager system smart contract
@@ -87,19 +90,20 @@ func NewDelegationManagerSystemSC(args ArgsNewDelegationManager) (*delegationMan
 	}
 
 	d := &delegationManager{
-		eei:                      args.Eei,
-		stakingSCAddr:            args.StakingSCAddress,
-		validatorSCAddr:          args.ValidatorSCAddress,
-		delegationMgrSCAddress:   args.DelegationMgrSCAddress,
-		configChangeAddr:         args.ConfigChangeAddress,
-		gasCost:                  args.GasCost,
-		marshalizer:              args.Marshalizer,
-		delegationMgrEnabled:     atomic.Flag{},
-		enableDelegationMgrEpoch: args.DelegationMgrSCConfig.EnabledEpoch,
-		minCreationDeposit:       minCreationDeposit,
-		minDelegationAmount:      minDelegationAmount,
-		minFee:                   args.DelegationSCConfig.MinServiceFee,
-		maxFee:                   args.DelegationSCConfig.MaxServiceFee,
+		eei:                              args.Eei,
+		stakingSCAddr:                    args.StakingSCAddress,
+		validatorSCAddr:                  args.ValidatorSCAddress,
+		delegationMgrSCAddress:           args.DelegationMgrSCAddress,
+		configChangeAddr:                 args.ConfigChangeAddress,
+		gasCost:                          args.GasCost,
+		marshalizer:                      args.Marshalizer,
+		delegationMgrEnabled:             atomic.Flag{},
+		enableDelegationMgrEpoch:         args.DelegationMgrSCConfig.EnabledEpoch,
+		validatorToDelegationEnableEpoch: args.DelegationMgrSCConfig.ValidatorToDelegationEnableEpoch,
+		minCreationDeposit:               minCreationDeposit,
+		minDelegationAmount:              minDelegationAmount,
+		minFee:                           args.DelegationSCConfig.MinServiceFee,
+		maxFee:                           args.DelegationSCConfig.MaxServiceFee,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(d)
@@ -128,6 +132,10 @@ func (d *delegationManager) Execute(args *vmcommon.ContractCallInput) vmcommon.R
 		return d.init(args)
 	case "createNewDelegationContract":
 		return d.createNewDelegationContract(args)
+	case "makeNewContractFromValidatorData":
+		return d.makeNewContractFromValidatorData(args)
+	case "mergeValidatorToDelegation":
+		return d.mergeValidatorToDelegation(args)
 	case "getAllContractAddresses":
 		return d.getAllContractAddresses(args)
 	case "getContractConfig":
@@ -200,44 +208,159 @@ func (d *delegationManager) createNewDelegationContract(args *vmcommon.ContractC
 		return vmcommon.UserError
 	}
 
-	delegationList, err := d.getDelegationContractList()
+	depositValue := big.NewInt(0).Set(args.CallValue)
+	_, returnCode := d.deployNewContract(
+		delegationManagement,
+		args.CallerAddr,
+		args.CallerAddr,
+		core.SCDeployInitFunctionName,
+		depositValue,
+		args.Arguments,
+	)
+
+	return returnCode
+}
+
+// makeNewContractFromValidatorData deploys a delegation contract which takes over the staked keys and the funds of
+// the caller's validator, the caller becoming the owner of the new contract
+func (d *delegationManager) makeNewContractFromValidatorData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkValidatorToDelegationInput(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if d.callerAlreadyDeployed(args.CallerAddr) {
+		d.eei.AddReturnMessage("caller already deployed a delegation sc")
+		return vmcommon.UserError
+	}
+
+	delegationManagement, err := d.getDelegationManagementData()
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
 
-	depositValue := big.NewInt(0).Set(args.CallValue)
-	newAddress := createNewAddress(delegationManagement.LastAddress)
+	arguments := append([][]byte{args.CallerAddr}, args.Arguments...)
+	newAddress, returnCode := d.deployNewContract(
+		delegationManagement,
+		args.CallerAddr,
+		d.delegationMgrSCAddress,
+		initFromValidatorData,
+		big.NewInt(0),
+		arguments,
+	)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	return d.moveValidatorData("changeOwnerOfValidatorData", args.CallerAddr, newAddress)
+}
+
+// mergeValidatorToDelegation moves the staked keys and the funds of the caller's validator into the delegation
+// contract given as argument, which must have been deployed by the caller
+func (d *delegationManager) mergeValidatorToDelegation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkValidatorToDelegationInput(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	delegationAddress := args.Arguments[0]
+	if !bytes.Equal(d.eei.GetStorage(args.CallerAddr), delegationAddress) {
+		d.eei.AddReturnMessage("caller is not the owner of the delegation contract")
+		return vmcommon.UserError
+	}
+
+	txData := "mergeValidatorDataToContract@" + hex.EncodeToString(args.CallerAddr)
+	vmOutput, err := d.eei.ExecuteOnDestContext(delegationAddress, d.delegationMgrSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	return d.moveValidatorData("mergeValidatorData", args.CallerAddr, delegationAddress)
+}
+
+func (d *delegationManager) checkValidatorToDelegationInput(args *vmcommon.ContractCallInput, numArguments int) vmcommon.ReturnCode {
+	if !d.flagValidatorToDelegation.IsSet() {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != numArguments {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationMgrOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	return vmcommon.Ok
+}
 
-	returnCode, err := d.eei.DeploySystemSC(vm.FirstDelegationSCAddress, newAddress, args.CallerAddr, depositValue, args.Arguments)
+func (d *delegationManager) moveValidatorData(function string, validatorAddress []byte, delegationAddress []byte) vmcommon.ReturnCode {
+	txData := function + "@" + hex.EncodeToString(validatorAddress) + "@" + hex.EncodeToString(delegationAddress)
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.validatorSCAddr, d.delegationMgrSCAddress, big.NewInt(0), []byte(txData))
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
+
+	return vmOutput.ReturnCode
+}
+
+func (d *delegationManager) deployNewContract(
+	delegationManagement *DelegationManagement,
+	ownerAddress []byte,
+	callerAddress []byte,
+	initFunction string,
+	depositValue *big.Int,
+	arguments [][]byte,
+) ([]byte, vmcommon.ReturnCode) {
+	delegationList, err := d.getDelegationContractList()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	newAddress := createNewAddress(delegationManagement.LastAddress)
+
+	returnCode, err := d.eei.DeploySystemSC(vm.FirstDelegationSCAddress, newAddress, callerAddress, initFunction, depositValue, arguments)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
 	if returnCode != vmcommon.Ok {
-		return returnCode
+		return nil, returnCode
 	}
 
 	delegationManagement.NumOfContracts += 1
 	delegationManagement.LastAddress = newAddress
 	delegationList.Addresses = append(delegationList.Addresses, newAddress)
 
-	d.eei.SetStorage(args.CallerAddr, newAddress)
+	d.eei.SetStorage(ownerAddress, newAddress)
 	err = d.saveDelegationManagementData(delegationManagement)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
-		return vmcommon.UserError
+		return nil, vmcommon.UserError
 	}
 
 	err = d.saveDelegationContractList(delegationList)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
-		return vmcommon.UserError
+		return nil, vmcommon.UserError
 	}
 
 	d.eei.Finish(newAddress)
 
-	return vmcommon.Ok
+	return newAddress, vmcommon.Ok
 }
 
 func (d *delegationManager) checkConfigChangeInput(args *vmcommon.ContractCallInput) error {
@@ -430,6 +553,9 @@ func (d *delegationManager) SetNewGasCost(gasCost vm.GasCost) {
 func (d *delegationManager) EpochConfirmed(epoch uint32) {
 	d.delegationMgrEnabled.Toggle(epoch >= d.enableDelegationMgrEpoch)
 	log.Debug("delegationManager", "enabled", d.delegationMgrEnabled.IsSet())
+
+	d.flagValidatorToDelegation.Toggle(epoch >= d.validatorToDelegationEnableEpoch)
+	log.Debug("delegationManager: validator to delegation", "enabled", d.flagValidatorToDelegation.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
@@ -426,6 +426,131 @@ func TestDelegationManagerSystemSC_ExecuteCreateNewDelegationContract(t *testing
 	assert.True(t, bytes.Equal(stakedData.RewardAddress, eei.scAddress))
 }
 
+func createDelegationManagerWithValidatorStub(validatorSc vm.SystemSmartContract) (*delegationManager, *vmContext) {
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+
+	delegationSCArgs := createMockArgumentsForDelegation()
+	delegationSCArgs.Eei = eei
+	delegationSc, _ := NewDelegationSystemSC(delegationSCArgs)
+	deployedAddress := createNewAddress(vm.FirstDelegationSCAddress)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{
+		GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+			switch string(key) {
+			case string(vm.ValidatorSCAddress):
+				return validatorSc, nil
+			case string(vm.FirstDelegationSCAddress), string(deployedAddress):
+				return delegationSc, nil
+			}
+			return nil, vm.ErrUnknownSystemSmartContract
+		},
+	})
+
+	args := createMockArgumentsForDelegationManager()
+	args.Eei = eei
+	dm, _ := NewDelegationManagerSystemSC(args)
+	eei.SetSCAddress(vm.DelegationManagerSCAddress)
+	_ = dm.saveDelegationContractList(&DelegationContractList{Addresses: make([][]byte, 0)})
+	_ = dm.saveDelegationManagementData(&DelegationManagement{
+		MinDeposit:          big.NewInt(10),
+		MinDelegationAmount: big.NewInt(10),
+		LastAddress:         vm.FirstDelegationSCAddress,
+	})
+
+	return dm, eei
+}
+
+func TestDelegationManagerSystemSC_MakeNewContractFromValidatorDataUserErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.DelegationMgrSCConfig.ValidatorToDelegationEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	dm, _ := NewDelegationManagerSystemSC(args)
+
+	vmInput := getDefaultVmInputForDelegationManager("makeNewContractFromValidatorData", [][]byte{{250}, {10}})
+	output := dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid function to call", eei.returnMessage)
+
+	dm.EpochConfirmed(1)
+	vmInput.Arguments = [][]byte{{250}}
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{{250}, {10}}
+	vmInput.CallValue = big.NewInt(10)
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.CallValue = big.NewInt(0)
+	eei.SetStorage(vmInput.CallerAddr, []byte("deployed"))
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "caller already deployed a delegation sc"))
+}
+
+func TestDelegationManagerSystemSC_MakeNewContractFromValidatorDataAndMerge(t *testing.T) {
+	t.Parallel()
+
+	validatorCalls := make([]*vmcommon.ContractCallInput, 0)
+	validatorSc := &mock.SystemSCStub{
+		ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			validatorCalls = append(validatorCalls, args)
+			return vmcommon.Ok
+		},
+	}
+	dm, eei := createDelegationManagerWithValidatorStub(validatorSc)
+
+	vmInput := getDefaultVmInputForDelegationManager("makeNewContractFromValidatorData", [][]byte{{}, {10}})
+	validatorData := &ValidatorDataV2{
+		RewardAddress:   vmInput.CallerAddr,
+		TotalStakeValue: big.NewInt(100),
+		BlsPubKeys:      [][]byte{[]byte("blsKey1")},
+	}
+	marshaledData, _ := dm.marshalizer.Marshal(validatorData)
+	eei.SetStorageForAddress(vm.ValidatorSCAddress, vmInput.CallerAddr, marshaledData)
+
+	output := dm.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	expectedAddress := createNewAddress(vm.FirstDelegationSCAddress)
+	assert.Equal(t, expectedAddress, eei.GetStorage(vmInput.CallerAddr))
+	require.Equal(t, 1, len(validatorCalls))
+	assert.Equal(t, "changeOwnerOfValidatorData", validatorCalls[0].Function)
+	assert.Equal(t, vm.DelegationManagerSCAddress, validatorCalls[0].CallerAddr)
+	assert.Equal(t, [][]byte{vmInput.CallerAddr, expectedAddress}, validatorCalls[0].Arguments)
+	assert.Equal(t, vmInput.CallerAddr, eei.GetStorageFromAddress(expectedAddress, []byte(ownerKey)))
+
+	vmInput = getDefaultVmInputForDelegationManager("mergeValidatorToDelegation", [][]byte{[]byte("another contract")})
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "caller is not the owner of the delegation contract"))
+
+	validatorData.BlsPubKeys = [][]byte{[]byte("blsKey2")}
+	marshaledData, _ = dm.marshalizer.Marshal(validatorData)
+	eei.SetStorageForAddress(vm.ValidatorSCAddress, vmInput.CallerAddr, marshaledData)
+	vmInput.Arguments = [][]byte{expectedAddress}
+	output = dm.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	require.Equal(t, 2, len(validatorCalls))
+	assert.Equal(t, "mergeValidatorData", validatorCalls[1].Function)
+	assert.Equal(t, [][]byte{vmInput.CallerAddr, expectedAddress}, validatorCalls[1].Arguments)
+}
+
 func TestDelegationManagerSystemSC_ExecuteGetAllContractAddresses(t *testing.T) {
 	t.Parallel()
 
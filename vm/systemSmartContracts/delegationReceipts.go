@@ -0,0 +1,493 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const receiptTokenDecimals = 18
+
+// The receipts are minted for a pool position held by the delegation contract under its own address. The rewards
+// earned by the pool are re-delegated before every receipts operation, so the value of a receipt is always
+// pool active stake / total receipts and it grows with each epoch the delegation contract is rewarded.
+
+// enableLiquidStaking issues the receipt token of the delegation contract. The call value pays the ESDT issue cost.
+// format: enableLiquidStaking@tokenName@tickerName
+func (d *delegation) enableLiquidStaking(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if !d.isOwner(args.CallerAddr) {
+		d.eei.AddReturnMessage("only owner can call this method")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	liquidStakingData, err := d.getLiquidStakingData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidStakingData != nil {
+		d.eei.AddReturnMessage("liquid staking is already enabled")
+		return vmcommon.UserError
+	}
+
+	// the ESDT contract requires a non zero initial supply: the single unit is kept by the delegation contract and it
+	// is not accounted as a receipt, so it cannot be used to unDelegate
+	issueArgs := [][]byte{
+		args.Arguments[0],
+		args.Arguments[1],
+		big.NewInt(1).Bytes(),
+		big.NewInt(receiptTokenDecimals).Bytes(),
+		[]byte(mintable),
+		[]byte(getStringFromBool(true)),
+		[]byte(burnable),
+		[]byte(getStringFromBool(true)),
+	}
+	vmOutput, err := d.executeOnESDTSC(args.RecipientAddr, "issue", issueArgs, args.CallValue)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+	if len(vmOutput.ReturnData) == 0 {
+		d.eei.AddReturnMessage("esdt contract did not return the token identifier")
+		return vmcommon.UserError
+	}
+
+	tokenIdentifier := vmOutput.ReturnData[len(vmOutput.ReturnData)-1]
+	err = d.saveLiquidStakingData(&LiquidStakingData{
+		TokenIdentifier: tokenIdentifier,
+		TotalReceipts:   big.NewInt(0),
+	})
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(tokenIdentifier)
+
+	return vmcommon.Ok
+}
+
+// delegateForReceipts adds the call value to the receipts pool and mints to the caller the receipts representing it
+func (d *delegation) delegateForReceipts(args *vmcommon.ContractCallInput, liquidStakingData *LiquidStakingData) vmcommon.ReturnCode {
+	poolAddress := args.RecipientAddr
+	returnCode := d.compoundLiquidStakingRewards(poolAddress)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	poolValue, err := d.getPoolActiveValue(poolAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	receipts := computeReceiptsForValue(args.CallValue, poolValue, liquidStakingData.TotalReceipts)
+	if receipts.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("delegate value is too low to mint receipts")
+		return vmcommon.UserError
+	}
+
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	returnCode = d.delegateUser(args.CallValue, poolAddress, poolAddress, dStatus)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	mintArgs := [][]byte{liquidStakingData.TokenIdentifier, receipts.Bytes(), args.CallerAddr}
+	vmOutput, err := d.executeOnESDTSC(poolAddress, "mint", mintArgs, big.NewInt(0))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	liquidStakingData.TotalReceipts.Add(liquidStakingData.TotalReceipts, receipts)
+	err = d.saveLiquidStakingData(liquidStakingData)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// isESDTTransferMatchingArguments checks that the token and the value from the call arguments are the ones actually
+// transferred with the call
+func isESDTTransferMatchingArguments(args *vmcommon.ContractCallInput) bool {
+	if args.ESDTValue == nil || args.ESDTValue.Cmp(zero) <= 0 {
+		return false
+	}
+	if !bytes.Equal(args.ESDTTokenName, args.Arguments[0]) {
+		return false
+	}
+
+	return args.ESDTValue.Cmp(big.NewInt(0).SetBytes(args.Arguments[1])) == 0
+}
+
+func isInitialSupplyTransfer(args *vmcommon.ContractCallInput, esdtSCAddress []byte) bool {
+	return len(args.Arguments) == 2 &&
+		bytes.Equal(args.CallerAddr, esdtSCAddress) &&
+		args.ESDTValue.Cmp(big.NewInt(1)) == 0
+}
+
+// unDelegateReceipts burns the received receipts and unStakes their value from the receipts pool into an unStaked
+// fund of the sender, which can be withdrawn after the unBond period as any other unDelegated value
+// format: ESDTTransfer@tokenIdentifier@numReceipts@unDelegate
+func (d *delegation) unDelegateReceipts(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 2 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !isESDTTransferMatchingArguments(args) {
+		d.eei.AddReturnMessage("transferred ESDT does not match the call arguments")
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	liquidStakingData, err := d.getLiquidStakingData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidStakingData == nil || !bytes.Equal(liquidStakingData.TokenIdentifier, args.ESDTTokenName) {
+		d.eei.AddReturnMessage("invalid receipt token")
+		return vmcommon.UserError
+	}
+	if isInitialSupplyTransfer(args, d.esdtSCAddr) {
+		// the single unit minted when the token was issued is kept by the contract, see enableLiquidStaking
+		return vmcommon.Ok
+	}
+	if len(args.Arguments) != 3 || string(args.Arguments[2]) != "unDelegate" {
+		d.eei.AddReturnMessage("receipts can be sent only to unDelegate")
+		return vmcommon.FunctionWrongSignature
+	}
+	receipts := big.NewInt(0).Set(args.ESDTValue)
+	if receipts.Cmp(zero) <= 0 || receipts.Cmp(liquidStakingData.TotalReceipts) > 0 {
+		d.eei.AddReturnMessage("invalid number of receipts")
+		return vmcommon.UserError
+	}
+
+	poolAddress := args.RecipientAddr
+	returnCode := d.compoundLiquidStakingRewards(poolAddress)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, pool, err := d.getOrCreateDelegatorData(poolAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(pool.ActiveFund) == 0 {
+		d.eei.AddReturnMessage("no active stake for the receipts")
+		return vmcommon.UserError
+	}
+	activeFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	valueToUnDelegate := computeValueForReceipts(receipts, activeFund.Value, liquidStakingData.TotalReceipts)
+	if valueToUnDelegate.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("invalid value to undelegate")
+		return vmcommon.UserError
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	returnData, returnCode := d.executeOnValidatorSCWithValueInArgs(poolAddress, "unStakeTokens", valueToUnDelegate)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	actualUserUnStake, err := d.resolveUnStakedUnBondResponse(returnData, valueToUnDelegate)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	activeFund.Value.Sub(activeFund.Value, actualUserUnStake)
+	err = d.saveFund(pool.ActiveFund, activeFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if activeFund.Value.Cmp(zero) == 0 {
+		removeActiveFundFromGlobalData(globalFund, pool.ActiveFund)
+		pool.ActiveFund = nil
+	}
+
+	returnCode = d.addUnStakedFundForReceiptsHolder(args.CallerAddr, actualUserUnStake, globalFund)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.saveDelegatorData(poolAddress, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	liquidStakingData.TotalReceipts.Sub(liquidStakingData.TotalReceipts, receipts)
+	err = d.saveLiquidStakingData(liquidStakingData)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	burnArgs := [][]byte{liquidStakingData.TokenIdentifier, receipts.Bytes()}
+	vmOutput, err := d.executeOnESDTSC(poolAddress, core.BuiltInFunctionESDTBurn, burnArgs, big.NewInt(0))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmOutput.ReturnCode
+}
+
+func (d *delegation) addUnStakedFundForReceiptsHolder(
+	address []byte,
+	value *big.Int,
+	globalFund *GlobalFundData,
+) vmcommon.ReturnCode {
+	isNew, delegator, err := d.getOrCreateDelegatorData(address)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+
+		dStatus, errGet := d.getDelegationStatus()
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		dStatus.NumUsers++
+		err = d.saveDelegationStatus(dStatus)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	unStakedFundKey, err := d.createAndSaveNextKeyFund(address, value, unStaked)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	globalFund.UnStakedFunds = append(globalFund.UnStakedFunds, unStakedFundKey)
+	globalFund.TotalActive.Sub(globalFund.TotalActive, value)
+	globalFund.TotalUnStaked.Add(globalFund.TotalUnStaked, value)
+	delegator.UnStakedFunds = append(delegator.UnStakedFunds, unStakedFundKey)
+
+	err = d.saveDelegatorData(address, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// compoundLiquidStakingRewards re-delegates the rewards of the receipts pool so that they increase the value of
+// every receipt
+func (d *delegation) compoundLiquidStakingRewards(poolAddress []byte) vmcommon.ReturnCode {
+	isNew, pool, err := d.getOrCreateDelegatorData(poolAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		return vmcommon.Ok
+	}
+	if len(pool.ActiveFund) == 0 {
+		// the pool was emptied, the next delegated value must not earn the rewards of the past epochs
+		pool.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+	}
+
+	err = d.computeAndUpdateRewards(poolAddress, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if pool.UnClaimedRewards.Cmp(zero) == 0 || len(pool.ActiveFund) == 0 {
+		err = d.saveDelegatorData(poolAddress, pool)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+
+		return vmcommon.Ok
+	}
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	pool.TotalCumulatedRewards.Add(pool.TotalCumulatedRewards, pool.UnClaimedRewards)
+	delegateValue := big.NewInt(0).Set(pool.UnClaimedRewards)
+	pool.UnClaimedRewards.SetUint64(0)
+
+	return d.finishDelegateUser(globalFund, pool, dConfig, dStatus, poolAddress,
+		poolAddress, delegateValue, false, dConfig.CheckCapOnReDelegateRewards)
+}
+
+func (d *delegation) getPoolActiveValue(poolAddress []byte) (*big.Int, error) {
+	_, pool, err := d.getOrCreateDelegatorData(poolAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(pool.ActiveFund) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	activeFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		return nil, err
+	}
+
+	return activeFund.Value, nil
+}
+
+func (d *delegation) getLiquidStakingInfo(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkArgumentsForGeneralViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	liquidStakingData, err := d.getLiquidStakingData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidStakingData == nil {
+		d.eei.AddReturnMessage("liquid staking is not enabled")
+		return vmcommon.UserError
+	}
+
+	poolValue, err := d.getPoolActiveValue(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(liquidStakingData.TokenIdentifier)
+	d.eei.Finish(liquidStakingData.TotalReceipts.Bytes())
+	d.eei.Finish(poolValue.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) executeOnESDTSC(address []byte, function string, args [][]byte, value *big.Int) (*vmcommon.VMOutput, error) {
+	esdtCall := function
+	for _, arg := range args {
+		esdtCall += "@" + hex.EncodeToString(arg)
+	}
+
+	return d.eei.ExecuteOnDestContext(d.esdtSCAddr, address, value, []byte(esdtCall))
+}
+
+func (d *delegation) getLiquidStakingData() (*LiquidStakingData, error) {
+	marshaledData := d.eei.GetStorage([]byte(liquidStakingKey))
+	if len(marshaledData) == 0 {
+		return nil, nil
+	}
+
+	liquidStakingData := &LiquidStakingData{}
+	err := d.marshalizer.Unmarshal(liquidStakingData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return liquidStakingData, nil
+}
+
+func (d *delegation) saveLiquidStakingData(liquidStakingData *LiquidStakingData) error {
+	marshaledData, err := d.marshalizer.Marshal(liquidStakingData)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(liquidStakingKey), marshaledData)
+	return nil
+}
+
+// computeReceiptsForValue returns value * totalReceipts / poolValue, receipts being minted one to one for an empty pool
+func computeReceiptsForValue(value *big.Int, poolValue *big.Int, totalReceipts *big.Int) *big.Int {
+	if poolValue.Cmp(zero) == 0 || totalReceipts.Cmp(zero) == 0 {
+		return big.NewInt(0).Set(value)
+	}
+
+	receipts := big.NewInt(0).Mul(value, totalReceipts)
+	return receipts.Div(receipts, poolValue)
+}
+
+// computeValueForReceipts returns receipts * poolValue / totalReceipts
+func computeValueForReceipts(receipts *big.Int, poolValue *big.Int, totalReceipts *big.Int) *big.Int {
+	value := big.NewInt(0).Mul(receipts, poolValue)
+	return value.Div(value, totalReceipts)
+}
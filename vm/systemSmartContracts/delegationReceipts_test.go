@@ -0,0 +1,267 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addESDTScToVmContext(eei *vmContext) {
+	esdtArgs := createMockArgumentsForESDT()
+	esdtArgs.Eei = eei
+	esdtArgs.ESDTSCAddress = vm.ESDTSCAddress
+	esdtSc, _ := NewESDTSmartContract(esdtArgs)
+
+	containerWithoutESDT := eei.systemContracts
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		if bytes.Equal(key, vm.ESDTSCAddress) {
+			return esdtSc, nil
+		}
+
+		return containerWithoutESDT.Get(key)
+	}})
+}
+
+func createDelegationWithLiquidStaking(t *testing.T, blockChainHook *mock.BlockChainHookStub) (*delegation, *vmContext) {
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	eei.gasRemaining = 1000
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+	addESDTScToVmContext(eei)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	d, _ := NewDelegationSystemSC(args)
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+
+	vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("receipts"), []byte("RCPT")})
+	vmInput.CallValue = big.NewInt(1000)
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	return d, eei
+}
+
+func getReceiptTokenData(t *testing.T, d *delegation, eei *vmContext) *ESDTData {
+	liquidStakingData, err := d.getLiquidStakingData()
+	require.Nil(t, err)
+
+	token := &ESDTData{}
+	err = d.marshalizer.Unmarshal(token, eei.GetStorageFromAddress(vm.ESDTSCAddress, liquidStakingData.TokenIdentifier))
+	require.Nil(t, err)
+
+	return token
+}
+
+func TestDelegationSystemSC_EnableLiquidStakingErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.DelegationSCConfig.LiquidStakingEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+
+	vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("receipts"), []byte("RCPT")})
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "enableLiquidStaking is an unknown function"))
+
+	d.EpochConfirmed(1)
+	vmInput.CallerAddr = []byte("not owner")
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only owner can call this method"))
+
+	vmInput.CallerAddr = []byte("owner")
+	vmInput.Arguments = [][]byte{[]byte("receipts")}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{[]byte("receipts"), []byte("RCPT")}
+	_ = d.saveLiquidStakingData(&LiquidStakingData{TokenIdentifier: []byte("RCPT-abcdef"), TotalReceipts: big.NewInt(0)})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "liquid staking is already enabled"))
+}
+
+func TestDelegationSystemSC_EnableLiquidStakingShouldIssueToken(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationWithLiquidStaking(t, &mock.BlockChainHookStub{})
+
+	liquidStakingData, err := d.getLiquidStakingData()
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(liquidStakingData.TokenIdentifier), "RCPT-"))
+	assert.Equal(t, big.NewInt(0), liquidStakingData.TotalReceipts)
+
+	token := getReceiptTokenData(t, d, eei)
+	assert.Equal(t, []byte("addr"), token.OwnerAddress)
+	assert.True(t, token.Mintable)
+	assert.True(t, token.Burnable)
+}
+
+func TestDelegationSystemSC_DelegateWithLiquidStakingShouldMintReceipts(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationWithLiquidStaking(t, &mock.BlockChainHookStub{})
+
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallerAddr = []byte("usr1")
+	vmInput.CallValue = big.NewInt(100)
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	liquidStakingData, _ := d.getLiquidStakingData()
+	assert.Equal(t, big.NewInt(100), liquidStakingData.TotalReceipts)
+
+	poolValue, _ := d.getPoolActiveValue(vmInput.RecipientAddr)
+	assert.Equal(t, big.NewInt(100), poolValue)
+
+	isNew, _, _ := d.getOrCreateDelegatorData(vmInput.CallerAddr)
+	assert.True(t, isNew)
+
+	token := getReceiptTokenData(t, d, eei)
+	assert.Equal(t, big.NewInt(101), token.MintedValue)
+
+	outputTransfers := eei.outputAccounts[string(vmInput.CallerAddr)].OutputTransfers
+	require.Equal(t, 1, len(outputTransfers))
+	assert.True(t, strings.HasPrefix(string(outputTransfers[0].Data), core.BuiltInFunctionESDTTransfer))
+}
+
+func TestDelegationSystemSC_UnDelegateReceiptsShouldIncludeRewards(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	d, eei := createDelegationWithLiquidStaking(t, blockChainHook)
+
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallerAddr = []byte("usr1")
+	vmInput.CallValue = big.NewInt(100)
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	currentEpoch = 1
+	_ = d.saveRewardData(1, &RewardComputationData{
+		RewardsToDistribute: big.NewInt(40),
+		TotalActive:         big.NewInt(100),
+		ServiceFee:          0,
+	})
+
+	vmInput.CallerAddr = []byte("usr2")
+	vmInput.CallValue = big.NewInt(140)
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	liquidStakingData, _ := d.getLiquidStakingData()
+	assert.Equal(t, big.NewInt(200), liquidStakingData.TotalReceipts)
+	poolValue, _ := d.getPoolActiveValue(vmInput.RecipientAddr)
+	assert.Equal(t, big.NewInt(280), poolValue)
+
+	vmInput = getDefaultVmInputForFunc(core.BuiltInFunctionESDTTransfer, [][]byte{[]byte("RCPT-000000"), {100}, []byte("unDelegate")})
+	vmInput.CallerAddr = []byte("usr1")
+	vmInput.ESDTTokenName = []byte("RCPT-000000")
+	vmInput.ESDTValue = big.NewInt(100)
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid receipt token"))
+
+	vmInput.Arguments[0] = liquidStakingData.TokenIdentifier
+	vmInput.ESDTTokenName = liquidStakingData.TokenIdentifier
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	liquidStakingData, _ = d.getLiquidStakingData()
+	assert.Equal(t, big.NewInt(100), liquidStakingData.TotalReceipts)
+	poolValue, _ = d.getPoolActiveValue(vmInput.RecipientAddr)
+	assert.Equal(t, big.NewInt(140), poolValue)
+
+	isNew, delegator, _ := d.getOrCreateDelegatorData(vmInput.CallerAddr)
+	require.False(t, isNew)
+	require.Equal(t, 1, len(delegator.UnStakedFunds))
+	unStakedFund, _ := d.getFund(delegator.UnStakedFunds[0])
+	assert.Equal(t, big.NewInt(140), unStakedFund.Value)
+
+	token := getReceiptTokenData(t, d, eei)
+	assert.Equal(t, big.NewInt(100), token.BurntValue)
+}
+
+func TestDelegationSystemSC_UnDelegateReceiptsNotMatchingTransferShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationWithLiquidStaking(t, &mock.BlockChainHookStub{})
+	liquidStakingData, _ := d.getLiquidStakingData()
+
+	vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTTransfer, [][]byte{liquidStakingData.TokenIdentifier, {100}, []byte("unDelegate")})
+	vmInput.CallerAddr = []byte("usr1")
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "transferred ESDT does not match the call arguments"))
+
+	vmInput.ESDTTokenName = liquidStakingData.TokenIdentifier
+	vmInput.ESDTValue = big.NewInt(1)
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.ESDTTokenName = []byte("OTHER-000000")
+	vmInput.ESDTValue = big.NewInt(100)
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestDelegationSystemSC_InitialSupplyTransferShouldBeAccepted(t *testing.T) {
+	t.Parallel()
+
+	d, _ := createDelegationWithLiquidStaking(t, &mock.BlockChainHookStub{})
+	liquidStakingData, _ := d.getLiquidStakingData()
+
+	vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTTransfer, [][]byte{liquidStakingData.TokenIdentifier, {1}})
+	vmInput.CallerAddr = vm.ESDTSCAddress
+	vmInput.ESDTTokenName = liquidStakingData.TokenIdentifier
+	vmInput.ESDTValue = big.NewInt(1)
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmInput.CallerAddr = []byte("usr1")
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	liquidStakingData, _ = d.getLiquidStakingData()
+	assert.Equal(t, big.NewInt(0), liquidStakingData.TotalReceipts)
+}
@@ -42,6 +42,7 @@ func createMockArgumentsForDelegation() ArgsNewDelegation {
 		Marshalizer:            &mock.MarshalizerMock{},
 		EpochNotifier:          &mock.EpochNotifierStub{},
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		ESDTSCAddress:          vm.ESDTSCAddress,
 	}
 }
 
@@ -426,6 +427,142 @@ func TestDelegationSystemSC_ExecuteInitShouldWork(t *testing.T) {
 	assert.Equal(t, fundKey, delegator.ActiveFund)
 }
 
+func createDelegationForValidatorData(minDeposit *big.Int) (*delegation, *vmContext) {
+	args := createMockArgumentsForDelegation()
+	args.GasCost.MetaChainSystemSCsCost.DelegationOps = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.gasRemaining = 100
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+
+	marshaledData, _ := args.Marshalizer.Marshal(&DelegationManagement{
+		MinDeposit:          minDeposit,
+		MinDelegationAmount: big.NewInt(1),
+	})
+	eei.SetStorageForAddress(vm.DelegationManagerSCAddress, []byte(delegationManagementKey), marshaledData)
+
+	return d, eei
+}
+
+func saveValidatorDataForDelegation(d *delegation, eei *vmContext, address []byte, totalStake *big.Int, blsKeys ...[]byte) {
+	marshaledData, _ := d.marshalizer.Marshal(&ValidatorDataV2{
+		RewardAddress:   address,
+		TotalStakeValue: totalStake,
+		LockedStake:     big.NewInt(0),
+		MaxStakePerNode: big.NewInt(0),
+		TotalUnstaked:   big.NewInt(0),
+		BlsPubKeys:      blsKeys,
+		NumRegistered:   uint32(len(blsKeys)),
+	})
+	eei.SetStorageForAddress(vm.ValidatorSCAddress, address, marshaledData)
+}
+
+func TestDelegationSystemSC_ExecuteInitFromValidatorDataWrongCallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationForValidatorData(big.NewInt(10))
+	vmInput := getDefaultVmInputForFunc(initFromValidatorData, [][]byte{[]byte("validator"), {250}, {10}})
+	vmInput.CallerAddr = []byte("validator")
+
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "only delegation manager can call this function", eei.returnMessage)
+}
+
+func TestDelegationSystemSC_ExecuteInitFromValidatorDataNotEnoughStakeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorAddress := []byte("validator")
+	d, eei := createDelegationForValidatorData(big.NewInt(1000))
+	saveValidatorDataForDelegation(d, eei, validatorAddress, big.NewInt(500), []byte("blsKey1"))
+	vmInput := getDefaultVmInputForFunc(initFromValidatorData, [][]byte{validatorAddress, {}, {10}})
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrNotEnoughInitialOwnerFunds.Error()))
+}
+
+func TestDelegationSystemSC_ExecuteInitFromValidatorDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	validatorAddress := []byte("validator")
+	totalStake := big.NewInt(2000)
+	d, eei := createDelegationForValidatorData(big.NewInt(1000))
+	saveValidatorDataForDelegation(d, eei, validatorAddress, totalStake, []byte("blsKey1"), []byte("blsKey2"))
+	vmInput := getDefaultVmInputForFunc(initFromValidatorData, [][]byte{validatorAddress, {}, {10}})
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	assert.Equal(t, validatorAddress, eei.GetStorage([]byte(ownerKey)))
+	dConfig, _ := d.getDelegationContractConfig()
+	assert.Equal(t, totalStake, dConfig.InitialOwnerFunds)
+
+	dStatus, _ := d.getDelegationStatus()
+	require.Equal(t, 2, len(dStatus.StakedKeys))
+	assert.Equal(t, []byte("blsKey1"), dStatus.StakedKeys[0].BLSKey)
+	assert.Equal(t, []byte("blsKey2"), dStatus.StakedKeys[1].BLSKey)
+	assert.Equal(t, uint64(1), dStatus.NumUsers)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, totalStake, globalFund.TotalActive)
+
+	_, delegator, _ := d.getOrCreateDelegatorData(validatorAddress)
+	ownerFund, _ := d.getFund(delegator.ActiveFund)
+	assert.Equal(t, totalStake, ownerFund.Value)
+
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestDelegationSystemSC_ExecuteMergeValidatorDataToContract(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("validator")
+	d, eei := createDelegationForValidatorData(big.NewInt(1000))
+	saveValidatorDataForDelegation(d, eei, ownerAddress, big.NewInt(2000), []byte("blsKey1"))
+	vmInput := getDefaultVmInputForFunc(initFromValidatorData, [][]byte{ownerAddress, {}, {10}})
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	saveValidatorDataForDelegation(d, eei, ownerAddress, big.NewInt(3000), []byte("blsKey2"), []byte("blsKey3"))
+	vmInput = getDefaultVmInputForFunc("mergeValidatorDataToContract", [][]byte{[]byte("another")})
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "only the owner of the contract can merge its validator data", eei.returnMessage)
+
+	eei.returnMessage = ""
+	vmInput.Arguments = [][]byte{ownerAddress}
+	vmInput.CallerAddr = ownerAddress
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "only delegation manager can call this function", eei.returnMessage)
+
+	vmInput.CallerAddr = vm.DelegationManagerSCAddress
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	dStatus, _ := d.getDelegationStatus()
+	assert.Equal(t, 3, len(dStatus.StakedKeys))
+	assert.Equal(t, uint64(1), dStatus.NumUsers)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(5000), globalFund.TotalActive)
+
+	_, delegator, _ := d.getOrCreateDelegatorData(ownerAddress)
+	ownerFund, _ := d.getFund(delegator.ActiveFund)
+	assert.Equal(t, big.NewInt(5000), ownerFund.Value)
+}
+
 func TestDelegationSystemSC_ExecuteAddNodesUserErrors(t *testing.T) {
 	t.Parallel()
 
@@ -1247,6 +1384,84 @@ func TestDelegationSystemSC_ExecuteUnStakeNodesAtEndOfEpoch(t *testing.T) {
 	assert.Equal(t, 2, len(dStatus.StakedKeys))
 }
 
+func TestDelegationSystemSC_ExecuteSlashActiveNotValidatorSCShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+
+	vmInput := getDefaultVmInputForFunc("slashActive", [][]byte{big.NewInt(10).Bytes()})
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "can be called by validator sc address only", eei.returnMessage)
+}
+
+func TestDelegationSystemSC_ExecuteSlashActiveShouldLowerAllActiveFunds(t *testing.T) {
+	t.Parallel()
+
+	blsKey1 := []byte("blsKey1")
+	blsKey2 := []byte("blsKey2")
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+
+	_ = d.saveDelegationStatus(&DelegationContractStatus{
+		StakedKeys: []*NodesData{{BLSKey: blsKey1}, {BLSKey: blsKey2}},
+	})
+	fundKey1, _ := d.createAndSaveNextKeyFund([]byte("delegator1"), big.NewInt(300), active)
+	fundKey2, _ := d.createAndSaveNextKeyFund([]byte("delegator2"), big.NewInt(100), active)
+	unStakedFundKey, _ := d.createAndSaveNextKeyFund([]byte("delegator2"), big.NewInt(50), unStaked)
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		ActiveFunds:   [][]byte{fundKey1, fundKey2},
+		UnStakedFunds: [][]byte{unStakedFundKey},
+		TotalActive:   big.NewInt(400),
+		TotalUnStaked: big.NewInt(50),
+	})
+
+	vmInput := getDefaultVmInputForFunc("slashActive", [][]byte{big.NewInt(100).Bytes(), blsKey2})
+	vmInput.CallerAddr = args.ValidatorSCAddress
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(300), globalFund.TotalActive)
+	assert.Equal(t, big.NewInt(300).Bytes(), eei.GetStorage([]byte(totalActiveKey)))
+
+	fund1, _ := d.getFund(fundKey1)
+	assert.Equal(t, big.NewInt(225), fund1.Value)
+	fund2, _ := d.getFund(fundKey2)
+	assert.Equal(t, big.NewInt(75), fund2.Value)
+	unStakedFund, _ := d.getFund(unStakedFundKey)
+	assert.Equal(t, big.NewInt(50), unStakedFund.Value)
+
+	dStatus, _ := d.getDelegationStatus()
+	require.Equal(t, 1, len(dStatus.StakedKeys))
+	assert.Equal(t, blsKey1, dStatus.StakedKeys[0].BLSKey)
+	require.Equal(t, 1, len(dStatus.UnStakedKeys))
+	assert.Equal(t, blsKey2, dStatus.UnStakedKeys[0].BLSKey)
+
+	_ = d.addValueToFund(fundKey2, big.NewInt(25))
+	fund2, _ = d.getFund(fundKey2)
+	assert.Equal(t, big.NewInt(100), fund2.Value)
+
+	newFundKey, _ := d.createAndSaveNextKeyFund([]byte("delegator3"), big.NewInt(40), active)
+	newFund, _ := d.getFund(newFundKey)
+	assert.Equal(t, big.NewInt(40), newFund.Value)
+}
+
 func TestDelegationSystemSC_ExecuteUnBondNodesUserErrors(t *testing.T) {
 	t.Parallel()
 
@@ -312,12 +312,13 @@ func createDirectCallInput(
 }
 
 // DeploySystemSC will deploy a smart contract according to the input
-// will call the init function and merge the vmOutputs
+// will call the provided init function and merge the vmOutputs
 // will add to the system smart contracts container the new address
 func (host *vmContext) DeploySystemSC(
 	baseContract []byte,
 	newAddress []byte,
 	ownerAddress []byte,
+	initFunction string,
 	value *big.Int,
 	input [][]byte,
 ) (vmcommon.ReturnCode, error) {
@@ -326,7 +327,7 @@ func (host *vmContext) DeploySystemSC(
 		return vmcommon.ExecutionFailed, vm.ErrUnknownSystemSmartContract
 	}
 
-	callInput := createDirectCallInput(newAddress, ownerAddress, value, core.SCDeployInitFunctionName, input)
+	callInput := createDirectCallInput(newAddress, ownerAddress, value, initFunction, input)
 	err := host.Transfer(callInput.RecipientAddr, host.scAddress, callInput.CallValue, nil, 0)
 	if err != nil {
 		return vmcommon.ExecutionFailed, err
@@ -52,6 +52,8 @@ type esdt struct {
 	hasher                 hashing.Hasher
 	enabledEpoch           uint32
 	flagEnabled            atomic.Flag
+	liquidStakingEpoch     uint32
+	flagLiquidStaking      atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
 }
@@ -67,6 +69,9 @@ type ArgsNewESDTSmartContract struct {
 	EpochNotifier          vm.EpochNotifier
 	EndOfEpochSCAddress    []byte
 	AddressPubKeyConverter core.PubkeyConverter
+	// LiquidStakingEnableEpoch is the epoch from which issue returns the new token identifier, as needed by the
+	// delegation contracts minting receipt tokens
+	LiquidStakingEnableEpoch uint32
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -101,6 +106,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
+		liquidStakingEpoch:     args.LiquidStakingEnableEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 	}
@@ -320,6 +326,9 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 	}
 
 	e.addToIssuedTokens(string(tokenIdentifier))
+	if e.flagLiquidStaking.IsSet() {
+		e.eei.Finish(tokenIdentifier)
+	}
 
 	return nil
 }
@@ -846,6 +855,9 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagLiquidStaking.Toggle(epoch >= e.liquidStakingEpoch)
+	log.Debug("esdt contract: liquid staking", "enabled", e.flagLiquidStaking.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
@@ -171,6 +171,44 @@ func TestEsdt_ExecuteIssue(t *testing.T) {
 	assert.Equal(t, vmcommon.Ok, output)
 }
 
+func TestEsdt_ExecuteIssueShouldReturnTokenIdentifierOnlyAfterLiquidStakingEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.LiquidStakingEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			Arguments:   [][]byte{[]byte("name"), []byte("TICKER"), big.NewInt(100).Bytes(), big.NewInt(10).Bytes()},
+			GasProvided: args.GasCost.MetaChainSystemSCsCost.ESDTIssue,
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issue",
+	}
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 0, len(eei.output))
+
+	e.EpochConfirmed(1)
+	vmInput.Arguments[1] = []byte("TICKERB")
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 1, len(eei.output))
+	assert.True(t, strings.HasPrefix(string(eei.output[0]), "TICKERB-"))
+}
+
 func TestEsdt_IssueInvalidNumberOfDecimals(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"math/big"
 	"sync"
 
@@ -19,11 +20,24 @@ import (
 
 const governanceConfigKey = "governanceConfig"
 const hardForkPrefix = "hardFork"
+const featureActivationPrefix = "featureActivation"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
 const validatorPrefix = "validator"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
+const maxFeatureNameLength = 64
+
+// minEpochsBeforeActivation is the minimum distance between the epoch in which a proposal is closed and the scheduled
+// activation epoch. The activation is published in the next epoch start meta block, so all the nodes know about it
+// at least one epoch before it takes effect
+const minEpochsBeforeActivation = 2
+
+// ScheduledActivationsKey is the storage key under which the governance contract keeps the scheduled activations
+const ScheduledActivationsKey = "scheduledActivations"
+
+// HardForkActivationName is the name under which an accepted hardFork proposal is scheduled
+const HardForkActivationName = "HardFork"
 
 // ArgsNewGovernanceContract defines the arguments needed for the on-chain governance contract
 type ArgsNewGovernanceContract struct {
@@ -53,6 +67,9 @@ type governanceContract struct {
 	enabledEpoch        uint32
 	flagEnabled         atomic.Flag
 	mutExecution        sync.RWMutex
+
+	scheduledActivationsEnableEpoch uint32
+	flagScheduledActivations        atomic.Flag
 }
 
 // NewGovernanceContract creates a new governance smart contract
@@ -87,6 +104,8 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		hasher:              args.Hasher,
 		governanceConfig:    args.GovernanceConfig,
 		enabledEpoch:        args.GovernanceConfig.EnabledEpoch,
+
+		scheduledActivationsEnableEpoch: args.GovernanceConfig.ScheduledActivationsEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(g)
 
@@ -115,6 +134,10 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.whiteListProposal(args)
 	case "hardFork":
 		return g.hardForkProposal(args)
+	case "featureActivation":
+		return g.featureActivationProposal(args)
+	case "getScheduledActivations":
+		return g.getScheduledActivations(args)
 	case "proposal":
 		return g.proposal(args)
 	case "vote":
@@ -448,11 +471,7 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 		g.eei.AddReturnMessage("proposal already exists")
 		return vmcommon.UserError
 	}
-
-	key := append([]byte(hardForkPrefix), gitHubCommit...)
-	marshaledData := g.eei.GetStorage(key)
-	if len(marshaledData) != 0 {
-		g.eei.AddReturnMessage("hardFork proposal already exists")
+	if g.topLevelProposalExists(hardForkPrefix, gitHubCommit) {
 		return vmcommon.UserError
 	}
 
@@ -475,16 +494,122 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 		return vmcommon.UserError
 	}
 
-	key = append([]byte(proposalPrefix), gitHubCommit...)
 	hardForkProposal := &HardForkProposal{
 		EpochToHardFork:    epochToHardFork,
 		NewSoftwareVersion: args.Arguments[1],
-		ProposalStatus:     key,
+		ProposalStatus:     append([]byte(proposalPrefix), gitHubCommit...),
+	}
+
+	// the general proposal was saved under the epoch argument before the scheduled activations were enabled
+	reference := gitHubCommit
+	if !g.flagScheduledActivations.IsSet() {
+		reference = args.Arguments[0]
 	}
 
-	key = append([]byte(hardForkPrefix), gitHubCommit...)
+	return g.saveTopLevelProposal(args.CallerAddr, reference, gitHubCommit, hardForkPrefix, hardForkProposal, startVoteNonce, endVoteNonce)
+}
+
+// featureActivationProposal opens a proposal for activating a protocol feature starting with the provided epoch
+// format: featureActivation@epoch@featureName@gitHubCommit@startVoteNonce@endVoteNonce
+func (g *governanceContract) featureActivationProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagScheduledActivations.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if args.CallValue.Cmp(g.baseProposalCost) != 0 {
+		g.eei.AddReturnMessage("invalid proposal cost, expected " + g.baseProposalCost.String())
+		return vmcommon.OutOfFunds
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 5 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 5")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !g.isWhiteListed(args.CallerAddr) {
+		g.eei.AddReturnMessage("called address is not whiteListed")
+		return vmcommon.UserError
+	}
+	featureName := args.Arguments[1]
+	if !isFeatureNameValid(featureName) {
+		g.eei.AddReturnMessage("invalid feature name")
+		return vmcommon.UserError
+	}
+	gitHubCommit := args.Arguments[2]
+	if len(gitHubCommit) != githubCommitLength {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", githubCommitLength))
+		return vmcommon.UserError
+	}
+	if g.proposalExists(gitHubCommit) {
+		g.eei.AddReturnMessage("proposal already exists")
+		return vmcommon.UserError
+	}
+	if g.topLevelProposalExists(featureActivationPrefix, gitHubCommit) {
+		return vmcommon.UserError
+	}
+
+	startVoteNonce, endVoteNonce, err := g.startEndNonceFromArguments(args.Arguments[3], args.Arguments[4])
+	if err != nil {
+		g.eei.AddReturnMessage("invalid start/end vote nonce" + err.Error())
+		return vmcommon.UserError
+	}
+
+	bigIntEpochToActivate, okConvert := big.NewInt(0).SetString(string(args.Arguments[0]), conversionBase)
+	if !okConvert || !bigIntEpochToActivate.IsUint64() || bigIntEpochToActivate.Uint64() > math.MaxUint32 {
+		g.eei.AddReturnMessage("invalid argument for epoch")
+		return vmcommon.UserError
+	}
+	epochToActivate := uint32(bigIntEpochToActivate.Uint64())
+	if epochToActivate <= g.eei.BlockChainHook().CurrentEpoch() {
+		g.eei.AddReturnMessage("invalid epoch to activate, it must be a future epoch")
+		return vmcommon.UserError
+	}
+
+	featureActivationProposal := &FeatureActivationProposal{
+		EpochToActivate: epochToActivate,
+		FeatureName:     featureName,
+		ProposalStatus:  append([]byte(proposalPrefix), gitHubCommit...),
+	}
+
+	return g.saveTopLevelProposal(args.CallerAddr, gitHubCommit, gitHubCommit, featureActivationPrefix, featureActivationProposal, startVoteNonce, endVoteNonce)
+}
+
+func isFeatureNameValid(featureName []byte) bool {
+	if len(featureName) == 0 || len(featureName) > maxFeatureNameLength {
+		return false
+	}
+
+	return isTokenNameHumanReadable(featureName)
+}
+
+func (g *governanceContract) topLevelProposalExists(prefix string, gitHubCommit []byte) bool {
+	key := append([]byte(prefix), gitHubCommit...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) != 0 {
+		g.eei.AddReturnMessage(prefix + " proposal already exists")
+		return true
+	}
+
+	return false
+}
+
+// saveTopLevelProposal saves the specific proposal under prefix + gitHubCommit and the general proposal, which
+// references it, under the provided reference
+func (g *governanceContract) saveTopLevelProposal(
+	issuer []byte,
+	reference []byte,
+	gitHubCommit []byte,
+	prefix string,
+	topLevelProposal interface{},
+	startVoteNonce uint64,
+	endVoteNonce uint64,
+) vmcommon.ReturnCode {
+	key := append([]byte(prefix), gitHubCommit...)
 	generalProposal := &GeneralProposal{
-		IssuerAddress:  args.CallerAddr,
+		IssuerAddress:  issuer,
 		GitHubCommit:   gitHubCommit,
 		StartVoteNonce: startVoteNonce,
 		EndVoteNonce:   endVoteNonce,
@@ -496,15 +621,15 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 		TopReference:   key,
 		Voters:         make([][]byte, 0),
 	}
-	marshaledData, err = g.marshalizer.Marshal(hardForkProposal)
+	marshaledData, err := g.marshalizer.Marshal(topLevelProposal)
 	if err != nil {
-		log.Warn("hardFork proposal marshal", "err", err)
+		log.Warn(prefix+" proposal marshal", "err", err)
 		g.eei.AddReturnMessage("marshal proposal" + err.Error())
 		return vmcommon.UserError
 	}
 	g.eei.SetStorage(key, marshaledData)
 
-	err = g.saveGeneralProposal(args.Arguments[0], generalProposal)
+	err = g.saveGeneralProposal(reference, generalProposal)
 	if err != nil {
 		log.Warn("save general proposal", "error", err)
 		g.eei.AddReturnMessage("saveGeneralProposal" + err.Error())
@@ -876,6 +1001,14 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
+	if g.flagScheduledActivations.IsSet() {
+		err = g.scheduleActivationIfAccepted(generalProposal)
+		if err != nil {
+			g.eei.AddReturnMessage("scheduleActivation error " + err.Error())
+			return vmcommon.UserError
+		}
+	}
+
 	for _, voter := range generalProposal.Voters {
 		key := append(proposal, voter...)
 		g.eei.SetStorage(key, nil)
@@ -908,10 +1041,174 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 	return nil
 }
 
+// scheduleActivationIfAccepted adds the activation of an accepted hardFork or feature activation proposal to the
+// scheduled activations list, which is read by the nodes in order to know when to switch to the new behavior
+func (g *governanceContract) scheduleActivationIfAccepted(generalProposal *GeneralProposal) error {
+	if !generalProposal.Voted {
+		return nil
+	}
+
+	var activation *ScheduledActivation
+	var err error
+	switch {
+	case bytes.HasPrefix(generalProposal.TopReference, []byte(hardForkPrefix)):
+		activation, err = g.createHardForkActivation(generalProposal)
+	case bytes.HasPrefix(generalProposal.TopReference, []byte(featureActivationPrefix)):
+		activation, err = g.createFeatureActivation(generalProposal)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	currentEpoch := g.eei.BlockChainHook().CurrentEpoch()
+	if activation.Epoch < currentEpoch+minEpochsBeforeActivation {
+		g.eei.AddReturnMessage(fmt.Sprintf("activation epoch %d is too close, proposal was not scheduled", activation.Epoch))
+		return nil
+	}
+
+	scheduledActivations, err := g.getScheduledActivationsData()
+	if err != nil {
+		return err
+	}
+
+	activations := append(scheduledActivations.Activations, activation)
+	scheduledActivations.Activations = removeUnusedActivations(activations, currentEpoch)
+
+	return g.saveScheduledActivations(scheduledActivations)
+}
+
+// removeUnusedActivations drops the hardForks whose epoch has passed and the feature activations replaced by a later
+// activation of the same feature, so the list, which is published in every epoch start meta block, does not grow
+// forever. The last activation of each feature is kept even if its epoch has passed, as the nodes read the
+// activation epochs of the features from this list
+func removeUnusedActivations(activations []*ScheduledActivation, currentEpoch uint32) []*ScheduledActivation {
+	lastActivationIndex := make(map[string]int)
+	for i, activation := range activations {
+		lastActivationIndex[string(activation.Name)] = i
+	}
+
+	usedActivations := make([]*ScheduledActivation, 0, len(activations))
+	for i, activation := range activations {
+		name := string(activation.Name)
+		if name == HardForkActivationName {
+			if activation.Epoch > currentEpoch {
+				usedActivations = append(usedActivations, activation)
+			}
+			continue
+		}
+		if lastActivationIndex[name] == i {
+			usedActivations = append(usedActivations, activation)
+		}
+	}
+
+	return usedActivations
+}
+
+func (g *governanceContract) createHardForkActivation(generalProposal *GeneralProposal) (*ScheduledActivation, error) {
+	hardForkProposal := &HardForkProposal{}
+	err := g.getTopLevelProposal(generalProposal.TopReference, hardForkProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduledActivation{
+		Name:            []byte(HardForkActivationName),
+		Epoch:           hardForkProposal.EpochToHardFork,
+		SoftwareVersion: hardForkProposal.NewSoftwareVersion,
+		GitHubCommit:    generalProposal.GitHubCommit,
+	}, nil
+}
+
+func (g *governanceContract) createFeatureActivation(generalProposal *GeneralProposal) (*ScheduledActivation, error) {
+	featureActivationProposal := &FeatureActivationProposal{}
+	err := g.getTopLevelProposal(generalProposal.TopReference, featureActivationProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduledActivation{
+		Name:         featureActivationProposal.FeatureName,
+		Epoch:        featureActivationProposal.EpochToActivate,
+		GitHubCommit: generalProposal.GitHubCommit,
+	}, nil
+}
+
+func (g *governanceContract) getTopLevelProposal(key []byte, topLevelProposal interface{}) error {
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return vm.ErrEmptyStorage
+	}
+
+	return g.marshalizer.Unmarshal(topLevelProposal, marshaledData)
+}
+
+// getScheduledActivations returns, for each scheduled activation, the name, epoch, software version and github commit
+func (g *governanceContract) getScheduledActivations(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagScheduledActivations.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("getScheduledActivations callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 0")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	scheduledActivations, err := g.getScheduledActivationsData()
+	if err != nil {
+		g.eei.AddReturnMessage("getScheduledActivations error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, activation := range scheduledActivations.Activations {
+		g.eei.Finish(activation.Name)
+		g.eei.Finish(big.NewInt(0).SetUint64(uint64(activation.Epoch)).Bytes())
+		g.eei.Finish(activation.SoftwareVersion)
+		g.eei.Finish(activation.GitHubCommit)
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) getScheduledActivationsData() (*ScheduledActivations, error) {
+	scheduledActivations := &ScheduledActivations{
+		Activations: make([]*ScheduledActivation, 0),
+	}
+	marshaledData := g.eei.GetStorage([]byte(ScheduledActivationsKey))
+	if len(marshaledData) == 0 {
+		return scheduledActivations, nil
+	}
+
+	err := g.marshalizer.Unmarshal(scheduledActivations, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledActivations, nil
+}
+
+func (g *governanceContract) saveScheduledActivations(scheduledActivations *ScheduledActivations) error {
+	marshaledData, err := g.marshalizer.Marshal(scheduledActivations)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage([]byte(ScheduledActivationsKey), marshaledData)
+	return nil
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (g *governanceContract) EpochConfirmed(epoch uint32) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagScheduledActivations.Toggle(epoch >= g.scheduledActivationsEnableEpoch)
+	log.Debug("governance contract: scheduled activations", "enabled", g.flagScheduledActivations.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
@@ -469,6 +469,163 @@ func (m *VoteData) GetVoteValue() string {
 	return ""
 }
 
+type FeatureActivationProposal struct {
+	EpochToActivate uint32 `protobuf:"varint,1,opt,name=EpochToActivate,proto3" json:"EpochToActivate"`
+	FeatureName     []byte `protobuf:"bytes,2,opt,name=FeatureName,proto3" json:"FeatureName"`
+	ProposalStatus  []byte `protobuf:"bytes,3,opt,name=ProposalStatus,proto3" json:"ProposalStatus"`
+}
+
+func (m *FeatureActivationProposal) Reset()      { *m = FeatureActivationProposal{} }
+func (*FeatureActivationProposal) ProtoMessage() {}
+func (*FeatureActivationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+func (m *FeatureActivationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureActivationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FeatureActivationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureActivationProposal.Merge(m, src)
+}
+func (m *FeatureActivationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureActivationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureActivationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureActivationProposal proto.InternalMessageInfo
+
+func (m *FeatureActivationProposal) GetEpochToActivate() uint32 {
+	if m != nil {
+		return m.EpochToActivate
+	}
+	return 0
+}
+
+func (m *FeatureActivationProposal) GetFeatureName() []byte {
+	if m != nil {
+		return m.FeatureName
+	}
+	return nil
+}
+
+func (m *FeatureActivationProposal) GetProposalStatus() []byte {
+	if m != nil {
+		return m.ProposalStatus
+	}
+	return nil
+}
+
+type ScheduledActivation struct {
+	Name            []byte `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name"`
+	Epoch           uint32 `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch"`
+	SoftwareVersion []byte `protobuf:"bytes,3,opt,name=SoftwareVersion,proto3" json:"SoftwareVersion"`
+	GitHubCommit    []byte `protobuf:"bytes,4,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
+}
+
+func (m *ScheduledActivation) Reset()      { *m = ScheduledActivation{} }
+func (*ScheduledActivation) ProtoMessage() {}
+func (*ScheduledActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{8}
+}
+func (m *ScheduledActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledActivation.Merge(m, src)
+}
+func (m *ScheduledActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledActivation proto.InternalMessageInfo
+
+func (m *ScheduledActivation) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ScheduledActivation) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ScheduledActivation) GetSoftwareVersion() []byte {
+	if m != nil {
+		return m.SoftwareVersion
+	}
+	return nil
+}
+
+func (m *ScheduledActivation) GetGitHubCommit() []byte {
+	if m != nil {
+		return m.GitHubCommit
+	}
+	return nil
+}
+
+type ScheduledActivations struct {
+	Activations []*ScheduledActivation `protobuf:"bytes,1,rep,name=Activations,proto3" json:"Activations"`
+}
+
+func (m *ScheduledActivations) Reset()      { *m = ScheduledActivations{} }
+func (*ScheduledActivations) ProtoMessage() {}
+func (*ScheduledActivations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{9}
+}
+func (m *ScheduledActivations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledActivations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledActivations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledActivations.Merge(m, src)
+}
+func (m *ScheduledActivations) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledActivations) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledActivations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledActivations proto.InternalMessageInfo
+
+func (m *ScheduledActivations) GetActivations() []*ScheduledActivation {
+	if m != nil {
+		return m.Activations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
@@ -477,65 +634,76 @@ func init() {
 	proto.RegisterType((*VoterData)(nil), "proto.VoterData")
 	proto.RegisterType((*ValidatorData)(nil), "proto.ValidatorData")
 	proto.RegisterType((*VoteData)(nil), "proto.VoteData")
+	proto.RegisterType((*FeatureActivationProposal)(nil), "proto.FeatureActivationProposal")
+	proto.RegisterType((*ScheduledActivation)(nil), "proto.ScheduledActivation")
+	proto.RegisterType((*ScheduledActivations)(nil), "proto.ScheduledActivations")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x4e, 0xe7, 0x63, 0x26, 0xa9, 0xc9, 0xec, 0x66, 0xdb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
-	0x08, 0xc8, 0x26, 0xa0, 0x82, 0xa0, 0x08, 0xbb, 0x9d, 0xf9, 0xd8, 0x01, 0xb7, 0x59, 0x6b, 0x86,
-	0x88, 0xe2, 0xa5, 0x92, 0xae, 0xe9, 0x34, 0x9b, 0xf4, 0x1b, 0xaa, 0xaa, 0x77, 0x10, 0x2f, 0xfe,
-	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
-	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0xd2, 0xf5, 0x3e, 0xcf, 0xd3, 0xef,
-	0x47, 0xd5, 0xfb, 0x56, 0xa1, 0x56, 0x08, 0x6f, 0x18, 0x8f, 0x69, 0x3c, 0x66, 0xbd, 0x39, 0x07,
-	0x09, 0x76, 0xcd, 0x2c, 0xef, 0x3d, 0x0d, 0x23, 0x39, 0x49, 0x46, 0xbd, 0x31, 0xcc, 0xfa, 0x21,
-	0x84, 0xd0, 0x37, 0xf4, 0x28, 0xb9, 0x34, 0xc8, 0x00, 0x63, 0x65, 0x5e, 0x9d, 0x9f, 0xaa, 0xe8,
-	0xe1, 0x29, 0x8b, 0x19, 0xa7, 0xd3, 0x57, 0x1c, 0xe6, 0x20, 0xe8, 0xd4, 0xfe, 0x14, 0x1d, 0x9e,
-	0x09, 0x91, 0x30, 0xfe, 0x3c, 0x08, 0x38, 0x13, 0xc2, 0xb1, 0xda, 0x56, 0xb7, 0xe9, 0x3d, 0x52,
-	0x29, 0x2e, 0x0a, 0xa4, 0x08, 0xed, 0x4f, 0x50, 0xf3, 0x34, 0x92, 0x2f, 0x92, 0xd1, 0x00, 0x66,
-	0xb3, 0x48, 0x3a, 0x65, 0xe3, 0xd7, 0x52, 0x29, 0x2e, 0xf0, 0xa4, 0x80, 0xec, 0xcf, 0xd0, 0x83,
-	0x73, 0x49, 0xb9, 0x1c, 0x82, 0x64, 0x3e, 0xc4, 0x63, 0xe6, 0x54, 0xda, 0x56, 0xb7, 0xea, 0xd9,
-	0x2a, 0xc5, 0x5b, 0x0a, 0xd9, 0xc2, 0x3a, 0xe3, 0x71, 0x1c, 0xac, 0x3d, 0xab, 0xc6, 0xd3, 0x64,
-	0xdc, 0xe4, 0x49, 0x01, 0xd9, 0xef, 0xa2, 0xca, 0x37, 0x4c, 0x38, 0xb5, 0xb6, 0xd5, 0xad, 0x79,
-	0xfb, 0x2a, 0xc5, 0x1a, 0x12, 0xfd, 0xb1, 0x9f, 0xa0, 0xb2, 0x0f, 0xce, 0x9e, 0x51, 0xf6, 0x54,
-	0x8a, 0xcb, 0x3e, 0x90, 0xb2, 0x0f, 0xf6, 0xfb, 0xa8, 0x3a, 0x64, 0x12, 0x9c, 0x7d, 0xa3, 0xd4,
-	0x55, 0x8a, 0x0d, 0x26, 0xe6, 0x6b, 0x77, 0x51, 0xfd, 0x08, 0x62, 0x39, 0xa0, 0x9c, 0x39, 0x75,
-	0xf3, 0x47, 0x53, 0xa5, 0x38, 0xe7, 0x48, 0x6e, 0xd9, 0x18, 0xd5, 0x74, 0x1d, 0x81, 0xd3, 0x68,
-	0x5b, 0xdd, 0xba, 0xd7, 0x50, 0x29, 0xce, 0x08, 0x92, 0x2d, 0x76, 0x07, 0xed, 0x69, 0x83, 0x0b,
-	0x07, 0xb5, 0x2b, 0xdd, 0xa6, 0x87, 0x54, 0x8a, 0x97, 0x0c, 0x59, 0xae, 0x7a, 0xd7, 0x17, 0x30,
-	0x27, 0xec, 0x92, 0x71, 0xa6, 0x77, 0x7d, 0xb0, 0x3e, 0xe7, 0x4d, 0x9e, 0x14, 0x90, 0x8e, 0x3c,
-	0x98, 0x82, 0x60, 0x81, 0xd3, 0x34, 0xb9, 0x4d, 0xe4, 0x8c, 0x21, 0xcb, 0xb5, 0xf3, 0x8b, 0x85,
-	0x1e, 0x7d, 0x3d, 0x89, 0x24, 0xfb, 0x32, 0x12, 0x32, 0x1f, 0x88, 0x67, 0xa8, 0x95, 0x93, 0xc5,
-	0x99, 0x78, 0xac, 0x52, 0xbc, 0xa3, 0x91, 0x1d, 0x46, 0xf7, 0x78, 0x15, 0xed, 0x5c, 0x52, 0x99,
-	0x88, 0xe5, 0x6c, 0x98, 0x1e, 0x17, 0x15, 0xb2, 0x85, 0x3b, 0x7f, 0x58, 0xa8, 0xf5, 0x82, 0xf2,
-	0xe0, 0x04, 0xf8, 0xeb, 0xbc, 0xa4, 0x2f, 0xd0, 0xc3, 0xe3, 0x39, 0x8c, 0x27, 0x17, 0xb0, 0x92,
-	0x4c, 0x45, 0x87, 0xde, 0x3b, 0x2a, 0xc5, 0xdb, 0x12, 0xd9, 0x26, 0xec, 0x13, 0x64, 0xfb, 0xec,
-	0xea, 0x1c, 0x2e, 0xe5, 0x15, 0xe5, 0x6c, 0xc8, 0xb8, 0x88, 0x20, 0x5e, 0xd6, 0xf4, 0x44, 0xa5,
-	0xf8, 0x1e, 0x95, 0xdc, 0xc3, 0xdd, 0xb3, 0xaf, 0xca, 0x7f, 0xde, 0xd7, 0xdf, 0x65, 0xd4, 0x3a,
-	0xcd, 0x6f, 0xf1, 0x00, 0xe2, 0xcb, 0x28, 0xd4, 0x93, 0xe4, 0x27, 0x33, 0x1f, 0x02, 0x96, 0x1d,
-	0x71, 0x25, 0x9b, 0xa4, 0x15, 0x47, 0x72, 0xcb, 0xfe, 0x10, 0x35, 0x5e, 0x46, 0xf1, 0x57, 0x09,
-	0xf0, 0x64, 0x66, 0x2a, 0xaf, 0x79, 0x87, 0x2a, 0xc5, 0x6b, 0x92, 0xac, 0x4d, 0xdd, 0xc1, 0x97,
-	0x51, 0xfc, 0x8a, 0x0a, 0x71, 0x31, 0xe1, 0x4c, 0x4c, 0x60, 0x1a, 0x98, 0x4a, 0x6b, 0x59, 0x07,
-	0xb7, 0x35, 0xb2, 0xc3, 0x2c, 0x23, 0xe8, 0x69, 0x5f, 0x47, 0xa8, 0x16, 0x22, 0x14, 0x34, 0xb2,
-	0xc3, 0xd8, 0x6f, 0xd0, 0xc1, 0xea, 0x04, 0x4e, 0x18, 0x33, 0xb7, 0xaf, 0xe9, 0x5d, 0xa8, 0x14,
-	0x6f, 0xd2, 0xbf, 0xfd, 0x89, 0x9f, 0xcf, 0xa8, 0x9c, 0xf4, 0x47, 0x51, 0xd8, 0x3b, 0x8b, 0xe5,
-	0xe7, 0x1b, 0xcf, 0xd9, 0xf1, 0x94, 0x43, 0x1c, 0xf8, 0x4c, 0x5e, 0x01, 0x7f, 0xdd, 0x67, 0x06,
-	0x3d, 0x0d, 0xa1, 0x1f, 0x50, 0x49, 0x7b, 0x5e, 0x14, 0x9e, 0xe9, 0x3b, 0x26, 0x24, 0xe3, 0x64,
-	0x33, 0x62, 0xe7, 0x3b, 0xd4, 0x30, 0xf7, 0xe6, 0x88, 0x4a, 0x6a, 0x7f, 0x80, 0xf6, 0x8b, 0x13,
-	0x7c, 0xa0, 0x52, 0xbc, 0xa2, 0xc8, 0xca, 0x28, 0xb4, 0xa1, 0xbc, 0xbe, 0xd0, 0xbb, 0x6d, 0xe8,
-	0xfc, 0x80, 0x0e, 0x87, 0x74, 0x1a, 0x05, 0x54, 0x42, 0x96, 0xe1, 0x19, 0x42, 0x47, 0x6c, 0xca,
-	0x42, 0x4d, 0xe8, 0x24, 0x95, 0xee, 0xc1, 0x47, 0xad, 0xec, 0xb5, 0xed, 0xe5, 0x75, 0x78, 0x0f,
-	0x54, 0x8a, 0x37, 0xfe, 0x23, 0x1b, 0xf6, 0xff, 0x48, 0x4e, 0x51, 0x5d, 0x87, 0x34, 0x79, 0x33,
-	0x2f, 0x0d, 0xb3, 0xad, 0x2d, 0xbd, 0x56, 0x3a, 0xc9, 0x55, 0x3d, 0x39, 0xda, 0x18, 0xd2, 0x69,
-	0xc2, 0x4c, 0x82, 0x46, 0x36, 0x39, 0x39, 0x49, 0xd6, 0xa6, 0xe7, 0x5f, 0xdf, 0xba, 0xa5, 0x9b,
-	0x5b, 0xb7, 0xf4, 0xf6, 0xd6, 0xb5, 0x7e, 0x5c, 0xb8, 0xd6, 0xaf, 0x0b, 0xd7, 0xfa, 0x7d, 0xe1,
-	0x5a, 0xd7, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0x5f, 0x0b, 0xd7, 0xfa, 0x67, 0xe1, 0x96, 0xde,
-	0x2e, 0x5c, 0xeb, 0xe7, 0x3b, 0xb7, 0x74, 0x7d, 0xe7, 0x96, 0x6e, 0xee, 0xdc, 0xd2, 0xb7, 0x8f,
-	0xc5, 0xf7, 0x42, 0xb2, 0xd9, 0xf9, 0x8c, 0x72, 0x39, 0x80, 0x58, 0x72, 0x3a, 0x96, 0x62, 0xb4,
-	0x67, 0x4e, 0xe2, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x85, 0xae, 0x19, 0xc1, 0x06,
-	0x00, 0x00,
+	// 965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6b, 0xe3, 0x46,
+	0x18, 0xb6, 0xfc, 0x91, 0xd8, 0x63, 0x7b, 0xe3, 0xd5, 0x86, 0x45, 0xbb, 0x14, 0xc9, 0x18, 0x0a,
+	0x86, 0xb2, 0x36, 0xfd, 0x80, 0x42, 0x4b, 0x61, 0x23, 0xe7, 0x63, 0x03, 0x8d, 0xd8, 0x8e, 0x83,
+	0x4b, 0x4b, 0x2f, 0x63, 0xeb, 0x8d, 0x2d, 0xd6, 0xd6, 0x84, 0xd1, 0x28, 0xa1, 0xf4, 0xd2, 0x1f,
+	0xd0, 0x43, 0xfb, 0x2f, 0x4a, 0x7f, 0x49, 0xf7, 0x96, 0x4b, 0x21, 0x27, 0xb5, 0x71, 0x28, 0x14,
+	0x9d, 0xf6, 0x27, 0x94, 0x19, 0xc9, 0xb2, 0x24, 0xfb, 0x90, 0xd2, 0x8b, 0xe6, 0x7d, 0x9e, 0xc7,
+	0xef, 0xc7, 0xcc, 0xbc, 0xef, 0x18, 0xb5, 0xa6, 0xf4, 0x0a, 0x98, 0x4b, 0xdc, 0x09, 0xf4, 0x2e,
+	0x19, 0xe5, 0x54, 0xad, 0xc8, 0xe5, 0xf9, 0x8b, 0xa9, 0xc3, 0x67, 0xfe, 0xb8, 0x37, 0xa1, 0x8b,
+	0xfe, 0x94, 0x4e, 0x69, 0x5f, 0xd2, 0x63, 0xff, 0x42, 0x22, 0x09, 0xa4, 0x15, 0x79, 0x75, 0x7e,
+	0x2a, 0xa3, 0xbd, 0x13, 0x70, 0x81, 0x91, 0xf9, 0x6b, 0x46, 0x2f, 0xa9, 0x47, 0xe6, 0xea, 0xa7,
+	0xa8, 0x79, 0xea, 0x79, 0x3e, 0xb0, 0x03, 0xdb, 0x66, 0xe0, 0x79, 0x9a, 0xd2, 0x56, 0xba, 0x0d,
+	0xf3, 0x71, 0x18, 0x18, 0x59, 0x01, 0x67, 0xa1, 0xfa, 0x09, 0x6a, 0x9c, 0x38, 0xfc, 0x95, 0x3f,
+	0x1e, 0xd0, 0xc5, 0xc2, 0xe1, 0x5a, 0x51, 0xfa, 0xb5, 0xc2, 0xc0, 0xc8, 0xf0, 0x38, 0x83, 0xd4,
+	0xcf, 0xd0, 0xa3, 0x21, 0x27, 0x8c, 0x8f, 0x28, 0x07, 0x8b, 0xba, 0x13, 0xd0, 0x4a, 0x6d, 0xa5,
+	0x5b, 0x36, 0xd5, 0x30, 0x30, 0x72, 0x0a, 0xce, 0x61, 0x91, 0xf1, 0xc8, 0xb5, 0xd7, 0x9e, 0x65,
+	0xe9, 0x29, 0x33, 0xa6, 0x79, 0x9c, 0x41, 0xea, 0x33, 0x54, 0xfa, 0x06, 0x3c, 0xad, 0xd2, 0x56,
+	0xba, 0x15, 0x73, 0x37, 0x0c, 0x0c, 0x01, 0xb1, 0xf8, 0xa8, 0x4f, 0x51, 0xd1, 0xa2, 0xda, 0x8e,
+	0x54, 0x76, 0xc2, 0xc0, 0x28, 0x5a, 0x14, 0x17, 0x2d, 0xaa, 0xbe, 0x87, 0xca, 0x23, 0xe0, 0x54,
+	0xdb, 0x95, 0x4a, 0x35, 0x0c, 0x0c, 0x89, 0xb1, 0xfc, 0xaa, 0x5d, 0x54, 0x3d, 0xa4, 0x2e, 0x1f,
+	0x10, 0x06, 0x5a, 0x55, 0xfe, 0xa2, 0x11, 0x06, 0x46, 0xc2, 0xe1, 0xc4, 0x52, 0x0d, 0x54, 0x11,
+	0x75, 0xd8, 0x5a, 0xad, 0xad, 0x74, 0xab, 0x66, 0x2d, 0x0c, 0x8c, 0x88, 0xc0, 0xd1, 0xa2, 0x76,
+	0xd0, 0x8e, 0x30, 0x98, 0xa7, 0xa1, 0x76, 0xa9, 0xdb, 0x30, 0x51, 0x18, 0x18, 0x31, 0x83, 0xe3,
+	0x55, 0xec, 0xfa, 0x9c, 0x5e, 0x62, 0xb8, 0x00, 0x06, 0x62, 0xd7, 0xf5, 0xf5, 0x39, 0xa7, 0x79,
+	0x9c, 0x41, 0x22, 0xf2, 0x60, 0x4e, 0x3d, 0xb0, 0xb5, 0x86, 0xcc, 0x2d, 0x23, 0x47, 0x0c, 0x8e,
+	0xd7, 0xce, 0x2f, 0x0a, 0x7a, 0xfc, 0xf5, 0xcc, 0xe1, 0xf0, 0xa5, 0xe3, 0xf1, 0xa4, 0x21, 0x5e,
+	0xa2, 0x56, 0x42, 0x66, 0x7b, 0x62, 0x3f, 0x0c, 0x8c, 0x0d, 0x0d, 0x6f, 0x30, 0xe2, 0x8e, 0x57,
+	0xd1, 0x86, 0x9c, 0x70, 0xdf, 0x8b, 0x7b, 0x43, 0xde, 0x71, 0x56, 0xc1, 0x39, 0xdc, 0xf9, 0x43,
+	0x41, 0xad, 0x57, 0x84, 0xd9, 0xc7, 0x94, 0xbd, 0x49, 0x4a, 0xfa, 0x02, 0xed, 0x1d, 0x5d, 0xd2,
+	0xc9, 0xec, 0x9c, 0xae, 0x24, 0x59, 0x51, 0xd3, 0x7c, 0x12, 0x06, 0x46, 0x5e, 0xc2, 0x79, 0x42,
+	0x3d, 0x46, 0xaa, 0x05, 0xd7, 0x43, 0x7a, 0xc1, 0xaf, 0x09, 0x83, 0x11, 0x30, 0xcf, 0xa1, 0x6e,
+	0x5c, 0xd3, 0xd3, 0x30, 0x30, 0xb6, 0xa8, 0x78, 0x0b, 0xb7, 0x65, 0x5f, 0xa5, 0x07, 0xef, 0xeb,
+	0xef, 0x22, 0x6a, 0x9d, 0x24, 0x53, 0x3c, 0xa0, 0xee, 0x85, 0x33, 0x15, 0x9d, 0x64, 0xf9, 0x0b,
+	0x8b, 0xda, 0x10, 0x1d, 0x71, 0x29, 0xea, 0xa4, 0x15, 0x87, 0x13, 0x4b, 0xfd, 0x00, 0xd5, 0xce,
+	0x1c, 0xf7, 0x2b, 0x9f, 0x32, 0x7f, 0x21, 0x2b, 0xaf, 0x98, 0xcd, 0x30, 0x30, 0xd6, 0x24, 0x5e,
+	0x9b, 0xe2, 0x06, 0xcf, 0x1c, 0xf7, 0x35, 0xf1, 0xbc, 0xf3, 0x19, 0x03, 0x6f, 0x46, 0xe7, 0xb6,
+	0xac, 0xb4, 0x12, 0xdd, 0x60, 0x5e, 0xc3, 0x1b, 0x4c, 0x1c, 0x41, 0x74, 0xfb, 0x3a, 0x42, 0x39,
+	0x13, 0x21, 0xa3, 0xe1, 0x0d, 0x46, 0xbd, 0x42, 0xf5, 0xd5, 0x09, 0x1c, 0x03, 0xc8, 0xe9, 0x6b,
+	0x98, 0xe7, 0x61, 0x60, 0xa4, 0xe9, 0xdf, 0xfe, 0x34, 0x0e, 0x16, 0x84, 0xcf, 0xfa, 0x63, 0x67,
+	0xda, 0x3b, 0x75, 0xf9, 0xe7, 0xa9, 0xe7, 0xec, 0x68, 0xce, 0xa8, 0x6b, 0x5b, 0xc0, 0xaf, 0x29,
+	0x7b, 0xd3, 0x07, 0x89, 0x5e, 0x4c, 0x69, 0xdf, 0x26, 0x9c, 0xf4, 0x4c, 0x67, 0x7a, 0x2a, 0x66,
+	0xcc, 0xe3, 0xc0, 0x70, 0x3a, 0x62, 0xe7, 0x3b, 0x54, 0x93, 0x73, 0x73, 0x48, 0x38, 0x51, 0xdf,
+	0x47, 0xbb, 0xd9, 0x0e, 0xae, 0x87, 0x81, 0xb1, 0xa2, 0xf0, 0xca, 0xc8, 0x5c, 0x43, 0x71, 0x3d,
+	0xd0, 0x9b, 0xd7, 0xd0, 0xf9, 0x01, 0x35, 0x47, 0x64, 0xee, 0xd8, 0x84, 0xd3, 0x28, 0xc3, 0x4b,
+	0x84, 0x0e, 0x61, 0x0e, 0x53, 0x41, 0x88, 0x24, 0xa5, 0x6e, 0xfd, 0xa3, 0x56, 0xf4, 0xda, 0xf6,
+	0x92, 0x3a, 0xcc, 0x47, 0x61, 0x60, 0xa4, 0x7e, 0x87, 0x53, 0xf6, 0x7f, 0x48, 0x4e, 0x50, 0x55,
+	0x84, 0x94, 0x79, 0x23, 0x2f, 0x01, 0xa3, 0xad, 0xc5, 0x5e, 0x2b, 0x1d, 0x27, 0xaa, 0xe8, 0x1c,
+	0x61, 0x8c, 0xc8, 0xdc, 0x07, 0x99, 0xa0, 0x16, 0x75, 0x4e, 0x42, 0xe2, 0xb5, 0xd9, 0x79, 0xab,
+	0xa0, 0x67, 0xc7, 0x40, 0xb8, 0xcf, 0xe0, 0x60, 0xc2, 0x9d, 0x2b, 0xc2, 0x1d, 0xea, 0x6e, 0x19,
+	0xc3, 0x58, 0x84, 0x2d, 0x63, 0xb8, 0x92, 0x70, 0x9e, 0x50, 0x3f, 0x44, 0xf5, 0x38, 0xb6, 0x45,
+	0x16, 0x10, 0xcf, 0xdf, 0x9e, 0x68, 0x89, 0x14, 0x8d, 0xd3, 0xe0, 0x7f, 0x4d, 0xdc, 0x5b, 0x05,
+	0x3d, 0x19, 0x4e, 0x66, 0x60, 0xfb, 0x73, 0xb0, 0xd7, 0xbb, 0x11, 0x8f, 0xbb, 0xcc, 0x1f, 0x75,
+	0x84, 0x7c, 0xdc, 0x65, 0x62, 0xf9, 0x15, 0x4f, 0xb6, 0xac, 0x5b, 0x96, 0xd7, 0x8c, 0x9e, 0x6c,
+	0x49, 0xe0, 0x68, 0x11, 0x87, 0x90, 0x7f, 0x49, 0xa2, 0x9a, 0xe4, 0x21, 0xe4, 0x24, 0x9c, 0x27,
+	0x36, 0xfe, 0x35, 0xcb, 0x0f, 0xf9, 0xd7, 0xec, 0x00, 0xda, 0xdf, 0xb2, 0x15, 0x4f, 0x3d, 0x43,
+	0xf5, 0x14, 0x8c, 0xfb, 0xef, 0x79, 0xdc, 0x7f, 0x5b, 0x3c, 0xa2, 0xe3, 0x4e, 0xb9, 0xe0, 0x34,
+	0x30, 0xad, 0x9b, 0x3b, 0xbd, 0x70, 0x7b, 0xa7, 0x17, 0xde, 0xdd, 0xe9, 0xca, 0x8f, 0x4b, 0x5d,
+	0xf9, 0x75, 0xa9, 0x2b, 0xbf, 0x2f, 0x75, 0xe5, 0x66, 0xa9, 0x2b, 0xb7, 0x4b, 0x5d, 0xf9, 0x6b,
+	0xa9, 0x2b, 0xff, 0x2c, 0xf5, 0xc2, 0xbb, 0xa5, 0xae, 0xfc, 0x7c, 0xaf, 0x17, 0x6e, 0xee, 0xf5,
+	0xc2, 0xed, 0xbd, 0x5e, 0xf8, 0x76, 0xdf, 0xfb, 0xde, 0xe3, 0xb0, 0x18, 0x2e, 0x08, 0xe3, 0x03,
+	0xea, 0x72, 0x46, 0x26, 0xdc, 0x1b, 0xef, 0xc8, 0x42, 0x3e, 0xfe, 0x77, 0x00, 0xe5, 0x08, 0x3f,
+	0x26, 0xc0, 0x08, 0x00, 0x00,
 }
 
 func (this *GeneralProposal) Equal(that interface{}) bool {
@@ -782,6 +950,98 @@ func (this *VoteData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FeatureActivationProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureActivationProposal)
+	if !ok {
+		that2, ok := that.(FeatureActivationProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochToActivate != that1.EpochToActivate {
+		return false
+	}
+	if !bytes.Equal(this.FeatureName, that1.FeatureName) {
+		return false
+	}
+	if !bytes.Equal(this.ProposalStatus, that1.ProposalStatus) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledActivation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledActivation)
+	if !ok {
+		that2, ok := that.(ScheduledActivation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Name, that1.Name) {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !bytes.Equal(this.SoftwareVersion, that1.SoftwareVersion) {
+		return false
+	}
+	if !bytes.Equal(this.GitHubCommit, that1.GitHubCommit) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledActivations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledActivations)
+	if !ok {
+		that2, ok := that.(ScheduledActivations)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Activations) != len(that1.Activations) {
+		return false
+	}
+	for i := range this.Activations {
+		if !this.Activations[i].Equal(that1.Activations[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GeneralProposal) GoString() string {
 	if this == nil {
 		return "nil"
@@ -875,6 +1135,43 @@ func (this *VoteData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *FeatureActivationProposal) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&systemSmartContracts.FeatureActivationProposal{")
+	s = append(s, "EpochToActivate: "+fmt.Sprintf("%#v", this.EpochToActivate)+",\n")
+	s = append(s, "FeatureName: "+fmt.Sprintf("%#v", this.FeatureName)+",\n")
+	s = append(s, "ProposalStatus: "+fmt.Sprintf("%#v", this.ProposalStatus)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledActivation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.ScheduledActivation{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "SoftwareVersion: "+fmt.Sprintf("%#v", this.SoftwareVersion)+",\n")
+	s = append(s, "GitHubCommit: "+fmt.Sprintf("%#v", this.GitHubCommit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledActivations) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.ScheduledActivations{")
+	if this.Activations != nil {
+		s = append(s, "Activations: "+fmt.Sprintf("%#v", this.Activations)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringGovernance(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1231,45 +1528,173 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGovernance(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeatureActivationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GeneralProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FeatureActivationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureActivationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.IssuerAddress)
-	if l > 0 {
-		n += 1 + l + sovGovernance(uint64(l))
-	}
-	l = len(m.GitHubCommit)
-	if l > 0 {
-		n += 1 + l + sovGovernance(uint64(l))
-	}
-	if m.StartVoteNonce != 0 {
-		n += 1 + sovGovernance(uint64(m.StartVoteNonce))
-	}
-	if m.EndVoteNonce != 0 {
-		n += 1 + sovGovernance(uint64(m.EndVoteNonce))
+	if len(m.ProposalStatus) > 0 {
+		i -= len(m.ProposalStatus)
+		copy(dAtA[i:], m.ProposalStatus)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.ProposalStatus)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Yes != 0 {
-		n += 1 + sovGovernance(uint64(m.Yes))
+	if len(m.FeatureName) > 0 {
+		i -= len(m.FeatureName)
+		copy(dAtA[i:], m.FeatureName)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.FeatureName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.No != 0 {
-		n += 1 + sovGovernance(uint64(m.No))
+	if m.EpochToActivate != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.EpochToActivate))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Veto != 0 {
-		n += 1 + sovGovernance(uint64(m.Veto))
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GitHubCommit) > 0 {
+		i -= len(m.GitHubCommit)
+		copy(dAtA[i:], m.GitHubCommit)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.GitHubCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SoftwareVersion) > 0 {
+		i -= len(m.SoftwareVersion)
+		copy(dAtA[i:], m.SoftwareVersion)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.SoftwareVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledActivations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledActivations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledActivations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Activations) > 0 {
+		for iNdEx := len(m.Activations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Activations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovernance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GeneralProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerAddress)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.GitHubCommit)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if m.StartVoteNonce != 0 {
+		n += 1 + sovGovernance(uint64(m.StartVoteNonce))
+	}
+	if m.EndVoteNonce != 0 {
+		n += 1 + sovGovernance(uint64(m.EndVoteNonce))
+	}
+	if m.Yes != 0 {
+		n += 1 + sovGovernance(uint64(m.Yes))
+	}
+	if m.No != 0 {
+		n += 1 + sovGovernance(uint64(m.No))
+	}
+	if m.Veto != 0 {
+		n += 1 + sovGovernance(uint64(m.Veto))
 	}
 	if m.DontCare != 0 {
 		n += 1 + sovGovernance(uint64(m.DontCare))
@@ -1406,6 +1831,65 @@ func (m *VoteData) Size() (n int) {
 	return n
 }
 
+func (m *FeatureActivationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochToActivate != 0 {
+		n += 1 + sovGovernance(uint64(m.EpochToActivate))
+	}
+	l = len(m.FeatureName)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.ProposalStatus)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGovernance(uint64(m.Epoch))
+	}
+	l = len(m.SoftwareVersion)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.GitHubCommit)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledActivations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activations) > 0 {
+		for _, e := range m.Activations {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGovernance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1508,6 +1992,46 @@ func (this *VoteData) String() string {
 	}, "")
 	return s
 }
+func (this *FeatureActivationProposal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FeatureActivationProposal{`,
+		`EpochToActivate:` + fmt.Sprintf("%v", this.EpochToActivate) + `,`,
+		`FeatureName:` + fmt.Sprintf("%v", this.FeatureName) + `,`,
+		`ProposalStatus:` + fmt.Sprintf("%v", this.ProposalStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledActivation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledActivation{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`SoftwareVersion:` + fmt.Sprintf("%v", this.SoftwareVersion) + `,`,
+		`GitHubCommit:` + fmt.Sprintf("%v", this.GitHubCommit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledActivations) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActivations := "[]*ScheduledActivation{"
+	for _, f := range this.Activations {
+		repeatedStringForActivations += strings.Replace(f.String(), "ScheduledActivation", "ScheduledActivation", 1) + ","
+	}
+	repeatedStringForActivations += "}"
+	s := strings.Join([]string{`&ScheduledActivations{`,
+		`Activations:` + repeatedStringForActivations + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGovernance(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2601,6 +3125,407 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeatureActivationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureActivationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureActivationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochToActivate", wireType)
+			}
+			m.EpochToActivate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochToActivate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeatureName = append(m.FeatureName[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeatureName == nil {
+				m.FeatureName = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalStatus = append(m.ProposalStatus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalStatus == nil {
+				m.ProposalStatus = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftwareVersion = append(m.SoftwareVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.SoftwareVersion == nil {
+				m.SoftwareVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHubCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHubCommit = append(m.GitHubCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.GitHubCommit == nil {
+				m.GitHubCommit = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledActivations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledActivations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledActivations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Activations = append(m.Activations, &ScheduledActivation{})
+			if err := m.Activations[len(m.Activations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovernance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
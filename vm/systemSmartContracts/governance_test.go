@@ -504,21 +504,7 @@ func testExecuteVote(t *testing.T, vote []byte) {
 	require.Equal(t, vmcommon.Ok, retCode)
 }
 
-// Test Scenario
-// A proposal is voted if it has 2 vote with yes
-// 1. Init governance smart contract
-// 2. WhiteList an address at genesis
-// 3. WhileList a new address, vote with 2 validators with yes and close white list proposal
-// 4. Create a new general proposal with new white listed address, vote with 2 validator with yes general proposal
-// and close proposal
-func TestGovernanceContract_ExecuteProposalCloseProposal(t *testing.T) {
-	t.Parallel()
-
-	blockChainHook := &mock.BlockChainHookStub{
-		CurrentNonceCalled: func() uint64 {
-			return 0
-		},
-	}
+func createGovernanceWithTwoValidators(blockChainHook *mock.BlockChainHookStub) (*governanceContract, []byte, []byte) {
 	atArgParser := parsers.NewCallArgsParser()
 	eei, _ := NewVMContext(
 		blockChainHook,
@@ -558,6 +544,26 @@ func TestGovernanceContract_ExecuteProposalCloseProposal(t *testing.T) {
 	args.Eei = eei
 	gsc, _ := NewGovernanceContract(args)
 
+	return gsc, validatorAddress1, validatorAddress2
+}
+
+// Test Scenario
+// A proposal is voted if it has 2 vote with yes
+// 1. Init governance smart contract
+// 2. WhiteList an address at genesis
+// 3. WhileList a new address, vote with 2 validators with yes and close white list proposal
+// 4. Create a new general proposal with new white listed address, vote with 2 validator with yes general proposal
+// and close proposal
+func TestGovernanceContract_ExecuteProposalCloseProposal(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	gsc, validatorAddress1, validatorAddress2 := createGovernanceWithTwoValidators(blockChainHook)
+
 	secondWLAddr := []byte("addr1")
 	recipientAddr := []byte("recipientAddress")
 	startNonce := uint64(100)
@@ -665,3 +671,236 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func openAndAcceptTopLevelProposal(
+	t *testing.T,
+	gsc *governanceContract,
+	blockChainHook *mock.BlockChainHookStub,
+	propType string,
+	arguments [][]byte,
+	gitHubCommit []byte,
+	validators ...[]byte,
+) {
+	whiteListedAddr := []byte("genesisAddr")
+	recipientAddr := []byte("recipientAddress")
+	startNonce := uint64(100)
+	stopNonce := uint64(1000)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	callInput := createVMInput(big.NewInt(100), propType, whiteListedAddr, recipientAddr)
+	callInput.Arguments = append(arguments, []byte(fmt.Sprintf("%d", startNonce)), []byte(fmt.Sprintf("%d", stopNonce)))
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	for _, validator := range validators {
+		voteProposal(t, gsc, validator, gitHubCommit, recipientAddr, "yes")
+	}
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return stopNonce + 1
+	}
+	closeProposal(t, gsc, whiteListedAddr, gitHubCommit, recipientAddr)
+}
+
+func TestGovernanceContract_FeatureActivationProposalErrors(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 5
+		},
+	}
+	gsc, _, _ := createGovernanceWithTwoValidators(blockChainHook)
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, []byte("addr1"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, []byte("genesisAddr"), recipientAddr)
+	eei := gsc.eei.(*vmContext)
+
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	callInput := createVMInput(big.NewInt(100), "featureActivation", []byte("genesisAddr"), recipientAddr)
+	callInput.Arguments = [][]byte{[]byte("10"), []byte("Feature-Name"), gitHubCommit, []byte("100"), []byte("1000")}
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "invalid feature name", eei.returnMessage)
+
+	eei.returnMessage = ""
+	callInput.Arguments[0] = []byte("5")
+	callInput.Arguments[1] = []byte("StakingV2EnableEpoch")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "invalid epoch to activate, it must be a future epoch", eei.returnMessage)
+
+	eei.returnMessage = ""
+	callInput.CallerAddr = []byte("not whitelisted")
+	callInput.Arguments[0] = []byte("10")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "called address is not whiteListed", eei.returnMessage)
+}
+
+func TestGovernanceContract_AcceptedProposalsShouldScheduleActivations(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 2
+		},
+	}
+	gsc, validatorAddress1, validatorAddress2 := createGovernanceWithTwoValidators(blockChainHook)
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, []byte("addr1"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, []byte("genesisAddr"), recipientAddr)
+
+	featureCommit := []byte("0123456789012345678901234567890123456789")
+	featureArgs := [][]byte{[]byte("10"), []byte("StakingV2EnableEpoch"), featureCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "featureActivation", featureArgs, featureCommit, validatorAddress1, validatorAddress2)
+
+	rejectedCommit := []byte("1123456789012345678901234567890123456789")
+	rejectedArgs := [][]byte{[]byte("12"), []byte("GasPriceModifierEnableEpoch"), rejectedCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "featureActivation", rejectedArgs, rejectedCommit, validatorAddress1)
+
+	hardForkCommit := []byte("2123456789012345678901234567890123456789")
+	hardForkArgs := [][]byte{[]byte("20"), []byte("v1.2.0"), hardForkCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "hardFork", hardForkArgs, hardForkCommit, validatorAddress1, validatorAddress2)
+
+	eei := gsc.eei.(*vmContext)
+	eei.output = make([][]byte, 0)
+	callInput := createVMInput(big.NewInt(0), "getScheduledActivations", []byte("anyone"), recipientAddr)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	expectedOutput := [][]byte{
+		[]byte("StakingV2EnableEpoch"), {10}, nil, featureCommit,
+		[]byte(HardForkActivationName), {20}, []byte("v1.2.0"), hardForkCommit,
+	}
+	require.Equal(t, expectedOutput, eei.output)
+}
+
+func TestGovernanceContract_AcceptedProposalWithTooCloseActivationShouldNotSchedule(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 2
+		},
+	}
+	gsc, validatorAddress1, validatorAddress2 := createGovernanceWithTwoValidators(blockChainHook)
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, []byte("addr1"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, []byte("genesisAddr"), recipientAddr)
+
+	featureCommit := []byte("0123456789012345678901234567890123456789")
+	featureArgs := [][]byte{[]byte("3"), []byte("GasPriceModifier"), featureCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "featureActivation", featureArgs, featureCommit, validatorAddress1, validatorAddress2)
+
+	eei := gsc.eei.(*vmContext)
+	eei.output = make([][]byte, 0)
+	callInput := createVMInput(big.NewInt(0), "getScheduledActivations", []byte("anyone"), recipientAddr)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 0, len(eei.output))
+}
+
+func TestGovernanceContract_AppliedActivationsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(2)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	gsc, validatorAddress1, validatorAddress2 := createGovernanceWithTwoValidators(blockChainHook)
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, []byte("addr1"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, []byte("genesisAddr"), recipientAddr)
+
+	firstCommit := []byte("0123456789012345678901234567890123456789")
+	firstArgs := [][]byte{[]byte("10"), []byte("GasPriceModifier"), firstCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "featureActivation", firstArgs, firstCommit, validatorAddress1, validatorAddress2)
+
+	otherFeatureCommit := []byte("1123456789012345678901234567890123456789")
+	otherFeatureArgs := [][]byte{[]byte("12"), []byte("BuiltInFunctions"), otherFeatureCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "featureActivation", otherFeatureArgs, otherFeatureCommit, validatorAddress1, validatorAddress2)
+
+	hardForkCommit := []byte("2123456789012345678901234567890123456789")
+	hardForkArgs := [][]byte{[]byte("10"), []byte("v1.2.0"), hardForkCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "hardFork", hardForkArgs, hardForkCommit, validatorAddress1, validatorAddress2)
+
+	currentEpoch = 15
+	secondCommit := []byte("3123456789012345678901234567890123456789")
+	secondArgs := [][]byte{[]byte("20"), []byte("GasPriceModifier"), secondCommit}
+	openAndAcceptTopLevelProposal(t, gsc, blockChainHook, "featureActivation", secondArgs, secondCommit, validatorAddress1, validatorAddress2)
+
+	eei := gsc.eei.(*vmContext)
+	eei.output = make([][]byte, 0)
+	callInput := createVMInput(big.NewInt(0), "getScheduledActivations", []byte("anyone"), recipientAddr)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	// the passed hardFork and the replaced feature activation are removed, the last activation of a feature is kept
+	expectedOutput := [][]byte{
+		[]byte("BuiltInFunctions"), {12}, nil, otherFeatureCommit,
+		[]byte("GasPriceModifier"), {20}, nil, secondCommit,
+	}
+	require.Equal(t, expectedOutput, eei.output)
+}
+
+func TestGovernanceContract_ScheduledActivationsNotEnabledShouldKeepTheOldBehavior(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 2
+		},
+	}
+	gsc, validatorAddress1, validatorAddress2 := createGovernanceWithTwoValidators(blockChainHook)
+	gsc.scheduledActivationsEnableEpoch = 3
+	gsc.EpochConfirmed(2)
+	recipientAddr := []byte("recipientAddress")
+	whiteListedAddr := []byte("genesisAddr")
+	initGovernanceSc(t, gsc, []byte("addr1"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, whiteListedAddr, recipientAddr)
+	eei := gsc.eei.(*vmContext)
+
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	callInput := createVMInput(big.NewInt(100), "featureActivation", whiteListedAddr, recipientAddr)
+	callInput.Arguments = [][]byte{[]byte("10"), []byte("GasPriceModifier"), gitHubCommit, []byte("100"), []byte("1000")}
+	require.Equal(t, vmcommon.FunctionNotFound, gsc.Execute(callInput))
+
+	callInput = createVMInput(big.NewInt(0), "getScheduledActivations", []byte("anyone"), recipientAddr)
+	require.Equal(t, vmcommon.FunctionNotFound, gsc.Execute(callInput))
+
+	// the hardFork proposal is saved under the epoch argument, as before
+	epochArgument := []byte("20")
+	hardForkArgs := [][]byte{epochArgument, []byte("v1.2.0"), gitHubCommit}
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	callInput = createVMInput(big.NewInt(100), "hardFork", whiteListedAddr, recipientAddr)
+	callInput.Arguments = append(hardForkArgs, []byte("100"), []byte("1000"))
+	require.Equal(t, vmcommon.Ok, gsc.Execute(callInput))
+	require.False(t, gsc.proposalExists(gitHubCommit))
+	require.True(t, gsc.proposalExists(epochArgument))
+	require.NotEqual(t, 0, len(eei.GetStorage(append([]byte(hardForkPrefix), gitHubCommit...))))
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 101
+	}
+	voteProposal(t, gsc, validatorAddress1, epochArgument, recipientAddr, "yes")
+	voteProposal(t, gsc, validatorAddress2, epochArgument, recipientAddr, "yes")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1001
+	}
+	closeProposal(t, gsc, whiteListedAddr, epochArgument, recipientAddr)
+
+	generalProposal, err := gsc.getGeneralProposal(epochArgument)
+	require.Nil(t, err)
+	require.True(t, generalProposal.Voted)
+	require.Equal(t, 0, len(eei.GetStorage([]byte(ScheduledActivationsKey))))
+}
@@ -168,6 +168,8 @@ func (s *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return s.unJail(args)
 	case "changeRewardAddress":
 		return s.changeRewardAddress(args)
+	case "changeOwnerAndRewardAddress":
+		return s.changeOwnerAndRewardAddress(args)
 	case "changeValidatorKeys":
 		return s.changeValidatorKey(args)
 	case "switchJailedWithWaiting":
@@ -309,6 +311,49 @@ func (s *stakingSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcomm
 	return vmcommon.Ok
 }
 
+func (s *stakingSC) changeOwnerAndRewardAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagStakingV2.IsSet() {
+		s.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, s.stakeAccessAddr) {
+		s.eei.AddReturnMessage("changeOwnerAndRewardAddress function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 2 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected min %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	newOwnerAddress := args.Arguments[0]
+	if len(newOwnerAddress) != s.walletAddressLen {
+		s.eei.AddReturnMessage("invalid owner address")
+		return vmcommon.UserError
+	}
+
+	for _, blsKey := range args.Arguments[1:] {
+		stakedData, err := s.getOrCreateRegisteredData(blsKey)
+		if err != nil {
+			s.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+			return vmcommon.UserError
+		}
+		if len(stakedData.RewardAddress) == 0 {
+			s.eei.AddReturnMessage("cannot change owner of a not registered key " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		stakedData.OwnerAddress = newOwnerAddress
+		stakedData.RewardAddress = newOwnerAddress
+		err = s.saveStakingData(blsKey, stakedData)
+		if err != nil {
+			s.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	return vmcommon.Ok
+}
+
 func (s *stakingSC) removeFromJailedNodes() {
 	stakeConfig := s.getConfig()
 	if stakeConfig.JailedNodes > 0 {
@@ -398,7 +443,9 @@ func (s *stakingSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 }
 
 func (s *stakingSC) jail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	if !bytes.Equal(args.CallerAddr, s.jailAccessAddr) {
+	// the validator SC jails the keys proven to have equivocated
+	isCallerAllowed := bytes.Equal(args.CallerAddr, s.jailAccessAddr) || bytes.Equal(args.CallerAddr, s.stakeAccessAddr)
+	if !isCallerAllowed {
 		return vmcommon.UserError
 	}
 
@@ -20,6 +20,7 @@ import (
 
 const unJailedFunds = "unJailFunds"
 const unStakeUnBondPauseKey = "unStakeUnBondPause"
+const slashedProofPrefix = "slashedProof"
 
 var zero = big.NewInt(0)
 
@@ -39,6 +40,7 @@ type validatorSC struct {
 	stakingV2Epoch        uint32
 	stakingSCAddress      []byte
 	validatorSCAddress    []byte
+	delegationMgrSCAddr   []byte
 	walletAddressLen      int
 	enableStakingEpoch    uint32
 	enableDoubleKeyEpoch  uint32
@@ -51,21 +53,32 @@ type validatorSC struct {
 	minDeposit            *big.Int
 	mutExecution          sync.RWMutex
 	endOfEpochAddress     []byte
+	proofVerifier         vm.EquivocationProofVerifier
+	slashingEnableEpoch   uint32
+	slashingPercentage    float64
+	flagSlashing          atomic.Flag
+
+	validatorToDelegationEnableEpoch uint32
+	flagValidatorToDelegation        atomic.Flag
 }
 
 // ArgsValidatorSmartContract is the arguments structure to create a new ValidatorSmartContract
 type ArgsValidatorSmartContract struct {
-	StakingSCConfig    config.StakingSystemSCConfig
-	GenesisTotalSupply *big.Int
-	Eei                vm.SystemEI
-	SigVerifier        vm.MessageSignVerifier
-	StakingSCAddress   []byte
-	ValidatorSCAddress []byte
-	GasCost            vm.GasCost
-	Marshalizer        marshal.Marshalizer
-	EpochNotifier      vm.EpochNotifier
-	EndOfEpochAddress  []byte
-	MinDeposit         string
+	StakingSCConfig        config.StakingSystemSCConfig
+	GenesisTotalSupply     *big.Int
+	Eei                    vm.SystemEI
+	SigVerifier            vm.MessageSignVerifier
+	StakingSCAddress       []byte
+	ValidatorSCAddress     []byte
+	DelegationMgrSCAddress []byte
+	GasCost                vm.GasCost
+	Marshalizer            marshal.Marshalizer
+	EpochNotifier          vm.EpochNotifier
+	EndOfEpochAddress      []byte
+	MinDeposit             string
+	ProofVerifier          vm.EquivocationProofVerifier
+
+	ValidatorToDelegationEnableEpoch uint32
 }
 
 // NewValidatorSmartContract creates an validator smart contract
@@ -81,6 +94,9 @@ func NewValidatorSmartContract(
 	if len(args.ValidatorSCAddress) == 0 {
 		return nil, vm.ErrNilValidatorSmartContractAddress
 	}
+	if len(args.DelegationMgrSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for delegation sc address", vm.ErrInvalidAddress)
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -96,6 +112,13 @@ func NewValidatorSmartContract(
 	if len(args.EndOfEpochAddress) < 1 {
 		return nil, vm.ErrInvalidEndOfEpochAccessAddress
 	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, vm.ErrNilEquivocationProofVerifier
+	}
+	slashingPercentage := args.StakingSCConfig.SlashingPercentage
+	if slashingPercentage < 0 || slashingPercentage > 1 {
+		return nil, fmt.Errorf("%w, value is %v", vm.ErrInvalidSlashingPercentage, slashingPercentage)
+	}
 
 	baseConfig := ValidatorConfig{
 		TotalSupply: big.NewInt(0).Set(args.GenesisTotalSupply),
@@ -136,6 +159,7 @@ func NewValidatorSmartContract(
 		enableStakingEpoch:    args.StakingSCConfig.StakeEnableEpoch,
 		stakingSCAddress:      args.StakingSCAddress,
 		validatorSCAddress:    args.ValidatorSCAddress,
+		delegationMgrSCAddr:   args.DelegationMgrSCAddress,
 		gasCost:               args.GasCost,
 		marshalizer:           args.Marshalizer,
 		minUnstakeTokensValue: minUnstakeTokensValue,
@@ -143,6 +167,11 @@ func NewValidatorSmartContract(
 		enableDoubleKeyEpoch:  args.StakingSCConfig.DoubleKeyProtectionEnableEpoch,
 		endOfEpochAddress:     args.EndOfEpochAddress,
 		minDeposit:            minDeposit,
+		proofVerifier:         args.ProofVerifier,
+		slashingEnableEpoch:   args.StakingSCConfig.SlashingEnableEpoch,
+		slashingPercentage:    slashingPercentage,
+
+		validatorToDelegationEnableEpoch: args.ValidatorToDelegationEnableEpoch,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
@@ -204,6 +233,12 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.getUnStakedTokensList(args)
 	case "reStakeUnStakedNodes":
 		return v.reStakeUnStakedNodes(args)
+	case "slash":
+		return v.slash(args)
+	case "changeOwnerOfValidatorData":
+		return v.changeOwnerOfValidatorData(args)
+	case "mergeValidatorData":
+		return v.mergeValidatorData(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -1749,13 +1784,312 @@ func (v *validatorSC) getTotalStakedTopUpStakedBlsKeys(args *vmcommon.ContractCa
 	return vmcommon.Ok
 }
 
-//nolint
-func (v *validatorSC) slash(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	// TODO: implement this. It is needed as last component of slashing. Slashing should happen to the funds of the
-	// validator which is running the nodes
+// slash punishes the validator key accused by the provided equivocation proof: the key is jailed and a percentage of
+// the node price is taken from the owner's stake and added to the unJail funds. Anyone can call this function, the
+// proof being verifiable only by using the accused public key. Each offence can be punished only once.
+func (v *validatorSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagSlashing.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.Slash)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	proof, err := v.proofVerifier.VerifyMarshalized(args.Arguments[0])
+	if err != nil {
+		v.eei.AddReturnMessage("invalid equivocation proof: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	proofKey := append([]byte(slashedProofPrefix), proof.ID()...)
+	if len(v.eei.GetStorage(proofKey)) > 0 {
+		v.eei.AddReturnMessage("equivocation was already punished")
+		return vmcommon.UserError
+	}
+
+	encodedBlsKey := hex.EncodeToString(proof.PubKey)
+	vmOutput, err := v.executeOnStakingSC([]byte("getOwner@" + encodedBlsKey))
+	if err != nil || vmOutput.ReturnCode != vmcommon.Ok || len(vmOutput.ReturnData) == 0 {
+		v.eei.AddReturnMessage("cannot get the owner of the accused key " + encodedBlsKey)
+		return vmcommon.UserError
+	}
+	ownerAddress := vmOutput.ReturnData[0]
+
+	vmOutput, err = v.executeOnStakingSC([]byte("jail@" + encodedBlsKey))
+	if err != nil || vmOutput.ReturnCode != vmcommon.Ok {
+		v.eei.AddReturnMessage("cannot jail the accused key " + encodedBlsKey)
+		return vmcommon.UserError
+	}
+
+	registrationData, err := v.getOrCreateRegistrationData(ownerAddress)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+
+	validatorConfig := v.getConfig(v.eei.BlockChainHook().CurrentEpoch())
+	slashedValue := core.GetIntTrimmedPercentageOfValue(validatorConfig.NodePrice, v.slashingPercentage)
+	if slashedValue.Cmp(registrationData.TotalStakeValue) > 0 {
+		slashedValue.Set(registrationData.TotalStakeValue)
+	}
+	registrationData.TotalStakeValue.Sub(registrationData.TotalStakeValue, slashedValue)
+
+	unStakedKeys, err := v.unStakeNodesNotCoveredByStake(registrationData, validatorConfig.NodePrice)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot unStake the nodes not covered by stake: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = v.saveRegistrationData(ownerAddress, registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	if v.isDelegationContract(ownerAddress) {
+		returnCode := v.slashDelegationContract(ownerAddress, slashedValue, unStakedKeys)
+		if returnCode != vmcommon.Ok {
+			return returnCode
+		}
+	}
+
+	v.addToUnJailFunds(slashedValue)
+	v.eei.SetStorage(proofKey, ownerAddress)
+	v.eei.Finish(slashedValue.Bytes())
+
 	return vmcommon.Ok
 }
 
+// unStakeNodesNotCoveredByStake unstakes the most recently added staked or waiting nodes of an owner until the
+// remaining stake covers all its active nodes again. Jailed nodes cannot be unstaked but still need collateral.
+func (v *validatorSC) unStakeNodesNotCoveredByStake(registrationData *ValidatorDataV2, nodePrice *big.Int) ([][]byte, error) {
+	numActiveNodes, listActiveNodes, err := v.getNumStakedAndWaitingNodes(registrationData, map[string]struct{}{}, false)
+	if err != nil {
+		return nil, err
+	}
+	if nodePrice.Cmp(zero) <= 0 {
+		return nil, nil
+	}
+
+	numCoveredNodes := big.NewInt(0).Div(registrationData.TotalStakeValue, nodePrice).Uint64()
+	unStakedKeys := make([][]byte, 0)
+	for i := len(listActiveNodes) - 1; i >= 0 && numActiveNodes > numCoveredNodes; i-- {
+		blsKey := listActiveNodes[i]
+		stakedData, errGet := v.getStakedData(blsKey)
+		if errGet != nil || stakedData.Jailed {
+			continue
+		}
+
+		vmOutput, errExec := v.executeOnStakingSC([]byte("unStake@" + hex.EncodeToString(blsKey) + "@" + hex.EncodeToString(registrationData.RewardAddress)))
+		if errExec != nil || vmOutput.ReturnCode != vmcommon.Ok {
+			v.eei.AddReturnMessage("cannot unStake not covered key " + hex.EncodeToString(blsKey))
+			continue
+		}
+
+		unStakedKeys = append(unStakedKeys, blsKey)
+		numActiveNodes--
+	}
+
+	return unStakedKeys, nil
+}
+
+func (v *validatorSC) isDelegationContract(address []byte) bool {
+	if !core.IsSmartContractAddress(address) {
+		return false
+	}
+
+	return len(v.eei.GetStorageFromAddress(address, []byte(delegationConfigKey))) > 0
+}
+
+// slashDelegationContract lowers the active stake of the delegation contract owning the slashed node by the slashed
+// value and informs it about the nodes which were unstaked as they were no longer covered
+func (v *validatorSC) slashDelegationContract(delegationAddress []byte, slashedValue *big.Int, unStakedKeys [][]byte) vmcommon.ReturnCode {
+	txData := "slashActive@" + hex.EncodeToString(slashedValue.Bytes())
+	for _, blsKey := range unStakedKeys {
+		txData += "@" + hex.EncodeToString(blsKey)
+	}
+
+	vmOutput, err := v.eei.ExecuteOnDestContext(delegationAddress, v.validatorSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		v.eei.AddReturnMessage("cannot slash the delegation contract: " + err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		v.eei.AddReturnMessage("cannot slash the delegation contract: " + vmOutput.ReturnCode.String())
+		return vmOutput.ReturnCode
+	}
+
+	return vmcommon.Ok
+}
+
+func (v *validatorSC) checkMoveValidatorDataArgs(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagValidatorToDelegation.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, v.delegationMgrSCAddr) {
+		v.eei.AddReturnMessage("function can be called only by the delegation manager")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[0]) != v.walletAddressLen || len(args.Arguments[1]) != v.walletAddressLen {
+		v.eei.AddReturnMessage("invalid address")
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// checkValidatorDataCanBeMoved verifies that a delegation contract can take over the validator's keys: all the keys
+// must be staked or in the waiting list, none of them jailed, and there must be no unstaked tokens left to unbond
+func (v *validatorSC) checkValidatorDataCanBeMoved(registrationData *ValidatorDataV2) error {
+	if len(registrationData.RewardAddress) == 0 || len(registrationData.BlsPubKeys) == 0 {
+		return fmt.Errorf("%w, no staked keys", vm.ErrValidatorDataCannotBeMoved)
+	}
+	if len(registrationData.UnstakedInfo) > 0 || registrationData.TotalUnstaked.Cmp(zero) > 0 {
+		return fmt.Errorf("%w, unstaked tokens must be unbonded first", vm.ErrValidatorDataCannotBeMoved)
+	}
+
+	for _, blsKey := range registrationData.BlsPubKeys {
+		stakedData, err := v.getStakedData(blsKey)
+		if err != nil {
+			return err
+		}
+		if stakedData.Jailed {
+			return fmt.Errorf("%w, key %s is jailed", vm.ErrValidatorDataCannotBeMoved, hex.EncodeToString(blsKey))
+		}
+		if !stakedData.Staked && !stakedData.Waiting {
+			return fmt.Errorf("%w, key %s must be unbonded first", vm.ErrValidatorDataCannotBeMoved, hex.EncodeToString(blsKey))
+		}
+	}
+
+	return nil
+}
+
+func (v *validatorSC) changeOwnerOfKeys(newOwnerAddress []byte, blsKeys [][]byte) vmcommon.ReturnCode {
+	txData := "changeOwnerAndRewardAddress@" + hex.EncodeToString(newOwnerAddress)
+	for _, blsKey := range blsKeys {
+		txData += "@" + hex.EncodeToString(blsKey)
+	}
+
+	vmOutput, err := v.executeOnStakingSC([]byte(txData))
+	if err != nil {
+		v.eei.AddReturnMessage("cannot change owner of keys: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmOutput.ReturnCode
+}
+
+func (v *validatorSC) changeOwnerOfValidatorData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := v.checkMoveValidatorDataArgs(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	oldAddress := args.Arguments[0]
+	newAddress := args.Arguments[1]
+	if len(v.eei.GetStorage(newAddress)) > 0 {
+		v.eei.AddReturnMessage("there is already validator data under the new address")
+		return vmcommon.UserError
+	}
+
+	registrationData, err := v.getOrCreateRegistrationData(oldAddress)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+	err = v.checkValidatorDataCanBeMoved(registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.ChangeRewardAddress * uint64(len(registrationData.BlsPubKeys)))
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	registrationData.RewardAddress = newAddress
+	err = v.saveRegistrationData(newAddress, registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	v.eei.SetStorage(oldAddress, nil)
+
+	return v.changeOwnerOfKeys(newAddress, registrationData.BlsPubKeys)
+}
+
+func (v *validatorSC) mergeValidatorData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := v.checkMoveValidatorDataArgs(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	oldAddress := args.Arguments[0]
+	delegationAddress := args.Arguments[1]
+	oldData, err := v.getOrCreateRegistrationData(oldAddress)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+	err = v.checkValidatorDataCanBeMoved(oldData)
+	if err != nil {
+		v.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	delegationData, err := v.getOrCreateRegistrationData(delegationAddress)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+	if len(delegationData.RewardAddress) == 0 {
+		v.eei.AddReturnMessage("there is no validator data under the delegation address")
+		return vmcommon.UserError
+	}
+
+	err = v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.ChangeRewardAddress * uint64(len(oldData.BlsPubKeys)))
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	delegationData.BlsPubKeys = append(delegationData.BlsPubKeys, oldData.BlsPubKeys...)
+	delegationData.NumRegistered += oldData.NumRegistered
+	delegationData.TotalStakeValue.Add(delegationData.TotalStakeValue, oldData.TotalStakeValue)
+	delegationData.LockedStake.Add(delegationData.LockedStake, oldData.LockedStake)
+	err = v.saveRegistrationData(delegationAddress, delegationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	v.eei.SetStorage(oldAddress, nil)
+
+	return v.changeOwnerOfKeys(delegationAddress, oldData.BlsPubKeys)
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (v *validatorSC) EpochConfirmed(epoch uint32) {
 	v.flagEnableStaking.Toggle(epoch >= v.enableStakingEpoch)
@@ -1767,6 +2101,12 @@ func (v *validatorSC) EpochConfirmed(epoch uint32) {
 	v.flagDoubleKey.Toggle(epoch >= v.enableDoubleKeyEpoch)
 	log.Debug("stakingAuctionSC: doubleKeyProtection", "enabled", v.flagDoubleKey.IsSet())
 
+	v.flagSlashing.Toggle(epoch >= v.slashingEnableEpoch)
+	log.Debug("validatorSC: slashing", "enabled", v.flagSlashing.IsSet())
+
+	v.flagValidatorToDelegation.Toggle(epoch >= v.validatorToDelegationEnableEpoch)
+	log.Debug("validatorSC: validator to delegation", "enabled", v.flagValidatorToDelegation.IsSet())
+
 }
 
 // CanUseContract returns true if contract can be used
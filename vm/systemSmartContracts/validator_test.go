@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -27,11 +28,12 @@ import (
 
 func createMockArgumentsForValidatorSC() ArgsValidatorSmartContract {
 	args := ArgsValidatorSmartContract{
-		Eei:                &mock.SystemEIStub{},
-		SigVerifier:        &mock.MessageSignVerifierMock{},
-		ValidatorSCAddress: []byte("validator"),
-		StakingSCAddress:   []byte("staking"),
-		EndOfEpochAddress:  []byte("endOfEpoch"),
+		Eei:                    &mock.SystemEIStub{},
+		SigVerifier:            &mock.MessageSignVerifierMock{},
+		ValidatorSCAddress:     []byte("validator"),
+		StakingSCAddress:       []byte("staking"),
+		EndOfEpochAddress:      []byte("endOfEpoch"),
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
 		StakingSCConfig: config.StakingSystemSCConfig{
 			GenesisNodePrice:                     "1000",
 			UnJailValue:                          "10",
@@ -51,6 +53,7 @@ func createMockArgumentsForValidatorSC() ArgsValidatorSmartContract {
 		GenesisTotalSupply: big.NewInt(100000000),
 		EpochNotifier:      &mock.EpochNotifierStub{},
 		MinDeposit:         "0",
+		ProofVerifier:      &mock.EquivocationProofVerifierStub{},
 	}
 
 	return args
@@ -456,6 +459,380 @@ func TestStakingValidatorSC_ExecuteStakeUnJail(t *testing.T) {
 	assert.Equal(t, vmcommon.UserError, retCode)
 }
 
+func TestNewStakingValidatorSmartContract_NilProofVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.ProofVerifier = nil
+	sc, err := NewValidatorSmartContract(args)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, vm.ErrNilEquivocationProofVerifier, err)
+}
+
+func TestNewStakingValidatorSmartContract_InvalidSlashingPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.SlashingPercentage = 1.01
+	sc, err := NewValidatorSmartContract(args)
+	assert.Nil(t, sc)
+	assert.True(t, errors.Is(err, vm.ErrInvalidSlashingPercentage))
+
+	args.StakingSCConfig.SlashingPercentage = -0.01
+	sc, err = NewValidatorSmartContract(args)
+	assert.Nil(t, sc)
+	assert.True(t, errors.Is(err, vm.ErrInvalidSlashingPercentage))
+}
+
+func TestStakingValidatorSC_SlashNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.SlashingEnableEpoch = 1
+	sc, _ := NewValidatorSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(arguments))
+}
+
+func TestStakingValidatorSC_SlashInvalidProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyMarshalizedCalled: func(buff []byte) (*slashing.EquivocationProof, error) {
+			return nil, errors.New("invalid signature")
+		},
+	}
+	sc, _ := NewValidatorSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(arguments))
+}
+
+func TestStakingValidatorSC_SlashShouldJailAndSlashOnlyOnce(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("address")
+	stakerPubKey := []byte("blsPubKey")
+	nodePrice := big.NewInt(10000000)
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.StakingV2Epoch = 0
+	args.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	args.StakingSCConfig.SlashingPercentage = 0.1
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyMarshalizedCalled: func(buff []byte) (*slashing.EquivocationProof, error) {
+			return &slashing.EquivocationProof{PubKey: stakerPubKey, Round: 37}, nil
+		},
+	}
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	argsStaking.StakingSCConfig.StakingV2Epoch = 0
+	argsStaking.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return stakingSc, nil
+	}})
+	args.Eei = eei
+	sc, _ := NewValidatorSmartContract(args)
+	eei.SetSCAddress(args.ValidatorSCAddress)
+	stake(t, sc, nodePrice, args.ValidatorSCAddress, stakerAddress, stakerPubKey, big.NewInt(1).Bytes())
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = []byte("reporter")
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	retCode := sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	registrationData, _ := sc.getOrCreateRegistrationData(stakerAddress)
+	assert.Equal(t, big.NewInt(9000000), registrationData.TotalStakeValue)
+	assert.Equal(t, big.NewInt(1000000).Bytes(), eei.GetStorage([]byte(unJailedFunds)))
+
+	stakedData := &StakedDataV2_0{}
+	_ = args.Marshalizer.Unmarshal(stakedData, eei.GetStorageFromAddress(args.StakingSCAddress, stakerPubKey))
+	assert.True(t, stakedData.Jailed)
+
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "equivocation was already punished", eei.returnMessage)
+}
+
+func TestStakingValidatorSC_SlashShouldUnStakeNodesNotCoveredAnymore(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("address")
+	stakerPubKey1 := []byte("blsPubKey1")
+	stakerPubKey2 := []byte("blsPubKey2")
+	nodePrice := big.NewInt(10000000)
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.StakingV2Epoch = 0
+	args.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	args.StakingSCConfig.SlashingPercentage = 0.1
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyMarshalizedCalled: func(buff []byte) (*slashing.EquivocationProof, error) {
+			return &slashing.EquivocationProof{PubKey: stakerPubKey1, Round: 37}, nil
+		},
+	}
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	argsStaking.StakingSCConfig.StakingV2Epoch = 0
+	argsStaking.StakingSCConfig.MaxNumberOfNodesForStake = 10
+	argsStaking.MinNumNodes = 0
+	argsStaking.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return stakingSc, nil
+	}})
+	args.Eei = eei
+	sc, _ := NewValidatorSmartContract(args)
+	eei.SetSCAddress(args.ValidatorSCAddress)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = stakerAddress
+	arguments.Arguments = [][]byte{big.NewInt(2).Bytes(), stakerPubKey1, []byte("signed"), stakerPubKey2, []byte("signed")}
+	arguments.CallValue = big.NewInt(0).Mul(nodePrice, big.NewInt(2))
+	require.Equal(t, vmcommon.Ok, sc.Execute(arguments))
+
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = []byte("reporter")
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	retCode := sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	registrationData, _ := sc.getOrCreateRegistrationData(stakerAddress)
+	assert.Equal(t, big.NewInt(19000000), registrationData.TotalStakeValue)
+
+	stakedData := &StakedDataV2_0{}
+	_ = args.Marshalizer.Unmarshal(stakedData, eei.GetStorageFromAddress(args.StakingSCAddress, stakerPubKey1))
+	assert.True(t, stakedData.Jailed)
+
+	stakedData = &StakedDataV2_0{}
+	_ = args.Marshalizer.Unmarshal(stakedData, eei.GetStorageFromAddress(args.StakingSCAddress, stakerPubKey2))
+	assert.False(t, stakedData.Staked)
+	assert.False(t, stakedData.Waiting)
+	assert.False(t, stakedData.Jailed)
+}
+
+func TestStakingValidatorSC_SlashWithInvalidStakedDataShouldReturnUserError(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("address")
+	stakerPubKey1 := []byte("blsPubKey1")
+	stakerPubKey2 := []byte("blsPubKey2")
+	nodePrice := big.NewInt(10000000)
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.StakingV2Epoch = 0
+	args.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	args.StakingSCConfig.SlashingPercentage = 0.1
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyMarshalizedCalled: func(buff []byte) (*slashing.EquivocationProof, error) {
+			return &slashing.EquivocationProof{PubKey: stakerPubKey1, Round: 37}, nil
+		},
+	}
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	argsStaking.StakingSCConfig.StakingV2Epoch = 0
+	argsStaking.StakingSCConfig.MaxNumberOfNodesForStake = 10
+	argsStaking.MinNumNodes = 0
+	argsStaking.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return stakingSc, nil
+	}})
+	args.Eei = eei
+	sc, _ := NewValidatorSmartContract(args)
+	eei.SetSCAddress(args.ValidatorSCAddress)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = stakerAddress
+	arguments.Arguments = [][]byte{big.NewInt(2).Bytes(), stakerPubKey1, []byte("signed"), stakerPubKey2, []byte("signed")}
+	arguments.CallValue = big.NewInt(0).Mul(nodePrice, big.NewInt(2))
+	require.Equal(t, vmcommon.Ok, sc.Execute(arguments))
+
+	eei.SetStorageForAddress(args.StakingSCAddress, stakerPubKey2, []byte("invalid staked data"))
+
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = []byte("reporter")
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "cannot unStake the nodes not covered by stake"))
+}
+
+func createValidatorSCWithStakingSC(nodePrice *big.Int) (*validatorSC, *vmContext, ArgsValidatorSmartContract) {
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.StakingV2Epoch = 0
+	args.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	argsStaking.StakingSCConfig.StakingV2Epoch = 0
+	argsStaking.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return stakingSc, nil
+	}})
+	args.Eei = eei
+	sc, _ := NewValidatorSmartContract(args)
+	eei.SetSCAddress(args.ValidatorSCAddress)
+
+	return sc, eei, args
+}
+
+func createMoveValidatorDataInput(function string, caller []byte, oldAddress []byte, newAddress []byte) *vmcommon.ContractCallInput {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = function
+	arguments.CallerAddr = caller
+	arguments.Arguments = [][]byte{oldAddress, newAddress}
+
+	return arguments
+}
+
+func TestStakingValidatorSC_MoveValidatorDataBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodePrice := big.NewInt(1000)
+	sc, eei, args := createValidatorSCWithStakingSC(nodePrice)
+	stake(t, sc, nodePrice, sc.validatorSCAddress, []byte("staker001"), []byte("blsKey1"), big.NewInt(1).Bytes())
+
+	// the top up mechanism is enabled, but the validator data can not be moved yet
+	sc.validatorToDelegationEnableEpoch = 1
+	sc.EpochConfirmed(0)
+
+	arguments := createMoveValidatorDataInput("changeOwnerOfValidatorData", args.DelegationMgrSCAddress, []byte("staker001"), []byte("delegat01"))
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "invalid method to call", eei.returnMessage)
+
+	eei.CleanCache()
+	arguments = createMoveValidatorDataInput("mergeValidatorData", args.DelegationMgrSCAddress, []byte("staker001"), []byte("delegat01"))
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "invalid method to call", eei.returnMessage)
+}
+
+func TestStakingValidatorSC_ChangeOwnerOfValidatorDataNotDelegationManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodePrice := big.NewInt(1000)
+	sc, eei, _ := createValidatorSCWithStakingSC(nodePrice)
+	stake(t, sc, nodePrice, sc.validatorSCAddress, []byte("staker001"), []byte("blsKey1"), big.NewInt(1).Bytes())
+
+	arguments := createMoveValidatorDataInput("changeOwnerOfValidatorData", []byte("staker001"), []byte("staker001"), []byte("delegat01"))
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "function can be called only by the delegation manager", eei.returnMessage)
+}
+
+func TestStakingValidatorSC_ChangeOwnerOfValidatorDataJailedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodePrice := big.NewInt(1000)
+	blsKey := []byte("blsKey1")
+	sc, eei, args := createValidatorSCWithStakingSC(nodePrice)
+	stake(t, sc, nodePrice, sc.validatorSCAddress, []byte("staker001"), blsKey, big.NewInt(1).Bytes())
+
+	stakedData, _ := sc.getStakedData(blsKey)
+	stakedData.Jailed = true
+	marshaledData, _ := args.Marshalizer.Marshal(stakedData)
+	eei.SetStorageForAddress(args.StakingSCAddress, blsKey, marshaledData)
+
+	arguments := createMoveValidatorDataInput("changeOwnerOfValidatorData", args.DelegationMgrSCAddress, []byte("staker001"), []byte("delegat01"))
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrValidatorDataCannotBeMoved.Error()))
+	assert.True(t, strings.Contains(eei.returnMessage, "is jailed"))
+}
+
+func TestStakingValidatorSC_ChangeOwnerOfValidatorDataUnstakedTokensShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodePrice := big.NewInt(1000)
+	sc, eei, args := createValidatorSCWithStakingSC(nodePrice)
+	stake(t, sc, nodePrice, sc.validatorSCAddress, []byte("staker001"), []byte("blsKey1"), big.NewInt(1).Bytes())
+
+	registrationData, _ := sc.getOrCreateRegistrationData([]byte("staker001"))
+	registrationData.UnstakedInfo = append(registrationData.UnstakedInfo, &UnstakedValue{UnstakedValue: big.NewInt(10)})
+	_ = sc.saveRegistrationData([]byte("staker001"), registrationData)
+
+	arguments := createMoveValidatorDataInput("changeOwnerOfValidatorData", args.DelegationMgrSCAddress, []byte("staker001"), []byte("delegat01"))
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "unstaked tokens must be unbonded first"))
+}
+
+func TestStakingValidatorSC_ChangeOwnerOfValidatorDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nodePrice := big.NewInt(1000)
+	blsKey := []byte("blsKey1")
+	oldAddress := []byte("staker001")
+	newAddress := []byte("delegat01")
+	sc, eei, args := createValidatorSCWithStakingSC(nodePrice)
+	stake(t, sc, nodePrice, sc.validatorSCAddress, oldAddress, blsKey, big.NewInt(1).Bytes())
+
+	arguments := createMoveValidatorDataInput("changeOwnerOfValidatorData", args.DelegationMgrSCAddress, oldAddress, newAddress)
+	retCode := sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, 0, len(eei.GetStorage(oldAddress)))
+	registrationData, _ := sc.getOrCreateRegistrationData(newAddress)
+	assert.Equal(t, [][]byte{blsKey}, registrationData.BlsPubKeys)
+	assert.Equal(t, nodePrice, registrationData.TotalStakeValue)
+	assert.Equal(t, newAddress, registrationData.RewardAddress)
+
+	stakedData, _ := sc.getStakedData(blsKey)
+	assert.Equal(t, newAddress, stakedData.OwnerAddress)
+	assert.Equal(t, newAddress, stakedData.RewardAddress)
+
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingValidatorSC_MergeValidatorDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nodePrice := big.NewInt(1000)
+	validatorAddress := []byte("staker001")
+	delegationAddress := []byte("delegat01")
+	sc, eei, args := createValidatorSCWithStakingSC(nodePrice)
+	stake(t, sc, nodePrice, sc.validatorSCAddress, validatorAddress, []byte("blsKey1"), big.NewInt(1).Bytes())
+	stake(t, sc, nodePrice, sc.validatorSCAddress, delegationAddress, []byte("blsKey2"), big.NewInt(1).Bytes())
+
+	arguments := createMoveValidatorDataInput("mergeValidatorData", args.DelegationMgrSCAddress, validatorAddress, delegationAddress)
+	retCode := sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, 0, len(eei.GetStorage(validatorAddress)))
+	registrationData, _ := sc.getOrCreateRegistrationData(delegationAddress)
+	assert.Equal(t, [][]byte{[]byte("blsKey2"), []byte("blsKey1")}, registrationData.BlsPubKeys)
+	assert.Equal(t, big.NewInt(2000), registrationData.TotalStakeValue)
+
+	stakedData, _ := sc.getStakedData([]byte("blsKey1"))
+	assert.Equal(t, delegationAddress, stakedData.OwnerAddress)
+	assert.Equal(t, delegationAddress, stakedData.RewardAddress)
+}
+
 func TestStakingValidatorSC_ExecuteStakeUnStakeOneBlsPubKey(t *testing.T) {
 	t.Parallel()
 